@@ -0,0 +1,30 @@
+#!/usr/bin/env bash
+# Copyright 2018 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+# Applies the patches carried against vendored dependencies, which are listed
+# in build/vendor-patches/<dependency>/ and applied in order. Run this after
+# `dep ensure`. A dependency's patches must be removed when it is updated in
+# Gopkg.toml to a release that includes them.
+
+REPO_ROOT="$(cd "$(dirname "${BASH_SOURCE}")/.." && pwd)"
+cd "${REPO_ROOT}"
+
+for patch in build/vendor-patches/*/*.patch; do
+    git apply --whitespace=nowarn "${patch}"
+done
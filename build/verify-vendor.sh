@@ -18,8 +18,9 @@ set -o nounset
 set -o pipefail
 
 dep ensure --vendor-only
+"$(dirname "${BASH_SOURCE}")/apply-vendor-patches.sh"
 if [[ -n "$(git status --porcelain vendor)" ]]; then
-    echo 'vendor/ is out-of-date: run `dep ensure --vendor-only` and then check in the changes'
+    echo 'vendor/ is out-of-date: run `dep ensure --vendor-only && build/apply-vendor-patches.sh` and then check in the changes'
     git status --porcelain vendor
     dep version
     exit 1
@@ -23,8 +23,11 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 
 	// Admission controllers
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/adoption"
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/broker/authsarcheck"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/dryrun"
 	siclifecycle "github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/servicebindings/lifecycle"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceinstance/deletionprotection"
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceplan/changevalidator"
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceplan/defaultserviceplan"
 )
@@ -35,4 +38,7 @@ func registerAllAdmissionPlugins(plugins *admission.Plugins) {
 	siclifecycle.Register(plugins)
 	changevalidator.Register(plugins)
 	authsarcheck.Register(plugins)
+	deletionprotection.Register(plugins)
+	dryrun.Register(plugins)
+	adoption.Register(plugins)
 }
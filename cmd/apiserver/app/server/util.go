@@ -19,6 +19,7 @@ package server
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"strings"
 	"time"
 
@@ -44,6 +45,7 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
 	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
 	"github.com/kubernetes-incubator/service-catalog/pkg/openapi"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/dryrun"
 	"github.com/kubernetes-incubator/service-catalog/pkg/util/kube"
 	"github.com/kubernetes-incubator/service-catalog/pkg/version"
 )
@@ -113,6 +115,12 @@ func buildGenericConfig(s *ServiceCatalogServerOptions) (*genericapiserver.Recom
 	}
 
 	genericConfig.SwaggerConfig = genericapiserver.DefaultSwaggerConfig()
+	// Dry runs are requested with a query parameter, which the REST storage
+	// of instances and bindings cannot see, so it is recorded in the
+	// request's context once the request has been authorized.
+	genericConfig.BuildHandlerChainFunc = func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		return genericapiserver.DefaultBuildHandlerChain(dryrun.WithDryRun(apiHandler, c.Serializer), c)
+	}
 	// TODO: investigate if we need metrics unique to service catalog, but take defaults for now
 	// see https://github.com/kubernetes-incubator/service-catalog/issues/677
 	genericConfig.EnableMetrics = true
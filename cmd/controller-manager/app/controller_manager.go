@@ -18,7 +18,9 @@ limitations under the License.
 package app
 
 import (
+	"crypto/rand"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/http/pprof"
@@ -27,12 +29,14 @@ import (
 	"strconv"
 	"time"
 
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"k8s.io/api/core/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/record"
@@ -45,6 +49,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/server/healthz"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
@@ -58,7 +63,12 @@ import (
 	servicecatalogv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	settingsv1alpha1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1"
 	servicecataloginformers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions"
+	servicecatalogv1beta1informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+	"github.com/kubernetes-incubator/service-catalog/pkg/controller/sharding"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/multinamespace"
+	"github.com/kubernetes-incubator/service-catalog/pkg/notifications"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
@@ -100,6 +110,35 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		glog.Warning("program option --port is obsolete and ignored, specify --secure-port instead")
 	}
 
+	if len(controllerManagerOptions.WatchNamespaces) > 0 && controllerManagerOptions.WatchNamespaceSelector != "" {
+		return fmt.Errorf("--watch-namespaces and --watch-namespace-selector cannot be used together")
+	}
+	if _, err := labels.Parse(controllerManagerOptions.WatchNamespaceSelector); err != nil {
+		return fmt.Errorf("invalid --watch-namespace-selector: %v", err)
+	}
+	if controllerManagerOptions.IgnoreClusterScopedResources && !watchesNamespaces(controllerManagerOptions) {
+		return fmt.Errorf("--ignore-cluster-scoped-resources requires --watch-namespaces or --watch-namespace-selector")
+	}
+
+	var brokerCallbacks *controller.BrokerCallbacks
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.BrokerCallbacks) {
+		if controllerManagerOptions.BrokerCallbackURL == "" {
+			return fmt.Errorf("the BrokerCallbacks feature requires --broker-callback-url")
+		}
+		// With leader election or sharding, a callback may reach a replica
+		// other than the one that sent the token, so every replica must
+		// derive tokens from the same key.
+		if controllerManagerOptions.BrokerCallbackKeyFile == "" &&
+			(controllerManagerOptions.LeaderElection.LeaderElect || controllerManagerOptions.Shards > 0) {
+			return fmt.Errorf("the BrokerCallbacks feature requires --broker-callback-key-file when --leader-elect or --shards is set")
+		}
+		key, err := loadBrokerCallbackKey(controllerManagerOptions.BrokerCallbackKeyFile)
+		if err != nil {
+			return err
+		}
+		brokerCallbacks = controller.NewBrokerCallbacks(controllerManagerOptions.BrokerCallbackURL, key)
+	}
+
 	// Build the K8s kubeconfig / client / clientBuilder
 	glog.V(4).Info("Building k8s kubeconfig")
 
@@ -169,6 +208,9 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		healthz.InstallHandler(mux, healthz.PingHealthz, apiAvailableChecker)
 		configz.InstallHandler(mux)
 		metrics.RegisterMetricsAndInstallHandler(mux)
+		if brokerCallbacks != nil {
+			mux.Handle(controller.BrokerCallbackPath, brokerCallbacks)
+		}
 
 		if controllerManagerOptions.EnableProfiling {
 			mux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -210,6 +252,10 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 	defer recordingWatch.Stop()
 	recorder := eventBroadcaster.NewRecorder(eventsScheme, v1.EventSource{Component: controllerManagerAgentName})
 
+	// shardManager shares the shards of the keyspace out between the
+	// replicas when the controller is sharded
+	var shardManager *sharding.Manager
+
 	// 'run' is the logic to run the controllers for the controller manager
 	run := func(stop <-chan struct{}) {
 		serviceCatalogClientBuilder := controller.SimpleClientBuilder{
@@ -228,12 +274,15 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		// 	k8sClientBuilder = rootClientBuilder
 		// }
 
-		err := StartControllers(controllerManagerOptions, k8sKubeconfig, serviceCatalogClientBuilder, recorder, stop)
+		err := StartControllers(controllerManagerOptions, k8sKubeconfig, serviceCatalogClientBuilder, recorder, shardManager, brokerCallbacks, stop)
 		glog.Fatalf("error running controllers: %v", err)
 		panic("unreachable")
 	}
 
 	if !controllerManagerOptions.LeaderElection.LeaderElect {
+		if controllerManagerOptions.Shards > 0 {
+			return fmt.Errorf("--shards requires --leader-elect")
+		}
 		run(make(<-chan (struct{})))
 		panic("unreachable")
 	}
@@ -244,6 +293,29 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		return err
 	}
 
+	if controllerManagerOptions.Shards > 0 {
+		glog.V(5).Infof("Using namespace %v for the shard leases", controllerManagerOptions.LeaderElectionNamespace)
+		shardManager, err = sharding.NewManager(sharding.Config{
+			Client:        leaderElectionClient.CoreV1(),
+			LockType:      controllerManagerOptions.LeaderElection.ResourceLock,
+			Namespace:     controllerManagerOptions.LeaderElectionNamespace,
+			Name:          "service-catalog-controller-manager",
+			Identity:      id + "-external-service-catalog-controller",
+			Shards:        controllerManagerOptions.Shards,
+			ShardKey:      controllerManagerOptions.ShardKey,
+			LeaseDuration: controllerManagerOptions.LeaderElection.LeaseDuration.Duration,
+			RenewDeadline: controllerManagerOptions.LeaderElection.RenewDeadline.Duration,
+			RetryPeriod:   controllerManagerOptions.LeaderElection.RetryPeriod.Duration,
+		})
+		if err != nil {
+			return err
+		}
+		// Every replica runs the controllers, reconciling the keys of the
+		// shards it holds
+		run(make(<-chan (struct{})))
+		panic("unreachable")
+	}
+
 	glog.V(5).Infof("Using namespace %v for leader election lock", controllerManagerOptions.LeaderElectionNamespace)
 
 	// Lock required for leader election
@@ -326,6 +398,8 @@ func StartControllers(s *options.ControllerManagerServer,
 	coreKubeconfig *rest.Config,
 	serviceCatalogClientBuilder controller.ClientBuilder,
 	recorder record.EventRecorder,
+	shardManager *sharding.Manager,
+	brokerCallbacks *controller.BrokerCallbacks,
 	stop <-chan struct{}) error {
 
 	// When Catalog Controller and Catalog API Server are started at the
@@ -366,18 +440,89 @@ func StartControllers(s *options.ControllerManagerServer,
 	// All shared informers are v1beta1 API level
 	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
 
+	// Build the informer factory for the core resources the controller reads,
+	// such as the secrets holding broker credentials
+	coreInformerFactory := kubeinformers.NewSharedInformerFactory(coreClient, s.ResyncInterval)
+
+	instanceInformer := serviceCatalogSharedInformers.ServiceInstances()
+	bindingInformer := serviceCatalogSharedInformers.ServiceBindings()
+	serviceBrokerInformer := serviceCatalogSharedInformers.ServiceBrokers()
+	serviceClassInformer := serviceCatalogSharedInformers.ServiceClasses()
+	servicePlanInformer := serviceCatalogSharedInformers.ServicePlans()
+	secretInformer := coreInformerFactory.Core().V1().Secrets()
+
+	// A controller restricted to a set of namespaces watches each of them
+	// with its own informers, instead of listing resources cluster-wide
+	var namespaceInformers *multinamespace.Informers
+	if watchesNamespaces(s) {
+		glog.V(5).Infof("Restricting the controller to namespaces %v, selector %q", s.WatchNamespaces, s.WatchNamespaceSelector)
+		namespaceInformers = multinamespace.NewInformers(
+			serviceCatalogClientBuilder.ClientOrDie("namespace-informers"),
+			coreClient,
+			s.ResyncInterval,
+			s.WatchNamespaces...,
+		)
+		if s.WatchNamespaceSelector != "" {
+			selector, err := labels.Parse(s.WatchNamespaceSelector)
+			if err != nil {
+				return err
+			}
+			multinamespace.WatchNamespaceSelector(coreInformerFactory.Core().V1().Namespaces(), selector, namespaceInformers)
+		}
+		instanceInformer = namespaceInformers.ServiceInstances()
+		bindingInformer = namespaceInformers.ServiceBindings()
+		serviceBrokerInformer = namespaceInformers.ServiceBrokers()
+		serviceClassInformer = namespaceInformers.ServiceClasses()
+		servicePlanInformer = namespaceInformers.ServicePlans()
+		secretInformer = namespaceInformers.Secrets()
+	}
+
+	// Cluster-scoped resources are not watched at all when ignored
+	var clusterServiceBrokerInformer servicecatalogv1beta1informers.ClusterServiceBrokerInformer
+	var clusterServiceClassInformer servicecatalogv1beta1informers.ClusterServiceClassInformer
+	var clusterServicePlanInformer servicecatalogv1beta1informers.ClusterServicePlanInformer
+	if !s.IgnoreClusterScopedResources {
+		clusterServiceBrokerInformer = serviceCatalogSharedInformers.ClusterServiceBrokers()
+		clusterServiceClassInformer = serviceCatalogSharedInformers.ClusterServiceClasses()
+		clusterServicePlanInformer = serviceCatalogSharedInformers.ClusterServicePlans()
+	}
+
+	// A nil *sharding.Manager must not be passed as a non-nil ShardFilter
+	var shards controller.ShardFilter
+	if shardManager != nil {
+		shards = shardManager
+	}
+
+	// Lifecycle events are delivered to notification sinks by a dispatcher
+	// the controller notifies
+	var notifier controller.Notifier
+	var dispatcher *notifications.Dispatcher
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NotificationSinks) {
+		notificationSinkInformer := serviceCatalogSharedInformers.NotificationSinks()
+		if namespaceInformers != nil {
+			notificationSinkInformer = namespaceInformers.NotificationSinks()
+		}
+		var clusterNotificationSinkInformer servicecatalogv1beta1informers.ClusterNotificationSinkInformer
+		if !s.IgnoreClusterScopedResources {
+			clusterNotificationSinkInformer = serviceCatalogSharedInformers.ClusterNotificationSinks()
+		}
+		dispatcher = notifications.NewDispatcher(coreClient, secretInformer, clusterNotificationSinkInformer, notificationSinkInformer, s.NotificationSinkAllowedHosts, s.NotificationRetries, s.NotificationTimeout)
+		notifier = dispatcher
+	}
+
 	glog.V(5).Infof("Creating controller; broker relist interval: %v", s.ServiceBrokerRelistInterval)
 	serviceCatalogController, err := controller.NewController(
 		coreClient,
 		serviceCatalogClientBuilder.ClientOrDie(controllerManagerAgentName).ServicecatalogV1beta1(),
-		serviceCatalogSharedInformers.ClusterServiceBrokers(),
-		serviceCatalogSharedInformers.ServiceBrokers(),
-		serviceCatalogSharedInformers.ClusterServiceClasses(),
-		serviceCatalogSharedInformers.ServiceClasses(),
-		serviceCatalogSharedInformers.ServiceInstances(),
-		serviceCatalogSharedInformers.ServiceBindings(),
-		serviceCatalogSharedInformers.ClusterServicePlans(),
-		serviceCatalogSharedInformers.ServicePlans(),
+		clusterServiceBrokerInformer,
+		serviceBrokerInformer,
+		clusterServiceClassInformer,
+		serviceClassInformer,
+		instanceInformer,
+		bindingInformer,
+		clusterServicePlanInformer,
+		servicePlanInformer,
+		secretInformer,
 		osbclientproxy.NewClient,
 		s.ServiceBrokerRelistInterval,
 		s.OSBAPIPreferredVersion,
@@ -386,6 +531,9 @@ func StartControllers(s *options.ControllerManagerServer,
 		s.OperationPollingMaximumBackoffDuration,
 		s.ClusterIDConfigMapName,
 		s.ClusterIDConfigMapNamespace,
+		shards,
+		notifier,
+		brokerCallbacks,
 	)
 	if err != nil {
 		return err
@@ -393,9 +541,29 @@ func StartControllers(s *options.ControllerManagerServer,
 
 	glog.V(1).Info("Starting shared informers")
 	informerFactory.Start(stop)
+	coreInformerFactory.Start(stop)
 
 	glog.V(5).Info("Waiting for caches to sync")
 	informerFactory.WaitForCacheSync(stop)
+	coreInformerFactory.WaitForCacheSync(stop)
+
+	if namespaceInformers != nil {
+		// The namespaces matching the selector have been added once the
+		// namespace informer synced
+		glog.V(1).Info("Starting namespace informers")
+		namespaceInformers.Start(stop)
+		namespaceInformers.WaitForCacheSync(stop)
+	}
+
+	if shardManager != nil {
+		glog.V(5).Info("Taking shard leases")
+		go shardManager.Run(stop)
+	}
+
+	if dispatcher != nil {
+		glog.V(5).Info("Running notification dispatcher")
+		go dispatcher.Run(s.ConcurrentSyncs, stop)
+	}
 
 	glog.V(5).Info("Running controller")
 	go serviceCatalogController.Run(s.ConcurrentSyncs, stop)
@@ -403,6 +571,33 @@ func StartControllers(s *options.ControllerManagerServer,
 	select {}
 }
 
+// watchesNamespaces returns whether the controller is restricted to a set of
+// namespaces.
+func watchesNamespaces(s *options.ControllerManagerServer) bool {
+	return len(s.WatchNamespaces) > 0 || s.WatchNamespaceSelector != ""
+}
+
+// loadBrokerCallbackKey reads the key broker callback tokens are derived from,
+// or generates a random one when no file is given.
+func loadBrokerCallbackKey(file string) ([]byte, error) {
+	if file == "" {
+		glog.Warning("No --broker-callback-key-file given; generating a random key, so broker callbacks for operations started before a restart will be rejected")
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate a broker callback key: %v", err)
+		}
+		return key, nil
+	}
+	key, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --broker-callback-key-file: %v", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("--broker-callback-key-file %q is empty", file)
+	}
+	return key, nil
+}
+
 // checkAPIAvailableResourcesServer is a HealthzChecker that makes sure the
 // Service-Catalog APIServer is contactable.
 type checkAPIAvailableResources struct {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/componentconfig"
 	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+	"github.com/kubernetes-incubator/service-catalog/pkg/controller/sharding"
 	k8scomponentconfig "github.com/kubernetes-incubator/service-catalog/pkg/kubernetes/pkg/apis/componentconfig"
 	"github.com/kubernetes-incubator/service-catalog/pkg/kubernetes/pkg/client/leaderelectionconfig"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
@@ -60,9 +61,14 @@ const (
 	defaultLeaderElectionNamespace                = "kube-system"
 	defaultReconciliationRetryDuration            = 7 * 24 * time.Hour
 	defaultOperationPollingMaximumBackoffDuration = 20 * time.Minute
+	defaultNotificationRetries                    = 3
+	defaultNotificationTimeout                    = 10 * time.Second
 )
 
-var defaultOSBAPIPreferredVersion = osb.LatestAPIVersion().HeaderValue()
+// defaultOSBAPIPreferredVersion is pinned rather than following the client
+// library's latest version, so that updating the library does not change the
+// version sent to brokers. Newer versions can be chosen with the flag.
+var defaultOSBAPIPreferredVersion = osb.Version2_13().HeaderValue()
 
 // NewControllerManagerServer creates a new ControllerManagerServer with a
 // default config.
@@ -81,10 +87,13 @@ func NewControllerManagerServer() *ControllerManagerServer {
 			ConcurrentSyncs:                        defaultConcurrentSyncs,
 			LeaderElection:                         leaderelectionconfig.DefaultLeaderElectionConfiguration(),
 			LeaderElectionNamespace:                defaultLeaderElectionNamespace,
+			ShardKey:                               sharding.KeyNamespace,
 			EnableProfiling:                        true,
 			EnableContentionProfiling:              false,
 			ReconciliationRetryDuration:            defaultReconciliationRetryDuration,
 			OperationPollingMaximumBackoffDuration: defaultOperationPollingMaximumBackoffDuration,
+			NotificationRetries:                    defaultNotificationRetries,
+			NotificationTimeout:                    defaultNotificationTimeout,
 			SecureServingOptions:                   genericoptions.NewSecureServingOptions(),
 		},
 	}
@@ -111,13 +120,23 @@ func (s *ControllerManagerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&s.ServiceBrokerRelistInterval, "broker-relist-interval", s.ServiceBrokerRelistInterval, "The interval on which a broker's catalog is relisted after the broker becomes ready")
 	fs.BoolVar(&s.OSBAPIContextProfile, "enable-osb-api-context-profile", s.OSBAPIContextProfile, "This does nothing.")
 	fs.MarkHidden("enable-osb-api-context-profile")
-	fs.StringVar(&s.OSBAPIPreferredVersion, "osb-api-preferred-version", s.OSBAPIPreferredVersion, "The string to send as the version header.")
+	fs.StringVar(&s.OSBAPIPreferredVersion, "osb-api-preferred-version", s.OSBAPIPreferredVersion, "The newest OSB API version to negotiate with brokers, one of 2.11, 2.12, 2.13, 2.14 or 2.15. Brokers that reject it with 412 Precondition Failed are sent older versions, down to 2.11.")
 	fs.BoolVar(&s.EnableProfiling, "profiling", s.EnableProfiling, "Enable profiling via web interface host:port/debug/pprof/")
 	fs.BoolVar(&s.EnableContentionProfiling, "contention-profiling", s.EnableContentionProfiling, "Enable lock contention profiling, if profiling is enabled")
 	leaderelectionconfig.BindFlags(&s.LeaderElection, fs)
 	fs.StringVar(&s.LeaderElectionNamespace, "leader-election-namespace", s.LeaderElectionNamespace, "Namespace to use for leader election lock")
+	fs.IntVar(&s.Shards, "shards", s.Shards, "Number of shards to split the reconciled resources into. When greater than 0, every replica reconciles the shards it holds a lease on instead of electing a single leader; requires --leader-elect. Every replica must use the same value.")
+	fs.StringVar(&s.ShardKey, "shard-key", s.ShardKey, "How resources are assigned to shards when --shards is set: \"namespace\" keeps the resources of a namespace in one shard, \"name\" spreads them out by name.")
+	fs.StringSliceVar(&s.WatchNamespaces, "watch-namespaces", s.WatchNamespaces, "Comma-separated list of namespaces whose instances, bindings and namespaced brokers are reconciled. All namespaces are reconciled when neither this nor --watch-namespace-selector is set.")
+	fs.StringVar(&s.WatchNamespaceSelector, "watch-namespace-selector", s.WatchNamespaceSelector, "Label selector of the namespaces whose instances, bindings and namespaced brokers are reconciled. Cannot be used with --watch-namespaces.")
+	fs.BoolVar(&s.IgnoreClusterScopedResources, "ignore-cluster-scoped-resources", s.IgnoreClusterScopedResources, "Ignore cluster-scoped brokers, classes and plans, and the instances and bindings that refer to them; requires --watch-namespaces or --watch-namespace-selector.")
 	fs.DurationVar(&s.ReconciliationRetryDuration, "reconciliation-retry-duration", s.ReconciliationRetryDuration, "The maximum amount of time to retry reconciliations on a resource before failing")
 	fs.DurationVar(&s.OperationPollingMaximumBackoffDuration, "operation-polling-maximum-backoff-duration", s.OperationPollingMaximumBackoffDuration, "The maximum amount of time to back-off while polling an OSB API operation")
+	fs.IntVar(&s.NotificationRetries, "notification-retries", s.NotificationRetries, "How many times the delivery of a lifecycle event to a notification sink is retried, with exponential backoff, before it is dropped. Requires the NotificationSinks feature.")
+	fs.StringSliceVar(&s.NotificationSinkAllowedHosts, "notification-sink-allowed-hosts", s.NotificationSinkAllowedHosts, "Comma-separated list of the hosts lifecycle events of NotificationSinks may be posted to; an entry of the form *.example.com allows every subdomain. NotificationSinks with other hosts receive no events. ClusterNotificationSinks are not restricted. Requires the NotificationSinks feature.")
+	fs.DurationVar(&s.NotificationTimeout, "notification-timeout", s.NotificationTimeout, "How long to wait for a notification sink to accept a lifecycle event. Requires the NotificationSinks feature.")
+	fs.StringVar(&s.BrokerCallbackURL, "broker-callback-url", s.BrokerCallbackURL, "The base URL brokers reach the controller-manager's secure port at, to report the completion of asynchronous operations. Requires the BrokerCallbacks feature.")
+	fs.StringVar(&s.BrokerCallbackKeyFile, "broker-callback-key-file", s.BrokerCallbackKeyFile, "File holding the key broker callback tokens are derived from. Every replica must use the same key, and the file is required when --leader-elect or --shards is set. When empty, a random key is generated at startup, and callbacks for operations started before a restart are rejected. Requires the BrokerCallbacks feature.")
 	s.SecureServingOptions.AddFlags(fs)
 	utilfeature.DefaultFeatureGate.AddFlag(fs)
 	fs.StringVar(&s.ClusterIDConfigMapName, "cluster-id-configmap-name", controller.DefaultClusterIDConfigMapName, "k8s name for clusterid configmap")
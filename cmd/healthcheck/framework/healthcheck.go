@@ -27,11 +27,11 @@ import (
 	"github.com/golang/glog"
 	v1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
-	util "github.com/kubernetes-incubator/service-catalog/test/util"
 	"github.com/spf13/cobra"
 	pflag "github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -88,25 +88,30 @@ var rootCmd = &cobra.Command{
 type HealthCheck struct {
 	kubeClientSet           kubernetes.Interface
 	serviceCatalogClientSet clientset.Interface
-	brokername              string
-	brokernamespace         string
-	serviceclassName        string
-	serviceclassID          string
-	serviceplanID           string
-	instanceName            string
-	bindingName             string
-	brokerendpointName      string
-	namespace               *corev1.Namespace // ns where we create instance and binding
-	frameworkError          error
+	scenarios               []Scenario
+
+	// The state of the scenario being run
+	scenario       *Scenario
+	step           string
+	serviceclassID string
+	serviceplanID  string
+	namespace      *corev1.Namespace // ns where we create instance and binding
+	frameworkError error
 }
 
+const (
+	instanceName = "healthcheck-instance"
+	bindingName  = "healthcheck-binding"
+)
+
 // NewHealthCheck creates a new HealthCheck object and initializes the kube
 // and catalog client sets.
 func NewHealthCheck(s *HealthCheckServer) (*HealthCheck, error) {
 	h := &HealthCheck{}
 	var kubeConfig *rest.Config
 
-	err := h.initBrokerAttributes(s)
+	var err error
+	h.scenarios, err = LoadScenarios(s)
 	if err != nil {
 		return nil, err
 	}
@@ -138,69 +143,95 @@ func NewHealthCheck(s *HealthCheckServer) (*HealthCheck, error) {
 	return h, nil
 }
 
-// RunHealthCheck runs an end to end verification against the "ups-broker".  It
-// validates the broker endpoint is available, then creates an instance and
-// binding and does validation along the way and then tears it down.  Some basic
-// Prometheus metrics are maintained that can be alerted off from.
+// RunHealthCheck runs each scenario in turn and returns the errors of those
+// that failed.  Some basic Prometheus metrics are maintained that can be
+// alerted off from.
 func (h *HealthCheck) RunHealthCheck(s *HealthCheckServer) error {
-	defer h.cleanup()
 	ExecutionCount.Inc()
 	hcStartTime := time.Now()
 
+	var errs []error
+	for i := range h.scenarios {
+		if err := h.runScenario(&h.scenarios[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		ReportOperationCompleted("healthcheck_completed", hcStartTime)
+		glog.V(2).Infof("Successfully ran health check in %v", time.Since(hcStartTime))
+		glog.V(4).Info("") // for readabilty/separation of test runs
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// runScenario runs an end to end verification against the broker of the
+// scenario.  It validates the broker is ready, then creates an instance and
+// binding and does validation along the way and then tears it down.  The
+// result is recorded in the metrics and on the status endpoint.
+func (h *HealthCheck) runScenario(scenario *Scenario) error {
+	h.scenario = scenario
+	h.step = ""
+	h.frameworkError = nil
+	defer h.cleanup()
+	ScenarioExecutionCount.WithLabelValues(scenario.Name, scenario.Broker).Inc()
+	startTime := time.Now()
+
 	h.verifyBrokerIsReady()
 	h.createNamespace()
 	h.createInstance()
 	h.createBinding()
+	h.deleteBinding()
 	h.deprovision()
 	h.deleteNamespace()
 
+	result := ScenarioResult{
+		Scenario:  scenario.Name,
+		Broker:    scenario.Broker,
+		StartTime: startTime,
+		Duration:  time.Since(startTime).Seconds(),
+		Succeeded: h.frameworkError == nil,
+	}
 	if h.frameworkError == nil {
-		ReportOperationCompleted("healthcheck_completed", hcStartTime)
-		glog.V(2).Infof("Successfully ran health check in %v", time.Since(hcStartTime))
-		glog.V(4).Info("") // for readabilty/separation of test runs
+		glog.V(2).Infof("Successfully ran health check scenario %q in %v", scenario.Name, time.Since(startTime))
 	} else {
+		result.FailedStep = h.step
+		result.Error = h.frameworkError.Error()
 		ErrorCount.WithLabelValues(h.frameworkError.Error()).Inc()
 	}
+	ReportScenarioCompleted(result)
+	lastResults.record(result)
 	return h.frameworkError
 }
 
-// verifyBrokerIsReady verifies the Broker is found and appears ready
+// verifyBrokerIsReady verifies the Broker is found and appears ready, and
+// that it offers the class and plan of the scenario
 func (h *HealthCheck) verifyBrokerIsReady() error {
-	h.frameworkError = nil
-	glog.V(4).Infof("checking for endpoint %v/%v", h.brokernamespace, h.brokerendpointName)
-	err := WaitForEndpoint(h.kubeClientSet, h.brokernamespace, h.brokerendpointName)
-	if err != nil {
-		return h.setError("endpoint not found: %v", err.Error())
-	}
-
-	url := "http://" + h.brokername + "." + h.brokernamespace + ".svc.cluster.local"
-	broker := &v1beta1.ClusterServiceBroker{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: h.brokername,
-		},
-		Spec: v1beta1.ClusterServiceBrokerSpec{
-			CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{
-				URL: url,
-			},
-		},
+	h.step = stepBrokerReady
+	operationStartTime := time.Now()
+	if h.scenario.EndpointName != "" {
+		glog.V(4).Infof("checking for endpoint %v/%v", h.scenario.EndpointNamespace, h.scenario.EndpointName)
+		err := WaitForEndpoint(h.kubeClientSet, h.scenario.EndpointNamespace, h.scenario.EndpointName)
+		if err != nil {
+			return h.setError("endpoint not found: %v", err.Error())
+		}
 	}
 
-	glog.V(4).Infof("checking for Broker %v to be ready", broker.Name)
-	err = util.WaitForBrokerCondition(h.serviceCatalogClientSet.ServicecatalogV1beta1(),
-		broker.Name,
-		v1beta1.ServiceBrokerCondition{
-			Type:   v1beta1.ServiceBrokerConditionReady,
-			Status: v1beta1.ConditionTrue,
-		},
-	)
+	timeout := h.scenario.timeout(stepBrokerReady)
+	glog.V(4).Infof("checking for Broker %v to be ready", h.scenario.Broker)
+	err := WaitForBrokerReady(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.scenario.Broker, timeout)
 	if err != nil {
 		return h.setError("broker not ready: %v", err.Error())
 	}
 
-	err = util.WaitForClusterServiceClassToExist(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.serviceclassID)
+	class, plan, err := WaitForClusterServicePlan(h.serviceCatalogClientSet.ServicecatalogV1beta1(),
+		h.scenario.Broker, h.scenario.Class, h.scenario.Plan, timeout)
 	if err != nil {
-		return h.setError("service class not found: %v", err.Error())
+		return h.setError("service class and plan not found: %v", err.Error())
 	}
+	h.serviceclassID = class.Name
+	h.serviceplanID = plan.Name
+	ReportStepCompleted(h.scenario, stepBrokerReady, operationStartTime)
 	return nil
 }
 
@@ -210,21 +241,26 @@ func (h *HealthCheck) createInstance() error {
 	if h.frameworkError != nil {
 		return h.frameworkError
 	}
+	h.step = stepProvision
 	glog.V(4).Info("Creating a ServiceInstance")
+	parameters, err := rawParameters(h.scenario.ProvisionParameters)
+	if err != nil {
+		return h.setError("error encoding provision parameters: %v", err.Error())
+	}
 	instance := &v1beta1.ServiceInstance{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      h.instanceName,
+			Name:      instanceName,
 			Namespace: h.namespace.Name,
 		},
 		Spec: v1beta1.ServiceInstanceSpec{
 			PlanReference: v1beta1.PlanReference{
-				ClusterServiceClassExternalName: h.serviceclassName,
-				ClusterServicePlanExternalName:  "default",
+				ClusterServiceClassExternalName: h.scenario.Class,
+				ClusterServicePlanExternalName:  h.scenario.Plan,
 			},
+			Parameters: parameters,
 		},
 	}
 	operationStartTime := time.Now()
-	var err error
 	instance, err = h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceInstances(h.namespace.Name).Create(instance)
 	if err != nil {
 		return h.setError("error creating instance: %v", err.Error())
@@ -235,21 +271,15 @@ func (h *HealthCheck) createInstance() error {
 	}
 
 	glog.V(4).Info("Waiting for ServiceInstance to be ready")
-	err = util.WaitForInstanceCondition(h.serviceCatalogClientSet.ServicecatalogV1beta1(),
-		h.namespace.Name,
-		h.instanceName,
-		v1beta1.ServiceInstanceCondition{
-			Type:   v1beta1.ServiceInstanceConditionReady,
-			Status: v1beta1.ConditionTrue,
-		},
-	)
+	err = WaitForInstanceReady(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.namespace.Name, instanceName, h.scenario.timeout(stepProvision))
 	if err != nil {
 		return h.setError("instance not ready: %v", err.Error())
 	}
 	ReportOperationCompleted("create_instance", operationStartTime)
+	ReportStepCompleted(h.scenario, stepProvision, operationStartTime)
 
 	glog.V(4).Info("Verifing references are resolved")
-	sc, err := h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceInstances(h.namespace.Name).Get(h.instanceName, metav1.GetOptions{})
+	sc, err := h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceInstances(h.namespace.Name).Get(instanceName, metav1.GetOptions{})
 	if err != nil {
 		return h.setError("error getting instance: %v", err.Error())
 	}
@@ -273,24 +303,30 @@ func (h *HealthCheck) createInstance() error {
 // createBinding creates a binding and verifies the binding and secret are
 // correct
 func (h *HealthCheck) createBinding() error {
-	if h.frameworkError != nil {
+	if h.frameworkError != nil || !h.scenario.bind() {
 		return h.frameworkError
 	}
+	h.step = stepBind
 	glog.V(4).Info("Creating a ServiceBinding")
+	parameters, err := rawParameters(h.scenario.BindParameters)
+	if err != nil {
+		return h.setError("error encoding bind parameters: %v", err.Error())
+	}
 	binding := &v1beta1.ServiceBinding{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      h.bindingName,
+			Name:      bindingName,
 			Namespace: h.namespace.Name,
 		},
 		Spec: v1beta1.ServiceBindingSpec{
 			ServiceInstanceRef: v1beta1.LocalObjectReference{
-				Name: h.instanceName,
+				Name: instanceName,
 			},
-			SecretName: "my-secret",
+			Parameters: parameters,
+			SecretName: bindingName,
 		},
 	}
 	operationStartTime := time.Now()
-	binding, err := h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceBindings(h.namespace.Name).Create(binding)
+	binding, err = h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceBindings(h.namespace.Name).Create(binding)
 	if err != nil {
 		return h.setError("Error creating binding: %v", err.Error())
 	}
@@ -299,21 +335,15 @@ func (h *HealthCheck) createBinding() error {
 	}
 
 	glog.V(4).Info("Waiting for ServiceBinding to be ready")
-	_, err = util.WaitForBindingCondition(h.serviceCatalogClientSet.ServicecatalogV1beta1(),
-		h.namespace.Name,
-		h.bindingName,
-		v1beta1.ServiceBindingCondition{
-			Type:   v1beta1.ServiceBindingConditionReady,
-			Status: v1beta1.ConditionTrue,
-		},
-	)
+	err = WaitForBindingReady(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.namespace.Name, bindingName, h.scenario.timeout(stepBind))
 	if err != nil {
 		return h.setError("binding not ready: %v", err.Error())
 	}
 	ReportOperationCompleted("binding_ready", operationStartTime)
+	ReportStepCompleted(h.scenario, stepBind, operationStartTime)
 
 	glog.V(4).Info("Validating that a secret was created after binding")
-	_, err = h.kubeClientSet.CoreV1().Secrets(h.namespace.Name).Get("my-secret", metav1.GetOptions{})
+	_, err = h.kubeClientSet.CoreV1().Secrets(h.namespace.Name).Get(bindingName, metav1.GetOptions{})
 	if err != nil {
 		return h.setError("Error getting secret: %v", err.Error())
 	}
@@ -321,46 +351,57 @@ func (h *HealthCheck) createBinding() error {
 	return nil
 }
 
-// deprovision deletes the service binding, deprovisions the service instance
-// and verifies it does the appropriate cleanup.
-func (h *HealthCheck) deprovision() error {
-	if h.frameworkError != nil {
+// deleteBinding deletes the service binding and verifies its secret is
+// removed.
+func (h *HealthCheck) deleteBinding() error {
+	if h.frameworkError != nil || !h.scenario.bind() {
 		return h.frameworkError
 	}
+	h.step = stepUnbind
 	glog.V(4).Info("Deleting the ServiceBinding.")
 	operationStartTime := time.Now()
-	err := h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceBindings(h.namespace.Name).Delete(h.bindingName, nil)
+	err := h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceBindings(h.namespace.Name).Delete(bindingName, nil)
 	if err != nil {
 		return h.setError("error deleting binding: %v", err.Error())
 	}
 
 	glog.V(4).Info("Waiting for ServiceBinding to be removed")
-	err = util.WaitForBindingToNotExist(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.namespace.Name, h.bindingName)
+	err = WaitForBindingToNotExist(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.namespace.Name, bindingName, h.scenario.timeout(stepUnbind))
 	if err != nil {
 		return h.setError("binding not removed: %v", err.Error())
 	}
 	ReportOperationCompleted("binding_deleted", operationStartTime)
+	ReportStepCompleted(h.scenario, stepUnbind, operationStartTime)
 
 	glog.V(4).Info("Verifying that the secret was deleted after deleting the binding")
-	_, err = h.kubeClientSet.CoreV1().Secrets(h.namespace.Name).Get("my-secret", metav1.GetOptions{})
+	_, err = h.kubeClientSet.CoreV1().Secrets(h.namespace.Name).Get(bindingName, metav1.GetOptions{})
 	if err == nil {
 		return h.setError("secret not deleted")
 	}
+	return nil
+}
 
-	// Deprovisioning the ServiceInstance
+// deprovision deprovisions the service instance and verifies it does the
+// appropriate cleanup.
+func (h *HealthCheck) deprovision() error {
+	if h.frameworkError != nil {
+		return h.frameworkError
+	}
+	h.step = stepDeprovision
 	glog.V(4).Info("Deleting the ServiceInstance")
-	operationStartTime = time.Now()
-	err = h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceInstances(h.namespace.Name).Delete(h.instanceName, nil)
+	operationStartTime := time.Now()
+	err := h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceInstances(h.namespace.Name).Delete(instanceName, nil)
 	if err != nil {
 		return h.setError("error deleting instance: %v", err.Error())
 	}
 
 	glog.V(4).Info("Waiting for ServiceInstance to be removed")
-	err = util.WaitForInstanceToNotExist(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.namespace.Name, h.instanceName)
+	err = WaitForInstanceToNotExist(h.serviceCatalogClientSet.ServicecatalogV1beta1(), h.namespace.Name, instanceName, h.scenario.timeout(stepDeprovision))
 	if err != nil {
 		return h.setError("instance not removed: %v", err.Error())
 	}
 	ReportOperationCompleted("instance_deleted", operationStartTime)
+	ReportStepCompleted(h.scenario, stepDeprovision, operationStartTime)
 	return nil
 }
 
@@ -368,8 +409,8 @@ func (h *HealthCheck) deprovision() error {
 func (h *HealthCheck) cleanup() {
 	if h.frameworkError != nil && h.namespace != nil {
 		glog.V(4).Infof("Cleaning up.  Deleting the binding, instance and test namespace %v", h.namespace.Name)
-		h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceBindings(h.namespace.Name).Delete(h.bindingName, nil)
-		h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceInstances(h.namespace.Name).Delete(h.instanceName, nil)
+		h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceBindings(h.namespace.Name).Delete(bindingName, nil)
+		h.serviceCatalogClientSet.ServicecatalogV1beta1().ServiceInstances(h.namespace.Name).Delete(instanceName, nil)
 		DeleteKubeNamespace(h.kubeClientSet, h.namespace.Name)
 		h.namespace = nil
 	}
@@ -379,10 +420,11 @@ func (h *HealthCheck) createNamespace() error {
 	if h.frameworkError != nil {
 		return h.frameworkError
 	}
+	h.step = stepNamespace
 	var err error
 	h.namespace, err = CreateKubeNamespace(h.kubeClientSet)
 	if err != nil {
-		h.setError(err.Error(), "%v")
+		return h.setError("%v", err.Error())
 	}
 	return nil
 }
@@ -391,6 +433,7 @@ func (h *HealthCheck) deleteNamespace() error {
 	if h.frameworkError != nil {
 		return h.frameworkError
 	}
+	h.step = stepNamespace
 	err := DeleteKubeNamespace(h.kubeClientSet, h.namespace.Name)
 	if err != nil {
 		return h.setError("failed to delete namespace: %v", err.Error())
@@ -399,32 +442,6 @@ func (h *HealthCheck) deleteNamespace() error {
 	return err
 }
 
-func (h *HealthCheck) initBrokerAttributes(s *HealthCheckServer) error {
-	switch s.TestBrokerName {
-	case "ups-broker":
-		h.brokername = "ups-broker"
-		h.brokernamespace = "ups-broker"
-		h.brokerendpointName = "ups-broker-ups-broker"
-		h.serviceclassName = "user-provided-service"
-		h.serviceclassID = "4f6e6cf6-ffdd-425f-a2c7-3c9258ad2468"
-		h.serviceplanID = "86064792-7ea2-467b-af93-ac9694d96d52"
-		h.instanceName = "ups-instance"
-		h.bindingName = "ups-binding"
-	case "osb-stub":
-		h.brokername = "osb-stub"
-		h.brokernamespace = "osb-stub"
-		h.brokerendpointName = "osb-stub"
-		h.serviceclassName = "noop-service"
-		h.serviceclassID = "0861dc50-beed-4f9d-ba97-e78f43b802da"
-		h.serviceplanID = "977715c5-4a12-452f-994a-4caf4f8cba02"
-		h.instanceName = "stub-instance"
-		h.bindingName = "stub-binding"
-	default:
-		return fmt.Errorf("invalid broker-name specified: %v.  Valid options are ups-broker and stub-broker", s.TestBrokerName)
-	}
-	return nil
-}
-
 // setError creates a new error using msg and param for the formated message.
 // The message is logged and the HealthCheck error state is set and returned.
 // This function attempts to log the location of the caller (file name & line
@@ -439,7 +456,7 @@ func (h *HealthCheck) setError(msg string, v ...interface{}) error {
 	}
 	partialFileName := file[context:]
 	format := fmt.Sprintf("...%s:%d: %v", partialFileName, line, msg)
-	h.frameworkError = fmt.Errorf(format, v)
+	h.frameworkError = fmt.Errorf(format, v...)
 	glog.Info(h.frameworkError.Error())
 	return h.frameworkError
 }
@@ -26,7 +26,8 @@ import (
 	"k8s.io/apiserver/pkg/server/healthz"
 )
 
-// ServeHTTP starts a new Http Server thread for /metrics and health probing
+// ServeHTTP starts a new Http Server thread for /metrics, /status and health
+// probing
 func ServeHTTP(healthcheckOptions *HealthCheckServer) error {
 
 	// Initialize SSL/TLS configuration.  Creats a self signed certificate and key if necessary
@@ -40,6 +41,7 @@ func ServeHTTP(healthcheckOptions *HealthCheckServer) error {
 		mux := http.NewServeMux()
 
 		RegisterMetricsAndInstallHandler(mux)
+		InstallStatusHandler(mux)
 		healthz.InstallHandler(mux, healthz.PingHealthz)
 
 		server := &http.Server{
@@ -65,6 +65,43 @@ var (
 			Help:       "processing time (s) of successfully executed operation, by operation.",
 			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
 		}, []string{"operation"})
+
+	// ScenarioExecutionCount is the number of times each scenario has run
+	ScenarioExecutionCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: promNamespace,
+			Name:      "scenario_execution_count",
+			Help:      "Number of times the health check scenario has run, by scenario and broker.",
+		},
+		[]string{"scenario", "broker"},
+	)
+
+	// ScenarioErrorCount is the number of times each scenario has failed
+	ScenarioErrorCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: promNamespace,
+			Name:      "scenario_error_count",
+			Help:      "Number of times the health check scenario ended in error, by scenario, broker and failed step.",
+		},
+		[]string{"scenario", "broker", "step"},
+	)
+
+	// scenarioStepTimeSummary records how long each step of a scenario took
+	scenarioStepTimeSummary = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Namespace:  promNamespace,
+			Name:       "scenario_step_duration_seconds",
+			Help:       "processing time (s) of successfully executed scenario steps, by scenario, broker and step.",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		}, []string{"scenario", "broker", "step"})
+
+	// scenarioLastSuccess is the time of the last successful run of each scenario
+	scenarioLastSuccess = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: promNamespace,
+			Name:      "scenario_last_success_timestamp_seconds",
+			Help:      "Unix time of the last successful run of the health check scenario, by scenario and broker.",
+		}, []string{"scenario", "broker"})
 )
 
 // ReportOperationCompleted records the elapses time in milliseconds for a specified operation
@@ -72,11 +109,30 @@ func ReportOperationCompleted(operation string, startTime time.Time) {
 	eventHandlingTimeSummary.WithLabelValues(operation).Observe(time.Since(startTime).Seconds())
 }
 
+// ReportStepCompleted records the elapsed time of a successful step of a
+// scenario.
+func ReportStepCompleted(scenario *Scenario, step string, startTime time.Time) {
+	scenarioStepTimeSummary.WithLabelValues(scenario.Name, scenario.Broker, step).Observe(time.Since(startTime).Seconds())
+}
+
+// ReportScenarioCompleted records the outcome of a run of a scenario.
+func ReportScenarioCompleted(result ScenarioResult) {
+	if result.Succeeded {
+		scenarioLastSuccess.WithLabelValues(result.Scenario, result.Broker).SetToCurrentTime()
+	} else {
+		ScenarioErrorCount.WithLabelValues(result.Scenario, result.Broker, result.FailedStep).Inc()
+	}
+}
+
 func register(registry *prometheus.Registry) {
 	registerMetrics.Do(func() {
 		registry.MustRegister(ExecutionCount)
 		registry.MustRegister(ErrorCount)
 		registry.MustRegister(eventHandlingTimeSummary)
+		registry.MustRegister(ScenarioExecutionCount)
+		registry.MustRegister(ScenarioErrorCount)
+		registry.MustRegister(scenarioStepTimeSummary)
+		registry.MustRegister(scenarioLastSuccess)
 	})
 }
 
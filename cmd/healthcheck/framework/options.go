@@ -36,6 +36,9 @@ type HealthCheckServer struct {
 	HealthCheckInterval  time.Duration
 	SecureServingOptions *genericoptions.SecureServingOptions
 	TestBrokerName       string
+
+	// ScenarioConfig is the path to a YAML file listing the scenarios to run
+	ScenarioConfig string
 }
 
 const (
@@ -61,6 +64,7 @@ func (s *HealthCheckServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.KubeConfig, "kubernetes-config", os.Getenv(clientcmd.RecommendedConfigPathEnvVar), "Path to config containing embedded authinfo for kubernetes. Default value is from environment variable "+clientcmd.RecommendedConfigPathEnvVar)
 	fs.StringVar(&s.KubeContext, "kubernetes-context", "", "config context to use for kuberentes. If unset, will use value from 'current-context'")
 	fs.DurationVar(&s.HealthCheckInterval, "healthcheck-interval", s.HealthCheckInterval, "How frequently the end to end health check should be performed")
-	fs.StringVar(&s.TestBrokerName, "broker-name", "ups-broker", "Broker Name to test against - can only be ups-broker or osb-stub.  You must ensure the specified broker is deployed.")
+	fs.StringVar(&s.TestBrokerName, "broker-name", "ups-broker", "Broker Name to test against - can only be ups-broker or osb-stub.  You must ensure the specified broker is deployed.  Ignored when --scenario-config is set.")
+	fs.StringVar(&s.ScenarioConfig, "scenario-config", "", "Path to a YAML file listing the health check scenarios; if unset, the built-in scenario for --broker-name is run")
 	s.SecureServingOptions.AddFlags(fs)
 }
@@ -0,0 +1,204 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/ghodss/yaml"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// Steps of a health check scenario, as reported in metrics and in the
+	// status of the scenario.
+	stepBrokerReady = "broker_ready"
+	stepProvision   = "provision"
+	stepBind        = "bind"
+	stepUnbind      = "unbind"
+	stepDeprovision = "deprovision"
+	stepNamespace   = "namespace"
+
+	defaultBrokerReadyTimeout = 3 * time.Minute
+)
+
+// ScenarioConfig is the content of the file given with --scenario-config.
+type ScenarioConfig struct {
+	Scenarios []Scenario `json:"scenarios"`
+}
+
+// Scenario describes one end to end health check: the broker to check, the
+// class and plan to provision, the parameters to send, and how long each
+// step may take.
+type Scenario struct {
+	// Name identifies the scenario in metrics and on the status endpoint.
+	Name string `json:"name"`
+	// Broker is the name of the ClusterServiceBroker offering the class.
+	Broker string `json:"broker"`
+	// EndpointNamespace and EndpointName, when set, name the Endpoints of
+	// the broker's Service, which must have an address before the broker is
+	// checked. Leave them empty for brokers running outside the cluster.
+	EndpointNamespace string `json:"endpointNamespace,omitempty"`
+	EndpointName      string `json:"endpointName,omitempty"`
+	// Class and Plan are the external names of the class and plan to
+	// provision.
+	Class string `json:"class"`
+	Plan  string `json:"plan"`
+	// ProvisionParameters and BindParameters are sent to the broker with the
+	// provision and bind requests.
+	ProvisionParameters map[string]interface{} `json:"provisionParameters,omitempty"`
+	BindParameters      map[string]interface{} `json:"bindParameters,omitempty"`
+	// Bind controls whether the instance is bound to and unbound from.
+	// Defaults to true.
+	Bind *bool `json:"bind,omitempty"`
+	// Timeouts limits how long each step may take.
+	Timeouts StepTimeouts `json:"timeouts,omitempty"`
+}
+
+// StepTimeouts are the maximum durations of the steps of a scenario. Unset
+// timeouts take their default: 3 minutes for the broker to be ready and 30
+// seconds for every other step.
+type StepTimeouts struct {
+	BrokerReady *metav1.Duration `json:"brokerReady,omitempty"`
+	Provision   *metav1.Duration `json:"provision,omitempty"`
+	Bind        *metav1.Duration `json:"bind,omitempty"`
+	Unbind      *metav1.Duration `json:"unbind,omitempty"`
+	Deprovision *metav1.Duration `json:"deprovision,omitempty"`
+}
+
+// builtinScenarios are the scenarios run against the test brokers when no
+// scenario config is given, selected with --broker-name.
+var builtinScenarios = map[string]Scenario{
+	"ups-broker": {
+		Name:              "ups-broker",
+		Broker:            "ups-broker",
+		EndpointNamespace: "ups-broker",
+		EndpointName:      "ups-broker-ups-broker",
+		Class:             "user-provided-service",
+		Plan:              "default",
+	},
+	"osb-stub": {
+		Name:              "osb-stub",
+		Broker:            "osb-stub",
+		EndpointNamespace: "osb-stub",
+		EndpointName:      "osb-stub",
+		Class:             "noop-service",
+		Plan:              "default",
+	},
+}
+
+// LoadScenarios returns the scenarios to run: those in the scenario config
+// file if one is given, and otherwise the built-in scenario of the test
+// broker selected with --broker-name.
+func LoadScenarios(s *HealthCheckServer) ([]Scenario, error) {
+	if s.ScenarioConfig == "" {
+		scenario, ok := builtinScenarios[s.TestBrokerName]
+		if !ok {
+			return nil, fmt.Errorf("invalid broker-name specified: %v.  Valid options are ups-broker and osb-stub", s.TestBrokerName)
+		}
+		return []Scenario{scenario}, nil
+	}
+
+	data, err := ioutil.ReadFile(s.ScenarioConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error reading scenario config: %v", err)
+	}
+	return parseScenarioConfig(data)
+}
+
+func parseScenarioConfig(data []byte) ([]Scenario, error) {
+	config := &ScenarioConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("error parsing scenario config: %v", err)
+	}
+	if len(config.Scenarios) == 0 {
+		return nil, fmt.Errorf("scenario config does not define any scenarios")
+	}
+
+	names := map[string]bool{}
+	for i, scenario := range config.Scenarios {
+		if err := scenario.validate(); err != nil {
+			return nil, fmt.Errorf("invalid scenario %d: %v", i, err)
+		}
+		if names[scenario.Name] {
+			return nil, fmt.Errorf("invalid scenario %d: duplicate name %q", i, scenario.Name)
+		}
+		names[scenario.Name] = true
+	}
+	return config.Scenarios, nil
+}
+
+func (s *Scenario) validate() error {
+	switch {
+	case s.Name == "":
+		return fmt.Errorf("name is required")
+	case s.Broker == "":
+		return fmt.Errorf("broker is required")
+	case s.Class == "":
+		return fmt.Errorf("class is required")
+	case s.Plan == "":
+		return fmt.Errorf("plan is required")
+	case (s.EndpointNamespace == "") != (s.EndpointName == ""):
+		return fmt.Errorf("endpointNamespace and endpointName must be set together")
+	}
+	return nil
+}
+
+// bind returns whether the scenario binds to the instance it provisions.
+func (s *Scenario) bind() bool {
+	return s.Bind == nil || *s.Bind
+}
+
+// timeout returns how long the given step of the scenario may take.
+func (s *Scenario) timeout(step string) time.Duration {
+	var d *metav1.Duration
+	switch step {
+	case stepBrokerReady:
+		if s.Timeouts.BrokerReady == nil {
+			return defaultBrokerReadyTimeout
+		}
+		d = s.Timeouts.BrokerReady
+	case stepProvision:
+		d = s.Timeouts.Provision
+	case stepBind:
+		d = s.Timeouts.Bind
+	case stepUnbind:
+		d = s.Timeouts.Unbind
+	case stepDeprovision:
+		d = s.Timeouts.Deprovision
+	}
+	if d == nil {
+		return defaultTimeout
+	}
+	return d.Duration
+}
+
+// rawParameters encodes parameters for a ServiceInstance or ServiceBinding.
+func rawParameters(parameters map[string]interface{}) (*runtime.RawExtension, error) {
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
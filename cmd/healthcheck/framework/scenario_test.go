@@ -0,0 +1,213 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseScenarioConfig(t *testing.T) {
+	cases := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name: "valid",
+			config: `
+scenarios:
+- name: ups
+  broker: ups-broker
+  endpointNamespace: ups-broker
+  endpointName: ups-broker-ups-broker
+  class: user-provided-service
+  plan: default
+  provisionParameters:
+    credentials:
+      user: healthcheck
+- name: mysql
+  broker: mysql-broker
+  class: mysql
+  plan: small
+  bind: false
+  timeouts:
+    provision: 5m
+`,
+		},
+		{
+			name:   "no scenarios",
+			config: `scenarios: []`,
+			err:    "does not define any scenarios",
+		},
+		{
+			name: "missing plan",
+			config: `
+scenarios:
+- name: ups
+  broker: ups-broker
+  class: user-provided-service
+`,
+			err: "plan is required",
+		},
+		{
+			name: "endpoint name without namespace",
+			config: `
+scenarios:
+- name: ups
+  broker: ups-broker
+  endpointName: ups-broker-ups-broker
+  class: user-provided-service
+  plan: default
+`,
+			err: "endpointNamespace and endpointName must be set together",
+		},
+		{
+			name: "duplicate names",
+			config: `
+scenarios:
+- name: ups
+  broker: ups-broker
+  class: user-provided-service
+  plan: default
+- name: ups
+  broker: other-broker
+  class: user-provided-service
+  plan: default
+`,
+			err: `duplicate name "ups"`,
+		},
+		{
+			name:   "malformed",
+			config: `scenarios: {`,
+			err:    "error parsing scenario config",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			scenarios, err := parseScenarioConfig([]byte(tc.config))
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(scenarios) != 2 {
+				t.Fatalf("expected 2 scenarios, got %d", len(scenarios))
+			}
+			if !scenarios[0].bind() || scenarios[1].bind() {
+				t.Fatalf("unexpected bind settings: %v, %v", scenarios[0].bind(), scenarios[1].bind())
+			}
+			parameters, err := rawParameters(scenarios[0].ProvisionParameters)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := `{"credentials":{"user":"healthcheck"}}`, string(parameters.Raw); e != a {
+				t.Fatalf("unexpected provision parameters: expected %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestLoadScenariosBuiltin(t *testing.T) {
+	scenarios, err := LoadScenarios(&HealthCheckServer{TestBrokerName: "osb-stub"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(scenarios) != 1 || scenarios[0].Class != "noop-service" {
+		t.Fatalf("unexpected scenarios: %+v", scenarios)
+	}
+
+	if _, err := LoadScenarios(&HealthCheckServer{TestBrokerName: "other-broker"}); err == nil {
+		t.Fatal("expected an error for an unknown broker")
+	}
+}
+
+func TestScenarioTimeout(t *testing.T) {
+	scenarios, err := parseScenarioConfig([]byte(`
+scenarios:
+- name: ups
+  broker: ups-broker
+  class: user-provided-service
+  plan: default
+  timeouts:
+    brokerReady: 10s
+    bind: 1m
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scenario := scenarios[0]
+
+	cases := map[string]time.Duration{
+		stepBrokerReady: 10 * time.Second,
+		stepProvision:   defaultTimeout,
+		stepBind:        time.Minute,
+		stepUnbind:      defaultTimeout,
+		stepDeprovision: defaultTimeout,
+	}
+	for step, expected := range cases {
+		if actual := scenario.timeout(step); actual != expected {
+			t.Errorf("%v: expected timeout %v, got %v", step, expected, actual)
+		}
+	}
+
+	scenario.Timeouts.BrokerReady = nil
+	if e, a := defaultBrokerReadyTimeout, scenario.timeout(stepBrokerReady); e != a {
+		t.Errorf("expected default broker ready timeout %v, got %v", e, a)
+	}
+}
+
+func TestStatusHandler(t *testing.T) {
+	recorder := &resultRecorder{results: map[string]ScenarioResult{}}
+	get := func() (int, StatusResponse) {
+		w := httptest.NewRecorder()
+		recorder.ServeHTTP(w, httptest.NewRequest("GET", "/status", nil))
+		status := StatusResponse{}
+		if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+			t.Fatalf("error decoding status: %v", err)
+		}
+		return w.Code, status
+	}
+
+	if code, status := get(); code != http.StatusOK || len(status.Scenarios) != 0 {
+		t.Fatalf("unexpected empty status: %v %+v", code, status)
+	}
+
+	recorder.record(ScenarioResult{Scenario: "ups", Broker: "ups-broker", Succeeded: true})
+	recorder.record(ScenarioResult{Scenario: "mysql", Broker: "mysql-broker", FailedStep: stepProvision, Error: "instance not ready"})
+	code, status := get()
+	if code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %v, got %v", http.StatusServiceUnavailable, code)
+	}
+	if len(status.Scenarios) != 2 || status.Scenarios[0].Scenario != "mysql" || status.Scenarios[0].FailedStep != stepProvision {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	recorder.record(ScenarioResult{Scenario: "mysql", Broker: "mysql-broker", Succeeded: true})
+	if code, _ := get(); code != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, code)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// ScenarioResult is the outcome of the last run of a scenario.
+type ScenarioResult struct {
+	Scenario  string    `json:"scenario"`
+	Broker    string    `json:"broker"`
+	StartTime time.Time `json:"startTime"`
+	// Duration is how long the run took, in seconds.
+	Duration  float64 `json:"duration"`
+	Succeeded bool    `json:"succeeded"`
+	// FailedStep and Error describe why an unsuccessful run failed.
+	FailedStep string `json:"failedStep,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// StatusResponse is served by the status endpoint.
+type StatusResponse struct {
+	Scenarios []ScenarioResult `json:"scenarios"`
+}
+
+// resultRecorder keeps the last result of each scenario.
+type resultRecorder struct {
+	mu      sync.RWMutex
+	results map[string]ScenarioResult
+}
+
+var lastResults = &resultRecorder{results: map[string]ScenarioResult{}}
+
+func (r *resultRecorder) record(result ScenarioResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results[result.Scenario] = result
+}
+
+func (r *resultRecorder) status() StatusResponse {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	status := StatusResponse{Scenarios: []ScenarioResult{}}
+	for _, result := range r.results {
+		status.Scenarios = append(status.Scenarios, result)
+	}
+	sort.Slice(status.Scenarios, func(i, j int) bool {
+		return status.Scenarios[i].Scenario < status.Scenarios[j].Scenario
+	})
+	return status
+}
+
+// ServeHTTP writes the last result of each scenario as JSON. The response
+// status is 503 if the last run of any scenario failed.
+func (r *resultRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	status := r.status()
+	code := http.StatusOK
+	for _, result := range status.Scenarios {
+		if !result.Succeeded {
+			code = http.StatusServiceUnavailable
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		glog.Errorf("Error writing status: %v", err)
+	}
+}
+
+// InstallStatusHandler installs the handler serving the last result of each
+// scenario at /status.
+func InstallStatusHandler(m *http.ServeMux) {
+	m.Handle("/status", lastResults)
+	glog.V(3).Info("Registered /status")
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	v1beta1servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// waitPoll determines how often the status of catalog resources is polled.
+const waitPoll = 500 * time.Millisecond
+
+// WaitForBrokerReady waits up to timeout for the named ClusterServiceBroker
+// to be ready.
+func WaitForBrokerReady(client v1beta1servicecatalog.ServicecatalogV1beta1Interface, name string, timeout time.Duration) error {
+	return wait.PollImmediate(waitPoll, timeout, func() (bool, error) {
+		broker, err := client.ClusterServiceBrokers().Get(name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("error getting Broker %v: %v", name, err)
+		}
+		for _, cond := range broker.Status.Conditions {
+			if cond.Type == v1beta1.ServiceBrokerConditionReady && cond.Status == v1beta1.ConditionTrue {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForClusterServicePlan waits up to timeout for the broker to offer the
+// class and plan with the given external names, and returns them.
+func WaitForClusterServicePlan(client v1beta1servicecatalog.ServicecatalogV1beta1Interface, broker, className, planName string, timeout time.Duration) (*v1beta1.ClusterServiceClass, *v1beta1.ClusterServicePlan, error) {
+	var class *v1beta1.ClusterServiceClass
+	var plan *v1beta1.ClusterServicePlan
+	err := wait.PollImmediate(waitPoll, timeout, func() (bool, error) {
+		classes, err := client.ClusterServiceClasses().List(metav1.ListOptions{
+			FieldSelector: fields.SelectorFromSet(fields.Set{
+				"spec.clusterServiceBrokerName": broker,
+				"spec.externalName":             className,
+			}).String(),
+		})
+		if err != nil {
+			return false, fmt.Errorf("error listing ClusterServiceClasses: %v", err)
+		}
+		if len(classes.Items) != 1 {
+			glog.V(5).Infof("Waiting for broker %v to offer class %v, found %d", broker, className, len(classes.Items))
+			return false, nil
+		}
+		class = &classes.Items[0]
+
+		plans, err := client.ClusterServicePlans().List(metav1.ListOptions{
+			FieldSelector: fields.SelectorFromSet(fields.Set{
+				"spec.clusterServiceClassRef.name": class.Name,
+				"spec.externalName":                planName,
+			}).String(),
+		})
+		if err != nil {
+			return false, fmt.Errorf("error listing ClusterServicePlans: %v", err)
+		}
+		if len(plans.Items) != 1 {
+			glog.V(5).Infof("Waiting for class %v to offer plan %v, found %d", className, planName, len(plans.Items))
+			return false, nil
+		}
+		plan = &plans.Items[0]
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return class, plan, nil
+}
+
+// WaitForInstanceReady waits up to timeout for the instance to be ready. It
+// gives up early if provisioning fails.
+func WaitForInstanceReady(client v1beta1servicecatalog.ServicecatalogV1beta1Interface, namespace, name string, timeout time.Duration) error {
+	return wait.PollImmediate(waitPoll, timeout, func() (bool, error) {
+		instance, err := client.ServiceInstances(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("error getting Instance %v/%v: %v", namespace, name, err)
+		}
+		for _, cond := range instance.Status.Conditions {
+			if cond.Status != v1beta1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case v1beta1.ServiceInstanceConditionReady:
+				return true, nil
+			case v1beta1.ServiceInstanceConditionFailed:
+				return false, fmt.Errorf("instance failed: %v: %v", cond.Reason, cond.Message)
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForBindingReady waits up to timeout for the binding to be ready. It
+// gives up early if binding fails.
+func WaitForBindingReady(client v1beta1servicecatalog.ServicecatalogV1beta1Interface, namespace, name string, timeout time.Duration) error {
+	return wait.PollImmediate(waitPoll, timeout, func() (bool, error) {
+		binding, err := client.ServiceBindings(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("error getting Binding %v/%v: %v", namespace, name, err)
+		}
+		for _, cond := range binding.Status.Conditions {
+			if cond.Status != v1beta1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case v1beta1.ServiceBindingConditionReady:
+				return true, nil
+			case v1beta1.ServiceBindingConditionFailed:
+				return false, fmt.Errorf("binding failed: %v: %v", cond.Reason, cond.Message)
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForInstanceToNotExist waits up to timeout for the instance to be
+// removed.
+func WaitForInstanceToNotExist(client v1beta1servicecatalog.ServicecatalogV1beta1Interface, namespace, name string, timeout time.Duration) error {
+	return wait.PollImmediate(waitPoll, timeout, func() (bool, error) {
+		_, err := client.ServiceInstances(namespace).Get(name, metav1.GetOptions{})
+		if apierrs.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+// WaitForBindingToNotExist waits up to timeout for the binding to be
+// removed.
+func WaitForBindingToNotExist(client v1beta1servicecatalog.ServicecatalogV1beta1Interface, namespace, name string, timeout time.Duration) error {
+	return wait.PollImmediate(waitPoll, timeout, func() (bool, error) {
+		_, err := client.ServiceBindings(namespace).Get(name, metav1.GetOptions{})
+		if apierrs.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
@@ -17,14 +17,21 @@ limitations under the License.
 package binding
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
 )
 
 type getCmd struct {
 	*command.Namespaced
 	*command.Formatted
+	*command.Watched
+	*command.Selected
+	*command.Sorted
 	name string
 }
 
@@ -33,6 +40,9 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd := &getCmd{
 		Namespaced: command.NewNamespaced(cxt),
 		Formatted:  command.NewFormatted(),
+		Watched:    command.NewWatched(),
+		Selected:   command.NewSelected(),
+		Sorted:     command.NewSorted(),
 	}
 	cmd := &cobra.Command{
 		Use:     "bindings [NAME]",
@@ -41,8 +51,10 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		Example: command.NormalizeExamples(`
   svcat get bindings
   svcat get bindings --all-namespaces
+  svcat get bindings -l app=wordpress -o name
   svcat get binding wordpress-mysql-binding
   svcat get binding -n ci concourse-postgres-binding
+  svcat get bindings --watch
 `),
 		PreRunE: command.PreRunE(getCmd),
 		RunE:    command.RunE(getCmd),
@@ -50,18 +62,33 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 
 	getCmd.AddNamespaceFlags(cmd.Flags(), true)
 	getCmd.AddOutputFlags(cmd.Flags())
+	getCmd.AddWatchFlag(cmd)
+	getCmd.AddSelectorFlag(cmd)
+	getCmd.AddSortByFlag(cmd)
 	return cmd
 }
 
 func (c *getCmd) Validate(args []string) error {
+	if c.Watch && c.RawSelector != "" {
+		return fmt.Errorf("--selector is not supported with --watch")
+	}
+
 	if len(args) > 0 {
 		c.name = args[0]
+
+		if c.RawSelector != "" {
+			return fmt.Errorf("--selector is not supported when specifiying binding name")
+		}
 	}
 
 	return nil
 }
 
 func (c *getCmd) Run() error {
+	if c.Watch {
+		return c.watch()
+	}
+
 	if c.name == "" {
 		return c.getAll()
 	}
@@ -75,6 +102,17 @@ func (c *getCmd) getAll() error {
 		return err
 	}
 
+	selected := bindings.Items[:0]
+	for _, binding := range bindings.Items {
+		if c.Matches(&binding) {
+			selected = append(selected, binding)
+		}
+	}
+	bindings.Items = selected
+	if err := c.SortItems(bindings.Items); err != nil {
+		return err
+	}
+
 	output.WriteBindingList(c.Output, c.OutputFormat, bindings)
 	return nil
 }
@@ -88,3 +126,18 @@ func (c *getCmd) get() error {
 	output.WriteBinding(c.Output, c.OutputFormat, *binding)
 	return nil
 }
+
+func (c *getCmd) watch() error {
+	since := time.Now()
+	bindings, err := c.App.WatchBindings(c.Namespace, c.name)
+	if err != nil {
+		return err
+	}
+	events, err := c.App.WatchEvents(c.Namespace, servicecatalog.BindingKind, c.name)
+	if err != nil {
+		bindings.Stop()
+		return err
+	}
+
+	return c.StreamWatches(c.Output, since, bindings, events)
+}
@@ -134,6 +134,9 @@ func TestGetCommand(t *testing.T) {
 			cmd := &getCmd{
 				Namespaced: command.NewNamespaced(cxt),
 				Formatted:  command.NewFormatted(),
+				Watched:    command.NewWatched(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = namespace
 			cmd.name = tc.bindingName
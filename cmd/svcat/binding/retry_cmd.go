@@ -0,0 +1,67 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/spf13/cobra"
+)
+
+type retryCmd struct {
+	*command.Namespaced
+	name string
+}
+
+// NewRetryCmd builds a "svcat retry binding" command.
+func NewRetryCmd(cxt *command.Context) *cobra.Command {
+	retryCmd := &retryCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:   "binding NAME",
+		Short: "Retry the failed bind of a binding",
+		Long: `Retry binding increments the retryRequests field of a failed binding. Then,
+service catalog clears the Failed condition and sends the bind request to the
+broker again.`,
+		Example: command.NormalizeExamples(`svcat retry binding wordpress-mysql-binding --namespace mynamespace`),
+		PreRunE: command.PreRunE(retryCmd),
+		RunE:    command.RunE(retryCmd),
+	}
+	retryCmd.AddNamespaceFlags(cmd.Flags(), false)
+
+	return cmd
+}
+
+func (c *retryCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("a binding name is required")
+	}
+	c.name = args[0]
+
+	return nil
+}
+
+func (c *retryCmd) Run() error {
+	const retries = 3
+	binding, err := c.App.RetryBinding(c.Namespace, c.name, retries)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.Output, "Retrying binding %s/%s\n", binding.Namespace, binding.Name)
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/spf13/cobra"
+)
+
+// DiffCmd contains the info needed to show how a broker's live catalog
+// differs from the classes and plans in the cluster.
+type DiffCmd struct {
+	*command.Context
+	Name string
+}
+
+// NewDiffCmd builds a "svcat diff broker" command. Like the other svcat
+// commands, such as "svcat sync broker", it puts the verb before the resource
+// rather than being a "svcat broker diff" subcommand.
+func NewDiffCmd(cxt *command.Context) *cobra.Command {
+	diffCmd := &DiffCmd{Context: cxt}
+	cmd := &cobra.Command{
+		Use:   "broker NAME",
+		Short: "Show what the next sync of a broker would change",
+		Long: `Fetches the live catalog of a cluster-scoped broker, applies its catalog
+restrictions, and lists the classes and plans that the next sync would add,
+change or remove. The catalog is fetched directly from the broker rather than
+by the controller, so its URL must be reachable from where svcat is run, and
+svcat reads the secret referenced by the broker's auth info with your
+credentials. Namespaced brokers are not supported.`,
+		Example: command.NormalizeExamples(`
+  svcat diff broker asb
+`),
+		PreRunE: command.PreRunE(diffCmd),
+		RunE:    command.RunE(diffCmd),
+	}
+	return cmd
+}
+
+// Validate checks that the required arguments have been provided.
+func (c *DiffCmd) Validate(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("a broker name is required")
+	}
+	c.Name = args[0]
+	return nil
+}
+
+// Run fetches and prints the diff of the broker's catalog.
+func (c *DiffCmd) Run() error {
+	diff, err := c.App.DiffBrokerCatalog(c.Name)
+	if err != nil {
+		return err
+	}
+
+	output.WriteCatalogDiff(c.Output, diff)
+	return nil
+}
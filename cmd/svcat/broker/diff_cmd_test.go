@@ -0,0 +1,111 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker_test
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/kubernetes-incubator/service-catalog/cmd/svcat/broker"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/test"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Diff Command", func() {
+	Describe("NewDiffCmd", func() {
+		It("Builds and returns a cobra command", func() {
+			cxt := &command.Context{}
+			cmd := NewDiffCmd(cxt)
+			Expect(*cmd).NotTo(BeNil())
+			Expect(cmd.Use).To(Equal("broker NAME"))
+			Expect(cmd.Short).To(ContainSubstring("Show what the next sync of a broker would change"))
+			Expect(cmd.Example).To(ContainSubstring("svcat diff broker asb"))
+		})
+	})
+	Describe("Validate", func() {
+		It("errors if a broker name is not provided", func() {
+			cmd := DiffCmd{}
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("broker name is required"))
+		})
+		It("stores the broker name", func() {
+			cmd := DiffCmd{}
+			err := cmd.Validate([]string{"mysqlbroker"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.Name).To(Equal("mysqlbroker"))
+		})
+	})
+	Describe("Run", func() {
+		var (
+			outputBuffer *bytes.Buffer
+			fakeSDK      *servicecatalogfakes.FakeSvcatClient
+			cmd          DiffCmd
+		)
+
+		BeforeEach(func() {
+			outputBuffer = &bytes.Buffer{}
+			fakeApp, _ := svcat.NewApp(nil, nil, "default")
+			fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+			fakeApp.SvcatClient = fakeSDK
+			cmd = DiffCmd{
+				Context: svcattest.NewContext(outputBuffer, fakeApp),
+				Name:    "mysqlbroker",
+			}
+		})
+
+		It("prints the classes and plans that would change", func() {
+			fakeSDK.DiffBrokerCatalogReturns(&catalogdiff.Diff{
+				AddedClasses: []string{"postgres"},
+				AddedPlans:   []string{"postgres/small"},
+				RemovedPlans: []string{"mysql/large"},
+			}, nil)
+
+			err := cmd.Run()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeSDK.DiffBrokerCatalogArgsForCall(0)).To(Equal("mysqlbroker"))
+			output := outputBuffer.String()
+			Expect(output).To(MatchRegexp(`Added\s+Class\s+postgres`))
+			Expect(output).To(MatchRegexp(`Added\s+Plan\s+postgres/small`))
+			Expect(output).To(MatchRegexp(`Removed\s+Plan\s+mysql/large`))
+		})
+
+		It("reports when nothing would change", func() {
+			fakeSDK.DiffBrokerCatalogReturns(&catalogdiff.Diff{}, nil)
+
+			err := cmd.Run()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputBuffer.String()).To(Equal("No changes.\n"))
+		})
+
+		It("bubbles up errors", func() {
+			fakeSDK.DiffBrokerCatalogReturns(nil, errors.New("unable to fetch the catalog"))
+
+			err := cmd.Run()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to fetch the catalog"))
+		})
+	})
+})
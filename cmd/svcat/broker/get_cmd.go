@@ -17,16 +17,24 @@ limitations under the License.
 package broker
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 type getCmd struct {
 	*command.Namespaced
 	*command.Formatted
 	*command.Scoped
+	*command.Watched
+	*command.Selected
+	*command.Sorted
 	name string
 }
 
@@ -36,6 +44,9 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		Namespaced: command.NewNamespaced(cxt),
 		Formatted:  command.NewFormatted(),
 		Scoped:     command.NewScoped(),
+		Watched:    command.NewWatched(),
+		Selected:   command.NewSelected(),
+		Sorted:     command.NewSorted(),
 	}
 	cmd := &cobra.Command{
 		Use:     "brokers [NAME]",
@@ -45,7 +56,9 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get brokers
   svcat get brokers --scope=cluster
   svcat get brokers --scope=all
+  svcat get brokers --sort-by .status.lastCatalogRetrievalTime -o wide
   svcat get broker minibroker
+  svcat get broker minibroker --watch
 `),
 		PreRunE: command.PreRunE(getCmd),
 		RunE:    command.RunE(getCmd),
@@ -53,18 +66,33 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddScopedFlags(cmd.Flags(), true)
 	getCmd.AddNamespaceFlags(cmd.Flags(), true)
+	getCmd.AddWatchFlag(cmd)
+	getCmd.AddSelectorFlag(cmd)
+	getCmd.AddSortByFlag(cmd)
 	return cmd
 }
 
 func (c *getCmd) Validate(args []string) error {
+	if c.Watch && c.RawSelector != "" {
+		return fmt.Errorf("--selector is not supported with --watch")
+	}
+
 	if len(args) > 0 {
 		c.name = args[0]
+
+		if c.RawSelector != "" {
+			return fmt.Errorf("--selector is not supported when specifiying broker name")
+		}
 	}
 
 	return nil
 }
 
 func (c *getCmd) Run() error {
+	if c.Watch {
+		return c.watch()
+	}
+
 	if c.name == "" {
 		return c.getAll()
 	}
@@ -82,6 +110,18 @@ func (c *getCmd) getAll() error {
 		return err
 	}
 
+	var selected []servicecatalog.Broker
+	for _, broker := range brokers {
+		if obj, ok := broker.(v1.Object); ok && !c.Matches(obj) {
+			continue
+		}
+		selected = append(selected, broker)
+	}
+	brokers = selected
+	if err := c.SortItems(brokers); err != nil {
+		return err
+	}
+
 	output.WriteBrokerList(c.Output, c.OutputFormat, brokers...)
 	return nil
 }
@@ -95,3 +135,38 @@ func (c *getCmd) get() error {
 	output.WriteBroker(c.Output, c.OutputFormat, *broker)
 	return nil
 }
+
+func (c *getCmd) watch() error {
+	since := time.Now()
+	opts := servicecatalog.ScopeOptions{
+		Namespace: c.Namespace,
+		Scope:     c.Scope,
+	}
+	brokers, err := c.App.WatchBrokers(opts, c.name)
+	if err != nil {
+		return err
+	}
+	watches := []watch.Interface{brokers}
+
+	// Events for cluster-scoped brokers are recorded in the default namespace.
+	if c.Scope.Matches(servicecatalog.ClusterScope) {
+		events, err := c.App.WatchEvents(v1.NamespaceDefault, servicecatalog.ClusterBrokerKind, c.name)
+		if err != nil {
+			brokers.Stop()
+			return err
+		}
+		watches = append(watches, events)
+	}
+	if c.Scope.Matches(servicecatalog.NamespaceScope) {
+		events, err := c.App.WatchEvents(c.Namespace, servicecatalog.BrokerKind, c.name)
+		if err != nil {
+			for _, w := range watches {
+				w.Stop()
+			}
+			return err
+		}
+		watches = append(watches, events)
+	}
+
+	return c.StreamWatches(c.Output, since, watches...)
+}
@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 var _ = Describe("Get Broker Command", func() {
@@ -47,12 +48,18 @@ var _ = Describe("Get Broker Command", func() {
 	})
 	Describe("Validate", func() {
 		It("allows broker name arg to be empty", func() {
-			cmd := &getCmd{}
+			cmd := &getCmd{
+				Watched:  command.NewWatched(),
+				Selected: command.NewSelected(),
+			}
 			err := cmd.Validate([]string{})
 			Expect(err).To(BeNil())
 		})
 		It("optionally parses the broker name argument", func() {
-			cmd := &getCmd{}
+			cmd := &getCmd{
+				Watched:  command.NewWatched(),
+				Selected: command.NewSelected(),
+			}
 			err := cmd.Validate([]string{"minibroker"})
 			Expect(err).To(BeNil())
 			Expect(cmd.name).To(Equal("minibroker"))
@@ -72,6 +79,9 @@ var _ = Describe("Get Broker Command", func() {
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
 				Scoped:     command.NewScoped(),
 				Formatted:  command.NewFormatted(),
+				Watched:    command.NewWatched(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = "default"
 			cmd.Scope = servicecatalog.NamespaceScope
@@ -104,6 +114,9 @@ var _ = Describe("Get Broker Command", func() {
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
 				Scoped:     command.NewScoped(),
 				Formatted:  command.NewFormatted(),
+				Watched:    command.NewWatched(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = ""
 			cmd.Scope = servicecatalog.NamespaceScope
@@ -137,6 +150,9 @@ var _ = Describe("Get Broker Command", func() {
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
 				Scoped:     command.NewScoped(),
 				Formatted:  command.NewFormatted(),
+				Watched:    command.NewWatched(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = "default"
 			cmd.Scope = servicecatalog.AllScope
@@ -154,5 +170,57 @@ var _ = Describe("Get Broker Command", func() {
 			Expect(output).To(ContainSubstring("global-broker"))
 			Expect(output).To(ContainSubstring("minibroker"))
 		})
+		It("Streams broker status changes and events with --watch", func() {
+			outputBuffer := &bytes.Buffer{}
+
+			fakeApp, _ := svcat.NewApp(nil, nil, "default")
+			fakeSDK := new(servicecatalogfakes.FakeSvcatClient)
+			brokerWatch := watch.NewFakeWithChanSize(1, false)
+			eventWatch := watch.NewFakeWithChanSize(1, false)
+			fakeSDK.WatchBrokersReturns(brokerWatch, nil)
+			fakeSDK.WatchEventsReturns(eventWatch, nil)
+			fakeApp.SvcatClient = fakeSDK
+			cmd := getCmd{
+				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+				Scoped:     command.NewScoped(),
+				Formatted:  command.NewFormatted(),
+				Watched:    command.NewWatched(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
+			}
+			cmd.Namespace = "default"
+			cmd.Scope = servicecatalog.ClusterScope
+			cmd.name = "minibroker"
+			cmd.Watch = true
+
+			brokerWatch.Add(&v1beta1.ClusterServiceBroker{
+				ObjectMeta: v1.ObjectMeta{Name: "minibroker"},
+				Status: v1beta1.ClusterServiceBrokerStatus{
+					CommonServiceBrokerStatus: v1beta1.CommonServiceBrokerStatus{
+						Conditions: []v1beta1.ServiceBrokerCondition{
+							{Type: v1beta1.ServiceBrokerConditionReady, Status: v1beta1.ConditionTrue, Reason: "FetchedCatalog"},
+						},
+					},
+				},
+			})
+			brokerWatch.Stop()
+			eventWatch.Stop()
+
+			err := cmd.Run()
+
+			Expect(err).NotTo(HaveOccurred())
+			opts, name := fakeSDK.WatchBrokersArgsForCall(0)
+			Expect(opts).To(Equal(servicecatalog.ScopeOptions{
+				Namespace: "default",
+				Scope:     servicecatalog.ClusterScope,
+			}))
+			Expect(name).To(Equal("minibroker"))
+			ns, kind, name := fakeSDK.WatchEventsArgsForCall(0)
+			Expect(ns).To(Equal("default"))
+			Expect(kind).To(Equal(servicecatalog.ClusterBrokerKind))
+			Expect(name).To(Equal("minibroker"))
+
+			Expect(outputBuffer.String()).To(ContainSubstring("broker minibroker: Ready=True (FetchedCatalog)"))
+		})
 	})
 })
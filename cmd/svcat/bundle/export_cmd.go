@@ -0,0 +1,110 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/spf13/cobra"
+)
+
+// ExportCmd contains the info needed to export the service catalog
+// resources in a namespace
+type ExportCmd struct {
+	*command.Namespaced
+	OutputFormat string
+	File         string
+}
+
+// NewExportCmd builds a "svcat export" command
+func NewExportCmd(cxt *command.Context) *cobra.Command {
+	exportCmd := &ExportCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the instances, bindings and their secrets in a namespace to a bundle",
+		Long: `Export writes the instances and bindings in a namespace, along with their
+external IDs, broker state and referenced secrets, to a bundle. The bundle can
+be imported into another cluster with "svcat import" without provisioning or
+binding again at the broker. The bundle contains secrets and should be
+handled accordingly.`,
+		Example: command.NormalizeExamples(`
+  svcat export --namespace dev --file dev-bundle.yaml
+  svcat export --namespace dev -o json
+`),
+		PreRunE: command.PreRunE(exportCmd),
+		RunE:    command.RunE(exportCmd),
+	}
+	exportCmd.AddNamespaceFlags(cmd.Flags(), false)
+	cmd.Flags().StringVarP(&exportCmd.OutputFormat, "output", "o", output.FormatYAML,
+		"The bundle format to use. Valid options are json or yaml. If not present, defaults to yaml",
+	)
+	cmd.Flags().StringVarP(&exportCmd.File, "file", "f", "",
+		"If present, the file to write the bundle to. Defaults to standard output",
+	)
+	return cmd
+}
+
+// Validate checks that the requested bundle format is supported
+func (c *ExportCmd) Validate(args []string) error {
+	c.OutputFormat = strings.ToLower(c.OutputFormat)
+	switch c.OutputFormat {
+	case output.FormatJSON, output.FormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid --output format %q, allowed values are: json and yaml", c.OutputFormat)
+	}
+}
+
+// Run runs the command
+func (c *ExportCmd) Run() error {
+	return c.Export()
+}
+
+// Export calls out to the pkg lib to build the bundle and writes it out
+func (c *ExportCmd) Export() error {
+	bundle, err := c.App.Export(c.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if c.OutputFormat == output.FormatJSON {
+		data, err = json.MarshalIndent(bundle, "", "   ")
+	} else {
+		data, err = yaml.Marshal(bundle)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to marshal the bundle (%s)", err)
+	}
+
+	if c.File == "" {
+		fmt.Fprint(c.Output, string(data))
+		return nil
+	}
+	if err := ioutil.WriteFile(c.File, data, 0600); err != nil {
+		return fmt.Errorf("unable to write the bundle to %s (%s)", c.File, err)
+	}
+	fmt.Fprintf(c.Output, "Exported %d instance(s) and %d binding(s) from %s to %s\n",
+		len(bundle.Instances), len(bundle.Bindings), c.Namespace, c.File)
+	return nil
+}
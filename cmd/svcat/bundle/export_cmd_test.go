@@ -0,0 +1,119 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	. "github.com/kubernetes-incubator/service-catalog/cmd/svcat/bundle"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	svcattest "github.com/kubernetes-incubator/service-catalog/cmd/svcat/test"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat"
+	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Export Command", func() {
+	var (
+		outputBuffer *bytes.Buffer
+		fakeSDK      *servicecatalogfakes.FakeSvcatClient
+		cmd          *ExportCmd
+		bundle       *servicecatalog.Bundle
+	)
+
+	BeforeEach(func() {
+		outputBuffer = &bytes.Buffer{}
+		fakeApp, _ := svcat.NewApp(nil, nil, "default")
+		fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+		fakeApp.SvcatClient = fakeSDK
+		cmd = &ExportCmd{
+			Namespaced:   command.NewNamespaced(svcattest.NewContext(outputBuffer, fakeApp)),
+			OutputFormat: "yaml",
+		}
+		cmd.Namespace = "dev"
+		bundle = &servicecatalog.Bundle{
+			APIVersion: servicecatalog.BundleAPIVersion,
+			Kind:       servicecatalog.BundleKind,
+			Namespace:  "dev",
+		}
+	})
+
+	Describe("NewExportCmd", func() {
+		It("Builds and returns a cobra command", func() {
+			cxt := &command.Context{}
+			cmd := NewExportCmd(cxt)
+			Expect(*cmd).NotTo(BeNil())
+			Expect(cmd.Use).To(Equal("export"))
+			Expect(cmd.Example).To(ContainSubstring("svcat export --namespace dev"))
+			Expect(cmd.Flags().Lookup("namespace")).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("file")).NotTo(BeNil())
+		})
+	})
+	Describe("Validate", func() {
+		It("errors on an unsupported output format", func() {
+			cmd.OutputFormat = "table"
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid --output format"))
+		})
+	})
+	Describe("Export", func() {
+		It("Writes the bundle to the output", func() {
+			fakeSDK.ExportReturns(bundle, nil)
+
+			err := cmd.Export()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeSDK.ExportArgsForCall(0)).To(Equal("dev"))
+			got := &servicecatalog.Bundle{}
+			Expect(yaml.Unmarshal(outputBuffer.Bytes(), got)).To(Succeed())
+			Expect(got).To(Equal(bundle))
+		})
+		It("Writes the bundle to a file", func() {
+			fakeSDK.ExportReturns(bundle, nil)
+			dir, err := ioutil.TempDir("", "svcat-export")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			cmd.File = filepath.Join(dir, "bundle.yaml")
+
+			err = cmd.Export()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputBuffer.String()).To(ContainSubstring("Exported 0 instance(s) and 0 binding(s) from dev"))
+			data, err := ioutil.ReadFile(cmd.File)
+			Expect(err).NotTo(HaveOccurred())
+			got := &servicecatalog.Bundle{}
+			Expect(yaml.Unmarshal(data, got)).To(Succeed())
+			Expect(got).To(Equal(bundle))
+		})
+		It("Bubbles up errors", func() {
+			fakeSDK.ExportReturns(nil, errors.New("instance dev/foo is not provisioned"))
+
+			err := cmd.Export()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not provisioned"))
+		})
+	})
+})
@@ -0,0 +1,102 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+	"github.com/spf13/cobra"
+)
+
+// ImportCmd contains the info needed to import a bundle
+type ImportCmd struct {
+	*command.Context
+	Namespace string
+	File      string
+}
+
+// NewImportCmd builds a "svcat import" command
+func NewImportCmd(cxt *command.Context) *cobra.Command {
+	importCmd := &ImportCmd{Context: cxt}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a bundle written by svcat export",
+		Long: `Import recreates the instances, bindings and secrets in a bundle written by
+"svcat export". The instances and bindings are adopted, so the controller
+records them as provisioned and bound without sending provision or bind
+requests to the broker. A broker offering every class and plan in the bundle
+must already be registered, and adopting requires the "adopt" verb on
+serviceinstances and servicebindings.
+Instances and bindings that already exist with the same external ID, and
+secrets that already exist with the same data, are skipped, so an import that
+failed part way may be run again.`,
+		Example: command.NormalizeExamples(`
+  svcat import --file dev-bundle.yaml
+  svcat import --file dev-bundle.yaml --namespace staging
+`),
+		PreRunE: command.PreRunE(importCmd),
+		RunE:    command.RunE(importCmd),
+	}
+	cmd.Flags().StringVarP(&importCmd.File, "file", "f", "",
+		"The bundle file to import",
+	)
+	cmd.Flags().StringVarP(&importCmd.Namespace, "namespace", "n", "",
+		"If present, the namespace to import into. Defaults to the namespace the bundle was exported from",
+	)
+	return cmd
+}
+
+// Validate checks that the required arguments have been provided
+func (c *ImportCmd) Validate(args []string) error {
+	if c.File == "" {
+		return fmt.Errorf("a bundle file is required")
+	}
+	return nil
+}
+
+// Run runs the command
+func (c *ImportCmd) Run() error {
+	return c.Import()
+}
+
+// Import reads the bundle and calls out to the pkg lib to recreate it
+func (c *ImportCmd) Import() error {
+	data, err := ioutil.ReadFile(c.File)
+	if err != nil {
+		return fmt.Errorf("unable to read the bundle from %s (%s)", c.File, err)
+	}
+	bundle := &servicecatalog.Bundle{}
+	if err := yaml.Unmarshal(data, bundle); err != nil {
+		return fmt.Errorf("unable to parse the bundle in %s (%s)", c.File, err)
+	}
+
+	if err := c.App.Import(bundle, c.Namespace); err != nil {
+		return err
+	}
+
+	namespace := c.Namespace
+	if namespace == "" {
+		namespace = bundle.Namespace
+	}
+	fmt.Fprintf(c.Output, "Imported %d instance(s) and %d binding(s) into %s\n",
+		len(bundle.Instances), len(bundle.Bindings), namespace)
+	return nil
+}
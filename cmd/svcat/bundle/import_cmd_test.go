@@ -0,0 +1,120 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	. "github.com/kubernetes-incubator/service-catalog/cmd/svcat/bundle"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	svcattest "github.com/kubernetes-incubator/service-catalog/cmd/svcat/test"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat"
+	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Import Command", func() {
+	var (
+		outputBuffer *bytes.Buffer
+		fakeSDK      *servicecatalogfakes.FakeSvcatClient
+		cmd          *ImportCmd
+		bundle       *servicecatalog.Bundle
+		dir          string
+	)
+
+	BeforeEach(func() {
+		outputBuffer = &bytes.Buffer{}
+		fakeApp, _ := svcat.NewApp(nil, nil, "default")
+		fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+		fakeApp.SvcatClient = fakeSDK
+
+		bundle = &servicecatalog.Bundle{
+			APIVersion: servicecatalog.BundleAPIVersion,
+			Kind:       servicecatalog.BundleKind,
+			Namespace:  "dev",
+		}
+		data, err := yaml.Marshal(bundle)
+		Expect(err).NotTo(HaveOccurred())
+		dir, err = ioutil.TempDir("", "svcat-import")
+		Expect(err).NotTo(HaveOccurred())
+		file := filepath.Join(dir, "bundle.yaml")
+		Expect(ioutil.WriteFile(file, data, 0600)).To(Succeed())
+
+		cmd = &ImportCmd{
+			Context: svcattest.NewContext(outputBuffer, fakeApp),
+			File:    file,
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("NewImportCmd", func() {
+		It("Builds and returns a cobra command", func() {
+			cxt := &command.Context{}
+			cmd := NewImportCmd(cxt)
+			Expect(*cmd).NotTo(BeNil())
+			Expect(cmd.Use).To(Equal("import"))
+			Expect(cmd.Example).To(ContainSubstring("svcat import --file dev-bundle.yaml"))
+		})
+	})
+	Describe("Validate", func() {
+		It("errors if a bundle file is not provided", func() {
+			cmd.File = ""
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("bundle file is required"))
+		})
+	})
+	Describe("Import", func() {
+		It("Imports the bundle into the namespace it was exported from", func() {
+			err := cmd.Import()
+
+			Expect(err).NotTo(HaveOccurred())
+			gotBundle, gotNamespace := fakeSDK.ImportArgsForCall(0)
+			Expect(gotBundle).To(Equal(bundle))
+			Expect(gotNamespace).To(Equal(""))
+			Expect(outputBuffer.String()).To(ContainSubstring("into dev"))
+		})
+		It("Imports the bundle into another namespace", func() {
+			cmd.Namespace = "staging"
+
+			err := cmd.Import()
+
+			Expect(err).NotTo(HaveOccurred())
+			_, gotNamespace := fakeSDK.ImportArgsForCall(0)
+			Expect(gotNamespace).To(Equal("staging"))
+			Expect(outputBuffer.String()).To(ContainSubstring("into staging"))
+		})
+		It("Bubbles up errors", func() {
+			fakeSDK.ImportReturns(errors.New("no broker in the target cluster offers"))
+
+			err := cmd.Import()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no broker"))
+		})
+	})
+})
@@ -17,17 +17,22 @@ limitations under the License.
 package class
 
 import (
+	"fmt"
+
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type getCmd struct {
 	*command.Namespaced
 	*command.Scoped
 	*command.Formatted
+	*command.Selected
+	*command.Sorted
 	lookupByUUID bool
 	uuid         string
 	name         string
@@ -39,6 +44,8 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		Namespaced: command.NewNamespaced(cxt),
 		Scoped:     command.NewScoped(),
 		Formatted:  command.NewFormatted(),
+		Selected:   command.NewSelected(),
+		Sorted:     command.NewSorted(),
 	}
 	cmd := &cobra.Command{
 		Use:     "classes [NAME]",
@@ -48,6 +55,7 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get classes
   svcat get classes --scope cluster
   svcat get classes --scope namespace --namespace dev
+  svcat get classes -o jsonpath='{range .[*]}{.spec.externalName}{"\n"}{end}'
   svcat get class mysqldb
   svcat get class --uuid 997b8372-8dac-40ac-ae65-758b4a5075a5
 `),
@@ -64,6 +72,8 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddNamespaceFlags(cmd.Flags(), true)
 	getCmd.AddScopedFlags(cmd.Flags(), true)
+	getCmd.AddSelectorFlag(cmd)
+	getCmd.AddSortByFlag(cmd)
 	return cmd
 }
 
@@ -74,6 +84,10 @@ func (c *getCmd) Validate(args []string) error {
 		} else {
 			c.name = args[0]
 		}
+
+		if c.RawSelector != "" {
+			return fmt.Errorf("--selector is not supported when specifiying class name")
+		}
 	}
 
 	return nil
@@ -97,6 +111,18 @@ func (c *getCmd) getAll() error {
 		return err
 	}
 
+	var selected []servicecatalog.Class
+	for _, class := range classes {
+		if obj, ok := class.(v1.Object); ok && !c.Matches(obj) {
+			continue
+		}
+		selected = append(selected, class)
+	}
+	classes = selected
+	if err := c.SortItems(classes); err != nil {
+		return err
+	}
+
 	output.WriteClassList(c.Output, c.OutputFormat, classes...)
 	return nil
 }
@@ -154,6 +154,8 @@ func TestListClasses(t *testing.T) {
 				Namespaced: command.NewNamespaced(cxt),
 				Scoped:     command.NewScoped(),
 				Formatted:  command.NewFormatted(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = ns
 			cmd.Scope = tc.scope
@@ -194,12 +196,12 @@ var _ = Describe("Get Classes Command", func() {
 	})
 	Describe("Validate", func() {
 		It("allows class name arg to be empty", func() {
-			cmd := &getCmd{}
+			cmd := &getCmd{Selected: command.NewSelected()}
 			err := cmd.Validate([]string{})
 			Expect(err).To(BeNil())
 		})
 		It("optionally parses the class name argument", func() {
-			cmd := &getCmd{}
+			cmd := &getCmd{Selected: command.NewSelected()}
 			err := cmd.Validate([]string{"mysqldb"})
 			Expect(err).To(BeNil())
 			Expect(cmd.name).To(Equal("mysqldb"))
@@ -218,6 +220,8 @@ var _ = Describe("Get Classes Command", func() {
 			cmd := getCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
 				Scoped:     command.NewScoped(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = "default"
 			cmd.Scope = servicecatalog.NamespaceScope
@@ -249,6 +253,8 @@ var _ = Describe("Get Classes Command", func() {
 			cmd := getCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
 				Scoped:     command.NewScoped(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = ""
 			cmd.Scope = servicecatalog.NamespaceScope
@@ -281,6 +287,8 @@ var _ = Describe("Get Classes Command", func() {
 			cmd := getCmd{
 				Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
 				Scoped:     command.NewScoped(),
+				Selected:   command.NewSelected(),
+				Sorted:     command.NewSorted(),
 			}
 			cmd.Namespace = "default"
 			cmd.Scope = servicecatalog.AllScope
@@ -61,6 +61,24 @@ func PreRunE(cmd Command) func(*cobra.Command, []string) error {
 				return err
 			}
 		}
+		if selectedCmd, ok := cmd.(HasSelectorFlag); ok {
+			err := selectedCmd.ApplySelectorFlag(c)
+			if err != nil {
+				return err
+			}
+		}
+		if sortedCmd, ok := cmd.(HasSortByFlag); ok {
+			err := sortedCmd.ApplySortByFlag(c)
+			if err != nil {
+				return err
+			}
+		}
+		if watchCmd, ok := cmd.(HasWatchFlag); ok {
+			err := watchCmd.ApplyWatchFlag(c.Flags())
+			if err != nil {
+				return err
+			}
+		}
 		if waitCmd, ok := cmd.(HasWaitFlags); ok {
 			err := waitCmd.ApplyWaitFlags()
 			if err != nil {
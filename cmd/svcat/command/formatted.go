@@ -17,9 +17,6 @@ limitations under the License.
 package command
 
 import (
-	"fmt"
-	"strings"
-
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
 	"github.com/spf13/pflag"
 )
@@ -46,19 +43,22 @@ func NewFormatted() *Formatted {
 // AddOutputFlags adds common output flags to a command that can have variable output formats.
 func (c *Formatted) AddOutputFlags(flags *pflag.FlagSet) {
 	flags.StringVarP(&c.OutputFormat, "output", "o", output.FormatTable,
-		"The output format to use. Valid options are table, json or yaml. If not present, defaults to table",
+		"The output format to use. Valid options are table, wide, name, json, yaml, jsonpath=TEMPLATE, go-template=TEMPLATE or custom-columns=HEADER:JSONPATH,... If not present, defaults to table",
 	)
 }
 
 // ApplyFormatFlags persists the format-related flags:
 // * --output
 func (c *Formatted) ApplyFormatFlags(flags *pflag.FlagSet) error {
-	c.OutputFormat = strings.ToLower(c.OutputFormat)
+	if err := output.ValidateFormat(c.OutputFormat); err != nil {
+		return err
+	}
 
-	switch c.OutputFormat {
-	case output.FormatTable, output.FormatJSON, output.FormatYAML:
-		return nil
-	default:
-		return fmt.Errorf("invalid --output format %q, allowed values are: table, json and yaml", c.OutputFormat)
+	// Only the name of the format is case insensitive, templates are kept as-is
+	format, template := output.SplitFormat(c.OutputFormat)
+	c.OutputFormat = format
+	if template != "" {
+		c.OutputFormat += "=" + template
 	}
+	return nil
 }
@@ -0,0 +1,74 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// HasSelectorFlag represents a command that supports --selector.
+type HasSelectorFlag interface {
+	// ApplySelectorFlag validates and persists the label selector flag.
+	//   --selector
+	ApplySelectorFlag(*cobra.Command) error
+}
+
+// Selected adds support to a command for filtering the listed resources by
+// their labels with the --selector flag.
+type Selected struct {
+	RawSelector string
+	Selector    labels.Selector
+}
+
+// NewSelected initializes a new label filtered command.
+func NewSelected() *Selected {
+	return &Selected{
+		Selector: labels.Everything(),
+	}
+}
+
+// AddSelectorFlag adds the label selector flag.
+//   --selector
+func (c *Selected) AddSelectorFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(
+		&c.RawSelector,
+		"selector",
+		"l",
+		"",
+		"Selector (label query) to filter on, supports '=', '==', '!=', 'in' and 'notin', e.g. -l key1=value1,key2!=value2",
+	)
+}
+
+// ApplySelectorFlag validates and persists the label selector flag.
+//   --selector
+func (c *Selected) ApplySelectorFlag(cmd *cobra.Command) error {
+	selector, err := labels.Parse(c.RawSelector)
+	if err != nil {
+		return fmt.Errorf("invalid --selector %q (%s)", c.RawSelector, err)
+	}
+	c.Selector = selector
+	return nil
+}
+
+// Matches determines if the labels of a resource match --selector.
+func (c *Selected) Matches(obj v1.Object) bool {
+	return c.Selector.Matches(labels.Set(obj.GetLabels()))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/spf13/cobra"
+)
+
+// HasSortByFlag represents a command that supports --sort-by.
+type HasSortByFlag interface {
+	// ApplySortByFlag validates the sort related flag.
+	//   --sort-by
+	ApplySortByFlag(*cobra.Command) error
+}
+
+// Sorted adds support to a command for ordering the listed resources with the
+// --sort-by flag.
+type Sorted struct {
+	SortBy string
+}
+
+// NewSorted initializes a new sorted command.
+func NewSorted() *Sorted {
+	return &Sorted{}
+}
+
+// AddSortByFlag adds the sort related flag.
+//   --sort-by
+func (c *Sorted) AddSortByFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&c.SortBy,
+		"sort-by",
+		"",
+		"If present, sort the results by the value of this jsonpath expression, e.g. --sort-by=.metadata.creationTimestamp",
+	)
+}
+
+// ApplySortByFlag validates the sort related flag.
+//   --sort-by
+func (c *Sorted) ApplySortByFlag(cmd *cobra.Command) error {
+	if c.SortBy == "" {
+		return nil
+	}
+	return output.ValidateSortBy(c.SortBy)
+}
+
+// SortItems sorts a slice of resources by --sort-by, leaving it untouched when
+// the flag is not set.
+func (c *Sorted) SortItems(items interface{}) error {
+	if c.SortBy == "" {
+		return nil
+	}
+	return output.SortByJSONPath(items, c.SortBy)
+}
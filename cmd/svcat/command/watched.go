@@ -0,0 +1,109 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// HasWatchFlag represents a command that supports --watch.
+type HasWatchFlag interface {
+	// ApplyWatchFlag validates the --watch flag.
+	ApplyWatchFlag(flags *pflag.FlagSet) error
+}
+
+// Watched adds support to a command for the --watch flag.
+type Watched struct {
+	Watch bool
+}
+
+// NewWatched initializes a new watched command.
+func NewWatched() *Watched {
+	return &Watched{}
+}
+
+// AddWatchFlag adds the --watch flag.
+func (c *Watched) AddWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&c.Watch, "watch", "w", false,
+		"Print the current status, then stream status changes and related events. Only supported with the table output format.")
+}
+
+// ApplyWatchFlag validates the --watch flag. Watching is only supported with
+// the table output format.
+func (c *Watched) ApplyWatchFlag(flags *pflag.FlagSet) error {
+	if !c.Watch {
+		return nil
+	}
+	if format, err := flags.GetString("output"); err == nil && strings.ToLower(format) != output.FormatTable {
+		return fmt.Errorf("--watch is only supported with the table output format")
+	}
+	return nil
+}
+
+// StreamWatches prints the changes reported by the watches until they are all
+// closed or one of them fails. Kubernetes events last seen before since are
+// skipped. The SDK's watches are resumed when the API server closes them, so
+// this normally runs until interrupted.
+func (c *Watched) StreamWatches(w io.Writer, since time.Time, watches ...watch.Interface) error {
+	printer := output.NewWatchPrinter(w, since)
+
+	done := make(chan struct{})
+	defer close(done)
+	defer func() {
+		for _, watcher := range watches {
+			watcher.Stop()
+		}
+	}()
+
+	events := make(chan watch.Event)
+	var wg sync.WaitGroup
+	for _, watcher := range watches {
+		wg.Add(1)
+		go func(watcher watch.Interface) {
+			defer wg.Done()
+			for event := range watcher.ResultChan() {
+				select {
+				case events <- event:
+				case <-done:
+					return
+				}
+			}
+		}(watcher)
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	for event := range events {
+		if event.Type == watch.Error {
+			return fmt.Errorf("watch failed (%s)", apierrors.FromObject(event.Object))
+		}
+		printer.WriteWatchEvent(event)
+	}
+	return nil
+}
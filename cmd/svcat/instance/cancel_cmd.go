@@ -0,0 +1,70 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/spf13/cobra"
+)
+
+type cancelInstanceCmd struct {
+	*command.Namespaced
+	name string
+}
+
+// NewCancelCmd builds a "svcat cancel instance" command.
+func NewCancelCmd(cxt *command.Context) *cobra.Command {
+	cancelInstanceCmd := &cancelInstanceCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:   "instance NAME",
+		Short: "Cancel the provision or update in progress on an instance",
+		Long: `Cancel instance increments the cancelRequests field of the instance. Then,
+service catalog stops polling the broker for the operation in progress. A
+cancelled provision fails with the Cancelled reason and the instance is
+deprovisioned at the broker. A cancelled update fails with the Cancelled reason.
+Deprovisions cannot be cancelled.`,
+		Example: command.NormalizeExamples(`svcat cancel instance wordpress-mysql-instance --namespace mynamespace`),
+		PreRunE: command.PreRunE(cancelInstanceCmd),
+		RunE:    command.RunE(cancelInstanceCmd),
+	}
+	cancelInstanceCmd.AddNamespaceFlags(cmd.Flags(), false)
+
+	return cmd
+}
+
+func (c *cancelInstanceCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("an instance name is required")
+	}
+	c.name = args[0]
+
+	return nil
+}
+
+func (c *cancelInstanceCmd) Run() error {
+	const retries = 3
+	instance, err := c.App.CancelInstance(c.Namespace, c.name, retries)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.Output, "Cancelling the %s of instance %s/%s\n",
+		instance.Status.CurrentOperation, instance.Namespace, instance.Name)
+	return nil
+}
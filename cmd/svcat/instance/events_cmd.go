@@ -0,0 +1,93 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+	"github.com/spf13/cobra"
+)
+
+type eventsCmd struct {
+	*command.Namespaced
+	*command.Formatted
+	*command.Watched
+	name string
+}
+
+// NewEventsCmd builds a "svcat events instance" command.
+func NewEventsCmd(cxt *command.Context) *cobra.Command {
+	eventsCmd := &eventsCmd{
+		Namespaced: command.NewNamespaced(cxt),
+		Formatted:  command.NewFormatted(),
+		Watched:    command.NewWatched(),
+	}
+	cmd := &cobra.Command{
+		Use:   "instance NAME",
+		Short: "Show the Kubernetes events recorded for an instance",
+		Example: command.NormalizeExamples(`
+  svcat events instance wordpress-mysql-instance
+  svcat events instance wordpress-mysql-instance --watch
+`),
+		PreRunE: command.PreRunE(eventsCmd),
+		RunE:    command.RunE(eventsCmd),
+	}
+	eventsCmd.AddNamespaceFlags(cmd.Flags(), false)
+	eventsCmd.AddOutputFlags(cmd.Flags())
+	eventsCmd.AddWatchFlag(cmd)
+
+	return cmd
+}
+
+func (c *eventsCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("an instance name is required")
+	}
+	c.name = args[0]
+
+	return nil
+}
+
+func (c *eventsCmd) Run() error {
+	since := time.Now()
+	events, err := c.App.RetrieveEvents(c.Namespace, servicecatalog.InstanceKind, c.name)
+	if err != nil {
+		return err
+	}
+
+	output.WriteEventList(c.Output, c.OutputFormat, events)
+	if !c.Watch {
+		return nil
+	}
+
+	fmt.Fprintln(c.Output)
+	instances, err := c.App.WatchInstances(c.Namespace, c.name)
+	if err != nil {
+		return err
+	}
+	eventWatch, err := c.App.WatchEvents(c.Namespace, servicecatalog.InstanceKind, c.name)
+	if err != nil {
+		instances.Stop()
+		return err
+	}
+
+	return c.StreamWatches(c.Output, since, instances, eventWatch)
+}
@@ -18,9 +18,11 @@ package instance
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
 )
 
@@ -29,6 +31,9 @@ type getCmd struct {
 	*command.Formatted
 	*command.PlanFiltered
 	*command.ClassFiltered
+	*command.Watched
+	*command.Selected
+	*command.Sorted
 	name string
 }
 
@@ -39,6 +44,9 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		Formatted:     command.NewFormatted(),
 		ClassFiltered: command.NewClassFiltered(),
 		PlanFiltered:  command.NewPlanFiltered(),
+		Watched:       command.NewWatched(),
+		Selected:      command.NewSelected(),
+		Sorted:        command.NewSorted(),
 	}
 	cmd := &cobra.Command{
 		Use:     "instances [NAME]",
@@ -49,8 +57,11 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get instances --class redis
   svcat get instances --plan default
   svcat get instances --all-namespaces
+  svcat get instances -l team=payments --sort-by .metadata.creationTimestamp
+  svcat get instances -o custom-columns=NAME:.metadata.name,PLAN:.spec.clusterServicePlanExternalName
   svcat get instance wordpress-mysql-instance
   svcat get instance -n ci concourse-postgres-instance
+  svcat get instance wordpress-mysql-instance --watch
 `),
 		PreRunE: command.PreRunE(getCmd),
 		RunE:    command.RunE(getCmd),
@@ -59,11 +70,21 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddClassFlag(cmd)
 	getCmd.AddPlanFlag(cmd)
+	getCmd.AddWatchFlag(cmd)
+	getCmd.AddSelectorFlag(cmd)
+	getCmd.AddSortByFlag(cmd)
 
 	return cmd
 }
 
 func (c *getCmd) Validate(args []string) error {
+	if c.Watch && (c.ClassFilter != "" || c.PlanFilter != "") {
+		return fmt.Errorf("class and plan filters are not supported with --watch")
+	}
+	if c.Watch && c.RawSelector != "" {
+		return fmt.Errorf("--selector is not supported with --watch")
+	}
+
 	if len(args) > 0 {
 		c.name = args[0]
 
@@ -74,12 +95,20 @@ func (c *getCmd) Validate(args []string) error {
 		if c.PlanFilter != "" {
 			return fmt.Errorf("plan filter is not supported when specifiying instance name")
 		}
+
+		if c.RawSelector != "" {
+			return fmt.Errorf("--selector is not supported when specifiying instance name")
+		}
 	}
 
 	return nil
 }
 
 func (c *getCmd) Run() error {
+	if c.Watch {
+		return c.watch()
+	}
+
 	if c.name == "" {
 		return c.getAll()
 	}
@@ -93,6 +122,17 @@ func (c *getCmd) getAll() error {
 		return err
 	}
 
+	selected := instances.Items[:0]
+	for _, instance := range instances.Items {
+		if c.Matches(&instance) {
+			selected = append(selected, instance)
+		}
+	}
+	instances.Items = selected
+	if err := c.SortItems(instances.Items); err != nil {
+		return err
+	}
+
 	output.WriteInstanceList(c.Output, c.OutputFormat, instances)
 	return nil
 }
@@ -107,3 +147,18 @@ func (c *getCmd) get() error {
 
 	return nil
 }
+
+func (c *getCmd) watch() error {
+	since := time.Now()
+	instances, err := c.App.WatchInstances(c.Namespace, c.name)
+	if err != nil {
+		return err
+	}
+	events, err := c.App.WatchEvents(c.Namespace, servicecatalog.InstanceKind, c.name)
+	if err != nil {
+		instances.Stop()
+		return err
+	}
+
+	return c.StreamWatches(c.Output, since, instances, events)
+}
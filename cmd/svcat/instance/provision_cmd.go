@@ -22,6 +22,7 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/parameters"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
 )
 
@@ -38,6 +39,7 @@ type provisonCmd struct {
 	params       interface{}
 	rawSecrets   []string
 	secrets      map[string]string
+	dryRun       bool
 }
 
 // NewProvisionCmd builds a "svcat provision" command
@@ -53,6 +55,7 @@ func NewProvisionCmd(cxt *command.Context) *cobra.Command {
   svcat provision wordpress-mysql-instance --class mysqldb --plan free -p location=eastus -p sslEnforcement=disabled
   svcat provision wordpress-mysql-instance --external-id a7c00676-4398-11e8-842f-0ed5f89f718b --class mysqldb --plan free
   svcat provision wordpress-mysql-instance --class mysqldb --plan free -s mysecret[dbparams]
+  svcat provision wordpress-mysql-instance --class mysqldb --plan free -p location=eastus --dry-run
   svcat provision secure-instance --class mysqldb --plan secureDB --params-json '{
     "encrypt" : true,
     "firewallRules" : [
@@ -82,6 +85,8 @@ func NewProvisionCmd(cxt *command.Context) *cobra.Command {
 		"Additional parameter, whose value is stored in a secret, to use when provisioning the service, format: SECRET[KEY]")
 	cmd.Flags().StringVar(&provisionCmd.jsonParams, "params-json", "",
 		"Additional parameters to use when provisioning the service, provided as a JSON object. Cannot be combined with --param")
+	cmd.Flags().BoolVar(&provisionCmd.dryRun, "dry-run", false,
+		"Validate the instance against the catalog, including the plan's parameter schema, without provisioning it")
 	provisionCmd.AddWaitFlags(cmd)
 
 	return cmd
@@ -93,6 +98,10 @@ func (c *provisonCmd) Validate(args []string) error {
 	}
 	c.instanceName = args[0]
 
+	if c.dryRun && c.Wait {
+		return fmt.Errorf("--wait is not supported with --dry-run")
+	}
+
 	var err error
 
 	if c.jsonParams != "" && len(c.rawParams) > 0 {
@@ -124,11 +133,18 @@ func (c *provisonCmd) Run() error {
 }
 
 func (c *provisonCmd) Provision() error {
-	instance, err := c.App.Provision(c.Namespace, c.instanceName, c.externalID, c.className, c.planName, c.params, c.secrets)
+	opts := &servicecatalog.ProvisionOptions{DryRun: c.dryRun}
+	instance, err := c.App.Provision(c.Namespace, c.instanceName, c.externalID, c.className, c.planName, c.params, c.secrets, opts)
 	if err != nil {
 		return err
 	}
 
+	if c.dryRun {
+		output.WriteInstanceDetails(c.Output, instance)
+		fmt.Fprintln(c.Output, "\nDry run: the instance is valid and was not provisioned.")
+		return nil
+	}
+
 	if c.Wait {
 		fmt.Fprintln(c.Output, "Waiting for the instance to be provisioned...")
 		finalInstance, err := c.App.WaitForInstance(instance.Namespace, instance.Name, c.Interval, c.Timeout)
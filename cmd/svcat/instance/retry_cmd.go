@@ -0,0 +1,67 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/spf13/cobra"
+)
+
+type retryInstanceCmd struct {
+	*command.Namespaced
+	name string
+}
+
+// NewRetryCmd builds a "svcat retry instance" command.
+func NewRetryCmd(cxt *command.Context) *cobra.Command {
+	retryInstanceCmd := &retryInstanceCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:   "instance NAME",
+		Short: "Retry the failed provision or update of an instance",
+		Long: `Retry instance increments the retryRequests field of a failed instance. Then,
+service catalog clears the Failed condition and sends the provision or update
+request to the broker again, starting over with a fresh backoff.`,
+		Example: command.NormalizeExamples(`svcat retry instance wordpress-mysql-instance --namespace mynamespace`),
+		PreRunE: command.PreRunE(retryInstanceCmd),
+		RunE:    command.RunE(retryInstanceCmd),
+	}
+	retryInstanceCmd.AddNamespaceFlags(cmd.Flags(), false)
+
+	return cmd
+}
+
+func (c *retryInstanceCmd) Validate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("an instance name is required")
+	}
+	c.name = args[0]
+
+	return nil
+}
+
+func (c *retryInstanceCmd) Run() error {
+	const retries = 3
+	instance, err := c.App.RetryInstance(c.Namespace, c.name, retries)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.Output, "Retrying instance %s/%s\n", instance.Namespace, instance.Name)
+	return nil
+}
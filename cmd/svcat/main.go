@@ -28,6 +28,7 @@ import (
 	_ "github.com/golang/glog" // Initialize glog flags
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/binding"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/broker"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/bundle"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/class"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/completion"
@@ -124,10 +125,17 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 	cmd.AddCommand(binding.NewBindCmd(cxt))
 	cmd.AddCommand(binding.NewUnbindCmd(cxt))
 	cmd.AddCommand(newSyncCmd(cxt))
+	cmd.AddCommand(newDiffCmd(cxt))
 	if !plugin.IsPlugin() {
 		cmd.AddCommand(newInstallCmd(cxt))
 	}
 	cmd.AddCommand(newTouchCmd(cxt))
+	cmd.AddCommand(newUpgradeCmd(cxt))
+	cmd.AddCommand(newCancelCmd(cxt))
+	cmd.AddCommand(newRetryCmd(cxt))
+	cmd.AddCommand(newEventsCmd(cxt))
+	cmd.AddCommand(bundle.NewExportCmd(cxt))
+	cmd.AddCommand(bundle.NewImportCmd(cxt))
 	cmd.AddCommand(versions.NewVersionCmd(cxt))
 	cmd.AddCommand(newCompletionCmd(cxt))
 
@@ -145,6 +153,16 @@ func newSyncCmd(cxt *command.Context) *cobra.Command {
 	return cmd
 }
 
+func newDiffCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show what the next sync of a service broker would change",
+	}
+	cmd.AddCommand(broker.NewDiffCmd(cxt))
+
+	return cmd
+}
+
 func newCreateCmd(cxt *command.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -202,6 +220,43 @@ func newTouchCmd(cxt *command.Context) *cobra.Command {
 	return cmd
 }
 
+func newUpgradeCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade a resource to a new maintenance version",
+	}
+	cmd.AddCommand(instance.NewUpgradeCmd(cxt))
+	return cmd
+}
+
+func newCancelCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel the operation in progress on a resource",
+	}
+	cmd.AddCommand(instance.NewCancelCmd(cxt))
+	return cmd
+}
+
+func newRetryCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retry the failed operation of a resource",
+	}
+	cmd.AddCommand(instance.NewRetryCmd(cxt))
+	cmd.AddCommand(binding.NewRetryCmd(cxt))
+	return cmd
+}
+
+func newEventsCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Show the Kubernetes events recorded for a resource",
+	}
+	cmd.AddCommand(instance.NewEventsCmd(cxt))
+	return cmd
+}
+
 func newCompletionCmd(ctx *command.Context) *cobra.Command {
 	return completion.NewCompletionCmd(ctx)
 }
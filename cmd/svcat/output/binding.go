@@ -36,22 +36,30 @@ func getBindingStatusFull(status v1beta1.ServiceBindingStatus) string {
 	return formatStatusFull(string(lastCond.Type), lastCond.Status, lastCond.Reason, lastCond.Message, lastCond.LastTransitionTime)
 }
 
-func writeBindingListTable(w io.Writer, bindingList *v1beta1.ServiceBindingList) {
+func writeBindingListTable(w io.Writer, bindingList *v1beta1.ServiceBindingList, wide bool) {
 	t := NewListTable(w)
-	t.SetHeader([]string{
+	header := []string{
 		"Name",
 		"Namespace",
 		"Instance",
 		"Status",
-	})
+	}
+	if wide {
+		header = append(header, "Secret", "External ID")
+	}
+	t.SetHeader(header)
 
 	for _, binding := range bindingList.Items {
-		t.Append([]string{
+		row := []string{
 			binding.Name,
 			binding.Namespace,
 			binding.Spec.ServiceInstanceRef.Name,
 			getBindingStatusShort(binding.Status),
-		})
+		}
+		if wide {
+			row = append(row, binding.Spec.SecretName, binding.Spec.ExternalID)
+		}
+		t.Append(row)
 	}
 	t.Render()
 }
@@ -64,7 +72,15 @@ func WriteBindingList(w io.Writer, outputFormat string, bindingList *v1beta1.Ser
 	case FormatYAML:
 		writeYAML(w, bindingList, 0)
 	case FormatTable:
-		writeBindingListTable(w, bindingList)
+		writeBindingListTable(w, bindingList, false)
+	case FormatWide:
+		writeBindingListTable(w, bindingList, true)
+	case FormatName:
+		for i := range bindingList.Items {
+			writeNames(w, &bindingList.Items[i])
+		}
+	default:
+		writeTemplate(w, outputFormat, bindingList)
 	}
 }
 
@@ -75,11 +91,15 @@ func WriteBinding(w io.Writer, outputFormat string, binding v1beta1.ServiceBindi
 		writeJSON(w, binding)
 	case FormatYAML:
 		writeYAML(w, binding, 0)
-	case FormatTable:
+	case FormatTable, FormatWide:
 		l := v1beta1.ServiceBindingList{
 			Items: []v1beta1.ServiceBinding{binding},
 		}
-		writeBindingListTable(w, &l)
+		writeBindingListTable(w, &l, outputFormat == FormatWide)
+	case FormatName:
+		writeNames(w, &binding)
+	default:
+		writeTemplate(w, outputFormat, binding)
 	}
 }
 
@@ -17,9 +17,11 @@ limitations under the License.
 package output
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 )
 
@@ -40,21 +42,35 @@ func getBrokerStatusFull(status v1beta1.CommonServiceBrokerStatus) string {
 	return formatStatusFull(string(lastCond.Type), lastCond.Status, lastCond.Reason, lastCond.Message, lastCond.LastTransitionTime)
 }
 
-func writeBrokerListTable(w io.Writer, brokers []servicecatalog.Broker) {
+func writeBrokerListTable(w io.Writer, brokers []servicecatalog.Broker, wide bool) {
 	t := NewListTable(w)
-	t.SetHeader([]string{
+	header := []string{
 		"Name",
 		"Namespace",
 		"URL",
 		"Status",
-	})
+	}
+	if wide {
+		header = append(header, "OSB API Version", "Catalog Revision")
+	}
+	t.SetHeader(header)
+
 	for _, broker := range brokers {
-		t.Append([]string{
+		row := []string{
 			broker.GetName(),
 			broker.GetNamespace(),
 			broker.GetURL(),
 			getBrokerStatusShort(broker.GetStatus()),
-		})
+		}
+		if wide {
+			status := broker.GetStatus()
+			revision := ""
+			if status.CatalogRevision != nil {
+				revision = fmt.Sprintf("%.12s", status.CatalogRevision.Hash)
+			}
+			row = append(row, status.OSBAPIVersion, revision)
+		}
+		t.Append(row)
 	}
 	t.Render()
 }
@@ -67,7 +83,15 @@ func WriteBrokerList(w io.Writer, outputFormat string, brokers ...servicecatalog
 	case FormatYAML:
 		writeYAML(w, brokers, 0)
 	case FormatTable:
-		writeBrokerListTable(w, brokers)
+		writeBrokerListTable(w, brokers, false)
+	case FormatWide:
+		writeBrokerListTable(w, brokers, true)
+	case FormatName:
+		for _, broker := range brokers {
+			writeNames(w, broker)
+		}
+	default:
+		writeTemplate(w, outputFormat, brokers)
 	}
 }
 
@@ -78,8 +102,12 @@ func WriteBroker(w io.Writer, outputFormat string, broker v1beta1.ClusterService
 		writeJSON(w, broker)
 	case FormatYAML:
 		writeYAML(w, broker, 0)
-	case FormatTable:
-		writeBrokerListTable(w, []servicecatalog.Broker{&broker})
+	case FormatTable, FormatWide:
+		writeBrokerListTable(w, []servicecatalog.Broker{&broker}, outputFormat == FormatWide)
+	case FormatName:
+		writeNames(w, &broker)
+	default:
+		writeTemplate(w, outputFormat, broker)
 	}
 }
 
@@ -92,6 +120,38 @@ func WriteBrokerDetails(w io.Writer, broker servicecatalog.Broker) {
 		{"URL:", broker.GetURL()},
 		{"Status:", getBrokerStatusFull(broker.GetStatus())},
 	})
+	if rev := broker.GetStatus().CatalogRevision; rev != nil {
+		t.Append([]string{"Catalog Revision:", fmt.Sprintf("%.12s - %d classes, %d plans @ %s",
+			rev.Hash, rev.ServiceClassCount, rev.ServicePlanCount, rev.Timestamp.UTC())})
+	}
 
 	t.Render()
 }
+
+// WriteCatalogDiff prints the classes and plans that the next relist of a
+// broker would add, change or remove.
+func WriteCatalogDiff(w io.Writer, diff *catalogdiff.Diff) {
+	if diff.IsEmpty() {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Change",
+		"Type",
+		"Name",
+	})
+	appendRows := func(change, kind string, names []string) {
+		for _, name := range names {
+			t.Append([]string{change, kind, name})
+		}
+	}
+	appendRows("Added", "Class", diff.AddedClasses)
+	appendRows("Changed", "Class", diff.ChangedClasses)
+	appendRows("Removed", "Class", diff.RemovedClasses)
+	appendRows("Added", "Plan", diff.AddedPlans)
+	appendRows("Changed", "Plan", diff.ChangedPlans)
+	appendRows("Removed", "Plan", diff.RemovedPlans)
+	t.Render()
+}
@@ -31,22 +31,30 @@ func getClassStatusText(status v1beta1.ClusterServiceClassStatus) string {
 	return statusActive
 }
 
-func writeClassListTable(w io.Writer, classes []servicecatalog.Class) {
+func writeClassListTable(w io.Writer, classes []servicecatalog.Class, wide bool) {
 	t := NewListTable(w)
 
-	t.SetHeader([]string{
+	header := []string{
 		"Name",
 		"Namespace",
 		"Description",
-	})
+	}
+	if wide {
+		header = append(header, "UUID")
+	}
+	t.SetHeader(header)
 	t.SetVariableColumn(3)
 
 	for _, class := range classes {
-		t.Append([]string{
+		row := []string{
 			class.GetExternalName(),
 			class.GetNamespace(),
 			class.GetDescription(),
-		})
+		}
+		if wide {
+			row = append(row, class.GetName())
+		}
+		t.Append(row)
 	}
 
 	t.Render()
@@ -60,7 +68,15 @@ func WriteClassList(w io.Writer, outputFormat string, classes ...servicecatalog.
 	case FormatYAML:
 		writeYAML(w, classes, 0)
 	case FormatTable:
-		writeClassListTable(w, classes)
+		writeClassListTable(w, classes, false)
+	case FormatWide:
+		writeClassListTable(w, classes, true)
+	case FormatName:
+		for _, class := range classes {
+			writeNames(w, class)
+		}
+	default:
+		writeTemplate(w, outputFormat, classes)
 	}
 }
 
@@ -71,8 +87,12 @@ func WriteClass(w io.Writer, outputFormat string, class v1beta1.ClusterServiceCl
 		writeJSON(w, class)
 	case FormatYAML:
 		writeYAML(w, class, 0)
-	case FormatTable:
-		writeClassListTable(w, []servicecatalog.Class{&class})
+	case FormatTable, FormatWide:
+		writeClassListTable(w, []servicecatalog.Class{&class}, outputFormat == FormatWide)
+	case FormatName:
+		writeNames(w, &class)
+	default:
+		writeTemplate(w, outputFormat, class)
 	}
 }
 
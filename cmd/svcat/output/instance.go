@@ -50,26 +50,33 @@ func appendInstanceDashboardURL(status v1beta1.ServiceInstanceStatus, table *tab
 	}
 }
 
-func writeInstanceListTable(w io.Writer, instanceList *v1beta1.ServiceInstanceList) {
+func writeInstanceListTable(w io.Writer, instanceList *v1beta1.ServiceInstanceList, wide bool) {
 	t := NewListTable(w)
-	t.SetHeader([]string{
+	header := []string{
 		"Name",
 		"Namespace",
 		"Class",
 		"Plan",
 		"Status",
-	})
+	}
+	if wide {
+		header = append(header, "External ID", "Operation")
+	}
+	t.SetHeader(header)
 
 	for _, instance := range instanceList.Items {
-		t.Append([]string{
+		row := []string{
 			instance.Name,
 			instance.Namespace,
 			instance.Spec.GetSpecifiedClusterServiceClass(),
 			instance.Spec.GetSpecifiedClusterServicePlan(),
 			getInstanceStatusShort(instance.Status),
-		})
+		}
+		if wide {
+			row = append(row, instance.Spec.ExternalID, string(instance.Status.CurrentOperation))
+		}
+		t.Append(row)
 	}
-
 	t.Render()
 }
 
@@ -81,7 +88,15 @@ func WriteInstanceList(w io.Writer, outputFormat string, instanceList *v1beta1.S
 	case FormatYAML:
 		writeYAML(w, instanceList, 0)
 	case FormatTable:
-		writeInstanceListTable(w, instanceList)
+		writeInstanceListTable(w, instanceList, false)
+	case FormatWide:
+		writeInstanceListTable(w, instanceList, true)
+	case FormatName:
+		for i := range instanceList.Items {
+			writeNames(w, &instanceList.Items[i])
+		}
+	default:
+		writeTemplate(w, outputFormat, instanceList)
 	}
 }
 
@@ -92,11 +107,15 @@ func WriteInstance(w io.Writer, outputFormat string, instance v1beta1.ServiceIns
 		writeJSON(w, instance)
 	case FormatYAML:
 		writeYAML(w, instance, 0)
-	case FormatTable:
+	case FormatTable, FormatWide:
 		p := v1beta1.ServiceInstanceList{
 			Items: []v1beta1.ServiceInstance{instance},
 		}
-		writeInstanceListTable(w, &p)
+		writeInstanceListTable(w, &p, outputFormat == FormatWide)
+	case FormatName:
+		writeNames(w, &instance)
+	default:
+		writeTemplate(w, outputFormat, instance)
 	}
 }
 
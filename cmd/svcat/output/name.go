@@ -0,0 +1,62 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// resourceName returns the reference to obj that kubectl accepts and prints
+// for the name output format, e.g. serviceinstance.servicecatalog.k8s.io/db.
+func resourceName(obj interface{}) string {
+	var resource, name string
+	switch o := obj.(type) {
+	case *v1beta1.ServiceInstance:
+		resource, name = "serviceinstance", o.Name
+	case *v1beta1.ServiceBinding:
+		resource, name = "servicebinding", o.Name
+	case *v1beta1.ClusterServiceBroker:
+		resource, name = "clusterservicebroker", o.Name
+	case *v1beta1.ServiceBroker:
+		resource, name = "servicebroker", o.Name
+	case *v1beta1.ClusterServiceClass:
+		resource, name = "clusterserviceclass", o.Name
+	case *v1beta1.ServiceClass:
+		resource, name = "serviceclass", o.Name
+	case *v1beta1.ClusterServicePlan:
+		resource, name = "clusterserviceplan", o.Name
+	case *v1beta1.ServicePlan:
+		resource, name = "serviceplan", o.Name
+	case *corev1.Event:
+		return "event/" + o.Name
+	default:
+		return fmt.Sprintf("%T", obj)
+	}
+	return fmt.Sprintf("%s.%s/%s", resource, v1beta1.GroupName, name)
+}
+
+// writeNames prints the kubectl reference to each of the given resources on
+// its own line.
+func writeNames(w io.Writer, objs ...interface{}) {
+	for _, obj := range objs {
+		fmt.Fprintln(w, resourceName(obj))
+	}
+}
@@ -39,8 +39,40 @@ const (
 
 	// FormatYAML is the --output flag value for yaml output.
 	FormatYAML = "yaml"
+
+	// FormatWide is the --output flag value for tabular output with
+	// additional columns.
+	FormatWide = "wide"
+
+	// FormatName is the --output flag value for printing only the
+	// resource/name of each result.
+	FormatName = "name"
+
+	// FormatJSONPath is the --output flag prefix for printing the results of
+	// a jsonpath template, e.g. jsonpath={.metadata.name}.
+	FormatJSONPath = "jsonpath"
+
+	// FormatGoTemplate is the --output flag prefix for printing the results
+	// of a go template, e.g. go-template={{.metadata.name}}.
+	FormatGoTemplate = "go-template"
+
+	// FormatCustomColumns is the --output flag prefix for printing a table of
+	// user defined columns, e.g. custom-columns=NAME:.metadata.name.
+	FormatCustomColumns = "custom-columns"
 )
 
+// SplitFormat splits an --output flag value into the name of the format and
+// its template, if any. The name is lowercased while the template is returned
+// as-is.
+func SplitFormat(outputFormat string) (format, template string) {
+	parts := strings.SplitN(outputFormat, "=", 2)
+	format = strings.ToLower(parts[0])
+	if len(parts) == 2 {
+		template = parts[1]
+	}
+	return format, template
+}
+
 func formatStatusShort(condition string, conditionStatus v1beta1.ConditionStatus, reason string) string {
 	if conditionStatus == v1beta1.ConditionTrue {
 		return condition
@@ -19,7 +19,6 @@ package output
 import (
 	"fmt"
 	"io"
-	"sort"
 	"strconv"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -33,36 +32,27 @@ func getPlanStatusShort(status v1beta1.ClusterServicePlanStatus) string {
 	return statusActive
 }
 
-// ByAge implements sort.Interface for []Person based on
-// the Age field.
-type byClass []v1beta1.ClusterServicePlan
-
-func (a byClass) Len() int {
-	return len(a)
-}
-func (a byClass) Swap(i, j int) {
-	a[i], a[j] = a[j], a[i]
-}
-func (a byClass) Less(i, j int) bool {
-	return a[i].Spec.ClusterServiceClassRef.Name < a[j].Spec.ClusterServiceClassRef.Name
-}
-
-func writePlanListTable(w io.Writer, plans []v1beta1.ClusterServicePlan, classNames map[string]string) {
-
-	sort.Sort(byClass(plans))
-
+func writePlanListTable(w io.Writer, plans []v1beta1.ClusterServicePlan, classNames map[string]string, wide bool) {
 	t := NewListTable(w)
-	t.SetHeader([]string{
+	header := []string{
 		"Name",
 		"Class",
 		"Description",
-	})
+	}
+	if wide {
+		header = append(header, "UUID", "Status", "Free")
+	}
+	t.SetHeader(header)
 	for _, plan := range plans {
-		t.Append([]string{
+		row := []string{
 			plan.Spec.ExternalName,
 			classNames[plan.Spec.ClusterServiceClassRef.Name],
 			plan.Spec.Description,
-		})
+		}
+		if wide {
+			row = append(row, plan.Name, getPlanStatusShort(plan.Status), strconv.FormatBool(plan.Spec.Free))
+		}
+		t.Append(row)
 	}
 	t.SetVariableColumn(3)
 
@@ -84,7 +74,15 @@ func WritePlanList(w io.Writer, outputFormat string, plans []v1beta1.ClusterServ
 	case FormatYAML:
 		writeYAML(w, list, 0)
 	case FormatTable:
-		writePlanListTable(w, plans, classNames)
+		writePlanListTable(w, plans, classNames, false)
+	case FormatWide:
+		writePlanListTable(w, plans, classNames, true)
+	case FormatName:
+		for i := range plans {
+			writeNames(w, &plans[i])
+		}
+	default:
+		writeTemplate(w, outputFormat, list)
 	}
 }
 
@@ -96,10 +94,14 @@ func WritePlan(w io.Writer, outputFormat string, plan v1beta1.ClusterServicePlan
 		writeJSON(w, plan)
 	case FormatYAML:
 		writeYAML(w, plan, 0)
-	case FormatTable:
+	case FormatTable, FormatWide:
 		classNames := map[string]string{}
 		classNames[class.Name] = class.Spec.ExternalName
-		writePlanListTable(w, []v1beta1.ClusterServicePlan{plan}, classNames)
+		writePlanListTable(w, []v1beta1.ClusterServicePlan{plan}, classNames, outputFormat == FormatWide)
+	case FormatName:
+		writeNames(w, &plan)
+	default:
+		writeTemplate(w, outputFormat, plan)
 	}
 }
 
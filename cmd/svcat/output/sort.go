@@ -0,0 +1,86 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ValidateSortBy checks that a --sort-by expression is a valid jsonpath.
+func ValidateSortBy(expression string) error {
+	_, err := newRelaxedJSONPath(expression)
+	return err
+}
+
+// SortByJSONPath sorts a slice of resources by the value that a jsonpath
+// expression, such as .metadata.name, selects from each of them. Resources
+// missing the value are sorted first, and resources with equal values keep
+// their original order.
+func SortByJSONPath(items interface{}, expression string) error {
+	j, err := newRelaxedJSONPath(expression)
+	if err != nil {
+		return err
+	}
+
+	slice := reflect.ValueOf(items)
+	if slice.Kind() != reflect.Slice {
+		return fmt.Errorf("unable to sort %T, expected a slice", items)
+	}
+
+	keys := make([]interface{}, slice.Len())
+	order := make([]int, slice.Len())
+	for i := range keys {
+		data, err := toGeneric(slice.Index(i).Interface())
+		if err != nil {
+			return err
+		}
+		values, err := findValues(j, data)
+		if err != nil {
+			return fmt.Errorf("unable to sort by %q (%s)", expression, err)
+		}
+		if len(values) > 0 {
+			keys[i] = values[0]
+		}
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return lessSortKey(keys[order[a]], keys[order[b]])
+	})
+
+	sorted := reflect.MakeSlice(slice.Type(), slice.Len(), slice.Len())
+	for i, index := range order {
+		sorted.Index(i).Set(slice.Index(index))
+	}
+	reflect.Copy(slice, sorted)
+	return nil
+}
+
+// lessSortKey compares numbers numerically and everything else by its text.
+func lessSortKey(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+	if x, ok := a.(float64); ok {
+		if y, ok := b.(float64); ok {
+			return x < y
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
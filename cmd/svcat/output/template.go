@@ -0,0 +1,229 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// ValidateFormat checks that an --output flag value names a supported format
+// and that its template, if any, can be parsed.
+func ValidateFormat(outputFormat string) error {
+	format, tmpl := SplitFormat(outputFormat)
+	switch format {
+	case FormatTable, FormatWide, FormatName, FormatJSON, FormatYAML:
+		if tmpl != "" {
+			return fmt.Errorf("the %s output format does not accept a template", format)
+		}
+		return nil
+	case FormatJSONPath, FormatGoTemplate, FormatCustomColumns:
+		if tmpl == "" {
+			return fmt.Errorf("the %s output format requires a template, e.g. %s=...", format, format)
+		}
+		_, err := newTemplatePrinter(format, tmpl)
+		return err
+	default:
+		return fmt.Errorf("invalid --output format %q, allowed values are: table, wide, name, json, yaml, jsonpath=..., go-template=... and custom-columns=...", outputFormat)
+	}
+}
+
+// templatePrinter renders the results of a jsonpath, go-template or
+// custom-columns template.
+type templatePrinter interface {
+	print(w io.Writer, data interface{}) error
+}
+
+func newTemplatePrinter(format, tmpl string) (templatePrinter, error) {
+	switch format {
+	case FormatJSONPath:
+		j := jsonpath.New("output")
+		if err := j.Parse(tmpl); err != nil {
+			return nil, fmt.Errorf("invalid jsonpath template %q (%s)", tmpl, err)
+		}
+		return &jsonPathPrinter{j}, nil
+	case FormatGoTemplate:
+		t, err := template.New("output").Parse(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid go-template %q (%s)", tmpl, err)
+		}
+		return &goTemplatePrinter{t}, nil
+	case FormatCustomColumns:
+		return newCustomColumnsPrinter(tmpl)
+	default:
+		return nil, fmt.Errorf("%s is not a template output format", format)
+	}
+}
+
+// writeTemplate writes obj using the template of a jsonpath=, go-template= or
+// custom-columns= output format. The template is applied to the same document
+// that the json output format prints.
+func writeTemplate(w io.Writer, outputFormat string, obj interface{}) {
+	printer, err := newTemplatePrinter(SplitFormat(outputFormat))
+	if err != nil {
+		fmt.Fprintf(w, "err parsing template: %v\n", err)
+		return
+	}
+	data, err := toGeneric(obj)
+	if err != nil {
+		fmt.Fprintf(w, "err marshaling json: %v\n", err)
+		return
+	}
+	if err := printer.print(w, data); err != nil {
+		fmt.Fprintf(w, "err executing template: %v\n", err)
+	}
+}
+
+// toGeneric converts obj into the maps, slices and values that its json
+// representation decodes to, so that templates address fields by their json
+// names.
+func toGeneric(obj interface{}) (interface{}, error) {
+	j, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	err = json.Unmarshal(j, &data)
+	return data, err
+}
+
+type jsonPathPrinter struct {
+	jsonPath *jsonpath.JSONPath
+}
+
+func (p *jsonPathPrinter) print(w io.Writer, data interface{}) error {
+	return p.jsonPath.Execute(w, data)
+}
+
+type goTemplatePrinter struct {
+	template *template.Template
+}
+
+func (p *goTemplatePrinter) print(w io.Writer, data interface{}) error {
+	return p.template.Execute(w, data)
+}
+
+type customColumn struct {
+	header   string
+	jsonPath *jsonpath.JSONPath
+}
+
+// customColumnsPrinter prints a table with a row for each listed item, or a
+// single row when printing one resource.
+type customColumnsPrinter struct {
+	columns []customColumn
+}
+
+// newCustomColumnsPrinter parses a comma separated list of HEADER:expression
+// column definitions, where each expression is a jsonpath relative to an item.
+func newCustomColumnsPrinter(spec string) (*customColumnsPrinter, error) {
+	p := &customColumnsPrinter{}
+	for _, def := range strings.Split(spec, ",") {
+		parts := strings.SplitN(def, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid custom column %q, expected HEADER:expression", def)
+		}
+		j, err := newRelaxedJSONPath(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		p.columns = append(p.columns, customColumn{header: parts[0], jsonPath: j})
+	}
+	return p, nil
+}
+
+func (p *customColumnsPrinter) print(w io.Writer, data interface{}) error {
+	items := []interface{}{data}
+	switch d := data.(type) {
+	case []interface{}:
+		items = d
+	case map[string]interface{}:
+		if list, ok := d["items"].([]interface{}); ok {
+			items = list
+		}
+	}
+
+	t := NewListTable(w)
+	var headers []string
+	for _, column := range p.columns {
+		headers = append(headers, column.header)
+	}
+	t.SetHeader(headers)
+
+	for _, item := range items {
+		var row []string
+		for _, column := range p.columns {
+			values, err := findValues(column.jsonPath, item)
+			if err != nil {
+				return err
+			}
+			cell := "<none>"
+			if len(values) > 0 {
+				var cells []string
+				for _, value := range values {
+					cells = append(cells, fmt.Sprint(value))
+				}
+				cell = strings.Join(cells, ",")
+			}
+			row = append(row, cell)
+		}
+		t.Append(row)
+	}
+	t.Render()
+	return nil
+}
+
+// newRelaxedJSONPath parses a jsonpath expression, allowing the surrounding
+// braces and the leading dot to be omitted as kubectl does for
+// custom-columns and --sort-by.
+func newRelaxedJSONPath(expression string) (*jsonpath.JSONPath, error) {
+	relaxed := strings.TrimSuffix(strings.TrimPrefix(expression, "{"), "}")
+	if !strings.HasPrefix(relaxed, ".") {
+		relaxed = "." + relaxed
+	}
+
+	j := jsonpath.New("column").AllowMissingKeys(true)
+	if err := j.Parse("{" + relaxed + "}"); err != nil {
+		return nil, fmt.Errorf("invalid jsonpath expression %q (%s)", expression, err)
+	}
+	return j, nil
+}
+
+// findValues returns the values that a jsonpath selects from data.
+func findValues(j *jsonpath.JSONPath, data interface{}) ([]interface{}, error) {
+	results, err := j.FindResults(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	for _, result := range results {
+		for _, value := range result {
+			if value.Kind() == reflect.Interface && value.IsNil() {
+				continue
+			}
+			values = append(values, value.Interface())
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr string
+	}{
+		{format: "table"},
+		{format: "WIDE"},
+		{format: "name"},
+		{format: "jsonpath={.metadata.name}"},
+		{format: "go-template={{.metadata.name}}"},
+		{format: "custom-columns=NAME:.metadata.name,UID:{.metadata.uid}"},
+		{format: "xml", wantErr: `invalid --output format "xml"`},
+		{format: "json=foo", wantErr: "the json output format does not accept a template"},
+		{format: "jsonpath", wantErr: "the jsonpath output format requires a template"},
+		{format: "jsonpath={.metadata", wantErr: "invalid jsonpath template"},
+		{format: "go-template={{.metadata", wantErr: "invalid go-template"},
+		{format: "custom-columns=NAME", wantErr: `invalid custom column "NAME"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			err := ValidateFormat(tt.format)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWriteTemplate(t *testing.T) {
+	list := &v1beta1.ServiceInstanceList{
+		Items: []v1beta1.ServiceInstance{
+			{ObjectMeta: v1.ObjectMeta{Name: "db", Labels: map[string]string{"team": "payments"}}},
+			{ObjectMeta: v1.ObjectMeta{Name: "cache"}},
+		},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"jsonpath={.items[*].metadata.name}", "db cache"},
+		{"go-template={{range .items}}{{.metadata.name}};{{end}}", "db;cache;"},
+		{"custom-columns=NAME:.metadata.name,TEAM:metadata.labels.team", "NAME      TEAM    \n+-------+----------+\n  db      payments  \n  cache   <none>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var b strings.Builder
+			writeTemplate(&b, tt.format, list)
+			if got := strings.TrimSpace(b.String()); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSortByJSONPath(t *testing.T) {
+	plans := []v1beta1.ClusterServicePlan{
+		{ObjectMeta: v1.ObjectMeta{Name: "b"}, Spec: v1beta1.ClusterServicePlanSpec{CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{ExternalName: "large"}}},
+		{ObjectMeta: v1.ObjectMeta{Name: "c"}},
+		{ObjectMeta: v1.ObjectMeta{Name: "a"}, Spec: v1beta1.ClusterServicePlanSpec{CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{ExternalName: "large"}}},
+		{ObjectMeta: v1.ObjectMeta{Name: "d"}, Spec: v1beta1.ClusterServicePlanSpec{CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{ExternalName: "default"}}},
+	}
+
+	if err := SortByJSONPath(plans, ".spec.externalName"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, plan := range plans {
+		names = append(names, plan.Name)
+	}
+	// plans without the field come first, ties keep their order
+	if got := strings.Join(names, ","); got != "c,d,b,a" {
+		t.Fatalf("unexpected order %s", got)
+	}
+
+	if err := SortByJSONPath(plans, ".spec["); err == nil {
+		t.Fatal("expected an error for an invalid expression")
+	}
+}
+
+func TestLessSortKey(t *testing.T) {
+	if !lessSortKey(float64(2), float64(10)) {
+		t.Error("expected numbers to be compared numerically")
+	}
+	if !lessSortKey("10", "2") {
+		t.Error("expected strings to be compared lexically")
+	}
+	if !lessSortKey(nil, "a") || lessSortKey("a", nil) || lessSortKey(nil, nil) {
+		t.Error("expected missing values to sort first")
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const watchTimeFormat = "15:04:05"
+
+// watchedCondition is a condition of a watched resource.
+type watchedCondition struct {
+	condType string
+	status   v1beta1.ConditionStatus
+	reason   string
+	message  string
+}
+
+// watchedState is the part of a watched resource's status that is reported
+// when it changes.
+type watchedState struct {
+	conditions       []watchedCondition
+	currentOperation string
+	lastOperation    string
+	deleting         bool
+}
+
+// WatchPrinter writes a line for each change to the status of watched
+// instances, bindings and brokers, and for each Kubernetes event recorded for
+// them.
+type WatchPrinter struct {
+	w     io.Writer
+	since time.Time
+	now   func() time.Time
+	seen  map[string]*watchedState
+}
+
+// NewWatchPrinter creates a printer writing to w. Kubernetes events last seen
+// before since are not printed.
+func NewWatchPrinter(w io.Writer, since time.Time) *WatchPrinter {
+	return &WatchPrinter{
+		w:     w,
+		since: since,
+		now:   time.Now,
+		seen:  make(map[string]*watchedState),
+	}
+}
+
+// WriteWatchEvent prints the changes reported by a watch event.
+func (p *WatchPrinter) WriteWatchEvent(event watch.Event) {
+	switch obj := event.Object.(type) {
+	case *v1beta1.ServiceInstance:
+		p.writeChanges(event.Type, "instance", namespacedName(obj.ObjectMeta), instanceWatchedState(obj))
+	case *v1beta1.ServiceBinding:
+		p.writeChanges(event.Type, "binding", namespacedName(obj.ObjectMeta), bindingWatchedState(obj))
+	case *v1beta1.ClusterServiceBroker:
+		p.writeChanges(event.Type, "broker", obj.Name, brokerWatchedState(obj.ObjectMeta, obj.Status.CommonServiceBrokerStatus))
+	case *v1beta1.ServiceBroker:
+		p.writeChanges(event.Type, "broker", namespacedName(obj.ObjectMeta), brokerWatchedState(obj.ObjectMeta, obj.Status.CommonServiceBrokerStatus))
+	case *corev1.Event:
+		if event.Type != watch.Deleted {
+			p.writeEvent(obj)
+		}
+	}
+}
+
+func (p *WatchPrinter) writeChanges(eventType watch.EventType, kind, name string, state *watchedState) {
+	key := kind + "/" + name
+	if eventType == watch.Deleted {
+		delete(p.seen, key)
+		p.writeLine(p.now(), kind, name, "deleted")
+		return
+	}
+
+	previous, ok := p.seen[key]
+	p.seen[key] = state
+	if !ok {
+		previous = &watchedState{}
+		if eventType == watch.Added && len(state.conditions) == 0 {
+			p.writeLine(p.now(), kind, name, "added")
+		}
+	}
+
+	if state.deleting && !previous.deleting {
+		p.writeLine(p.now(), kind, name, "deletion requested")
+	}
+	for _, condition := range state.conditions {
+		if !previous.hasCondition(condition) {
+			p.writeLine(p.now(), kind, name, formatWatchedCondition(condition))
+		}
+	}
+	if state.currentOperation != previous.currentOperation {
+		if state.currentOperation != "" {
+			p.writeLine(p.now(), kind, name, fmt.Sprintf("started %s operation", state.currentOperation))
+		} else {
+			p.writeLine(p.now(), kind, name, fmt.Sprintf("finished %s operation", previous.currentOperation))
+		}
+	}
+	if state.lastOperation != previous.lastOperation && state.lastOperation != "" {
+		p.writeLine(p.now(), kind, name, fmt.Sprintf("broker operation %q", state.lastOperation))
+	}
+}
+
+func (p *WatchPrinter) writeEvent(event *corev1.Event) {
+	if event.LastTimestamp.Time.Before(p.since) {
+		return
+	}
+
+	name := event.InvolvedObject.Name
+	if event.InvolvedObject.Namespace != "" {
+		name = event.InvolvedObject.Namespace + "/" + name
+	}
+	message := strings.TrimRight(event.Message, ".")
+	p.writeLine(event.LastTimestamp.Time, eventObjectKind(event.InvolvedObject.Kind), name,
+		fmt.Sprintf("event %s %s: %s", event.Type, event.Reason, message))
+}
+
+func (p *WatchPrinter) writeLine(timestamp time.Time, kind, name, change string) {
+	fmt.Fprintf(p.w, "%s  %s %s: %s\n", timestamp.Format(watchTimeFormat), kind, name, change)
+}
+
+func (s *watchedState) hasCondition(condition watchedCondition) bool {
+	for _, c := range s.conditions {
+		if c == condition {
+			return true
+		}
+	}
+	return false
+}
+
+func formatWatchedCondition(condition watchedCondition) string {
+	change := fmt.Sprintf("%s=%s", condition.condType, condition.status)
+	if condition.reason != "" {
+		change += fmt.Sprintf(" (%s)", condition.reason)
+	}
+	if condition.message != "" {
+		change += ": " + strings.TrimRight(condition.message, ".")
+	}
+	return change
+}
+
+func instanceWatchedState(instance *v1beta1.ServiceInstance) *watchedState {
+	state := &watchedState{
+		currentOperation: string(instance.Status.CurrentOperation),
+		deleting:         instance.DeletionTimestamp != nil,
+	}
+	if instance.Status.LastOperation != nil {
+		state.lastOperation = *instance.Status.LastOperation
+	}
+	for _, c := range instance.Status.Conditions {
+		state.conditions = append(state.conditions, watchedCondition{string(c.Type), c.Status, c.Reason, c.Message})
+	}
+	return state
+}
+
+func bindingWatchedState(binding *v1beta1.ServiceBinding) *watchedState {
+	state := &watchedState{
+		currentOperation: string(binding.Status.CurrentOperation),
+		deleting:         binding.DeletionTimestamp != nil,
+	}
+	if binding.Status.LastOperation != nil {
+		state.lastOperation = *binding.Status.LastOperation
+	}
+	for _, c := range binding.Status.Conditions {
+		state.conditions = append(state.conditions, watchedCondition{string(c.Type), c.Status, c.Reason, c.Message})
+	}
+	return state
+}
+
+func brokerWatchedState(meta v1.ObjectMeta, status v1beta1.CommonServiceBrokerStatus) *watchedState {
+	state := &watchedState{
+		deleting: meta.DeletionTimestamp != nil,
+	}
+	for _, c := range status.Conditions {
+		state.conditions = append(state.conditions, watchedCondition{string(c.Type), c.Status, c.Reason, c.Message})
+	}
+	return state
+}
+
+func namespacedName(meta v1.ObjectMeta) string {
+	return meta.Namespace + "/" + meta.Name
+}
+
+func eventObjectKind(kind string) string {
+	switch kind {
+	case "ServiceInstance":
+		return "instance"
+	case "ServiceBinding":
+		return "binding"
+	case "ClusterServiceBroker", "ServiceBroker":
+		return "broker"
+	default:
+		return strings.ToLower(kind)
+	}
+}
+
+func writeEventListTable(w io.Writer, events []corev1.Event, wide bool) {
+	t := NewListTable(w)
+	header := []string{
+		"Last Seen",
+		"Type",
+		"Reason",
+		"Count",
+		"Message",
+	}
+	if wide {
+		header = append(header, "First Seen", "Source")
+	}
+	t.SetHeader(header)
+
+	for _, event := range events {
+		row := []string{
+			event.LastTimestamp.UTC().Format(time.RFC3339),
+			event.Type,
+			event.Reason,
+			fmt.Sprintf("%d", event.Count),
+			strings.TrimRight(event.Message, "."),
+		}
+		if wide {
+			row = append(row, event.FirstTimestamp.UTC().Format(time.RFC3339), event.Source.Component)
+		}
+		t.Append(row)
+	}
+
+	t.Render()
+}
+
+// WriteEventList prints a list of Kubernetes events.
+func WriteEventList(w io.Writer, outputFormat string, events []corev1.Event) {
+	switch outputFormat {
+	case FormatJSON:
+		writeJSON(w, events)
+	case FormatYAML:
+		writeYAML(w, events, 0)
+	case FormatTable:
+		writeEventListTable(w, events, false)
+	case FormatWide:
+		writeEventListTable(w, events, true)
+	case FormatName:
+		for i := range events {
+			writeNames(w, &events[i])
+		}
+	default:
+		writeTemplate(w, outputFormat, events)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestWatchPrinter(t *testing.T) {
+	now := time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+	since := now.Add(-time.Minute)
+	operationKey := "op-1"
+
+	instance := func(operation v1beta1.ServiceInstanceOperation, lastOperation *string, conditions ...v1beta1.ServiceInstanceCondition) *v1beta1.ServiceInstance {
+		return &v1beta1.ServiceInstance{
+			ObjectMeta: v1.ObjectMeta{Namespace: "ns", Name: "db"},
+			Status: v1beta1.ServiceInstanceStatus{
+				CurrentOperation: operation,
+				LastOperation:    lastOperation,
+				Conditions:       conditions,
+			},
+		}
+	}
+	provisioning := v1beta1.ServiceInstanceCondition{
+		Type:    v1beta1.ServiceInstanceConditionReady,
+		Status:  v1beta1.ConditionFalse,
+		Reason:  "Provisioning",
+		Message: "The instance is being provisioned asynchronously.",
+	}
+	provisioned := v1beta1.ServiceInstanceCondition{
+		Type:    v1beta1.ServiceInstanceConditionReady,
+		Status:  v1beta1.ConditionTrue,
+		Reason:  "ProvisionedSuccessfully",
+		Message: "The instance was provisioned successfully",
+	}
+	event := func(lastSeen time.Time, reason string) *corev1.Event {
+		return &corev1.Event{
+			InvolvedObject: corev1.ObjectReference{Kind: "ServiceInstance", Namespace: "ns", Name: "db"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         reason,
+			Message:        "The instance was provisioned successfully.",
+			LastTimestamp:  v1.NewTime(lastSeen),
+		}
+	}
+
+	events := []watch.Event{
+		{Type: watch.Added, Object: instance("", nil)},
+		{Type: watch.Modified, Object: instance(v1beta1.ServiceInstanceOperationProvision, nil, provisioning)},
+		{Type: watch.Modified, Object: instance(v1beta1.ServiceInstanceOperationProvision, &operationKey, provisioning)},
+		// unchanged status
+		{Type: watch.Modified, Object: instance(v1beta1.ServiceInstanceOperationProvision, &operationKey, provisioning)},
+		{Type: watch.Added, Object: event(since.Add(-time.Second), "OldEvent")},
+		{Type: watch.Added, Object: event(now, "ProvisionedSuccessfully")},
+		{Type: watch.Modified, Object: instance("", &operationKey, provisioned)},
+		{Type: watch.Deleted, Object: instance("", &operationKey, provisioned)},
+	}
+
+	buf := &bytes.Buffer{}
+	printer := NewWatchPrinter(buf, since)
+	printer.now = func() time.Time { return now }
+	for _, e := range events {
+		printer.WriteWatchEvent(e)
+	}
+
+	expected := `12:00:00  instance ns/db: added
+12:00:00  instance ns/db: Ready=False (Provisioning): The instance is being provisioned asynchronously
+12:00:00  instance ns/db: started Provision operation
+12:00:00  instance ns/db: broker operation "op-1"
+12:00:00  instance ns/db: event Normal ProvisionedSuccessfully: The instance was provisioned successfully
+12:00:00  instance ns/db: Ready=True (ProvisionedSuccessfully): The instance was provisioned successfully
+12:00:00  instance ns/db: finished Provision operation
+12:00:00  instance ns/db: deleted
+`
+	if actual := buf.String(); actual != expected {
+		t.Fatalf("unexpected output\n\nexpected:\n%s\nactual:\n%s", expected, actual)
+	}
+}
+
+func TestWatchPrinterBrokers(t *testing.T) {
+	now := time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+	ready := v1beta1.ServiceBrokerCondition{
+		Type:   v1beta1.ServiceBrokerConditionReady,
+		Status: v1beta1.ConditionTrue,
+		Reason: "FetchedCatalog",
+	}
+
+	buf := &bytes.Buffer{}
+	printer := NewWatchPrinter(buf, now)
+	printer.now = func() time.Time { return now }
+	printer.WriteWatchEvent(watch.Event{Type: watch.Added, Object: &v1beta1.ClusterServiceBroker{
+		ObjectMeta: v1.ObjectMeta{Name: "global"},
+		Status: v1beta1.ClusterServiceBrokerStatus{
+			CommonServiceBrokerStatus: v1beta1.CommonServiceBrokerStatus{Conditions: []v1beta1.ServiceBrokerCondition{ready}},
+		},
+	}})
+	deletionTimestamp := v1.NewTime(now)
+	printer.WriteWatchEvent(watch.Event{Type: watch.Modified, Object: &v1beta1.ServiceBroker{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns", Name: "local", DeletionTimestamp: &deletionTimestamp},
+	}})
+
+	expected := `12:00:00  broker global: Ready=True (FetchedCatalog)
+12:00:00  broker ns/local: deletion requested
+`
+	if actual := buf.String(); actual != expected {
+		t.Fatalf("unexpected output\n\nexpected:\n%s\nactual:\n%s", expected, actual)
+	}
+}
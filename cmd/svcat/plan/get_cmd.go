@@ -30,6 +30,8 @@ import (
 type getCmd struct {
 	*command.Context
 	*command.Formatted
+	*command.Selected
+	*command.Sorted
 	lookupByUUID bool
 	uuid         string
 	name         string
@@ -44,6 +46,8 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd := &getCmd{
 		Context:   cxt,
 		Formatted: command.NewFormatted(),
+		Selected:  command.NewSelected(),
+		Sorted:    command.NewSorted(),
 	}
 	cmd := &cobra.Command{
 		Use:     "plans [NAME]",
@@ -55,6 +59,7 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get plan CLASS_NAME/PLAN_NAME
   svcat get plan --uuid PLAN_UUID
   svcat get plans --class CLASS_NAME
+  svcat get plans --sort-by .spec.externalName -o wide
   svcat get plan --class CLASS_NAME PLAN_NAME
   svcat get plans --uuid --class CLASS_UUID
   svcat get plan --uuid --class CLASS_UUID PLAN_UUID
@@ -77,6 +82,8 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		"Filter plans based on class. When --uuid is specified, the class name is interpreted as a uuid.",
 	)
 	getCmd.AddOutputFlags(cmd.Flags())
+	getCmd.AddSelectorFlag(cmd)
+	getCmd.AddSortByFlag(cmd)
 	return cmd
 }
 
@@ -94,6 +101,10 @@ func (c *getCmd) Validate(args []string) error {
 		} else {
 			c.name = args[0]
 		}
+
+		if c.RawSelector != "" {
+			return fmt.Errorf("--selector is not supported when specifiying plan name")
+		}
 	}
 	if c.classFilter != "" {
 		if c.lookupByUUID {
@@ -147,6 +158,23 @@ func (c *getCmd) getAll() error {
 		return fmt.Errorf("unable to list plans (%s)", err)
 	}
 
+	selected := plans[:0]
+	for _, plan := range plans {
+		if c.Matches(&plan) {
+			selected = append(selected, plan)
+		}
+	}
+	plans = selected
+	if c.SortBy == "" {
+		// List the plans of each class together unless asked otherwise
+		err = output.SortByJSONPath(plans, ".spec.clusterServiceClassRef.name")
+	} else {
+		err = c.SortItems(plans)
+	}
+	if err != nil {
+		return err
+	}
+
 	output.WritePlanList(c.Output, c.OutputFormat, plans, classes)
 	return nil
 }
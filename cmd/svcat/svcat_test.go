@@ -152,9 +152,18 @@ func TestCommandValidation(t *testing.T) {
 		{"unbind requires arg", "unbind", "an instance or binding name is required"},
 		{"sync requires names", "sync broker", "a broker name is required"},
 		{"deprovision requires name", "deprovision", "an instance name is required"},
+		{"events instance requires name", "events instance", "an instance name is required"},
+		{"get instances --watch requires table output", "get instances --watch -o json", "--watch is only supported with the table output format"},
+		{"get instances --watch does not accept --class", "get instances --watch --class foo", "class and plan filters are not supported with --watch"},
+		{"get instances does not accept unknown output formats", "get instances -o xml", "invalid --output format \"xml\""},
+		{"get instances requires a jsonpath template", "get instances -o jsonpath", "the jsonpath output format requires a template"},
+		{"get instances does not accept invalid custom columns", "get instances -o custom-columns=NAME", "invalid custom column \"NAME\""},
+		{"get instances does not accept invalid selectors", "get instances -l team=a=b", "invalid --selector"},
+		{"get instance does not accept --selector", "get instance foo -l team=payments", "--selector is not supported when specifiying instance name"},
 		{"provision does not accept --param and --params-json",
 			`provision name --class class --plan plan --params-json '{}' --param k=v`,
 			"--params-json cannot be used with --param"},
+		{"provision does not accept --dry-run with --wait", "provision name --class class --plan plan --dry-run --wait", "--wait is not supported with --dry-run"},
 		{"bind does not accept --param and --params-json",
 			`bind name --params-json '{}' --param k=v`,
 			"--params-json cannot be used with --param"},
@@ -225,6 +234,12 @@ func TestCommandOutput(t *testing.T) {
 		{name: "list all instances filtered by existing class", cmd: "get instances --all-namespaces --class user-provided-service", golden: "output/get-instances-all-namespaces-by-class.txt"},
 		{name: "list all instances filtered by not existing class", cmd: "get instances --all-namespaces --class wrong", golden: "output/get-instances-all-namespaces-by-wrong-class.txt"},
 		{name: "list all instances", cmd: "get instances --all-namespaces", golden: "output/get-instances-all-namespaces.txt"},
+		{name: "list all instances (wide)", cmd: "get instances --all-namespaces -o wide", golden: "output/get-instances-all-namespaces-wide.txt"},
+		{name: "list all instances (name)", cmd: "get instances --all-namespaces -o name", golden: "output/get-instances-all-namespaces-name.txt"},
+		{name: "list all instances (jsonpath)", cmd: "get instances --all-namespaces -o jsonpath={.items[*].metadata.namespace}", golden: "output/get-instances-all-namespaces-jsonpath.txt"},
+		{name: "list all instances (custom-columns)", cmd: "get instances --all-namespaces -o custom-columns=NAME:.metadata.name,NAMESPACE:.metadata.namespace,TEAM:.metadata.labels.team", golden: "output/get-instances-all-namespaces-custom-columns.txt"},
+		{name: "list all instances sorted by namespace", cmd: "get instances --all-namespaces --sort-by .metadata.namespace", golden: "output/get-instances-all-namespaces-sorted.txt"},
+		{name: "list all instances filtered by label", cmd: "get instances --all-namespaces -l team=payments", golden: "output/get-instances-all-namespaces-by-label.txt"},
 		{name: "get instance", cmd: "get instance ups-instance -n test-ns", golden: "output/get-instance.txt"},
 		{name: "get instance (json)", cmd: "get instance ups-instance -n test-ns -o json", golden: "output/get-instance.json"},
 		{name: "get instance (yaml)", cmd: "get instance ups-instance -n test-ns -o yaml", golden: "output/get-instance.yaml"},
@@ -235,6 +250,7 @@ func TestCommandOutput(t *testing.T) {
 		{name: "unbind instance and wait", cmd: "unbind ups-instance -n test-ns --wait", golden: "output/unbind-instance-and-wait.txt"},
 		{name: "provision instance", cmd: "provision ups-instance -n test-ns --class user-provided-service --plan default", golden: "output/provision-instance.txt"},
 		{name: "provision instance and wait", cmd: "provision ups-instance -n test-ns --class user-provided-service --plan default --wait", golden: "output/provision-instance-and-wait.txt"},
+		{name: "provision instance (dry run)", cmd: "provision ups-instance -n test-ns --class user-provided-service --plan default --dry-run", golden: "output/provision-instance-dry-run.txt"},
 		{name: "deprovision instance", cmd: "deprovision ups-instance -n test-ns", golden: "output/deprovision-instance.txt"},
 		{name: "deprovision instance and wait", cmd: "deprovision ups-instance -n test-ns --wait", golden: "output/deprovision-instance-and-wait.txt"},
 
@@ -242,6 +258,7 @@ func TestCommandOutput(t *testing.T) {
 		{name: "list all bindings in a namespace (json)", cmd: "get bindings -n test-ns -o json", golden: "output/get-bindings.json"},
 		{name: "list all bindings in a namespace (yaml)", cmd: "get bindings -n test-ns -o yaml", golden: "output/get-bindings.yaml"},
 		{name: "list all bindings", cmd: "get bindings --all-namespaces", golden: "output/get-bindings-all-namespaces.txt"},
+		{name: "get binding (go-template)", cmd: "get binding -n test-ns ups-binding -o go-template={{.spec.secretName}}", golden: "output/get-binding-go-template.txt"},
 		{name: "get binding", cmd: "get binding ups-binding -n test-ns", golden: "output/get-binding.txt"},
 		{name: "get binding (json)", cmd: "get binding ups-binding -n test-ns -o json", golden: "output/get-binding.json"},
 		{name: "get binding (yaml)", cmd: "get binding ups-binding -n test-ns -o yaml", golden: "output/get-binding.yaml"},
@@ -325,6 +342,9 @@ func TestNamespacedCommands(t *testing.T) {
 
 		{name: "describe binding with flag namespace", cmd: "describe binding NAME --namespace " + flagNS, wantNS: flagNS},
 		{name: "describe binding with context namespace", cmd: "describe binding NAME", wantNS: contextNS},
+
+		{name: "export with flag namespace", cmd: "export --namespace " + flagNS, wantNS: flagNS},
+		{name: "export with context namespace", cmd: "export", wantNS: contextNS},
 	}
 
 	for _, tc := range testcases {
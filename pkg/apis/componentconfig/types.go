@@ -89,6 +89,27 @@ type ControllerManagerConfiguration struct {
 	// lock.
 	LeaderElectionNamespace string
 
+	// Shards is the number of shards the keys reconciled by the controller
+	// are split into. Each replica of the controller manager reconciles the
+	// keys of the shards it holds the lease of, instead of one elected
+	// leader reconciling them all. 0 disables sharding.
+	Shards int
+	// ShardKey is how keys are assigned to shards: "namespace" keeps the
+	// resources of a namespace in one shard, "name" spreads them out.
+	ShardKey string
+
+	// WatchNamespaces restricts the namespaced resources the controller
+	// watches to those of the given namespaces. Empty watches all namespaces.
+	WatchNamespaces []string
+	// WatchNamespaceSelector restricts the namespaced resources the
+	// controller watches to those of the namespaces matching the label
+	// selector.
+	WatchNamespaceSelector string
+	// IgnoreClusterScopedResources makes a controller restricted to a set of
+	// namespaces ignore cluster-scoped brokers, classes and plans, and the
+	// instances and bindings that refer to them.
+	IgnoreClusterScopedResources bool
+
 	// enableProfiling enables profiling via web interface host:port/debug/pprof/
 	EnableProfiling bool
 
@@ -103,6 +124,27 @@ type ControllerManagerConfiguration struct {
 	// backoff for polling OSB API operations will use.
 	OperationPollingMaximumBackoffDuration time.Duration
 
+	// NotificationRetries is how many times the delivery of a lifecycle
+	// event to a notification sink is retried before it is dropped.
+	NotificationRetries int
+	// NotificationTimeout is how long the controller waits for a
+	// notification sink to accept an event.
+	NotificationTimeout time.Duration
+	// NotificationSinkAllowedHosts are the hosts the controller posts the
+	// events of NotificationSinks to. An entry of the form "*.example.com"
+	// allows every subdomain. ClusterNotificationSinks are not restricted.
+	NotificationSinkAllowedHosts []string
+
+	// BrokerCallbackURL is the base URL brokers reach the controller's
+	// callback endpoint at, to report the completion of asynchronous
+	// operations.
+	BrokerCallbackURL string
+	// BrokerCallbackKeyFile is the path of a file holding the key the
+	// per-operation callback tokens are derived from. It is required with
+	// leader election or sharding. When empty, a random key is generated at
+	// startup.
+	BrokerCallbackKeyFile string
+
 	SecureServingOptions *genericoptions.SecureServingOptions
 
 	// ClusterIDConfigMapName is the k8s name that the clusterid configmap will have
@@ -60,6 +60,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterServicePlanList{},
 		&ServicePlan{},
 		&ServicePlanList{},
+		&ClusterServicePlanTransitionPolicy{},
+		&ClusterServicePlanTransitionPolicyList{},
+		&ClusterNotificationSink{},
+		&ClusterNotificationSinkList{},
+		&NotificationSink{},
+		&NotificationSinkList{},
 		&ServiceInstance{},
 		&ServiceInstanceList{},
 		&ServiceBinding{},
@@ -111,6 +111,13 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 				panic(fmt.Sprintf("Failed to create parameter object: %v", err))
 			}
 			is.Parameters = parameters
+			// The defaulter sets an empty DeletionPolicy to Delete, so
+			// generate a non-empty value to keep round-trips stable.
+			if c.RandBool() {
+				is.DeletionPolicy = servicecatalog.DeletionPolicyDelete
+			} else {
+				is.DeletionPolicy = servicecatalog.DeletionPolicyRetain
+			}
 		},
 		func(bs *servicecatalog.ServiceBindingSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(bs)
@@ -127,6 +134,11 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 				panic(fmt.Sprintf("Failed to create parameter object: %v", err))
 			}
 			bs.Parameters = parameters
+			if c.RandBool() {
+				bs.DeletionPolicy = servicecatalog.DeletionPolicyDelete
+			} else {
+				bs.DeletionPolicy = servicecatalog.DeletionPolicyRetain
+			}
 		},
 		func(bs *servicecatalog.ServiceInstancePropertiesState, c fuzz.Continue) {
 			c.FuzzNoCustom(bs)
@@ -105,6 +105,32 @@ type CommonServiceBrokerSpec struct {
 	// CatalogRestrictions is a set of restrictions on which of a broker's services
 	// and plans have resources created for them.
 	CatalogRestrictions *CatalogRestrictions
+
+	// MaxConcurrentRequests is the maximum number of requests the controller
+	// sends to the broker at the same time. Resources whose requests are over
+	// the limit are requeued and reconciled again shortly after. If unset,
+	// the number of concurrent requests is only limited by the controller's
+	// number of workers.
+	MaxConcurrentRequests *int32
+
+	// RequestsPerSecond is the maximum rate at which the controller sends
+	// requests to the broker. Bursts of up to one second's worth of requests
+	// are allowed, and resources whose requests are over the limit are
+	// requeued. If unset, the request rate is not limited.
+	RequestsPerSecond *int32
+
+	// ReconciliationRetryDuration overrides the controller's
+	// --reconciliation-retry-duration for this broker's resources: the
+	// maximum amount of time to retry an operation, or poll an asynchronous
+	// one, before giving up. A plan's maximum_polling_duration takes
+	// precedence for polling.
+	ReconciliationRetryDuration *metav1.Duration
+
+	// OperationPollingMaximumBackoffDuration overrides the controller's
+	// --operation-polling-maximum-backoff-duration for this broker's
+	// resources: the maximum amount of time to wait between polls of an
+	// asynchronous operation.
+	OperationPollingMaximumBackoffDuration *metav1.Duration
 }
 
 // CatalogRestrictions is a set of restrictions on which of a broker's services
@@ -202,6 +228,13 @@ type ClusterServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *ClusterBearerTokenAuthConfig
+	// ClusterClientCertificateAuthConfig provides configuration to present a TLS
+	// client certificate to the broker.
+	ClientCertificate *ClusterClientCertificateAuthConfig
+	// ClusterOAuth2ClientCredentialsAuthConfig provides configuration to obtain
+	// a bearer token from an OAuth2 token endpoint using the client credentials
+	// grant.
+	OAuth2ClientCredentials *ClusterOAuth2ClientCredentialsAuthConfig
 }
 
 // ClusterBasicAuthConfig provides config for the basic authentication of
@@ -227,6 +260,34 @@ type ClusterBearerTokenAuthConfig struct {
 	SecretRef *ObjectReference
 }
 
+// ClusterClientCertificateAuthConfig provides config for the TLS client
+// certificate authentication of cluster scoped brokers.
+type ClusterClientCertificateAuthConfig struct {
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to this ClusterServiceBroker.
+	//
+	// Required fields:
+	// - Secret.Data["tls.crt"] - PEM encoded client certificate
+	// - Secret.Data["tls.key"] - PEM encoded private key of the certificate
+	SecretRef *ObjectReference
+}
+
+// ClusterOAuth2ClientCredentialsAuthConfig provides config for the OAuth2
+// client credentials authentication of cluster scoped brokers.
+type ClusterOAuth2ClientCredentialsAuthConfig struct {
+	// TokenURL is the URL of the OAuth2 token endpoint.
+	TokenURL string
+	// Scopes are the scopes to request for the token.
+	Scopes []string
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to the token endpoint.
+	//
+	// Required fields:
+	// - Secret.Data["clientID"] - client ID for the token endpoint
+	// - Secret.Data["clientSecret"] - client secret for the token endpoint
+	SecretRef *ObjectReference
+}
+
 // ServiceBrokerAuthInfo is a union type that contains information on
 // one of the authentication methods the the service catalog and brokers may
 // support, according to the OpenServiceBroker API specification
@@ -238,6 +299,13 @@ type ServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *BearerTokenAuthConfig
+	// ClientCertificateAuthConfig provides configuration to present a TLS
+	// client certificate to the broker.
+	ClientCertificate *ClientCertificateAuthConfig
+	// OAuth2ClientCredentialsAuthConfig provides configuration to obtain a
+	// bearer token from an OAuth2 token endpoint using the client credentials
+	// grant.
+	OAuth2ClientCredentials *OAuth2ClientCredentialsAuthConfig
 }
 
 // BasicAuthConfig provides config for the basic authentication of
@@ -263,6 +331,34 @@ type BearerTokenAuthConfig struct {
 	SecretRef *LocalObjectReference
 }
 
+// ClientCertificateAuthConfig provides config for the TLS client certificate
+// authentication of namespace scoped brokers.
+type ClientCertificateAuthConfig struct {
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to this ServiceBroker.
+	//
+	// Required fields:
+	// - Secret.Data["tls.crt"] - PEM encoded client certificate
+	// - Secret.Data["tls.key"] - PEM encoded private key of the certificate
+	SecretRef *LocalObjectReference
+}
+
+// OAuth2ClientCredentialsAuthConfig provides config for the OAuth2 client
+// credentials authentication of namespace scoped brokers.
+type OAuth2ClientCredentialsAuthConfig struct {
+	// TokenURL is the URL of the OAuth2 token endpoint.
+	TokenURL string
+	// Scopes are the scopes to request for the token.
+	Scopes []string
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to the token endpoint.
+	//
+	// Required fields:
+	// - Secret.Data["clientID"] - client ID for the token endpoint
+	// - Secret.Data["clientSecret"] - client secret for the token endpoint
+	SecretRef *LocalObjectReference
+}
+
 const (
 	// BasicAuthUsernameKey is the key of the username for SecretTypeBasicAuth secrets
 	BasicAuthUsernameKey = "username"
@@ -271,6 +367,20 @@ const (
 
 	// BearerTokenKey is the key of the bearer token for SecretTypeBearerTokenAuth secrets
 	BearerTokenKey = "token"
+
+	// ClientCertificateCertKey is the key of the PEM encoded client certificate
+	// for client certificate auth secrets
+	ClientCertificateCertKey = "tls.crt"
+	// ClientCertificateKeyKey is the key of the PEM encoded private key for
+	// client certificate auth secrets
+	ClientCertificateKeyKey = "tls.key"
+
+	// OAuth2ClientIDKey is the key of the client ID for OAuth2 client
+	// credentials auth secrets
+	OAuth2ClientIDKey = "clientID"
+	// OAuth2ClientSecretKey is the key of the client secret for OAuth2 client
+	// credentials auth secrets
+	OAuth2ClientSecretKey = "clientSecret"
 )
 
 // CommonServiceBrokerStatus represents the current status of a ServiceBroker.
@@ -288,6 +398,38 @@ type CommonServiceBrokerStatus struct {
 	// LastCatalogRetrievalTime is the time the Catalog was last fetched from
 	// the Service Broker
 	LastCatalogRetrievalTime *metav1.Time
+
+	// CatalogRevision describes the catalog that was last successfully
+	// reconciled from the Service Broker.
+	CatalogRevision *CatalogRevision
+
+	// OSBAPIVersion is the version of the Open Service Broker API negotiated
+	// with the Service Broker when its catalog was last fetched. Requests to
+	// the broker are sent with this version.
+	OSBAPIVersion string
+}
+
+// CatalogRevision identifies a version of a broker's catalog.
+type CatalogRevision struct {
+	// Hash is the SHA-256 digest of the catalog returned by the broker,
+	// computed before CatalogRestrictions are applied.
+	Hash string
+
+	// ETag is the entity tag the broker returned with the catalog, if any.
+	// It is sent in the If-None-Match header when relisting the catalog.
+	ETag string
+
+	// Timestamp is the time at which this revision of the catalog was first
+	// observed by the controller.
+	Timestamp metav1.Time
+
+	// ServiceClassCount is the number of service classes in the catalog
+	// after CatalogRestrictions were applied.
+	ServiceClassCount int
+
+	// ServicePlanCount is the number of service plans in the catalog after
+	// CatalogRestrictions were applied.
+	ServicePlanCount int
 }
 
 // ClusterServiceBrokerStatus represents the current status of a
@@ -445,6 +587,11 @@ type CommonServiceClassSpec struct {
 	// its endpoint is supported for all plans.
 	BindingRetrievable bool
 
+	// InstancesRetrievable indicates whether fetching an instance via a GET
+	// on its endpoint is supported for all plans. The endpoint requires OSB
+	// API 2.14.
+	InstancesRetrievable bool
+
 	// PlanUpdatable indicates whether instances provisioned from this
 	// ServiceClass may change ServicePlans after being provisioned.
 	PlanUpdatable bool
@@ -576,6 +723,26 @@ type CommonServicePlanSpec struct {
 	// broker's response, which allows clients to see what the credentials
 	// will look like even before the binding operation is performed.
 	ServiceBindingCreateResponseSchema *runtime.RawExtension
+
+	// MaintenanceInfo is the plan's current maintenance version, as reported
+	// in the broker's catalog. When it moves ahead of the version applied to
+	// an instance of the plan, the instance can be upgraded.
+	MaintenanceInfo *MaintenanceInfo
+
+	// MaximumPollingDuration is the longest that asynchronous operations on
+	// the instances and bindings of the plan are polled, as reported in the
+	// broker's catalog.
+	MaximumPollingDuration *metav1.Duration
+}
+
+// MaintenanceInfo describes a version of the maintenance, such as the
+// software version, that a broker applies to the instances of a plan.
+type MaintenanceInfo struct {
+	// Version is the semantic version of the maintenance.
+	Version string
+
+	// Description describes the changes in this version.
+	Description string
 }
 
 // ClusterServicePlanSpec represents details about the ClusterServicePlan
@@ -648,6 +815,224 @@ type ServicePlanStatus struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ClusterServicePlanTransitionPolicyList is a list of
+// ClusterServicePlanTransitionPolicies.
+type ClusterServicePlanTransitionPolicyList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []ClusterServicePlanTransitionPolicy
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterServicePlanTransitionPolicy declares which plan changes are allowed
+// for the instances of a ClusterServiceClass or a ServiceClass, and who may
+// make them.
+type ClusterServicePlanTransitionPolicy struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec ClusterServicePlanTransitionPolicySpec
+}
+
+// ClusterServicePlanTransitionPolicySpec represents the plan changes allowed
+// for the instances of a ClusterServiceClass or a ServiceClass.
+type ClusterServicePlanTransitionPolicySpec struct {
+	// ClusterServiceClassName is the Kubernetes name of the
+	// ClusterServiceClass whose plan changes are governed by this policy.
+	// Exactly one of ClusterServiceClassName and ServiceClassRef must be set.
+	ClusterServiceClassName string
+
+	// ServiceClassRef is the namespace and Kubernetes name of the
+	// ServiceClass whose plan changes are governed by this policy.
+	ServiceClassRef *ObjectReference
+
+	// PlanOrder lists the external names of the plans of the class, from the
+	// lowest tier to the highest. A change to a plan later in the list is an
+	// upgrade and a change to a plan earlier in the list is a downgrade.
+	PlanOrder []string
+
+	// Rules are the plan changes that are allowed. A plan change is allowed
+	// when at least one rule matches it, and denied otherwise.
+	Rules []PlanTransitionRule
+}
+
+// PlanTransitionDirection is the direction of a plan change along the
+// PlanOrder of a ClusterServicePlanTransitionPolicy.
+type PlanTransitionDirection string
+
+const (
+	// PlanTransitionDirectionUpgrade matches changes to a plan later in the
+	// PlanOrder.
+	PlanTransitionDirectionUpgrade PlanTransitionDirection = "Upgrade"
+
+	// PlanTransitionDirectionDowngrade matches changes to a plan earlier in
+	// the PlanOrder.
+	PlanTransitionDirectionDowngrade PlanTransitionDirection = "Downgrade"
+)
+
+// PlanTransitionRule allows the plan changes that match all of its fields.
+type PlanTransitionRule struct {
+	// From selects the plans that are changed from. An empty selector
+	// matches every plan.
+	From PlanTransitionSelector
+
+	// To selects the plans that are changed to. An empty selector matches
+	// every plan.
+	To PlanTransitionSelector
+
+	// Direction restricts the rule to upgrades or downgrades. When empty,
+	// changes in either direction match.
+	Direction PlanTransitionDirection
+
+	// Users are the names of the users that may make the matching plan
+	// changes.
+	Users []string
+
+	// Groups are the groups whose members may make the matching plan
+	// changes. When both Users and Groups are empty, anyone may make them.
+	Groups []string
+}
+
+// PlanTransitionSelector selects plans by external name and by cost.
+type PlanTransitionSelector struct {
+	// ExternalNames are the external names of the selected plans. When
+	// empty, plans with any name are selected.
+	ExternalNames []string
+
+	// Free, if set, selects only free plans when true and only paid plans
+	// when false.
+	Free *bool
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNotificationSinkList is a list of ClusterNotificationSinks.
+type ClusterNotificationSinkList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []ClusterNotificationSink
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNotificationSink is an endpoint that is notified of the lifecycle
+// events of the ServiceInstances and ServiceBindings of every namespace, and
+// of the removal of classes from broker catalogs.
+type ClusterNotificationSink struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Spec defines where and which events are sent.
+	Spec ClusterNotificationSinkSpec
+}
+
+// ClusterNotificationSinkSpec represents where and which events are sent to
+// a ClusterNotificationSink.
+type ClusterNotificationSinkSpec struct {
+	CommonNotificationSinkSpec
+
+	// SigningSecretRef is a reference to a Secret whose signingKey entry is
+	// used to sign the events sent to the sink.
+	SigningSecretRef *ObjectReference
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSinkList is a list of NotificationSinks.
+type NotificationSinkList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []NotificationSink
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSink is an endpoint that is notified of the lifecycle events of
+// the ServiceInstances, ServiceBindings and ServiceClasses of its namespace.
+type NotificationSink struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Spec defines where and which events are sent.
+	Spec NotificationSinkSpec
+}
+
+// NotificationSinkSpec represents where and which events are sent to a
+// NotificationSink.
+type NotificationSinkSpec struct {
+	CommonNotificationSinkSpec
+
+	// SigningSecretRef is a reference to a Secret in the namespace of the
+	// sink whose signingKey entry is used to sign the events sent to the
+	// sink.
+	SigningSecretRef *LocalObjectReference
+}
+
+// CommonNotificationSinkSpec represents the details common to
+// ClusterNotificationSinks and NotificationSinks.
+type CommonNotificationSinkSpec struct {
+	// URL is the http or https URL the events are posted to, as CloudEvents
+	// in structured JSON mode.
+	URL string
+
+	// EventTypes are the types of the events sent to the sink. When empty,
+	// events of every type are sent.
+	EventTypes []NotificationEventType
+}
+
+// NotificationEventType is the type of a lifecycle event sent to notification
+// sinks.
+type NotificationEventType string
+
+const (
+	// NotificationEventInstanceProvisioned is sent when a ServiceInstance
+	// has been provisioned by its broker.
+	NotificationEventInstanceProvisioned NotificationEventType = "InstanceProvisioned"
+
+	// NotificationEventInstanceFailed is sent when the provision, update or
+	// deprovision of a ServiceInstance has failed and will not be retried.
+	NotificationEventInstanceFailed NotificationEventType = "InstanceFailed"
+
+	// NotificationEventInstanceDeprovisioned is sent when a ServiceInstance
+	// has been deprovisioned by its broker.
+	NotificationEventInstanceDeprovisioned NotificationEventType = "InstanceDeprovisioned"
+
+	// NotificationEventBindingBound is sent when a ServiceBinding has been
+	// bound and its credentials injected.
+	NotificationEventBindingBound NotificationEventType = "BindingBound"
+
+	// NotificationEventBindingFailed is sent when the bind of a
+	// ServiceBinding has failed and will not be retried.
+	NotificationEventBindingFailed NotificationEventType = "BindingFailed"
+
+	// NotificationEventBindingUnbound is sent when a ServiceBinding has been
+	// unbound by its broker.
+	NotificationEventBindingUnbound NotificationEventType = "BindingUnbound"
+
+	// NotificationEventCredentialsRotated is sent when the credentials
+	// injected for a ServiceBinding have changed.
+	NotificationEventCredentialsRotated NotificationEventType = "CredentialsRotated"
+
+	// NotificationEventClassRemoved is sent when a class has been removed
+	// from the catalog of its broker.
+	NotificationEventClassRemoved NotificationEventType = "ClassRemoved"
+)
+
+// NotificationSigningKeySecretKey is the key of the entry of a signing Secret
+// that holds the key the events sent to a sink are signed with.
+const NotificationSigningKeySecretKey = "signingKey"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ServiceInstanceList is a list of instances.
 type ServiceInstanceList struct {
 	metav1.TypeMeta
@@ -823,6 +1208,49 @@ type ServiceInstanceSpec struct {
 	// allows for parameters to be updated with any out-of-band changes that have
 	// been made to the secrets from which the parameters are sourced.
 	UpdateRequests int64
+
+	// DeletionPolicy specifies what the controller does at the broker when
+	// the ServiceInstance is deleted. With Delete, the instance is
+	// deprovisioned. With Retain, only the finalizer is removed and the
+	// instance is left in place at the broker.
+	DeletionPolicy DeletionPolicy
+
+	// MaintenanceInfoVersion requests an upgrade of the instance to the given
+	// maintenance version of its plan. When it differs from the version
+	// applied to the instance, the controller sends an update request
+	// carrying the plan's maintenance info. It must match the plan's current
+	// maintenance version.
+	MaintenanceInfoVersion string
+
+	// CancelRequests is a strictly increasing, non-negative integer counter
+	// that can be incremented by a user to cancel the operation in progress
+	// on the instance. An in-progress provision is abandoned and the
+	// instance deprovisioned at the broker; an in-progress update is
+	// abandoned. Deprovisions cannot be cancelled.
+	CancelRequests int64
+
+	// RetryRequests is a strictly increasing, non-negative integer counter
+	// that can be incremented by a user to retry an operation that failed
+	// terminally. The Failed condition is cleared and the operation is
+	// driven again with a fresh backoff.
+	RetryRequests int64
+
+	// MaintenanceWindow restricts the updates of the instance at the broker
+	// to the windows it describes. Outside of a window, spec changes stay
+	// pending and are reported by the PendingUpdate condition. Provisioning
+	// and deprovisioning are not restricted.
+	MaintenanceWindow *MaintenanceWindow
+}
+
+// MaintenanceWindow describes recurring windows of time in which a
+// ServiceInstance may be updated at its broker.
+type MaintenanceWindow struct {
+	// Schedule is a cron schedule, in UTC, of the times the window opens,
+	// such as "0 2 * * 6" for every Saturday at 02:00.
+	Schedule string
+
+	// Duration is how long the window stays open.
+	Duration metav1.Duration
 }
 
 // ServiceInstanceStatus represents the current status of an Instance.
@@ -867,6 +1295,11 @@ type ServiceInstanceStatus struct {
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time
 
+	// NextPollTime is when the controller next polls the broker for the
+	// progress of the current asynchronous operation. It is unset when no
+	// asynchronous operation is in progress.
+	NextPollTime *metav1.Time
+
 	// InProgressProperties is the properties state of the ServiceInstance when
 	// a Provision, Update or Deprovision is in progress.
 	InProgressProperties *ServiceInstancePropertiesState
@@ -881,6 +1314,10 @@ type ServiceInstanceStatus struct {
 	// DeprovisionStatus describes what has been done to deprovision the
 	// ServiceInstance.
 	DeprovisionStatus ServiceInstanceDeprovisionStatus
+
+	// ObservedCancelRequests is the value of CancelRequests in the spec of
+	// the instance that the controller last acted on.
+	ObservedCancelRequests int64
 }
 
 // ServiceInstanceCondition contains condition information about an Instance.
@@ -919,6 +1356,16 @@ const (
 	// ServiceInstanceConditionOrphanMitigation represents information about an
 	// orphan mitigation that is required after failed provisioning.
 	ServiceInstanceConditionOrphanMitigation ServiceInstanceConditionType = "OrphanMitigation"
+
+	// ServiceInstanceConditionUpgradeAvailable represents whether the
+	// instance's plan has a newer maintenance version than the one applied
+	// to the instance.
+	ServiceInstanceConditionUpgradeAvailable ServiceInstanceConditionType = "UpgradeAvailable"
+
+	// ServiceInstanceConditionPendingUpdate represents whether a change to
+	// the instance's spec is waiting for the instance's maintenance window,
+	// or for updates to the instance to be resumed.
+	ServiceInstanceConditionPendingUpdate ServiceInstanceConditionType = "PendingUpdate"
 )
 
 // ServiceInstanceOperation represents a type of operation the controller can
@@ -968,6 +1415,10 @@ type ServiceInstancePropertiesState struct {
 
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo
+
+	// MaintenanceInfo is the maintenance version of the plan that the broker
+	// knows this ServiceInstance to be on.
+	MaintenanceInfo *MaintenanceInfo
 }
 
 // ServiceInstanceDeprovisionStatus is the status of deprovisioning a
@@ -1082,6 +1533,18 @@ type ServiceBindingSpec struct {
 	// settable by the end-user. User-provided values for this field are not saved.
 	// +optional
 	UserInfo *UserInfo
+
+	// DeletionPolicy specifies what the controller does at the broker when
+	// the ServiceBinding is deleted. With Delete, the binding is unbound.
+	// With Retain, only the finalizer is removed and the binding is left in
+	// place at the broker.
+	DeletionPolicy DeletionPolicy
+
+	// RetryRequests is a strictly increasing, non-negative integer counter
+	// that can be incremented by a user to retry an operation that failed
+	// terminally. The Failed condition is cleared and the operation is
+	// driven again with a fresh backoff.
+	RetryRequests int64
 }
 
 // ServiceBindingStatus represents the current status of a ServiceBinding.
@@ -1116,6 +1579,11 @@ type ServiceBindingStatus struct {
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time
 
+	// NextPollTime is when the controller next polls the broker for the
+	// progress of the current asynchronous operation. It is unset when no
+	// asynchronous operation is in progress.
+	NextPollTime *metav1.Time
+
 	// InProgressProperties is the properties state of the
 	// ServiceBinding when a Bind is in progress. If the current
 	// operation is an Unbind, this will be nil.
@@ -1184,6 +1652,52 @@ const (
 	FinalizerServiceCatalog string = "kubernetes-incubator/service-catalog"
 )
 
+// DeletionPolicy describes what happens at the broker when a ServiceInstance
+// or ServiceBinding is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete indicates that the instance is deprovisioned, or
+	// the binding is unbound, before the resource is removed.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyRetain indicates that the resource is removed without
+	// sending a deprovision or unbind request to the broker.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// DeletionProtectionAnnotation marks a ServiceInstance that must not be
+// deleted while the annotation is set to "true". It is enforced by the
+// ServiceInstanceDeletionProtection admission plugin.
+const DeletionProtectionAnnotation = "servicecatalog.k8s.io/deletion-protection"
+
+// AdoptionAnnotation marks a ServiceInstance or ServiceBinding that already
+// exists at the broker when it is created, for example after it has been
+// imported from another cluster. When set to "true", the controller records
+// the resource as provisioned or bound without sending a provision or bind
+// request. The broker is asked to confirm the resource when it supports
+// fetching it. Setting the annotation requires the "adopt" verb on the
+// resource.
+const AdoptionAnnotation = "servicecatalog.k8s.io/adopt"
+
+// BrokerCallbackAnnotation records the time of the last broker callback
+// accepted for a ServiceInstance or ServiceBinding. The replica that receives
+// the callback sets it, so that the replica that reconciles the resource
+// polls the broker right away.
+const BrokerCallbackAnnotation = "servicecatalog.k8s.io/broker-callback"
+
+// PausedAnnotation pauses the updates of a ServiceInstance at its broker
+// while it is set to "true". Spec changes stay pending and are reported by
+// the PendingUpdate condition. Provisioning and deprovisioning are not
+// paused.
+const PausedAnnotation = "servicecatalog.k8s.io/paused"
+
+// DryRunAnnotation is set by the API server on the ServiceInstance or
+// ServiceBinding that the validating admission plugins see during a dry run,
+// which is requested with the dryRun=All query parameter, so that plugins can
+// run the checks that only apply to dry runs. It is never persisted, and
+// clients may not set it.
+const DryRunAnnotation = "servicecatalog.k8s.io/dry-run"
+
 // ServiceBindingPropertiesState is the state of a
 // ServiceBinding that the ServiceBroker knows about.
 type ServiceBindingPropertiesState struct {
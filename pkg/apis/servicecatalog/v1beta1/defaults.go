@@ -38,9 +38,18 @@ func setCommonServiceBrokerDefaults(spec *CommonServiceBrokerSpec) {
 	}
 }
 
+func SetDefaults_ServiceInstanceSpec(spec *ServiceInstanceSpec) {
+	if spec.DeletionPolicy == "" {
+		spec.DeletionPolicy = DeletionPolicyDelete
+	}
+}
+
 func SetDefaults_ServiceBinding(binding *ServiceBinding) {
 	// If not specified, make the SecretName default to the binding name
 	if binding.Spec.SecretName == "" {
 		binding.Spec.SecretName = binding.Name
 	}
+	if binding.Spec.DeletionPolicy == "" {
+		binding.Spec.DeletionPolicy = DeletionPolicyDelete
+	}
 }
@@ -117,3 +117,43 @@ func TestSetDefaultClusterServiceBroker(t *testing.T) {
 		}
 	}
 }
+
+func TestSetDefaultDeletionPolicy(t *testing.T) {
+	cases := []struct {
+		name     string
+		policy   versioned.DeletionPolicy
+		expected versioned.DeletionPolicy
+	}{
+		{
+			name:     "deletion policy not set",
+			expected: versioned.DeletionPolicyDelete,
+		},
+		{
+			name:     "deletion policy set to retain",
+			policy:   versioned.DeletionPolicyRetain,
+			expected: versioned.DeletionPolicyRetain,
+		},
+	}
+
+	for _, tc := range cases {
+		instance := &versioned.ServiceInstance{}
+		instance.Spec.DeletionPolicy = tc.policy
+		ai := roundTrip(t, runtime.Object(instance)).(*versioned.ServiceInstance)
+		if tc.expected != ai.Spec.DeletionPolicy {
+			t.Errorf(
+				"%v: unexpected default DeletionPolicy for instance: expected %v, got %v",
+				tc.name, tc.expected, ai.Spec.DeletionPolicy,
+			)
+		}
+
+		binding := &versioned.ServiceBinding{}
+		binding.Spec.DeletionPolicy = tc.policy
+		ab := roundTrip(t, runtime.Object(binding)).(*versioned.ServiceBinding)
+		if tc.expected != ab.Spec.DeletionPolicy {
+			t.Errorf(
+				"%v: unexpected default DeletionPolicy for binding: expected %v, got %v",
+				tc.name, tc.expected, ab.Spec.DeletionPolicy,
+			)
+		}
+	}
+}
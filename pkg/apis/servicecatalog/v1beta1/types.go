@@ -126,6 +126,36 @@ type CommonServiceBrokerSpec struct {
 	// and plans have resources created for them.
 	// +optional
 	CatalogRestrictions *CatalogRestrictions `json:"catalogRestrictions,omitempty"`
+
+	// MaxConcurrentRequests is the maximum number of requests the controller
+	// sends to the broker at the same time. Resources whose requests are over
+	// the limit are requeued and reconciled again shortly after. If unset,
+	// the number of concurrent requests is only limited by the controller's
+	// number of workers.
+	// +optional
+	MaxConcurrentRequests *int32 `json:"maxConcurrentRequests,omitempty"`
+
+	// RequestsPerSecond is the maximum rate at which the controller sends
+	// requests to the broker. Bursts of up to one second's worth of requests
+	// are allowed, and resources whose requests are over the limit are
+	// requeued. If unset, the request rate is not limited.
+	// +optional
+	RequestsPerSecond *int32 `json:"requestsPerSecond,omitempty"`
+
+	// ReconciliationRetryDuration overrides the controller's
+	// --reconciliation-retry-duration for this broker's resources: the
+	// maximum amount of time to retry an operation, or poll an asynchronous
+	// one, before giving up. A plan's maximum_polling_duration takes
+	// precedence for polling.
+	// +optional
+	ReconciliationRetryDuration *metav1.Duration `json:"reconciliationRetryDuration,omitempty"`
+
+	// OperationPollingMaximumBackoffDuration overrides the controller's
+	// --operation-polling-maximum-backoff-duration for this broker's
+	// resources: the maximum amount of time to wait between polls of an
+	// asynchronous operation.
+	// +optional
+	OperationPollingMaximumBackoffDuration *metav1.Duration `json:"operationPollingMaximumBackoffDuration,omitempty"`
 }
 
 // CatalogRestrictions is a set of restrictions on which of a broker's services
@@ -223,6 +253,13 @@ type ClusterServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *ClusterBearerTokenAuthConfig `json:"bearer,omitempty"`
+	// ClusterClientCertificateAuthConfig provides configuration to present a TLS
+	// client certificate to the broker.
+	ClientCertificate *ClusterClientCertificateAuthConfig `json:"clientCertificate,omitempty"`
+	// ClusterOAuth2ClientCredentialsAuthConfig provides configuration to obtain
+	// a bearer token from an OAuth2 token endpoint using the client credentials
+	// grant.
+	OAuth2ClientCredentials *ClusterOAuth2ClientCredentialsAuthConfig `json:"oauth2ClientCredentials,omitempty"`
 }
 
 // ClusterBasicAuthConfig provides config for the basic authentication of
@@ -248,6 +285,34 @@ type ClusterBearerTokenAuthConfig struct {
 	SecretRef *ObjectReference `json:"secretRef,omitempty"`
 }
 
+// ClusterClientCertificateAuthConfig provides config for the TLS client
+// certificate authentication of cluster scoped brokers.
+type ClusterClientCertificateAuthConfig struct {
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to this ServiceBroker.
+	//
+	// Required fields:
+	// - Secret.Data["tls.crt"] - PEM encoded client certificate
+	// - Secret.Data["tls.key"] - PEM encoded private key of the certificate
+	SecretRef *ObjectReference `json:"secretRef,omitempty"`
+}
+
+// ClusterOAuth2ClientCredentialsAuthConfig provides config for the OAuth2
+// client credentials authentication of cluster scoped brokers.
+type ClusterOAuth2ClientCredentialsAuthConfig struct {
+	// TokenURL is the URL of the OAuth2 token endpoint.
+	TokenURL string `json:"tokenURL"`
+	// Scopes are the scopes to request for the token.
+	Scopes []string `json:"scopes,omitempty"`
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to the token endpoint.
+	//
+	// Required fields:
+	// - Secret.Data["clientID"] - client ID for the token endpoint
+	// - Secret.Data["clientSecret"] - client secret for the token endpoint
+	SecretRef *ObjectReference `json:"secretRef,omitempty"`
+}
+
 // ServiceBrokerAuthInfo is a union type that contains information on
 // one of the authentication methods the the service catalog and brokers may
 // support, according to the OpenServiceBroker API specification
@@ -259,6 +324,13 @@ type ServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *BearerTokenAuthConfig `json:"bearer,omitempty"`
+	// ClientCertificateAuthConfig provides configuration to present a TLS
+	// client certificate to the broker.
+	ClientCertificate *ClientCertificateAuthConfig `json:"clientCertificate,omitempty"`
+	// OAuth2ClientCredentialsAuthConfig provides configuration to obtain a
+	// bearer token from an OAuth2 token endpoint using the client credentials
+	// grant.
+	OAuth2ClientCredentials *OAuth2ClientCredentialsAuthConfig `json:"oauth2ClientCredentials,omitempty"`
 }
 
 // BasicAuthConfig provides config for the basic authentication of
@@ -284,6 +356,34 @@ type BearerTokenAuthConfig struct {
 	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
 }
 
+// ClientCertificateAuthConfig provides config for the TLS client certificate
+// authentication of namespace scoped brokers.
+type ClientCertificateAuthConfig struct {
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to this ServiceBroker.
+	//
+	// Required fields:
+	// - Secret.Data["tls.crt"] - PEM encoded client certificate
+	// - Secret.Data["tls.key"] - PEM encoded private key of the certificate
+	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// OAuth2ClientCredentialsAuthConfig provides config for the OAuth2 client
+// credentials authentication of namespace scoped brokers.
+type OAuth2ClientCredentialsAuthConfig struct {
+	// TokenURL is the URL of the OAuth2 token endpoint.
+	TokenURL string `json:"tokenURL"`
+	// Scopes are the scopes to request for the token.
+	Scopes []string `json:"scopes,omitempty"`
+	// SecretRef is a reference to a Secret containing information the
+	// catalog should use to authenticate to the token endpoint.
+	//
+	// Required fields:
+	// - Secret.Data["clientID"] - client ID for the token endpoint
+	// - Secret.Data["clientSecret"] - client secret for the token endpoint
+	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+}
+
 const (
 	// BasicAuthUsernameKey is the key of the username for SecretTypeBasicAuth secrets
 	BasicAuthUsernameKey = "username"
@@ -292,6 +392,20 @@ const (
 
 	// BearerTokenKey is the key of the bearer token for SecretTypeBearerTokenAuth secrets
 	BearerTokenKey = "token"
+
+	// ClientCertificateCertKey is the key of the PEM encoded client certificate
+	// for client certificate auth secrets
+	ClientCertificateCertKey = "tls.crt"
+	// ClientCertificateKeyKey is the key of the PEM encoded private key for
+	// client certificate auth secrets
+	ClientCertificateKeyKey = "tls.key"
+
+	// OAuth2ClientIDKey is the key of the client ID for OAuth2 client
+	// credentials auth secrets
+	OAuth2ClientIDKey = "clientID"
+	// OAuth2ClientSecretKey is the key of the client secret for OAuth2 client
+	// credentials auth secrets
+	OAuth2ClientSecretKey = "clientSecret"
 )
 
 // CommonServiceBrokerStatus represents the current status of a Broker.
@@ -309,6 +423,41 @@ type CommonServiceBrokerStatus struct {
 	// LastCatalogRetrievalTime is the time the Catalog was last fetched from
 	// the Service Broker
 	LastCatalogRetrievalTime *metav1.Time `json:"lastCatalogRetrievalTime,omitempty"`
+
+	// CatalogRevision describes the catalog that was last successfully
+	// reconciled from the Service Broker.
+	// +optional
+	CatalogRevision *CatalogRevision `json:"catalogRevision,omitempty"`
+
+	// OSBAPIVersion is the version of the Open Service Broker API negotiated
+	// with the Service Broker when its catalog was last fetched. Requests to
+	// the broker are sent with this version.
+	// +optional
+	OSBAPIVersion string `json:"osbAPIVersion,omitempty"`
+}
+
+// CatalogRevision identifies a version of a broker's catalog.
+type CatalogRevision struct {
+	// Hash is the SHA-256 digest of the catalog returned by the broker,
+	// computed before CatalogRestrictions are applied.
+	Hash string `json:"hash"`
+
+	// ETag is the entity tag the broker returned with the catalog, if any.
+	// It is sent in the If-None-Match header when relisting the catalog.
+	// +optional
+	ETag string `json:"etag,omitempty"`
+
+	// Timestamp is the time at which this revision of the catalog was first
+	// observed by the controller.
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// ServiceClassCount is the number of service classes in the catalog
+	// after CatalogRestrictions were applied.
+	ServiceClassCount int `json:"serviceClassCount"`
+
+	// ServicePlanCount is the number of service plans in the catalog after
+	// CatalogRestrictions were applied.
+	ServicePlanCount int `json:"servicePlanCount"`
 }
 
 // ClusterServiceBrokerStatus represents the current status of a
@@ -485,6 +634,12 @@ type CommonServiceClassSpec struct {
 	// its endpoint is supported for all plans.
 	BindingRetrievable bool `json:"bindingRetrievable"`
 
+	// InstancesRetrievable indicates whether fetching an instance via a GET
+	// on its endpoint is supported for all plans. The endpoint requires OSB
+	// API 2.14.
+	// +optional
+	InstancesRetrievable bool `json:"instancesRetrievable,omitempty"`
+
 	// PlanUpdatable indicates whether instances provisioned from this
 	// ServiceClass may change ServicePlans after being
 	// provisioned.
@@ -634,6 +789,29 @@ type CommonServicePlanSpec struct {
 	// broker's response, which allows clients to see what the credentials
 	// will look like even before the binding operation is performed.
 	ServiceBindingCreateResponseSchema *runtime.RawExtension `json:"serviceBindingCreateResponseSchema,omitempty"`
+
+	// MaintenanceInfo is the plan's current maintenance version, as reported
+	// in the broker's catalog. When it moves ahead of the version applied to
+	// an instance of the plan, the instance can be upgraded.
+	// +optional
+	MaintenanceInfo *MaintenanceInfo `json:"maintenanceInfo,omitempty"`
+
+	// MaximumPollingDuration is the longest that asynchronous operations on
+	// the instances and bindings of the plan are polled, as reported in the
+	// broker's catalog.
+	// +optional
+	MaximumPollingDuration *metav1.Duration `json:"maximumPollingDuration,omitempty"`
+}
+
+// MaintenanceInfo describes a version of the maintenance, such as the
+// software version, that a broker applies to the instances of a plan.
+type MaintenanceInfo struct {
+	// Version is the semantic version of the maintenance.
+	Version string `json:"version"`
+
+	// Description describes the changes in this version.
+	// +optional
+	Description string `json:"description,omitempty"`
 }
 
 // ClusterServicePlanSpec represents details about a ClusterServicePlan.
@@ -718,6 +896,257 @@ type ServicePlanStatus struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ClusterServicePlanTransitionPolicyList is a list of
+// ClusterServicePlanTransitionPolicies.
+type ClusterServicePlanTransitionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterServicePlanTransitionPolicy `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterServicePlanTransitionPolicy declares which plan changes are allowed
+// for the instances of a ClusterServiceClass or a ServiceClass, and who may
+// make them.
+// +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAME:.metadata.name,CLASS:.spec.clusterServiceClassName
+type ClusterServicePlanTransitionPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Non-namespaced.  The name of this resource in etcd is in ObjectMeta.Name.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the plan changes allowed by the policy.
+	// +optional
+	Spec ClusterServicePlanTransitionPolicySpec `json:"spec,omitempty"`
+}
+
+// ClusterServicePlanTransitionPolicySpec represents the plan changes allowed
+// for the instances of a ClusterServiceClass or a ServiceClass.
+type ClusterServicePlanTransitionPolicySpec struct {
+	// ClusterServiceClassName is the Kubernetes name of the
+	// ClusterServiceClass whose plan changes are governed by this policy.
+	// Exactly one of ClusterServiceClassName and ServiceClassRef must be set.
+	// +optional
+	ClusterServiceClassName string `json:"clusterServiceClassName,omitempty"`
+
+	// ServiceClassRef is the namespace and Kubernetes name of the
+	// ServiceClass whose plan changes are governed by this policy.
+	// +optional
+	ServiceClassRef *ObjectReference `json:"serviceClassRef,omitempty"`
+
+	// PlanOrder lists the external names of the plans of the class, from the
+	// lowest tier to the highest. A change to a plan later in the list is an
+	// upgrade and a change to a plan earlier in the list is a downgrade.
+	// +optional
+	PlanOrder []string `json:"planOrder,omitempty"`
+
+	// Rules are the plan changes that are allowed. A plan change is allowed
+	// when at least one rule matches it, and denied otherwise.
+	// +optional
+	Rules []PlanTransitionRule `json:"rules,omitempty"`
+}
+
+// PlanTransitionDirection is the direction of a plan change along the
+// PlanOrder of a ClusterServicePlanTransitionPolicy.
+type PlanTransitionDirection string
+
+const (
+	// PlanTransitionDirectionUpgrade matches changes to a plan later in the
+	// PlanOrder.
+	PlanTransitionDirectionUpgrade PlanTransitionDirection = "Upgrade"
+
+	// PlanTransitionDirectionDowngrade matches changes to a plan earlier in
+	// the PlanOrder.
+	PlanTransitionDirectionDowngrade PlanTransitionDirection = "Downgrade"
+)
+
+// PlanTransitionRule allows the plan changes that match all of its fields.
+type PlanTransitionRule struct {
+	// From selects the plans that are changed from. An empty selector
+	// matches every plan.
+	// +optional
+	From PlanTransitionSelector `json:"from,omitempty"`
+
+	// To selects the plans that are changed to. An empty selector matches
+	// every plan.
+	// +optional
+	To PlanTransitionSelector `json:"to,omitempty"`
+
+	// Direction restricts the rule to upgrades or downgrades. When empty,
+	// changes in either direction match.
+	// +optional
+	Direction PlanTransitionDirection `json:"direction,omitempty"`
+
+	// Users are the names of the users that may make the matching plan
+	// changes.
+	// +optional
+	Users []string `json:"users,omitempty"`
+
+	// Groups are the groups whose members may make the matching plan
+	// changes. When both Users and Groups are empty, anyone may make them.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// PlanTransitionSelector selects plans by external name and by cost.
+type PlanTransitionSelector struct {
+	// ExternalNames are the external names of the selected plans. When
+	// empty, plans with any name are selected.
+	// +optional
+	ExternalNames []string `json:"externalNames,omitempty"`
+
+	// Free, if set, selects only free plans when true and only paid plans
+	// when false.
+	// +optional
+	Free *bool `json:"free,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNotificationSinkList is a list of ClusterNotificationSinks.
+type ClusterNotificationSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterNotificationSink `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNotificationSink is an endpoint that is notified of the lifecycle
+// events of the ServiceInstances and ServiceBindings of every namespace, and
+// of the removal of classes from broker catalogs.
+// +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAME:.metadata.name,URL:.spec.url
+type ClusterNotificationSink struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Non-namespaced.  The name of this resource in etcd is in ObjectMeta.Name.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines where and which events are sent.
+	// +optional
+	Spec ClusterNotificationSinkSpec `json:"spec,omitempty"`
+}
+
+// ClusterNotificationSinkSpec represents where and which events are sent to
+// a ClusterNotificationSink.
+type ClusterNotificationSinkSpec struct {
+	CommonNotificationSinkSpec `json:",inline"`
+
+	// SigningSecretRef is a reference to a Secret whose signingKey entry is
+	// used to sign the events sent to the sink.
+	// +optional
+	SigningSecretRef *ObjectReference `json:"signingSecretRef,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSinkList is a list of NotificationSinks.
+type NotificationSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NotificationSink `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSink is an endpoint that is notified of the lifecycle events of
+// the ServiceInstances, ServiceBindings and ServiceClasses of its namespace.
+// +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAME:.metadata.name,URL:.spec.url
+type NotificationSink struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// The name of this resource in etcd is in ObjectMeta.Name.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines where and which events are sent.
+	// +optional
+	Spec NotificationSinkSpec `json:"spec,omitempty"`
+}
+
+// NotificationSinkSpec represents where and which events are sent to a
+// NotificationSink.
+type NotificationSinkSpec struct {
+	CommonNotificationSinkSpec `json:",inline"`
+
+	// SigningSecretRef is a reference to a Secret in the namespace of the
+	// sink whose signingKey entry is used to sign the events sent to the
+	// sink.
+	// +optional
+	SigningSecretRef *LocalObjectReference `json:"signingSecretRef,omitempty"`
+}
+
+// CommonNotificationSinkSpec represents the details common to
+// ClusterNotificationSinks and NotificationSinks.
+type CommonNotificationSinkSpec struct {
+	// URL is the http or https URL the events are posted to, as CloudEvents
+	// in structured JSON mode.
+	URL string `json:"url"`
+
+	// EventTypes are the types of the events sent to the sink. When empty,
+	// events of every type are sent.
+	// +optional
+	EventTypes []NotificationEventType `json:"eventTypes,omitempty"`
+}
+
+// NotificationEventType is the type of a lifecycle event sent to notification
+// sinks.
+type NotificationEventType string
+
+const (
+	// NotificationEventInstanceProvisioned is sent when a ServiceInstance
+	// has been provisioned by its broker.
+	NotificationEventInstanceProvisioned NotificationEventType = "InstanceProvisioned"
+
+	// NotificationEventInstanceFailed is sent when the provision, update or
+	// deprovision of a ServiceInstance has failed and will not be retried.
+	NotificationEventInstanceFailed NotificationEventType = "InstanceFailed"
+
+	// NotificationEventInstanceDeprovisioned is sent when a ServiceInstance
+	// has been deprovisioned by its broker.
+	NotificationEventInstanceDeprovisioned NotificationEventType = "InstanceDeprovisioned"
+
+	// NotificationEventBindingBound is sent when a ServiceBinding has been
+	// bound and its credentials injected.
+	NotificationEventBindingBound NotificationEventType = "BindingBound"
+
+	// NotificationEventBindingFailed is sent when the bind of a
+	// ServiceBinding has failed and will not be retried.
+	NotificationEventBindingFailed NotificationEventType = "BindingFailed"
+
+	// NotificationEventBindingUnbound is sent when a ServiceBinding has been
+	// unbound by its broker.
+	NotificationEventBindingUnbound NotificationEventType = "BindingUnbound"
+
+	// NotificationEventCredentialsRotated is sent when the credentials
+	// injected for a ServiceBinding have changed.
+	NotificationEventCredentialsRotated NotificationEventType = "CredentialsRotated"
+
+	// NotificationEventClassRemoved is sent when a class has been removed
+	// from the catalog of its broker.
+	NotificationEventClassRemoved NotificationEventType = "ClassRemoved"
+)
+
+// NotificationSigningKeySecretKey is the key of the entry of a signing Secret
+// that holds the key the events sent to a sink are signed with.
+const NotificationSigningKeySecretKey = "signingKey"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ServiceInstanceList is a list of instances.
 type ServiceInstanceList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -912,6 +1341,54 @@ type ServiceInstanceSpec struct {
 	// been made to the secrets from which the parameters are sourced.
 	// +optional
 	UpdateRequests int64 `json:"updateRequests"`
+
+	// DeletionPolicy specifies what the controller does at the broker when
+	// the ServiceInstance is deleted. With Delete, the instance is
+	// deprovisioned. With Retain, only the finalizer is removed and the
+	// instance is left in place at the broker. Defaults to Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// MaintenanceInfoVersion requests an upgrade of the instance to the given
+	// maintenance version of its plan. When it differs from the version
+	// applied to the instance, the controller sends an update request
+	// carrying the plan's maintenance info. It must match the plan's current
+	// maintenance version.
+	// +optional
+	MaintenanceInfoVersion string `json:"maintenanceInfoVersion,omitempty"`
+
+	// CancelRequests is a strictly increasing, non-negative integer counter
+	// that can be incremented by a user to cancel the operation in progress
+	// on the instance. An in-progress provision is abandoned and the
+	// instance deprovisioned at the broker; an in-progress update is
+	// abandoned. Deprovisions cannot be cancelled.
+	// +optional
+	CancelRequests int64 `json:"cancelRequests,omitempty"`
+
+	// RetryRequests is a strictly increasing, non-negative integer counter
+	// that can be incremented by a user to retry an operation that failed
+	// terminally. The Failed condition is cleared and the operation is
+	// driven again with a fresh backoff.
+	// +optional
+	RetryRequests int64 `json:"retryRequests,omitempty"`
+
+	// MaintenanceWindow restricts the updates of the instance at the broker
+	// to the windows it describes. Outside of a window, spec changes stay
+	// pending and are reported by the PendingUpdate condition. Provisioning
+	// and deprovisioning are not restricted.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindow describes recurring windows of time in which a
+// ServiceInstance may be updated at its broker.
+type MaintenanceWindow struct {
+	// Schedule is a cron schedule, in UTC, of the times the window opens,
+	// such as "0 2 * * 6" for every Saturday at 02:00.
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open.
+	Duration metav1.Duration `json:"duration"`
 }
 
 // ServiceInstanceStatus represents the current status of an Instance.
@@ -956,6 +1433,12 @@ type ServiceInstanceStatus struct {
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time `json:"operationStartTime,omitempty"`
 
+	// NextPollTime is when the controller next polls the broker for the
+	// progress of the current asynchronous operation. It is unset when no
+	// asynchronous operation is in progress.
+	// +optional
+	NextPollTime *metav1.Time `json:"nextPollTime,omitempty"`
+
 	// InProgressProperties is the properties state of the ServiceInstance when
 	// a Provision, Update or Deprovision is in progress.
 	InProgressProperties *ServiceInstancePropertiesState `json:"inProgressProperties,omitempty"`
@@ -970,6 +1453,11 @@ type ServiceInstanceStatus struct {
 	// DeprovisionStatus describes what has been done to deprovision the
 	// ServiceInstance.
 	DeprovisionStatus ServiceInstanceDeprovisionStatus `json:"deprovisionStatus"`
+
+	// ObservedCancelRequests is the value of CancelRequests in the spec of
+	// the instance that the controller last acted on.
+	// +optional
+	ObservedCancelRequests int64 `json:"observedCancelRequests,omitempty"`
 }
 
 // ServiceInstanceCondition contains condition information about an Instance.
@@ -1008,6 +1496,16 @@ const (
 	// ServiceInstanceConditionOrphanMitigation represents information about an
 	// orphan mitigation that is required after failed provisioning.
 	ServiceInstanceConditionOrphanMitigation ServiceInstanceConditionType = "OrphanMitigation"
+
+	// ServiceInstanceConditionUpgradeAvailable represents whether the
+	// instance's plan has a newer maintenance version than the one applied
+	// to the instance.
+	ServiceInstanceConditionUpgradeAvailable ServiceInstanceConditionType = "UpgradeAvailable"
+
+	// ServiceInstanceConditionPendingUpdate represents whether a change to
+	// the instance's spec is waiting for the instance's maintenance window,
+	// or for updates to the instance to be resumed.
+	ServiceInstanceConditionPendingUpdate ServiceInstanceConditionType = "PendingUpdate"
 )
 
 // ServiceInstanceOperation represents a type of operation the controller can
@@ -1057,6 +1555,10 @@ type ServiceInstancePropertiesState struct {
 
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
+
+	// MaintenanceInfo is the maintenance version of the plan that the broker
+	// knows this ServiceInstance to be on.
+	MaintenanceInfo *MaintenanceInfo `json:"maintenanceInfo,omitempty"`
 }
 
 // ServiceInstanceDeprovisionStatus is the status of deprovisioning a
@@ -1182,6 +1684,20 @@ type ServiceBindingSpec struct {
 	// settable by the end-user. User-provided values for this field are not saved.
 	// +optional
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
+
+	// DeletionPolicy specifies what the controller does at the broker when
+	// the ServiceBinding is deleted. With Delete, the binding is unbound.
+	// With Retain, only the finalizer is removed and the binding is left in
+	// place at the broker. Defaults to Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// RetryRequests is a strictly increasing, non-negative integer counter
+	// that can be incremented by a user to retry an operation that failed
+	// terminally. The Failed condition is cleared and the operation is
+	// driven again with a fresh backoff.
+	// +optional
+	RetryRequests int64 `json:"retryRequests,omitempty"`
 }
 
 // ServiceBindingStatus represents the current status of a ServiceBinding.
@@ -1216,6 +1732,12 @@ type ServiceBindingStatus struct {
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time `json:"operationStartTime,omitempty"`
 
+	// NextPollTime is when the controller next polls the broker for the
+	// progress of the current asynchronous operation. It is unset when no
+	// asynchronous operation is in progress.
+	// +optional
+	NextPollTime *metav1.Time `json:"nextPollTime,omitempty"`
+
 	// InProgressProperties is the properties state of the
 	// ServiceBinding when a Bind is in progress. If the current
 	// operation is an Unbind, this will be nil.
@@ -1305,6 +1827,45 @@ const (
 	FinalizerServiceCatalog string = "kubernetes-incubator/service-catalog"
 )
 
+// DeletionPolicy describes what happens at the broker when a ServiceInstance
+// or ServiceBinding is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete indicates that the instance is deprovisioned, or
+	// the binding is unbound, before the resource is removed.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyRetain indicates that the resource is removed without
+	// sending a deprovision or unbind request to the broker.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// DeletionProtectionAnnotation marks a ServiceInstance that must not be
+// deleted while the annotation is set to "true". It is enforced by the
+// ServiceInstanceDeletionProtection admission plugin.
+const DeletionProtectionAnnotation = "servicecatalog.k8s.io/deletion-protection"
+
+// AdoptionAnnotation marks a ServiceInstance or ServiceBinding that already
+// exists at the broker when it is created, for example after it has been
+// imported from another cluster. When set to "true", the controller records
+// the resource as provisioned or bound without sending a provision or bind
+// request. The broker is asked to confirm the resource when it supports
+// fetching it. Setting the annotation requires the "adopt" verb on the
+// resource.
+const AdoptionAnnotation = "servicecatalog.k8s.io/adopt"
+
+// BrokerCallbackAnnotation records the time of the last broker callback
+// accepted for a ServiceInstance or ServiceBinding. The replica that receives
+// the callback sets it, so that the replica that reconciles the resource
+// polls the broker right away.
+const BrokerCallbackAnnotation = "servicecatalog.k8s.io/broker-callback"
+
+// PausedAnnotation pauses the updates of a ServiceInstance at its broker
+// while it is set to "true". Spec changes stay pending and are reported by
+// the PendingUpdate condition. Provisioning and deprovisioning are not
+// paused.
+const PausedAnnotation = "servicecatalog.k8s.io/paused"
+
 // ServiceBindingPropertiesState is the state of a
 // ServiceBinding that the ClusterServiceBroker knows about.
 type ServiceBindingPropertiesState struct {
@@ -47,10 +47,24 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_servicecatalog_BearerTokenAuthConfig_To_v1beta1_BearerTokenAuthConfig,
 		Convert_v1beta1_CatalogRestrictions_To_servicecatalog_CatalogRestrictions,
 		Convert_servicecatalog_CatalogRestrictions_To_v1beta1_CatalogRestrictions,
+		Convert_v1beta1_CatalogRevision_To_servicecatalog_CatalogRevision,
+		Convert_servicecatalog_CatalogRevision_To_v1beta1_CatalogRevision,
+		Convert_v1beta1_ClientCertificateAuthConfig_To_servicecatalog_ClientCertificateAuthConfig,
+		Convert_servicecatalog_ClientCertificateAuthConfig_To_v1beta1_ClientCertificateAuthConfig,
 		Convert_v1beta1_ClusterBasicAuthConfig_To_servicecatalog_ClusterBasicAuthConfig,
 		Convert_servicecatalog_ClusterBasicAuthConfig_To_v1beta1_ClusterBasicAuthConfig,
 		Convert_v1beta1_ClusterBearerTokenAuthConfig_To_servicecatalog_ClusterBearerTokenAuthConfig,
 		Convert_servicecatalog_ClusterBearerTokenAuthConfig_To_v1beta1_ClusterBearerTokenAuthConfig,
+		Convert_v1beta1_ClusterClientCertificateAuthConfig_To_servicecatalog_ClusterClientCertificateAuthConfig,
+		Convert_servicecatalog_ClusterClientCertificateAuthConfig_To_v1beta1_ClusterClientCertificateAuthConfig,
+		Convert_v1beta1_ClusterNotificationSink_To_servicecatalog_ClusterNotificationSink,
+		Convert_servicecatalog_ClusterNotificationSink_To_v1beta1_ClusterNotificationSink,
+		Convert_v1beta1_ClusterNotificationSinkList_To_servicecatalog_ClusterNotificationSinkList,
+		Convert_servicecatalog_ClusterNotificationSinkList_To_v1beta1_ClusterNotificationSinkList,
+		Convert_v1beta1_ClusterNotificationSinkSpec_To_servicecatalog_ClusterNotificationSinkSpec,
+		Convert_servicecatalog_ClusterNotificationSinkSpec_To_v1beta1_ClusterNotificationSinkSpec,
+		Convert_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig_To_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig,
+		Convert_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig_To_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig,
 		Convert_v1beta1_ClusterObjectReference_To_servicecatalog_ClusterObjectReference,
 		Convert_servicecatalog_ClusterObjectReference_To_v1beta1_ClusterObjectReference,
 		Convert_v1beta1_ClusterServiceBroker_To_servicecatalog_ClusterServiceBroker,
@@ -79,6 +93,14 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_servicecatalog_ClusterServicePlanSpec_To_v1beta1_ClusterServicePlanSpec,
 		Convert_v1beta1_ClusterServicePlanStatus_To_servicecatalog_ClusterServicePlanStatus,
 		Convert_servicecatalog_ClusterServicePlanStatus_To_v1beta1_ClusterServicePlanStatus,
+		Convert_v1beta1_ClusterServicePlanTransitionPolicy_To_servicecatalog_ClusterServicePlanTransitionPolicy,
+		Convert_servicecatalog_ClusterServicePlanTransitionPolicy_To_v1beta1_ClusterServicePlanTransitionPolicy,
+		Convert_v1beta1_ClusterServicePlanTransitionPolicyList_To_servicecatalog_ClusterServicePlanTransitionPolicyList,
+		Convert_servicecatalog_ClusterServicePlanTransitionPolicyList_To_v1beta1_ClusterServicePlanTransitionPolicyList,
+		Convert_v1beta1_ClusterServicePlanTransitionPolicySpec_To_servicecatalog_ClusterServicePlanTransitionPolicySpec,
+		Convert_servicecatalog_ClusterServicePlanTransitionPolicySpec_To_v1beta1_ClusterServicePlanTransitionPolicySpec,
+		Convert_v1beta1_CommonNotificationSinkSpec_To_servicecatalog_CommonNotificationSinkSpec,
+		Convert_servicecatalog_CommonNotificationSinkSpec_To_v1beta1_CommonNotificationSinkSpec,
 		Convert_v1beta1_CommonServiceBrokerSpec_To_servicecatalog_CommonServiceBrokerSpec,
 		Convert_servicecatalog_CommonServiceBrokerSpec_To_v1beta1_CommonServiceBrokerSpec,
 		Convert_v1beta1_CommonServiceBrokerStatus_To_servicecatalog_CommonServiceBrokerStatus,
@@ -93,12 +115,28 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlanStatus,
 		Convert_v1beta1_LocalObjectReference_To_servicecatalog_LocalObjectReference,
 		Convert_servicecatalog_LocalObjectReference_To_v1beta1_LocalObjectReference,
+		Convert_v1beta1_MaintenanceInfo_To_servicecatalog_MaintenanceInfo,
+		Convert_servicecatalog_MaintenanceInfo_To_v1beta1_MaintenanceInfo,
+		Convert_v1beta1_MaintenanceWindow_To_servicecatalog_MaintenanceWindow,
+		Convert_servicecatalog_MaintenanceWindow_To_v1beta1_MaintenanceWindow,
+		Convert_v1beta1_NotificationSink_To_servicecatalog_NotificationSink,
+		Convert_servicecatalog_NotificationSink_To_v1beta1_NotificationSink,
+		Convert_v1beta1_NotificationSinkList_To_servicecatalog_NotificationSinkList,
+		Convert_servicecatalog_NotificationSinkList_To_v1beta1_NotificationSinkList,
+		Convert_v1beta1_NotificationSinkSpec_To_servicecatalog_NotificationSinkSpec,
+		Convert_servicecatalog_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec,
+		Convert_v1beta1_OAuth2ClientCredentialsAuthConfig_To_servicecatalog_OAuth2ClientCredentialsAuthConfig,
+		Convert_servicecatalog_OAuth2ClientCredentialsAuthConfig_To_v1beta1_OAuth2ClientCredentialsAuthConfig,
 		Convert_v1beta1_ObjectReference_To_servicecatalog_ObjectReference,
 		Convert_servicecatalog_ObjectReference_To_v1beta1_ObjectReference,
 		Convert_v1beta1_ParametersFromSource_To_servicecatalog_ParametersFromSource,
 		Convert_servicecatalog_ParametersFromSource_To_v1beta1_ParametersFromSource,
 		Convert_v1beta1_PlanReference_To_servicecatalog_PlanReference,
 		Convert_servicecatalog_PlanReference_To_v1beta1_PlanReference,
+		Convert_v1beta1_PlanTransitionRule_To_servicecatalog_PlanTransitionRule,
+		Convert_servicecatalog_PlanTransitionRule_To_v1beta1_PlanTransitionRule,
+		Convert_v1beta1_PlanTransitionSelector_To_servicecatalog_PlanTransitionSelector,
+		Convert_servicecatalog_PlanTransitionSelector_To_v1beta1_PlanTransitionSelector,
 		Convert_v1beta1_RemoveKeyTransform_To_servicecatalog_RemoveKeyTransform,
 		Convert_servicecatalog_RemoveKeyTransform_To_v1beta1_RemoveKeyTransform,
 		Convert_v1beta1_RenameKeyTransform_To_servicecatalog_RenameKeyTransform,
@@ -272,6 +310,54 @@ func Convert_servicecatalog_CatalogRestrictions_To_v1beta1_CatalogRestrictions(i
 	return autoConvert_servicecatalog_CatalogRestrictions_To_v1beta1_CatalogRestrictions(in, out, s)
 }
 
+func autoConvert_v1beta1_CatalogRevision_To_servicecatalog_CatalogRevision(in *CatalogRevision, out *servicecatalog.CatalogRevision, s conversion.Scope) error {
+	out.Hash = in.Hash
+	out.ETag = in.ETag
+	out.Timestamp = in.Timestamp
+	out.ServiceClassCount = in.ServiceClassCount
+	out.ServicePlanCount = in.ServicePlanCount
+	return nil
+}
+
+// Convert_v1beta1_CatalogRevision_To_servicecatalog_CatalogRevision is an autogenerated conversion function.
+func Convert_v1beta1_CatalogRevision_To_servicecatalog_CatalogRevision(in *CatalogRevision, out *servicecatalog.CatalogRevision, s conversion.Scope) error {
+	return autoConvert_v1beta1_CatalogRevision_To_servicecatalog_CatalogRevision(in, out, s)
+}
+
+func autoConvert_servicecatalog_CatalogRevision_To_v1beta1_CatalogRevision(in *servicecatalog.CatalogRevision, out *CatalogRevision, s conversion.Scope) error {
+	out.Hash = in.Hash
+	out.ETag = in.ETag
+	out.Timestamp = in.Timestamp
+	out.ServiceClassCount = in.ServiceClassCount
+	out.ServicePlanCount = in.ServicePlanCount
+	return nil
+}
+
+// Convert_servicecatalog_CatalogRevision_To_v1beta1_CatalogRevision is an autogenerated conversion function.
+func Convert_servicecatalog_CatalogRevision_To_v1beta1_CatalogRevision(in *servicecatalog.CatalogRevision, out *CatalogRevision, s conversion.Scope) error {
+	return autoConvert_servicecatalog_CatalogRevision_To_v1beta1_CatalogRevision(in, out, s)
+}
+
+func autoConvert_v1beta1_ClientCertificateAuthConfig_To_servicecatalog_ClientCertificateAuthConfig(in *ClientCertificateAuthConfig, out *servicecatalog.ClientCertificateAuthConfig, s conversion.Scope) error {
+	out.SecretRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1beta1_ClientCertificateAuthConfig_To_servicecatalog_ClientCertificateAuthConfig is an autogenerated conversion function.
+func Convert_v1beta1_ClientCertificateAuthConfig_To_servicecatalog_ClientCertificateAuthConfig(in *ClientCertificateAuthConfig, out *servicecatalog.ClientCertificateAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClientCertificateAuthConfig_To_servicecatalog_ClientCertificateAuthConfig(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClientCertificateAuthConfig_To_v1beta1_ClientCertificateAuthConfig(in *servicecatalog.ClientCertificateAuthConfig, out *ClientCertificateAuthConfig, s conversion.Scope) error {
+	out.SecretRef = (*LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_servicecatalog_ClientCertificateAuthConfig_To_v1beta1_ClientCertificateAuthConfig is an autogenerated conversion function.
+func Convert_servicecatalog_ClientCertificateAuthConfig_To_v1beta1_ClientCertificateAuthConfig(in *servicecatalog.ClientCertificateAuthConfig, out *ClientCertificateAuthConfig, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClientCertificateAuthConfig_To_v1beta1_ClientCertificateAuthConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterBasicAuthConfig_To_servicecatalog_ClusterBasicAuthConfig(in *ClusterBasicAuthConfig, out *servicecatalog.ClusterBasicAuthConfig, s conversion.Scope) error {
 	out.SecretRef = (*servicecatalog.ObjectReference)(unsafe.Pointer(in.SecretRef))
 	return nil
@@ -312,6 +398,124 @@ func Convert_servicecatalog_ClusterBearerTokenAuthConfig_To_v1beta1_ClusterBeare
 	return autoConvert_servicecatalog_ClusterBearerTokenAuthConfig_To_v1beta1_ClusterBearerTokenAuthConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_ClusterClientCertificateAuthConfig_To_servicecatalog_ClusterClientCertificateAuthConfig(in *ClusterClientCertificateAuthConfig, out *servicecatalog.ClusterClientCertificateAuthConfig, s conversion.Scope) error {
+	out.SecretRef = (*servicecatalog.ObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1beta1_ClusterClientCertificateAuthConfig_To_servicecatalog_ClusterClientCertificateAuthConfig is an autogenerated conversion function.
+func Convert_v1beta1_ClusterClientCertificateAuthConfig_To_servicecatalog_ClusterClientCertificateAuthConfig(in *ClusterClientCertificateAuthConfig, out *servicecatalog.ClusterClientCertificateAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterClientCertificateAuthConfig_To_servicecatalog_ClusterClientCertificateAuthConfig(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterClientCertificateAuthConfig_To_v1beta1_ClusterClientCertificateAuthConfig(in *servicecatalog.ClusterClientCertificateAuthConfig, out *ClusterClientCertificateAuthConfig, s conversion.Scope) error {
+	out.SecretRef = (*ObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_servicecatalog_ClusterClientCertificateAuthConfig_To_v1beta1_ClusterClientCertificateAuthConfig is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterClientCertificateAuthConfig_To_v1beta1_ClusterClientCertificateAuthConfig(in *servicecatalog.ClusterClientCertificateAuthConfig, out *ClusterClientCertificateAuthConfig, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterClientCertificateAuthConfig_To_v1beta1_ClusterClientCertificateAuthConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterNotificationSink_To_servicecatalog_ClusterNotificationSink(in *ClusterNotificationSink, out *servicecatalog.ClusterNotificationSink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ClusterNotificationSinkSpec_To_servicecatalog_ClusterNotificationSinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ClusterNotificationSink_To_servicecatalog_ClusterNotificationSink is an autogenerated conversion function.
+func Convert_v1beta1_ClusterNotificationSink_To_servicecatalog_ClusterNotificationSink(in *ClusterNotificationSink, out *servicecatalog.ClusterNotificationSink, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterNotificationSink_To_servicecatalog_ClusterNotificationSink(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterNotificationSink_To_v1beta1_ClusterNotificationSink(in *servicecatalog.ClusterNotificationSink, out *ClusterNotificationSink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_servicecatalog_ClusterNotificationSinkSpec_To_v1beta1_ClusterNotificationSinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_ClusterNotificationSink_To_v1beta1_ClusterNotificationSink is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterNotificationSink_To_v1beta1_ClusterNotificationSink(in *servicecatalog.ClusterNotificationSink, out *ClusterNotificationSink, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterNotificationSink_To_v1beta1_ClusterNotificationSink(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterNotificationSinkList_To_servicecatalog_ClusterNotificationSinkList(in *ClusterNotificationSinkList, out *servicecatalog.ClusterNotificationSinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]servicecatalog.ClusterNotificationSink)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ClusterNotificationSinkList_To_servicecatalog_ClusterNotificationSinkList is an autogenerated conversion function.
+func Convert_v1beta1_ClusterNotificationSinkList_To_servicecatalog_ClusterNotificationSinkList(in *ClusterNotificationSinkList, out *servicecatalog.ClusterNotificationSinkList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterNotificationSinkList_To_servicecatalog_ClusterNotificationSinkList(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterNotificationSinkList_To_v1beta1_ClusterNotificationSinkList(in *servicecatalog.ClusterNotificationSinkList, out *ClusterNotificationSinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterNotificationSink)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_servicecatalog_ClusterNotificationSinkList_To_v1beta1_ClusterNotificationSinkList is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterNotificationSinkList_To_v1beta1_ClusterNotificationSinkList(in *servicecatalog.ClusterNotificationSinkList, out *ClusterNotificationSinkList, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterNotificationSinkList_To_v1beta1_ClusterNotificationSinkList(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterNotificationSinkSpec_To_servicecatalog_ClusterNotificationSinkSpec(in *ClusterNotificationSinkSpec, out *servicecatalog.ClusterNotificationSinkSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_CommonNotificationSinkSpec_To_servicecatalog_CommonNotificationSinkSpec(&in.CommonNotificationSinkSpec, &out.CommonNotificationSinkSpec, s); err != nil {
+		return err
+	}
+	out.SigningSecretRef = (*servicecatalog.ObjectReference)(unsafe.Pointer(in.SigningSecretRef))
+	return nil
+}
+
+// Convert_v1beta1_ClusterNotificationSinkSpec_To_servicecatalog_ClusterNotificationSinkSpec is an autogenerated conversion function.
+func Convert_v1beta1_ClusterNotificationSinkSpec_To_servicecatalog_ClusterNotificationSinkSpec(in *ClusterNotificationSinkSpec, out *servicecatalog.ClusterNotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterNotificationSinkSpec_To_servicecatalog_ClusterNotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterNotificationSinkSpec_To_v1beta1_ClusterNotificationSinkSpec(in *servicecatalog.ClusterNotificationSinkSpec, out *ClusterNotificationSinkSpec, s conversion.Scope) error {
+	if err := Convert_servicecatalog_CommonNotificationSinkSpec_To_v1beta1_CommonNotificationSinkSpec(&in.CommonNotificationSinkSpec, &out.CommonNotificationSinkSpec, s); err != nil {
+		return err
+	}
+	out.SigningSecretRef = (*ObjectReference)(unsafe.Pointer(in.SigningSecretRef))
+	return nil
+}
+
+// Convert_servicecatalog_ClusterNotificationSinkSpec_To_v1beta1_ClusterNotificationSinkSpec is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterNotificationSinkSpec_To_v1beta1_ClusterNotificationSinkSpec(in *servicecatalog.ClusterNotificationSinkSpec, out *ClusterNotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterNotificationSinkSpec_To_v1beta1_ClusterNotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig_To_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig(in *ClusterOAuth2ClientCredentialsAuthConfig, out *servicecatalog.ClusterOAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	out.TokenURL = in.TokenURL
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	out.SecretRef = (*servicecatalog.ObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig_To_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig is an autogenerated conversion function.
+func Convert_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig_To_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig(in *ClusterOAuth2ClientCredentialsAuthConfig, out *servicecatalog.ClusterOAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig_To_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig_To_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig(in *servicecatalog.ClusterOAuth2ClientCredentialsAuthConfig, out *ClusterOAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	out.TokenURL = in.TokenURL
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	out.SecretRef = (*ObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig_To_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig_To_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig(in *servicecatalog.ClusterOAuth2ClientCredentialsAuthConfig, out *ClusterOAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterOAuth2ClientCredentialsAuthConfig_To_v1beta1_ClusterOAuth2ClientCredentialsAuthConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterObjectReference_To_servicecatalog_ClusterObjectReference(in *ClusterObjectReference, out *servicecatalog.ClusterObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -367,6 +571,8 @@ func Convert_servicecatalog_ClusterServiceBroker_To_v1beta1_ClusterServiceBroker
 func autoConvert_v1beta1_ClusterServiceBrokerAuthInfo_To_servicecatalog_ClusterServiceBrokerAuthInfo(in *ClusterServiceBrokerAuthInfo, out *servicecatalog.ClusterServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*servicecatalog.ClusterBasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*servicecatalog.ClusterBearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.ClientCertificate = (*servicecatalog.ClusterClientCertificateAuthConfig)(unsafe.Pointer(in.ClientCertificate))
+	out.OAuth2ClientCredentials = (*servicecatalog.ClusterOAuth2ClientCredentialsAuthConfig)(unsafe.Pointer(in.OAuth2ClientCredentials))
 	return nil
 }
 
@@ -378,6 +584,8 @@ func Convert_v1beta1_ClusterServiceBrokerAuthInfo_To_servicecatalog_ClusterServi
 func autoConvert_servicecatalog_ClusterServiceBrokerAuthInfo_To_v1beta1_ClusterServiceBrokerAuthInfo(in *servicecatalog.ClusterServiceBrokerAuthInfo, out *ClusterServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*ClusterBasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*ClusterBearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.ClientCertificate = (*ClusterClientCertificateAuthConfig)(unsafe.Pointer(in.ClientCertificate))
+	out.OAuth2ClientCredentials = (*ClusterOAuth2ClientCredentialsAuthConfig)(unsafe.Pointer(in.OAuth2ClientCredentials))
 	return nil
 }
 
@@ -672,6 +880,102 @@ func Convert_servicecatalog_ClusterServicePlanStatus_To_v1beta1_ClusterServicePl
 	return autoConvert_servicecatalog_ClusterServicePlanStatus_To_v1beta1_ClusterServicePlanStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ClusterServicePlanTransitionPolicy_To_servicecatalog_ClusterServicePlanTransitionPolicy(in *ClusterServicePlanTransitionPolicy, out *servicecatalog.ClusterServicePlanTransitionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ClusterServicePlanTransitionPolicySpec_To_servicecatalog_ClusterServicePlanTransitionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ClusterServicePlanTransitionPolicy_To_servicecatalog_ClusterServicePlanTransitionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_ClusterServicePlanTransitionPolicy_To_servicecatalog_ClusterServicePlanTransitionPolicy(in *ClusterServicePlanTransitionPolicy, out *servicecatalog.ClusterServicePlanTransitionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterServicePlanTransitionPolicy_To_servicecatalog_ClusterServicePlanTransitionPolicy(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterServicePlanTransitionPolicy_To_v1beta1_ClusterServicePlanTransitionPolicy(in *servicecatalog.ClusterServicePlanTransitionPolicy, out *ClusterServicePlanTransitionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_servicecatalog_ClusterServicePlanTransitionPolicySpec_To_v1beta1_ClusterServicePlanTransitionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_ClusterServicePlanTransitionPolicy_To_v1beta1_ClusterServicePlanTransitionPolicy is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterServicePlanTransitionPolicy_To_v1beta1_ClusterServicePlanTransitionPolicy(in *servicecatalog.ClusterServicePlanTransitionPolicy, out *ClusterServicePlanTransitionPolicy, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterServicePlanTransitionPolicy_To_v1beta1_ClusterServicePlanTransitionPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterServicePlanTransitionPolicyList_To_servicecatalog_ClusterServicePlanTransitionPolicyList(in *ClusterServicePlanTransitionPolicyList, out *servicecatalog.ClusterServicePlanTransitionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]servicecatalog.ClusterServicePlanTransitionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ClusterServicePlanTransitionPolicyList_To_servicecatalog_ClusterServicePlanTransitionPolicyList is an autogenerated conversion function.
+func Convert_v1beta1_ClusterServicePlanTransitionPolicyList_To_servicecatalog_ClusterServicePlanTransitionPolicyList(in *ClusterServicePlanTransitionPolicyList, out *servicecatalog.ClusterServicePlanTransitionPolicyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterServicePlanTransitionPolicyList_To_servicecatalog_ClusterServicePlanTransitionPolicyList(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterServicePlanTransitionPolicyList_To_v1beta1_ClusterServicePlanTransitionPolicyList(in *servicecatalog.ClusterServicePlanTransitionPolicyList, out *ClusterServicePlanTransitionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterServicePlanTransitionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_servicecatalog_ClusterServicePlanTransitionPolicyList_To_v1beta1_ClusterServicePlanTransitionPolicyList is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterServicePlanTransitionPolicyList_To_v1beta1_ClusterServicePlanTransitionPolicyList(in *servicecatalog.ClusterServicePlanTransitionPolicyList, out *ClusterServicePlanTransitionPolicyList, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterServicePlanTransitionPolicyList_To_v1beta1_ClusterServicePlanTransitionPolicyList(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterServicePlanTransitionPolicySpec_To_servicecatalog_ClusterServicePlanTransitionPolicySpec(in *ClusterServicePlanTransitionPolicySpec, out *servicecatalog.ClusterServicePlanTransitionPolicySpec, s conversion.Scope) error {
+	out.ClusterServiceClassName = in.ClusterServiceClassName
+	out.ServiceClassRef = (*servicecatalog.ObjectReference)(unsafe.Pointer(in.ServiceClassRef))
+	out.PlanOrder = *(*[]string)(unsafe.Pointer(&in.PlanOrder))
+	out.Rules = *(*[]servicecatalog.PlanTransitionRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1beta1_ClusterServicePlanTransitionPolicySpec_To_servicecatalog_ClusterServicePlanTransitionPolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_ClusterServicePlanTransitionPolicySpec_To_servicecatalog_ClusterServicePlanTransitionPolicySpec(in *ClusterServicePlanTransitionPolicySpec, out *servicecatalog.ClusterServicePlanTransitionPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterServicePlanTransitionPolicySpec_To_servicecatalog_ClusterServicePlanTransitionPolicySpec(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterServicePlanTransitionPolicySpec_To_v1beta1_ClusterServicePlanTransitionPolicySpec(in *servicecatalog.ClusterServicePlanTransitionPolicySpec, out *ClusterServicePlanTransitionPolicySpec, s conversion.Scope) error {
+	out.ClusterServiceClassName = in.ClusterServiceClassName
+	out.ServiceClassRef = (*ObjectReference)(unsafe.Pointer(in.ServiceClassRef))
+	out.PlanOrder = *(*[]string)(unsafe.Pointer(&in.PlanOrder))
+	out.Rules = *(*[]PlanTransitionRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_servicecatalog_ClusterServicePlanTransitionPolicySpec_To_v1beta1_ClusterServicePlanTransitionPolicySpec is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterServicePlanTransitionPolicySpec_To_v1beta1_ClusterServicePlanTransitionPolicySpec(in *servicecatalog.ClusterServicePlanTransitionPolicySpec, out *ClusterServicePlanTransitionPolicySpec, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterServicePlanTransitionPolicySpec_To_v1beta1_ClusterServicePlanTransitionPolicySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_CommonNotificationSinkSpec_To_servicecatalog_CommonNotificationSinkSpec(in *CommonNotificationSinkSpec, out *servicecatalog.CommonNotificationSinkSpec, s conversion.Scope) error {
+	out.URL = in.URL
+	out.EventTypes = *(*[]servicecatalog.NotificationEventType)(unsafe.Pointer(&in.EventTypes))
+	return nil
+}
+
+// Convert_v1beta1_CommonNotificationSinkSpec_To_servicecatalog_CommonNotificationSinkSpec is an autogenerated conversion function.
+func Convert_v1beta1_CommonNotificationSinkSpec_To_servicecatalog_CommonNotificationSinkSpec(in *CommonNotificationSinkSpec, out *servicecatalog.CommonNotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_CommonNotificationSinkSpec_To_servicecatalog_CommonNotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_servicecatalog_CommonNotificationSinkSpec_To_v1beta1_CommonNotificationSinkSpec(in *servicecatalog.CommonNotificationSinkSpec, out *CommonNotificationSinkSpec, s conversion.Scope) error {
+	out.URL = in.URL
+	out.EventTypes = *(*[]NotificationEventType)(unsafe.Pointer(&in.EventTypes))
+	return nil
+}
+
+// Convert_servicecatalog_CommonNotificationSinkSpec_To_v1beta1_CommonNotificationSinkSpec is an autogenerated conversion function.
+func Convert_servicecatalog_CommonNotificationSinkSpec_To_v1beta1_CommonNotificationSinkSpec(in *servicecatalog.CommonNotificationSinkSpec, out *CommonNotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_servicecatalog_CommonNotificationSinkSpec_To_v1beta1_CommonNotificationSinkSpec(in, out, s)
+}
+
 func autoConvert_v1beta1_CommonServiceBrokerSpec_To_servicecatalog_CommonServiceBrokerSpec(in *CommonServiceBrokerSpec, out *servicecatalog.CommonServiceBrokerSpec, s conversion.Scope) error {
 	out.URL = in.URL
 	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
@@ -680,6 +984,10 @@ func autoConvert_v1beta1_CommonServiceBrokerSpec_To_servicecatalog_CommonService
 	out.RelistDuration = (*v1.Duration)(unsafe.Pointer(in.RelistDuration))
 	out.RelistRequests = in.RelistRequests
 	out.CatalogRestrictions = (*servicecatalog.CatalogRestrictions)(unsafe.Pointer(in.CatalogRestrictions))
+	out.MaxConcurrentRequests = (*int32)(unsafe.Pointer(in.MaxConcurrentRequests))
+	out.RequestsPerSecond = (*int32)(unsafe.Pointer(in.RequestsPerSecond))
+	out.ReconciliationRetryDuration = (*v1.Duration)(unsafe.Pointer(in.ReconciliationRetryDuration))
+	out.OperationPollingMaximumBackoffDuration = (*v1.Duration)(unsafe.Pointer(in.OperationPollingMaximumBackoffDuration))
 	return nil
 }
 
@@ -696,6 +1004,10 @@ func autoConvert_servicecatalog_CommonServiceBrokerSpec_To_v1beta1_CommonService
 	out.RelistDuration = (*v1.Duration)(unsafe.Pointer(in.RelistDuration))
 	out.RelistRequests = in.RelistRequests
 	out.CatalogRestrictions = (*CatalogRestrictions)(unsafe.Pointer(in.CatalogRestrictions))
+	out.MaxConcurrentRequests = (*int32)(unsafe.Pointer(in.MaxConcurrentRequests))
+	out.RequestsPerSecond = (*int32)(unsafe.Pointer(in.RequestsPerSecond))
+	out.ReconciliationRetryDuration = (*v1.Duration)(unsafe.Pointer(in.ReconciliationRetryDuration))
+	out.OperationPollingMaximumBackoffDuration = (*v1.Duration)(unsafe.Pointer(in.OperationPollingMaximumBackoffDuration))
 	return nil
 }
 
@@ -709,6 +1021,8 @@ func autoConvert_v1beta1_CommonServiceBrokerStatus_To_servicecatalog_CommonServi
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
+	out.CatalogRevision = (*servicecatalog.CatalogRevision)(unsafe.Pointer(in.CatalogRevision))
+	out.OSBAPIVersion = in.OSBAPIVersion
 	return nil
 }
 
@@ -722,6 +1036,8 @@ func autoConvert_servicecatalog_CommonServiceBrokerStatus_To_v1beta1_CommonServi
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
+	out.CatalogRevision = (*CatalogRevision)(unsafe.Pointer(in.CatalogRevision))
+	out.OSBAPIVersion = in.OSBAPIVersion
 	return nil
 }
 
@@ -736,6 +1052,7 @@ func autoConvert_v1beta1_CommonServiceClassSpec_To_servicecatalog_CommonServiceC
 	out.Description = in.Description
 	out.Bindable = in.Bindable
 	out.BindingRetrievable = in.BindingRetrievable
+	out.InstancesRetrievable = in.InstancesRetrievable
 	out.PlanUpdatable = in.PlanUpdatable
 	out.ExternalMetadata = (*runtime.RawExtension)(unsafe.Pointer(in.ExternalMetadata))
 	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
@@ -754,6 +1071,7 @@ func autoConvert_servicecatalog_CommonServiceClassSpec_To_v1beta1_CommonServiceC
 	out.Description = in.Description
 	out.Bindable = in.Bindable
 	out.BindingRetrievable = in.BindingRetrievable
+	out.InstancesRetrievable = in.InstancesRetrievable
 	out.PlanUpdatable = in.PlanUpdatable
 	out.ExternalMetadata = (*runtime.RawExtension)(unsafe.Pointer(in.ExternalMetadata))
 	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
@@ -797,6 +1115,8 @@ func autoConvert_v1beta1_CommonServicePlanSpec_To_servicecatalog_CommonServicePl
 	out.ServiceInstanceUpdateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceInstanceUpdateParameterSchema))
 	out.ServiceBindingCreateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceBindingCreateParameterSchema))
 	out.ServiceBindingCreateResponseSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceBindingCreateResponseSchema))
+	out.MaintenanceInfo = (*servicecatalog.MaintenanceInfo)(unsafe.Pointer(in.MaintenanceInfo))
+	out.MaximumPollingDuration = (*v1.Duration)(unsafe.Pointer(in.MaximumPollingDuration))
 	return nil
 }
 
@@ -816,6 +1136,8 @@ func autoConvert_servicecatalog_CommonServicePlanSpec_To_v1beta1_CommonServicePl
 	out.ServiceInstanceUpdateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceInstanceUpdateParameterSchema))
 	out.ServiceBindingCreateParameterSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceBindingCreateParameterSchema))
 	out.ServiceBindingCreateResponseSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ServiceBindingCreateResponseSchema))
+	out.MaintenanceInfo = (*MaintenanceInfo)(unsafe.Pointer(in.MaintenanceInfo))
+	out.MaximumPollingDuration = (*v1.Duration)(unsafe.Pointer(in.MaximumPollingDuration))
 	return nil
 }
 
@@ -864,6 +1186,148 @@ func Convert_servicecatalog_LocalObjectReference_To_v1beta1_LocalObjectReference
 	return autoConvert_servicecatalog_LocalObjectReference_To_v1beta1_LocalObjectReference(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceInfo_To_servicecatalog_MaintenanceInfo(in *MaintenanceInfo, out *servicecatalog.MaintenanceInfo, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceInfo_To_servicecatalog_MaintenanceInfo is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceInfo_To_servicecatalog_MaintenanceInfo(in *MaintenanceInfo, out *servicecatalog.MaintenanceInfo, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceInfo_To_servicecatalog_MaintenanceInfo(in, out, s)
+}
+
+func autoConvert_servicecatalog_MaintenanceInfo_To_v1beta1_MaintenanceInfo(in *servicecatalog.MaintenanceInfo, out *MaintenanceInfo, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_servicecatalog_MaintenanceInfo_To_v1beta1_MaintenanceInfo is an autogenerated conversion function.
+func Convert_servicecatalog_MaintenanceInfo_To_v1beta1_MaintenanceInfo(in *servicecatalog.MaintenanceInfo, out *MaintenanceInfo, s conversion.Scope) error {
+	return autoConvert_servicecatalog_MaintenanceInfo_To_v1beta1_MaintenanceInfo(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceWindow_To_servicecatalog_MaintenanceWindow(in *MaintenanceWindow, out *servicecatalog.MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceWindow_To_servicecatalog_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceWindow_To_servicecatalog_MaintenanceWindow(in *MaintenanceWindow, out *servicecatalog.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceWindow_To_servicecatalog_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_servicecatalog_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in *servicecatalog.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_servicecatalog_MaintenanceWindow_To_v1beta1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_servicecatalog_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in *servicecatalog.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_servicecatalog_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationSink_To_servicecatalog_NotificationSink(in *NotificationSink, out *servicecatalog.NotificationSink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_NotificationSinkSpec_To_servicecatalog_NotificationSinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_NotificationSink_To_servicecatalog_NotificationSink is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSink_To_servicecatalog_NotificationSink(in *NotificationSink, out *servicecatalog.NotificationSink, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSink_To_servicecatalog_NotificationSink(in, out, s)
+}
+
+func autoConvert_servicecatalog_NotificationSink_To_v1beta1_NotificationSink(in *servicecatalog.NotificationSink, out *NotificationSink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_servicecatalog_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_NotificationSink_To_v1beta1_NotificationSink is an autogenerated conversion function.
+func Convert_servicecatalog_NotificationSink_To_v1beta1_NotificationSink(in *servicecatalog.NotificationSink, out *NotificationSink, s conversion.Scope) error {
+	return autoConvert_servicecatalog_NotificationSink_To_v1beta1_NotificationSink(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationSinkList_To_servicecatalog_NotificationSinkList(in *NotificationSinkList, out *servicecatalog.NotificationSinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]servicecatalog.NotificationSink)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_NotificationSinkList_To_servicecatalog_NotificationSinkList is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkList_To_servicecatalog_NotificationSinkList(in *NotificationSinkList, out *servicecatalog.NotificationSinkList, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkList_To_servicecatalog_NotificationSinkList(in, out, s)
+}
+
+func autoConvert_servicecatalog_NotificationSinkList_To_v1beta1_NotificationSinkList(in *servicecatalog.NotificationSinkList, out *NotificationSinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NotificationSink)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_servicecatalog_NotificationSinkList_To_v1beta1_NotificationSinkList is an autogenerated conversion function.
+func Convert_servicecatalog_NotificationSinkList_To_v1beta1_NotificationSinkList(in *servicecatalog.NotificationSinkList, out *NotificationSinkList, s conversion.Scope) error {
+	return autoConvert_servicecatalog_NotificationSinkList_To_v1beta1_NotificationSinkList(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationSinkSpec_To_servicecatalog_NotificationSinkSpec(in *NotificationSinkSpec, out *servicecatalog.NotificationSinkSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_CommonNotificationSinkSpec_To_servicecatalog_CommonNotificationSinkSpec(&in.CommonNotificationSinkSpec, &out.CommonNotificationSinkSpec, s); err != nil {
+		return err
+	}
+	out.SigningSecretRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.SigningSecretRef))
+	return nil
+}
+
+// Convert_v1beta1_NotificationSinkSpec_To_servicecatalog_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkSpec_To_servicecatalog_NotificationSinkSpec(in *NotificationSinkSpec, out *servicecatalog.NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkSpec_To_servicecatalog_NotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_servicecatalog_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(in *servicecatalog.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	if err := Convert_servicecatalog_CommonNotificationSinkSpec_To_v1beta1_CommonNotificationSinkSpec(&in.CommonNotificationSinkSpec, &out.CommonNotificationSinkSpec, s); err != nil {
+		return err
+	}
+	out.SigningSecretRef = (*LocalObjectReference)(unsafe.Pointer(in.SigningSecretRef))
+	return nil
+}
+
+// Convert_servicecatalog_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_servicecatalog_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(in *servicecatalog.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_servicecatalog_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_OAuth2ClientCredentialsAuthConfig_To_servicecatalog_OAuth2ClientCredentialsAuthConfig(in *OAuth2ClientCredentialsAuthConfig, out *servicecatalog.OAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	out.TokenURL = in.TokenURL
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	out.SecretRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1beta1_OAuth2ClientCredentialsAuthConfig_To_servicecatalog_OAuth2ClientCredentialsAuthConfig is an autogenerated conversion function.
+func Convert_v1beta1_OAuth2ClientCredentialsAuthConfig_To_servicecatalog_OAuth2ClientCredentialsAuthConfig(in *OAuth2ClientCredentialsAuthConfig, out *servicecatalog.OAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_OAuth2ClientCredentialsAuthConfig_To_servicecatalog_OAuth2ClientCredentialsAuthConfig(in, out, s)
+}
+
+func autoConvert_servicecatalog_OAuth2ClientCredentialsAuthConfig_To_v1beta1_OAuth2ClientCredentialsAuthConfig(in *servicecatalog.OAuth2ClientCredentialsAuthConfig, out *OAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	out.TokenURL = in.TokenURL
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	out.SecretRef = (*LocalObjectReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_servicecatalog_OAuth2ClientCredentialsAuthConfig_To_v1beta1_OAuth2ClientCredentialsAuthConfig is an autogenerated conversion function.
+func Convert_servicecatalog_OAuth2ClientCredentialsAuthConfig_To_v1beta1_OAuth2ClientCredentialsAuthConfig(in *servicecatalog.OAuth2ClientCredentialsAuthConfig, out *OAuth2ClientCredentialsAuthConfig, s conversion.Scope) error {
+	return autoConvert_servicecatalog_OAuth2ClientCredentialsAuthConfig_To_v1beta1_OAuth2ClientCredentialsAuthConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_ObjectReference_To_servicecatalog_ObjectReference(in *ObjectReference, out *servicecatalog.ObjectReference, s conversion.Scope) error {
 	out.Namespace = in.Namespace
 	out.Name = in.Name
@@ -948,6 +1412,64 @@ func Convert_servicecatalog_PlanReference_To_v1beta1_PlanReference(in *serviceca
 	return autoConvert_servicecatalog_PlanReference_To_v1beta1_PlanReference(in, out, s)
 }
 
+func autoConvert_v1beta1_PlanTransitionRule_To_servicecatalog_PlanTransitionRule(in *PlanTransitionRule, out *servicecatalog.PlanTransitionRule, s conversion.Scope) error {
+	if err := Convert_v1beta1_PlanTransitionSelector_To_servicecatalog_PlanTransitionSelector(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_PlanTransitionSelector_To_servicecatalog_PlanTransitionSelector(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	out.Direction = servicecatalog.PlanTransitionDirection(in.Direction)
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_v1beta1_PlanTransitionRule_To_servicecatalog_PlanTransitionRule is an autogenerated conversion function.
+func Convert_v1beta1_PlanTransitionRule_To_servicecatalog_PlanTransitionRule(in *PlanTransitionRule, out *servicecatalog.PlanTransitionRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_PlanTransitionRule_To_servicecatalog_PlanTransitionRule(in, out, s)
+}
+
+func autoConvert_servicecatalog_PlanTransitionRule_To_v1beta1_PlanTransitionRule(in *servicecatalog.PlanTransitionRule, out *PlanTransitionRule, s conversion.Scope) error {
+	if err := Convert_servicecatalog_PlanTransitionSelector_To_v1beta1_PlanTransitionSelector(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := Convert_servicecatalog_PlanTransitionSelector_To_v1beta1_PlanTransitionSelector(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	out.Direction = PlanTransitionDirection(in.Direction)
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_servicecatalog_PlanTransitionRule_To_v1beta1_PlanTransitionRule is an autogenerated conversion function.
+func Convert_servicecatalog_PlanTransitionRule_To_v1beta1_PlanTransitionRule(in *servicecatalog.PlanTransitionRule, out *PlanTransitionRule, s conversion.Scope) error {
+	return autoConvert_servicecatalog_PlanTransitionRule_To_v1beta1_PlanTransitionRule(in, out, s)
+}
+
+func autoConvert_v1beta1_PlanTransitionSelector_To_servicecatalog_PlanTransitionSelector(in *PlanTransitionSelector, out *servicecatalog.PlanTransitionSelector, s conversion.Scope) error {
+	out.ExternalNames = *(*[]string)(unsafe.Pointer(&in.ExternalNames))
+	out.Free = (*bool)(unsafe.Pointer(in.Free))
+	return nil
+}
+
+// Convert_v1beta1_PlanTransitionSelector_To_servicecatalog_PlanTransitionSelector is an autogenerated conversion function.
+func Convert_v1beta1_PlanTransitionSelector_To_servicecatalog_PlanTransitionSelector(in *PlanTransitionSelector, out *servicecatalog.PlanTransitionSelector, s conversion.Scope) error {
+	return autoConvert_v1beta1_PlanTransitionSelector_To_servicecatalog_PlanTransitionSelector(in, out, s)
+}
+
+func autoConvert_servicecatalog_PlanTransitionSelector_To_v1beta1_PlanTransitionSelector(in *servicecatalog.PlanTransitionSelector, out *PlanTransitionSelector, s conversion.Scope) error {
+	out.ExternalNames = *(*[]string)(unsafe.Pointer(&in.ExternalNames))
+	out.Free = (*bool)(unsafe.Pointer(in.Free))
+	return nil
+}
+
+// Convert_servicecatalog_PlanTransitionSelector_To_v1beta1_PlanTransitionSelector is an autogenerated conversion function.
+func Convert_servicecatalog_PlanTransitionSelector_To_v1beta1_PlanTransitionSelector(in *servicecatalog.PlanTransitionSelector, out *PlanTransitionSelector, s conversion.Scope) error {
+	return autoConvert_servicecatalog_PlanTransitionSelector_To_v1beta1_PlanTransitionSelector(in, out, s)
+}
+
 func autoConvert_v1beta1_RemoveKeyTransform_To_servicecatalog_RemoveKeyTransform(in *RemoveKeyTransform, out *servicecatalog.RemoveKeyTransform, s conversion.Scope) error {
 	out.Key = in.Key
 	return nil
@@ -1154,6 +1676,8 @@ func autoConvert_v1beta1_ServiceBindingSpec_To_servicecatalog_ServiceBindingSpec
 	out.SecretTransforms = *(*[]servicecatalog.SecretTransform)(unsafe.Pointer(&in.SecretTransforms))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.DeletionPolicy = servicecatalog.DeletionPolicy(in.DeletionPolicy)
+	out.RetryRequests = in.RetryRequests
 	return nil
 }
 
@@ -1172,6 +1696,8 @@ func autoConvert_servicecatalog_ServiceBindingSpec_To_v1beta1_ServiceBindingSpec
 	out.SecretTransforms = *(*[]SecretTransform)(unsafe.Pointer(&in.SecretTransforms))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.RetryRequests = in.RetryRequests
 	return nil
 }
 
@@ -1187,6 +1713,7 @@ func autoConvert_v1beta1_ServiceBindingStatus_To_servicecatalog_ServiceBindingSt
 	out.CurrentOperation = servicecatalog.ServiceBindingOperation(in.CurrentOperation)
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
+	out.NextPollTime = (*v1.Time)(unsafe.Pointer(in.NextPollTime))
 	out.InProgressProperties = (*servicecatalog.ServiceBindingPropertiesState)(unsafe.Pointer(in.InProgressProperties))
 	out.ExternalProperties = (*servicecatalog.ServiceBindingPropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
@@ -1206,6 +1733,7 @@ func autoConvert_servicecatalog_ServiceBindingStatus_To_v1beta1_ServiceBindingSt
 	out.CurrentOperation = ServiceBindingOperation(in.CurrentOperation)
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
+	out.NextPollTime = (*v1.Time)(unsafe.Pointer(in.NextPollTime))
 	out.InProgressProperties = (*ServiceBindingPropertiesState)(unsafe.Pointer(in.InProgressProperties))
 	out.ExternalProperties = (*ServiceBindingPropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
@@ -1253,6 +1781,8 @@ func Convert_servicecatalog_ServiceBroker_To_v1beta1_ServiceBroker(in *serviceca
 func autoConvert_v1beta1_ServiceBrokerAuthInfo_To_servicecatalog_ServiceBrokerAuthInfo(in *ServiceBrokerAuthInfo, out *servicecatalog.ServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*servicecatalog.BasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*servicecatalog.BearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.ClientCertificate = (*servicecatalog.ClientCertificateAuthConfig)(unsafe.Pointer(in.ClientCertificate))
+	out.OAuth2ClientCredentials = (*servicecatalog.OAuth2ClientCredentialsAuthConfig)(unsafe.Pointer(in.OAuth2ClientCredentials))
 	return nil
 }
 
@@ -1264,6 +1794,8 @@ func Convert_v1beta1_ServiceBrokerAuthInfo_To_servicecatalog_ServiceBrokerAuthIn
 func autoConvert_servicecatalog_ServiceBrokerAuthInfo_To_v1beta1_ServiceBrokerAuthInfo(in *servicecatalog.ServiceBrokerAuthInfo, out *ServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*BasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*BearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.ClientCertificate = (*ClientCertificateAuthConfig)(unsafe.Pointer(in.ClientCertificate))
+	out.OAuth2ClientCredentials = (*OAuth2ClientCredentialsAuthConfig)(unsafe.Pointer(in.OAuth2ClientCredentials))
 	return nil
 }
 
@@ -1566,6 +2098,7 @@ func autoConvert_v1beta1_ServiceInstancePropertiesState_To_servicecatalog_Servic
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersChecksum = in.ParametersChecksum
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.MaintenanceInfo = (*servicecatalog.MaintenanceInfo)(unsafe.Pointer(in.MaintenanceInfo))
 	return nil
 }
 
@@ -1582,6 +2115,7 @@ func autoConvert_servicecatalog_ServiceInstancePropertiesState_To_v1beta1_Servic
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersChecksum = in.ParametersChecksum
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
+	out.MaintenanceInfo = (*MaintenanceInfo)(unsafe.Pointer(in.MaintenanceInfo))
 	return nil
 }
 
@@ -1603,6 +2137,11 @@ func autoConvert_v1beta1_ServiceInstanceSpec_To_servicecatalog_ServiceInstanceSp
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
 	out.UpdateRequests = in.UpdateRequests
+	out.DeletionPolicy = servicecatalog.DeletionPolicy(in.DeletionPolicy)
+	out.MaintenanceInfoVersion = in.MaintenanceInfoVersion
+	out.CancelRequests = in.CancelRequests
+	out.RetryRequests = in.RetryRequests
+	out.MaintenanceWindow = (*servicecatalog.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
 	return nil
 }
 
@@ -1624,6 +2163,11 @@ func autoConvert_servicecatalog_ServiceInstanceSpec_To_v1beta1_ServiceInstanceSp
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
 	out.UpdateRequests = in.UpdateRequests
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.MaintenanceInfoVersion = in.MaintenanceInfoVersion
+	out.CancelRequests = in.CancelRequests
+	out.RetryRequests = in.RetryRequests
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
 	return nil
 }
 
@@ -1642,10 +2186,12 @@ func autoConvert_v1beta1_ServiceInstanceStatus_To_servicecatalog_ServiceInstance
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.ObservedGeneration = in.ObservedGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
+	out.NextPollTime = (*v1.Time)(unsafe.Pointer(in.NextPollTime))
 	out.InProgressProperties = (*servicecatalog.ServiceInstancePropertiesState)(unsafe.Pointer(in.InProgressProperties))
 	out.ExternalProperties = (*servicecatalog.ServiceInstancePropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.ProvisionStatus = servicecatalog.ServiceInstanceProvisionStatus(in.ProvisionStatus)
 	out.DeprovisionStatus = servicecatalog.ServiceInstanceDeprovisionStatus(in.DeprovisionStatus)
+	out.ObservedCancelRequests = in.ObservedCancelRequests
 	return nil
 }
 
@@ -1664,10 +2210,12 @@ func autoConvert_servicecatalog_ServiceInstanceStatus_To_v1beta1_ServiceInstance
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.ObservedGeneration = in.ObservedGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
+	out.NextPollTime = (*v1.Time)(unsafe.Pointer(in.NextPollTime))
 	out.InProgressProperties = (*ServiceInstancePropertiesState)(unsafe.Pointer(in.InProgressProperties))
 	out.ExternalProperties = (*ServiceInstancePropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.ProvisionStatus = ServiceInstanceProvisionStatus(in.ProvisionStatus)
 	out.DeprovisionStatus = ServiceInstanceDeprovisionStatus(in.DeprovisionStatus)
+	out.ObservedCancelRequests = in.ObservedCancelRequests
 	return nil
 }
 
@@ -95,6 +95,9 @@ func validateServiceBindingSpec(spec *sc.ServiceBindingSpec, fldPath *field.Path
 		allErrs = append(allErrs, validateParametersFromSource(spec.ParametersFrom, fldPath)...)
 	}
 
+	allErrs = append(allErrs, validateDeletionPolicy(spec.DeletionPolicy, fldPath.Child("deletionPolicy"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.RetryRequests, fldPath.Child("retryRequests"))...)
+
 	return allErrs
 }
 
@@ -236,6 +239,9 @@ func ValidateServiceBindingUpdate(new *sc.ServiceBinding, old *sc.ServiceBinding
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, internalValidateServiceBindingUpdateAllowed(new, old)...)
 	allErrs = append(allErrs, internalValidateServiceBinding(new, false)...)
+	if new.Spec.RetryRequests < old.Spec.RetryRequests {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("retryRequests"), new.Spec.RetryRequests, "new retryRequests value must not be less than the old one"))
+	}
 	return allErrs
 }
 
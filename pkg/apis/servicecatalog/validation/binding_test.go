@@ -162,6 +162,33 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid deletionPolicy retain",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.DeletionPolicy = servicecatalog.DeletionPolicyRetain
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid deletionPolicy",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.DeletionPolicy = "Orphan"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "negative retryRequests",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.RetryRequests = -1
+				return b
+			}(),
+			valid: false,
+		},
 
 		{
 			name:    "valid with in-progress bind",
@@ -637,3 +664,21 @@ func TestInternalValidateServiceBindingUpdateAllowed(t *testing.T) {
 		}
 	}
 }
+
+// TestValidateServiceBindingUpdateRetryRequests tests that the RetryRequests
+// of a binding cannot be decreased.
+func TestValidateServiceBindingUpdateRetryRequests(t *testing.T) {
+	oldBinding := validServiceBinding()
+	oldBinding.Spec.RetryRequests = 2
+
+	newBinding := validServiceBinding()
+	newBinding.Spec.RetryRequests = 3
+	if errs := ValidateServiceBindingUpdate(newBinding, oldBinding); len(errs) != 0 {
+		t.Fatalf("unexpected error increasing retryRequests: %v", errs)
+	}
+
+	newBinding.Spec.RetryRequests = 1
+	if errs := ValidateServiceBindingUpdate(newBinding, oldBinding); len(errs) == 0 {
+		t.Fatal("expected an error decreasing retryRequests")
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package validation
 
 import (
+	"net/url"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -79,6 +81,12 @@ func validateClusterServiceBrokerSpec(spec *sc.ClusterServiceBrokerSpec, fldPath
 					field.Required(fldPath.Child("authInfo", "bearer", "secretRef"), "a basic auth secret is required"),
 				)
 			}
+		} else if spec.AuthInfo.ClientCertificate != nil {
+			allErrs = append(allErrs, validateClusterAuthSecretRef(spec.AuthInfo.ClientCertificate.SecretRef, fldPath.Child("authInfo", "clientCertificate", "secretRef"), "a client certificate secret is required")...)
+		} else if spec.AuthInfo.OAuth2ClientCredentials != nil {
+			oauth2Path := fldPath.Child("authInfo", "oauth2ClientCredentials")
+			allErrs = append(allErrs, validateOAuth2TokenURL(spec.AuthInfo.OAuth2ClientCredentials.TokenURL, oauth2Path.Child("tokenURL"))...)
+			allErrs = append(allErrs, validateClusterAuthSecretRef(spec.AuthInfo.OAuth2ClientCredentials.SecretRef, oauth2Path.Child("secretRef"), "a client credentials secret is required")...)
 		} else {
 			// Authentication
 			allErrs = append(
@@ -141,6 +149,12 @@ func validateServiceBrokerSpec(spec *sc.ServiceBrokerSpec, fldPath *field.Path)
 					field.Required(fldPath.Child("authInfo", "bearer", "secretRef"), "a basic auth secret is required"),
 				)
 			}
+		} else if spec.AuthInfo.ClientCertificate != nil {
+			allErrs = append(allErrs, validateLocalAuthSecretRef(spec.AuthInfo.ClientCertificate.SecretRef, fldPath.Child("authInfo", "clientCertificate", "secretRef"), "a client certificate secret is required")...)
+		} else if spec.AuthInfo.OAuth2ClientCredentials != nil {
+			oauth2Path := fldPath.Child("authInfo", "oauth2ClientCredentials")
+			allErrs = append(allErrs, validateOAuth2TokenURL(spec.AuthInfo.OAuth2ClientCredentials.TokenURL, oauth2Path.Child("tokenURL"))...)
+			allErrs = append(allErrs, validateLocalAuthSecretRef(spec.AuthInfo.OAuth2ClientCredentials.SecretRef, oauth2Path.Child("secretRef"), "a client credentials secret is required")...)
 		} else {
 			// Authentication
 			allErrs = append(
@@ -159,6 +173,49 @@ func validateServiceBrokerSpec(spec *sc.ServiceBrokerSpec, fldPath *field.Path)
 	return allErrs
 }
 
+// validateClusterAuthSecretRef validates a reference to the auth secret of a
+// cluster scoped broker.
+func validateClusterAuthSecretRef(secretRef *sc.ObjectReference, fldPath *field.Path, requiredMsg string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if secretRef == nil {
+		return append(allErrs, field.Required(fldPath, requiredMsg))
+	}
+	for _, msg := range apivalidation.ValidateNamespaceName(secretRef.Namespace, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), secretRef.Namespace, msg))
+	}
+	for _, msg := range apivalidation.NameIsDNSSubdomain(secretRef.Name, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), secretRef.Name, msg))
+	}
+	return allErrs
+}
+
+// validateLocalAuthSecretRef validates a reference to the auth secret of a
+// namespace scoped broker.
+func validateLocalAuthSecretRef(secretRef *sc.LocalObjectReference, fldPath *field.Path, requiredMsg string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if secretRef == nil {
+		return append(allErrs, field.Required(fldPath, requiredMsg))
+	}
+	for _, msg := range apivalidation.NameIsDNSSubdomain(secretRef.Name, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), secretRef.Name, msg))
+	}
+	return allErrs
+}
+
+// validateOAuth2TokenURL validates the token endpoint of an OAuth2 client
+// credentials auth config, which must be an absolute http or https URL.
+func validateOAuth2TokenURL(tokenURL string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if tokenURL == "" {
+		return append(allErrs, field.Required(fldPath, "a token URL is required"))
+	}
+	u, err := url.Parse(tokenURL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		allErrs = append(allErrs, field.Invalid(fldPath, tokenURL, "must be an absolute http or https URL"))
+	}
+	return allErrs
+}
+
 func validateCommonServiceBrokerSpec(spec *sc.CommonServiceBrokerSpec, fldPath *field.Path) field.ErrorList {
 	commonErrs := field.ErrorList{}
 
@@ -205,6 +262,34 @@ func validateCommonServiceBrokerSpec(spec *sc.CommonServiceBrokerSpec, fldPath *
 		}
 	}
 
+	if spec.MaxConcurrentRequests != nil && *spec.MaxConcurrentRequests <= 0 {
+		commonErrs = append(
+			commonErrs,
+			field.Invalid(fldPath.Child("maxConcurrentRequests"), *spec.MaxConcurrentRequests, "maxConcurrentRequests must be greater than zero"),
+		)
+	}
+
+	if spec.RequestsPerSecond != nil && *spec.RequestsPerSecond <= 0 {
+		commonErrs = append(
+			commonErrs,
+			field.Invalid(fldPath.Child("requestsPerSecond"), *spec.RequestsPerSecond, "requestsPerSecond must be greater than zero"),
+		)
+	}
+
+	if spec.ReconciliationRetryDuration != nil && spec.ReconciliationRetryDuration.Duration <= 0 {
+		commonErrs = append(
+			commonErrs,
+			field.Invalid(fldPath.Child("reconciliationRetryDuration"), spec.ReconciliationRetryDuration.Duration.String(), "reconciliationRetryDuration must be greater than zero"),
+		)
+	}
+
+	if spec.OperationPollingMaximumBackoffDuration != nil && spec.OperationPollingMaximumBackoffDuration.Duration <= 0 {
+		commonErrs = append(
+			commonErrs,
+			field.Invalid(fldPath.Child("operationPollingMaximumBackoffDuration"), spec.OperationPollingMaximumBackoffDuration.Duration.String(), "operationPollingMaximumBackoffDuration must be greater than zero"),
+		)
+	}
+
 	// TODO: could validate if the fields being selected are on the approve list, but this will require breaking
 	// apart the label selector.
 	if spec.CatalogRestrictions != nil && len(spec.CatalogRestrictions.ServiceClass) > 0 {
@@ -18,9 +18,12 @@ package validation
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/ghodss/yaml"
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+	"github.com/kubernetes-incubator/service-catalog/pkg/cron"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -103,6 +106,31 @@ func validateServiceInstanceSpec(spec *sc.ServiceInstanceSpec, fldPath *field.Pa
 	}
 
 	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.UpdateRequests, fldPath.Child("updateRequests"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.CancelRequests, fldPath.Child("cancelRequests"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.RetryRequests, fldPath.Child("retryRequests"))...)
+	allErrs = append(allErrs, validateDeletionPolicy(spec.DeletionPolicy, fldPath.Child("deletionPolicy"))...)
+	if spec.MaintenanceWindow != nil {
+		allErrs = append(allErrs, validateMaintenanceWindow(spec.MaintenanceWindow, fldPath.Child("maintenanceWindow"))...)
+	}
+
+	return allErrs
+}
+
+// validateMaintenanceWindow validates that the window's schedule parses and
+// activates, and that the window stays open for some time.
+func validateMaintenanceWindow(window *sc.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if window.Schedule == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedule"), "schedule is required"))
+	} else if schedule, err := cron.Parse(window.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), window.Schedule, err.Error()))
+	} else if schedule.Next(time.Now()).IsZero() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), window.Schedule, "schedule never activates"))
+	}
+	if window.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), window.Duration.Duration.String(), "duration must be positive"))
+	}
 
 	return allErrs
 }
@@ -339,6 +367,12 @@ func ValidateServiceInstanceUpdate(new *sc.ServiceInstance, old *sc.ServiceInsta
 	if new.Spec.UpdateRequests < old.Spec.UpdateRequests {
 		allErrs = append(allErrs, field.Invalid(specFieldPath.Child("updateRequests"), new.Spec.UpdateRequests, "new updateRequests value must not be less than the old one"))
 	}
+	if new.Spec.CancelRequests < old.Spec.CancelRequests {
+		allErrs = append(allErrs, field.Invalid(specFieldPath.Child("cancelRequests"), new.Spec.CancelRequests, "new cancelRequests value must not be less than the old one"))
+	}
+	if new.Spec.RetryRequests < old.Spec.RetryRequests {
+		allErrs = append(allErrs, field.Invalid(specFieldPath.Child("retryRequests"), new.Spec.RetryRequests, "new retryRequests value must not be less than the old one"))
+	}
 
 	return allErrs
 }
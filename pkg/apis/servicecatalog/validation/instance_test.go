@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -297,6 +298,89 @@ func TestValidateServiceInstance(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid deletionPolicy retain",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.DeletionPolicy = servicecatalog.DeletionPolicyRetain
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid deletionPolicy",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.DeletionPolicy = "Orphan"
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "negative cancelRequests",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.CancelRequests = -1
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "negative retryRequests",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.RetryRequests = -1
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid maintenanceWindow",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.MaintenanceWindow = &servicecatalog.MaintenanceWindow{
+					Schedule: "0 2 * * 6",
+					Duration: metav1.Duration{Duration: 2 * time.Hour},
+				}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid maintenanceWindow schedule",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.MaintenanceWindow = &servicecatalog.MaintenanceWindow{
+					Schedule: "0 25 * * *",
+					Duration: metav1.Duration{Duration: 2 * time.Hour},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "maintenanceWindow that never opens",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.MaintenanceWindow = &servicecatalog.MaintenanceWindow{
+					Schedule: "0 0 30 2 *",
+					Duration: metav1.Duration{Duration: 2 * time.Hour},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "maintenanceWindow without duration",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.MaintenanceWindow = &servicecatalog.MaintenanceWindow{
+					Schedule: "0 2 * * 6",
+				}
+				return i
+			}(),
+			valid: false,
+		},
 		{
 			name:     "valid with in-progress provision",
 			instance: validServiceInstanceWithInProgressProvision(),
@@ -0,0 +1,123 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net/url"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+var validNotificationEventTypes = map[sc.NotificationEventType]bool{
+	sc.NotificationEventInstanceProvisioned:   true,
+	sc.NotificationEventInstanceFailed:        true,
+	sc.NotificationEventInstanceDeprovisioned: true,
+	sc.NotificationEventBindingBound:          true,
+	sc.NotificationEventBindingFailed:         true,
+	sc.NotificationEventBindingUnbound:        true,
+	sc.NotificationEventCredentialsRotated:    true,
+	sc.NotificationEventClassRemoved:          true,
+}
+
+var validNotificationEventTypeValues = []string{
+	string(sc.NotificationEventInstanceProvisioned),
+	string(sc.NotificationEventInstanceFailed),
+	string(sc.NotificationEventInstanceDeprovisioned),
+	string(sc.NotificationEventBindingBound),
+	string(sc.NotificationEventBindingFailed),
+	string(sc.NotificationEventBindingUnbound),
+	string(sc.NotificationEventCredentialsRotated),
+	string(sc.NotificationEventClassRemoved),
+}
+
+// ValidateClusterNotificationSink validates a ClusterNotificationSink and
+// returns a list of errors.
+func ValidateClusterNotificationSink(sink *sc.ClusterNotificationSink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs,
+		apivalidation.ValidateObjectMeta(
+			&sink.ObjectMeta,
+			false, /* namespace required */
+			apivalidation.NameIsDNSSubdomain,
+			field.NewPath("metadata"))...)
+
+	specPath := field.NewPath("spec")
+	allErrs = append(allErrs, validateCommonNotificationSinkSpec(&sink.Spec.CommonNotificationSinkSpec, specPath)...)
+	if sink.Spec.SigningSecretRef != nil {
+		allErrs = append(allErrs, validateClusterAuthSecretRef(sink.Spec.SigningSecretRef, specPath.Child("signingSecretRef"), "")...)
+	}
+	return allErrs
+}
+
+// ValidateClusterNotificationSinkUpdate checks that when changing from an
+// older ClusterNotificationSink to a newer one is okay.
+func ValidateClusterNotificationSinkUpdate(new *sc.ClusterNotificationSink, old *sc.ClusterNotificationSink) field.ErrorList {
+	return ValidateClusterNotificationSink(new)
+}
+
+// ValidateNotificationSink validates a NotificationSink and returns a list of
+// errors.
+func ValidateNotificationSink(sink *sc.NotificationSink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs,
+		apivalidation.ValidateObjectMeta(
+			&sink.ObjectMeta,
+			true, /* namespace required */
+			apivalidation.NameIsDNSSubdomain,
+			field.NewPath("metadata"))...)
+
+	specPath := field.NewPath("spec")
+	allErrs = append(allErrs, validateCommonNotificationSinkSpec(&sink.Spec.CommonNotificationSinkSpec, specPath)...)
+	if sink.Spec.SigningSecretRef != nil {
+		allErrs = append(allErrs, validateLocalAuthSecretRef(sink.Spec.SigningSecretRef, specPath.Child("signingSecretRef"), "")...)
+	}
+	return allErrs
+}
+
+// ValidateNotificationSinkUpdate checks that when changing from an older
+// NotificationSink to a newer one is okay.
+func ValidateNotificationSinkUpdate(new *sc.NotificationSink, old *sc.NotificationSink) field.ErrorList {
+	return ValidateNotificationSink(new)
+}
+
+func validateCommonNotificationSinkSpec(spec *sc.CommonNotificationSinkSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "a sink url is required"))
+	} else if u, err := url.Parse(spec.URL); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), spec.URL, "must be an absolute http or https URL"))
+	}
+
+	seen := sets.NewString()
+	for i, eventType := range spec.EventTypes {
+		if !validNotificationEventTypes[eventType] {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("eventTypes").Index(i), eventType, validNotificationEventTypeValues))
+		} else if seen.Has(string(eventType)) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("eventTypes").Index(i), eventType))
+		}
+		seen.Insert(string(eventType))
+	}
+
+	return allErrs
+}
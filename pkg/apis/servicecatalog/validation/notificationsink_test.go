@@ -0,0 +1,229 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+func validClusterNotificationSink() *servicecatalog.ClusterNotificationSink {
+	return &servicecatalog.ClusterNotificationSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-sink",
+		},
+		Spec: servicecatalog.ClusterNotificationSinkSpec{
+			CommonNotificationSinkSpec: servicecatalog.CommonNotificationSinkSpec{
+				URL: "https://events.example.com/hook",
+				EventTypes: []servicecatalog.NotificationEventType{
+					servicecatalog.NotificationEventInstanceProvisioned,
+					servicecatalog.NotificationEventClassRemoved,
+				},
+			},
+			SigningSecretRef: &servicecatalog.ObjectReference{
+				Namespace: "test-ns",
+				Name:      "test-secret",
+			},
+		},
+	}
+}
+
+func validNotificationSink() *servicecatalog.NotificationSink {
+	return &servicecatalog.NotificationSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-sink",
+			Namespace: "test-ns",
+		},
+		Spec: servicecatalog.NotificationSinkSpec{
+			CommonNotificationSinkSpec: servicecatalog.CommonNotificationSinkSpec{
+				URL: "http://events.test-ns.svc:8080",
+			},
+			SigningSecretRef: &servicecatalog.LocalObjectReference{
+				Name: "test-secret",
+			},
+		},
+	}
+}
+
+func TestValidateClusterNotificationSink(t *testing.T) {
+	testCases := []struct {
+		name  string
+		sink  *servicecatalog.ClusterNotificationSink
+		valid bool
+	}{
+		{
+			name:  "valid sink",
+			sink:  validClusterNotificationSink(),
+			valid: true,
+		},
+		{
+			name: "valid sink without signing secret or event types",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.SigningSecretRef = nil
+				s.Spec.EventTypes = nil
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "namespace set",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Namespace = "test-ns"
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "missing url",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.URL = ""
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "relative url",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.URL = "/hook"
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "non-http url",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.URL = "ftp://events.example.com"
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "unknown event type",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.EventTypes = []servicecatalog.NotificationEventType{"InstanceExploded"}
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "duplicate event type",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.EventTypes = []servicecatalog.NotificationEventType{
+					servicecatalog.NotificationEventBindingBound,
+					servicecatalog.NotificationEventBindingBound,
+				}
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "signing secret without namespace",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.SigningSecretRef.Namespace = ""
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "signing secret without name",
+			sink: func() *servicecatalog.ClusterNotificationSink {
+				s := validClusterNotificationSink()
+				s.Spec.SigningSecretRef.Name = ""
+				return s
+			}(),
+			valid: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateClusterNotificationSink(tc.sink)
+			t.Log(errs)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("%v: unexpected error: %v", tc.name, errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Errorf("%v: unexpected success", tc.name)
+			}
+		})
+	}
+}
+
+func TestValidateNotificationSink(t *testing.T) {
+	testCases := []struct {
+		name  string
+		sink  *servicecatalog.NotificationSink
+		valid bool
+	}{
+		{
+			name:  "valid sink",
+			sink:  validNotificationSink(),
+			valid: true,
+		},
+		{
+			name: "missing namespace",
+			sink: func() *servicecatalog.NotificationSink {
+				s := validNotificationSink()
+				s.Namespace = ""
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid url",
+			sink: func() *servicecatalog.NotificationSink {
+				s := validNotificationSink()
+				s.Spec.URL = "events"
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "signing secret without name",
+			sink: func() *servicecatalog.NotificationSink {
+				s := validNotificationSink()
+				s.Spec.SigningSecretRef.Name = ""
+				return s
+			}(),
+			valid: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateNotificationSink(tc.sink)
+			t.Log(errs)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("%v: unexpected error: %v", tc.name, errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Errorf("%v: unexpected success", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+var validPlanTransitionDirections = map[sc.PlanTransitionDirection]bool{
+	sc.PlanTransitionDirection(""):      true,
+	sc.PlanTransitionDirectionUpgrade:   true,
+	sc.PlanTransitionDirectionDowngrade: true,
+}
+
+var validPlanTransitionDirectionValues = []string{
+	string(sc.PlanTransitionDirectionUpgrade),
+	string(sc.PlanTransitionDirectionDowngrade),
+}
+
+// ValidateClusterServicePlanTransitionPolicy validates a
+// ClusterServicePlanTransitionPolicy and returns a list of errors.
+func ValidateClusterServicePlanTransitionPolicy(policy *sc.ClusterServicePlanTransitionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs,
+		apivalidation.ValidateObjectMeta(
+			&policy.ObjectMeta,
+			false, /* namespace required */
+			apivalidation.NameIsDNSSubdomain,
+			field.NewPath("metadata"))...)
+
+	allErrs = append(allErrs, validateClusterServicePlanTransitionPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateClusterServicePlanTransitionPolicyUpdate checks that when changing
+// from an older ClusterServicePlanTransitionPolicy to a newer one is okay.
+func ValidateClusterServicePlanTransitionPolicyUpdate(new *sc.ClusterServicePlanTransitionPolicy, old *sc.ClusterServicePlanTransitionPolicy) field.ErrorList {
+	return ValidateClusterServicePlanTransitionPolicy(new)
+}
+
+func validateClusterServicePlanTransitionPolicySpec(spec *sc.ClusterServicePlanTransitionPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case spec.ClusterServiceClassName == "" && spec.ServiceClassRef == nil:
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterServiceClassName"), "exactly one of clusterServiceClassName or serviceClassRef is required"))
+	case spec.ClusterServiceClassName != "" && spec.ServiceClassRef != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceClassRef"), "serviceClassRef must not be set with clusterServiceClassName"))
+	case spec.ClusterServiceClassName != "":
+		for _, msg := range validateCommonServiceClassName(spec.ClusterServiceClassName, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("clusterServiceClassName"), spec.ClusterServiceClassName, msg))
+		}
+	default:
+		refPath := fldPath.Child("serviceClassRef")
+		if spec.ServiceClassRef.Namespace == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("namespace"), "namespace is required"))
+		}
+		for _, msg := range apivalidation.ValidateNamespaceName(spec.ServiceClassRef.Namespace, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("namespace"), spec.ServiceClassRef.Namespace, msg))
+		}
+		if spec.ServiceClassRef.Name == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), "name is required"))
+		}
+		for _, msg := range validateCommonServiceClassName(spec.ServiceClassRef.Name, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("name"), spec.ServiceClassRef.Name, msg))
+		}
+	}
+
+	ordered := sets.NewString()
+	for i, name := range spec.PlanOrder {
+		if ordered.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("planOrder").Index(i), name))
+		}
+		ordered.Insert(name)
+		for _, msg := range validateCommonServicePlanName(name, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("planOrder").Index(i), name, msg))
+		}
+	}
+
+	for i, rule := range spec.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+
+		if !validPlanTransitionDirections[rule.Direction] {
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("direction"), rule.Direction, validPlanTransitionDirectionValues))
+		} else if rule.Direction != "" && len(spec.PlanOrder) == 0 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("direction"), rule.Direction, "direction requires spec.planOrder to be set"))
+		}
+
+		allErrs = append(allErrs, validatePlanTransitionSelector(&rule.From, rulePath.Child("from"))...)
+		allErrs = append(allErrs, validatePlanTransitionSelector(&rule.To, rulePath.Child("to"))...)
+
+		for j, user := range rule.Users {
+			if user == "" {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("users").Index(j), user, "user must not be empty"))
+			}
+		}
+		for j, group := range rule.Groups {
+			if group == "" {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("groups").Index(j), group, "group must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validatePlanTransitionSelector(selector *sc.PlanTransitionSelector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, name := range selector.ExternalNames {
+		for _, msg := range validateCommonServicePlanName(name, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("externalNames").Index(i), name, msg))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+)
+
+func validClusterServicePlanTransitionPolicy() *servicecatalog.ClusterServicePlanTransitionPolicy {
+	paid := false
+	return &servicecatalog.ClusterServicePlanTransitionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-policy",
+		},
+		Spec: servicecatalog.ClusterServicePlanTransitionPolicySpec{
+			ClusterServiceClassName: "test-serviceclass",
+			PlanOrder:               []string{"free", "small", "large"},
+			Rules: []servicecatalog.PlanTransitionRule{
+				{
+					Direction: servicecatalog.PlanTransitionDirectionUpgrade,
+				},
+				{
+					To:     servicecatalog.PlanTransitionSelector{Free: &paid},
+					Groups: []string{"dba"},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateClusterServicePlanTransitionPolicy(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy *servicecatalog.ClusterServicePlanTransitionPolicy
+		valid  bool
+	}{
+		{
+			name:   "valid policy",
+			policy: validClusterServicePlanTransitionPolicy(),
+			valid:  true,
+		},
+		{
+			name: "valid policy without rules",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.Rules = nil
+				return p
+			}(),
+			valid: true,
+		},
+		{
+			name: "namespace set",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Namespace = "test-ns"
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "missing class",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.ClusterServiceClassName = ""
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid namespaced class",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.ClusterServiceClassName = ""
+				p.Spec.ServiceClassRef = &servicecatalog.ObjectReference{Namespace: "test-ns", Name: "test-serviceclass"}
+				return p
+			}(),
+			valid: true,
+		},
+		{
+			name: "namespaced class without namespace",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.ClusterServiceClassName = ""
+				p.Spec.ServiceClassRef = &servicecatalog.ObjectReference{Name: "test-serviceclass"}
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "both cluster and namespaced class",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.ServiceClassRef = &servicecatalog.ObjectReference{Namespace: "test-ns", Name: "test-serviceclass"}
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "duplicate plan in order",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.PlanOrder = []string{"free", "small", "free"}
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid plan name in order",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.PlanOrder = []string{"%"}
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid direction",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.Rules[0].Direction = "Sideways"
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "direction without plan order",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.PlanOrder = nil
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid plan name in selector",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.Rules[1].From.ExternalNames = []string{"%"}
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "empty user",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.Rules[1].Users = []string{""}
+				return p
+			}(),
+			valid: false,
+		},
+		{
+			name: "empty group",
+			policy: func() *servicecatalog.ClusterServicePlanTransitionPolicy {
+				p := validClusterServicePlanTransitionPolicy()
+				p.Spec.Rules[1].Groups = []string{""}
+				return p
+			}(),
+			valid: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := ValidateClusterServicePlanTransitionPolicy(tc.policy)
+			t.Log(errs)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("%v: unexpected error: %v", tc.name, errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Errorf("%v: unexpected success", tc.name)
+			}
+		})
+	}
+}
@@ -104,6 +104,14 @@ func validateCommonServicePlanSpec(spec sc.CommonServicePlanSpec, fldPath *field
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("externalName"), spec.ExternalName, msg))
 	}
 
+	if spec.MaintenanceInfo != nil && "" == spec.MaintenanceInfo.Version {
+		allErrs = append(allErrs, field.Required(fldPath.Child("maintenanceInfo", "version"), "maintenanceInfo.version is required"))
+	}
+
+	if spec.MaximumPollingDuration != nil && spec.MaximumPollingDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maximumPollingDuration"), spec.MaximumPollingDuration.Duration.String(), "maximumPollingDuration must be greater than zero"))
+	}
+
 	return allErrs
 
 }
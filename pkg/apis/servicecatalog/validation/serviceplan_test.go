@@ -18,6 +18,7 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -83,6 +84,42 @@ func TestValidateClusterServicePlan(t *testing.T) {
 			}(),
 			valid: true,
 		},
+		{
+			name: "valid maintenanceInfo",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.MaintenanceInfo = &servicecatalog.MaintenanceInfo{Version: "1.2.0"}
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "maintenanceInfo without version",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.MaintenanceInfo = &servicecatalog.MaintenanceInfo{Description: "Patches"}
+				return s
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid maximumPollingDuration",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.MaximumPollingDuration = &metav1.Duration{Duration: time.Hour}
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "non-positive maximumPollingDuration",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.MaximumPollingDuration = &metav1.Duration{}
+				return s
+			}(),
+			valid: false,
+		},
 		{
 			name: "missing name",
 			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
@@ -28,6 +28,25 @@ func stringIsHexadecimal(s string) bool {
 	return hexademicalStringRegexp.MatchString(s)
 }
 
+var validDeletionPolicies = map[sc.DeletionPolicy]bool{
+	sc.DeletionPolicy(""):   true,
+	sc.DeletionPolicyDelete: true,
+	sc.DeletionPolicyRetain: true,
+}
+
+var validDeletionPolicyValues = []string{
+	string(sc.DeletionPolicyDelete),
+	string(sc.DeletionPolicyRetain),
+}
+
+func validateDeletionPolicy(policy sc.DeletionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !validDeletionPolicies[policy] {
+		allErrs = append(allErrs, field.NotSupported(fldPath, policy, validDeletionPolicyValues))
+	}
+	return allErrs
+}
+
 func validateParametersFromSource(parametersFrom []sc.ParametersFromSource, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -18,6 +18,7 @@ package apiserver
 
 import (
 	"github.com/golang/glog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/dryrun"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
@@ -119,6 +120,9 @@ func (c completedEtcdConfig) NewServer(stopCh <-chan struct{}) (*ServiceCatalogA
 				for _, storage := range mappings { // resource name (brokers, brokers/status) to backing storage
 					go func(store rest.Storage) {
 						s, ok := store.(*registry.Store)
+						if d, isDryRun := store.(*dryrun.REST); isDryRun {
+							s, ok = d.Store, true
+						}
 						if ok {
 							<-stopCh
 							s.DestroyFunc()
@@ -0,0 +1,276 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokerauth
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenRequestTimeout is how long a request to a token endpoint may take.
+	tokenRequestTimeout = 60 * time.Second
+
+	// maxTokenRefreshWindow is the longest time before its expiry that a
+	// token is replaced. Tokens with short lifetimes are replaced after 90%
+	// of their lifetime has passed.
+	maxTokenRefreshWindow = time.Minute
+
+	// maxTokenResponseSize bounds how much of a token endpoint's response is
+	// read.
+	maxTokenResponseSize = 1 << 20
+)
+
+// Token is an access token obtained from an OAuth2 token endpoint.
+type Token struct {
+	// AccessToken is the token to send to the broker as a bearer token.
+	AccessToken string
+	// Obtained is when the token was obtained.
+	Obtained time.Time
+	// Expiry is when the token expires. It is zero if the token endpoint did
+	// not return a lifetime, in which case the token is used until the
+	// credentials it was obtained with change.
+	Expiry time.Time
+}
+
+// needsRefresh returns whether the token should be replaced at the given time.
+func (t *Token) needsRefresh(now time.Time) bool {
+	if t.Expiry.IsZero() {
+		return false
+	}
+	window := t.Expiry.Sub(t.Obtained) / 10
+	if window > maxTokenRefreshWindow {
+		window = maxTokenRefreshWindow
+	}
+	return !now.Before(t.Expiry.Add(-window))
+}
+
+// NewTokenHTTPClient returns an HTTP client for requests to a token endpoint
+// that uses the same TLS settings as the broker the token is for.
+func NewTokenHTTPClient(caData []byte, insecure bool) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure}
+	if len(caData) != 0 {
+		if insecure {
+			return nil, fmt.Errorf("cannot specify root CAs and to skip TLS verification")
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		rootCAs.AppendCertsFromPEM(caData)
+		tlsConfig.RootCAs = rootCAs
+	}
+	return &http.Client{
+		Timeout: tokenRequestTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
+// FetchClientCredentialsToken obtains an access token from an OAuth2 token
+// endpoint using the client credentials grant (RFC 6749, section 4.4).
+func FetchClientCredentialsToken(client *http.Client, tokenURL, clientID, clientSecret string, scopes []string) (*Token, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+	req, err := http.NewRequest(http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+
+	now := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting token from %s: %v", tokenURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading token response from %s: %v", tokenURL, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint %s returned status %d: %s", tokenURL, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return nil, fmt.Errorf("error parsing token response from %s: %v", tokenURL, err)
+	}
+	if tokenResponse.AccessToken == "" {
+		return nil, fmt.Errorf("token response from %s didn't contain an access token", tokenURL)
+	}
+	if tokenResponse.TokenType != "" && !strings.EqualFold(tokenResponse.TokenType, "bearer") {
+		return nil, fmt.Errorf("token endpoint %s returned unsupported token type %q", tokenURL, tokenResponse.TokenType)
+	}
+
+	token := &Token{
+		AccessToken: tokenResponse.AccessToken,
+		Obtained:    now,
+	}
+	if tokenResponse.ExpiresIn > 0 {
+		token.Expiry = now.Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// TokenCache holds the most recent access token for each broker and replaces
+// it shortly before it expires. It also holds the HTTP client used to contact
+// each broker's token endpoint, so that its connections are reused across
+// token requests. It is safe for concurrent use.
+type TokenCache struct {
+	mutex   sync.Mutex
+	tokens  map[string]*cachedToken
+	fetches map[string]*tokenFetch
+	clients map[string]*cachedHTTPClient
+	now     func() time.Time
+}
+
+type cachedToken struct {
+	source string
+	token  *Token
+}
+
+// tokenFetch is a token request in progress. done is closed once token and
+// err are set.
+type tokenFetch struct {
+	source string
+	done   chan struct{}
+	token  *Token
+	err    error
+}
+
+type cachedHTTPClient struct {
+	caData   []byte
+	insecure bool
+	client   *http.Client
+}
+
+// NewTokenCache returns an empty TokenCache.
+func NewTokenCache() *TokenCache {
+	return &TokenCache{
+		tokens:  make(map[string]*cachedToken),
+		fetches: make(map[string]*tokenFetch),
+		clients: make(map[string]*cachedHTTPClient),
+		now:     time.Now,
+	}
+}
+
+// HTTPClient returns the HTTP client for requests to the token endpoint of
+// key, creating it with NewTokenHTTPClient if there is none or if the TLS
+// settings it was created with have changed. A replaced client has its idle
+// connections closed.
+func (c *TokenCache) HTTPClient(key string, caData []byte, insecure bool) (*http.Client, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached, ok := c.clients[key]
+	if ok && cached.insecure == insecure && bytes.Equal(cached.caData, caData) {
+		return cached.client, nil
+	}
+
+	client, err := NewTokenHTTPClient(caData, insecure)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		closeIdleConnections(cached.client)
+	}
+	c.clients[key] = &cachedHTTPClient{
+		caData:   append([]byte(nil), caData...),
+		insecure: insecure,
+		client:   client,
+	}
+	return client, nil
+}
+
+// Token returns the cached token for key, calling fetch to obtain a new one
+// if there is none, if it is about to expire, or if it was obtained from a
+// different source. The source identifies the token endpoint and the
+// credentials used with it, so that changing either results in a new token.
+// The lock is not held while fetching, so a slow token endpoint only delays
+// the brokers that use it. Concurrent calls for the same key and source wait
+// for a single fetch and share its result.
+func (c *TokenCache) Token(key, source string, fetch func() (*Token, error)) (*Token, error) {
+	c.mutex.Lock()
+	if cached, ok := c.tokens[key]; ok && cached.source == source && !cached.token.needsRefresh(c.now()) {
+		c.mutex.Unlock()
+		return cached.token, nil
+	}
+	if inFlight, ok := c.fetches[key]; ok && inFlight.source == source {
+		c.mutex.Unlock()
+		<-inFlight.done
+		return inFlight.token, inFlight.err
+	}
+	f := &tokenFetch{source: source, done: make(chan struct{})}
+	c.fetches[key] = f
+	c.mutex.Unlock()
+
+	defer close(f.done)
+	f.token, f.err = fetch()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// The fetch is not cached if it was superseded by one for a different
+	// source, or if the key was removed, while it was in progress.
+	if c.fetches[key] == f {
+		delete(c.fetches, key)
+		if f.err == nil {
+			c.tokens[key] = &cachedToken{source: source, token: f.token}
+		}
+	}
+	return f.token, f.err
+}
+
+// Remove drops the cached token and HTTP client for key.
+func (c *TokenCache) Remove(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if cached, ok := c.clients[key]; ok {
+		closeIdleConnections(cached.client)
+	}
+	delete(c.tokens, key)
+	delete(c.fetches, key)
+	delete(c.clients, key)
+}
+
+func closeIdleConnections(client *http.Client) {
+	if transport, ok := client.Transport.(*http.Transport); ok {
+		transport.CloseIdleConnections()
+	}
+}
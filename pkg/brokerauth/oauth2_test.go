@@ -0,0 +1,289 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokerauth
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchClientCredentialsToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unexpected error parsing form: %v", err)
+		}
+		if e, a := "client_credentials", r.PostForm.Get("grant_type"); e != a {
+			t.Errorf("unexpected grant_type: expected %q, got %q", e, a)
+		}
+		if e, a := "read write", r.PostForm.Get("scope"); e != a {
+			t.Errorf("unexpected scope: expected %q, got %q", e, a)
+		}
+		if id, secret, ok := r.BasicAuth(); !ok || id != "id" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":"invalid_client"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"token","token_type":"Bearer","expires_in":3600}`)
+	}))
+	defer server.Close()
+
+	token, err := FetchClientCredentialsToken(server.Client(), server.URL, "id", "secret", []string{"read", "write"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "token", token.AccessToken; e != a {
+		t.Fatalf("unexpected access token: expected %q, got %q", e, a)
+	}
+	if e, a := time.Hour, token.Expiry.Sub(token.Obtained); e != a {
+		t.Fatalf("unexpected token lifetime: expected %v, got %v", e, a)
+	}
+
+	if _, err := FetchClientCredentialsToken(server.Client(), server.URL, "id", "wrong", []string{"read", "write"}); err == nil {
+		t.Fatal("expected an error for rejected credentials")
+	}
+}
+
+func TestFetchClientCredentialsTokenInvalidResponses(t *testing.T) {
+	cases := []struct {
+		name     string
+		response string
+	}{
+		{
+			name:     "no access token",
+			response: `{"token_type":"Bearer"}`,
+		},
+		{
+			name:     "unsupported token type",
+			response: `{"access_token":"token","token_type":"mac"}`,
+		},
+		{
+			name:     "not json",
+			response: `access_token=token`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tc.response)
+			}))
+			defer server.Close()
+
+			if _, err := FetchClientCredentialsToken(server.Client(), server.URL, "id", "secret", nil); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestTokenCache(t *testing.T) {
+	now := time.Now()
+	cache := NewTokenCache()
+	cache.now = func() time.Time { return now }
+
+	fetches := 0
+	fetch := func() (*Token, error) {
+		fetches++
+		return &Token{
+			AccessToken: fmt.Sprintf("token-%d", fetches),
+			Obtained:    now,
+			Expiry:      now.Add(time.Hour),
+		}, nil
+	}
+
+	get := func(key, source string) string {
+		token, err := cache.Token(key, source, fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return token.AccessToken
+	}
+
+	if e, a := "token-1", get("broker", "v1"); e != a {
+		t.Fatalf("expected %q, got %q", e, a)
+	}
+	if e, a := "token-1", get("broker", "v1"); e != a {
+		t.Fatalf("expected the cached token %q, got %q", e, a)
+	}
+	if e, a := "token-2", get("broker", "v2"); e != a {
+		t.Fatalf("expected a new token for new credentials %q, got %q", e, a)
+	}
+
+	now = now.Add(58 * time.Minute)
+	if e, a := "token-2", get("broker", "v2"); e != a {
+		t.Fatalf("expected the cached token %q before the refresh window, got %q", e, a)
+	}
+	now = now.Add(90 * time.Second)
+	if e, a := "token-3", get("broker", "v2"); e != a {
+		t.Fatalf("expected a refreshed token %q, got %q", e, a)
+	}
+
+	cache.Remove("broker")
+	if e, a := "token-4", get("broker", "v2"); e != a {
+		t.Fatalf("expected a new token after removal %q, got %q", e, a)
+	}
+}
+
+// TestTokenCacheConcurrentFetches ensures that concurrent requests for an
+// expired token wait for a single fetch and share its result.
+func TestTokenCacheConcurrentFetches(t *testing.T) {
+	const callers = 10
+
+	now := time.Now()
+	cache := NewTokenCache()
+	var checks int32
+	cache.now = func() time.Time {
+		atomic.AddInt32(&checks, 1)
+		return now
+	}
+
+	// With an expired token cached, every caller checks the time, under the
+	// lock, before it finds the fetch in progress.
+	expired := func() (*Token, error) {
+		return &Token{AccessToken: "expired", Obtained: now.Add(-time.Hour), Expiry: now}, nil
+	}
+	if _, err := cache.Token("broker", "v1", expired); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	atomic.StoreInt32(&checks, 0)
+
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func() (*Token, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return &Token{AccessToken: "fresh", Obtained: now, Expiry: now.Add(time.Hour)}, nil
+	}
+
+	var wg sync.WaitGroup
+	tokens := make(chan string, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := cache.Token("broker", "v1", fetch)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			tokens <- token.AccessToken
+		}()
+	}
+	for atomic.LoadInt32(&checks) < callers {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	close(tokens)
+
+	if e, a := int32(1), atomic.LoadInt32(&fetches); e != a {
+		t.Fatalf("expected %d fetch, got %d", e, a)
+	}
+	for token := range tokens {
+		if e, a := "fresh", token; e != a {
+			t.Fatalf("expected %q, got %q", e, a)
+		}
+	}
+}
+
+func TestTokenCacheHTTPClient(t *testing.T) {
+	cache := NewTokenCache()
+
+	get := func(key string, caData []byte, insecure bool) *http.Client {
+		client, err := cache.HTTPClient(key, caData, insecure)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return client
+	}
+
+	first := get("broker", nil, false)
+	if get("broker", nil, false) != first {
+		t.Fatal("expected the HTTP client to be reused")
+	}
+	if get("other-broker", nil, false) == first {
+		t.Fatal("expected another broker to get its own HTTP client")
+	}
+
+	insecure := get("broker", nil, true)
+	if insecure == first {
+		t.Fatal("expected a new HTTP client after the TLS settings changed")
+	}
+	if get("broker", nil, true) != insecure {
+		t.Fatal("expected the new HTTP client to be reused")
+	}
+
+	cache.Remove("broker")
+	if get("broker", nil, true) == insecure {
+		t.Fatal("expected a new HTTP client after removal")
+	}
+
+	if _, err := cache.HTTPClient("broker", []byte("ca"), true); err == nil {
+		t.Fatal("expected an error for root CAs with TLS verification skipped")
+	}
+}
+
+func TestTokenNeedsRefresh(t *testing.T) {
+	obtained := time.Now()
+	cases := []struct {
+		name    string
+		token   Token
+		at      time.Duration
+		refresh bool
+	}{
+		{
+			name:  "no expiry",
+			token: Token{Obtained: obtained},
+			at:    24 * time.Hour,
+		},
+		{
+			name:  "long lived token before window",
+			token: Token{Obtained: obtained, Expiry: obtained.Add(time.Hour)},
+			at:    58 * time.Minute,
+		},
+		{
+			name:    "long lived token in window",
+			token:   Token{Obtained: obtained, Expiry: obtained.Add(time.Hour)},
+			at:      59 * time.Minute,
+			refresh: true,
+		},
+		{
+			name:  "short lived token before window",
+			token: Token{Obtained: obtained, Expiry: obtained.Add(100 * time.Second)},
+			at:    89 * time.Second,
+		},
+		{
+			name:    "short lived token in window",
+			token:   Token{Obtained: obtained, Expiry: obtained.Add(100 * time.Second)},
+			at:      90 * time.Second,
+			refresh: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if e, a := tc.refresh, tc.token.needsRefresh(obtained.Add(tc.at)); e != a {
+				t.Fatalf("expected needsRefresh %v, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package brokerauth builds the credentials that service catalog presents to
+// brokers configured with client certificate or OAuth2 client credentials
+// authentication.
+package brokerauth
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// ClientCertificateFromSecret returns the TLS client certificate held in a
+// client certificate auth secret.
+func ClientCertificateFromSecret(secret *corev1.Secret) (*tls.Certificate, error) {
+	certBytes, ok := secret.Data[v1beta1.ClientCertificateCertKey]
+	if !ok {
+		return nil, fmt.Errorf("auth secret didn't contain %s", v1beta1.ClientCertificateCertKey)
+	}
+	keyBytes, ok := secret.Data[v1beta1.ClientCertificateKeyKey]
+	if !ok {
+		return nil, fmt.Errorf("auth secret didn't contain %s", v1beta1.ClientCertificateKeyKey)
+	}
+
+	cert, err := tls.X509KeyPair(certBytes, keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("auth secret didn't contain a valid client certificate: %v", err)
+	}
+	return &cert, nil
+}
+
+// ClientCredentialsFromSecret returns the client ID and client secret held in
+// an OAuth2 client credentials auth secret.
+func ClientCredentialsFromSecret(secret *corev1.Secret) (string, string, error) {
+	clientID, ok := secret.Data[v1beta1.OAuth2ClientIDKey]
+	if !ok {
+		return "", "", fmt.Errorf("auth secret didn't contain %s", v1beta1.OAuth2ClientIDKey)
+	}
+	clientSecret, ok := secret.Data[v1beta1.OAuth2ClientSecretKey]
+	if !ok {
+		return "", "", fmt.Errorf("auth secret didn't contain %s", v1beta1.OAuth2ClientSecretKey)
+	}
+	return string(clientID), string(clientSecret), nil
+}
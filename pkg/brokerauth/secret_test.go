@@ -0,0 +1,106 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package brokerauth
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/cert"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestClientCertificateFromSecret(t *testing.T) {
+	certPEM, keyPEM, err := cert.GenerateSelfSignedCertKey("client.example.com", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error generating certificate: %v", err)
+	}
+
+	cases := []struct {
+		name    string
+		data    map[string][]byte
+		success bool
+	}{
+		{
+			name: "valid",
+			data: map[string][]byte{
+				v1beta1.ClientCertificateCertKey: certPEM,
+				v1beta1.ClientCertificateKeyKey:  keyPEM,
+			},
+			success: true,
+		},
+		{
+			name: "missing key",
+			data: map[string][]byte{
+				v1beta1.ClientCertificateCertKey: certPEM,
+			},
+		},
+		{
+			name: "missing certificate",
+			data: map[string][]byte{
+				v1beta1.ClientCertificateKeyKey: keyPEM,
+			},
+		},
+		{
+			name: "invalid certificate",
+			data: map[string][]byte{
+				v1beta1.ClientCertificateCertKey: []byte("not a certificate"),
+				v1beta1.ClientCertificateKeyKey:  keyPEM,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			certificate, err := ClientCertificateFromSecret(&corev1.Secret{Data: tc.data})
+			if tc.success {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(certificate.Certificate) == 0 {
+					t.Fatal("expected a certificate chain")
+				}
+			} else if err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestClientCredentialsFromSecret(t *testing.T) {
+	clientID, clientSecret, err := ClientCredentialsFromSecret(&corev1.Secret{
+		Data: map[string][]byte{
+			v1beta1.OAuth2ClientIDKey:     []byte("id"),
+			v1beta1.OAuth2ClientSecretKey: []byte("secret"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if clientID != "id" || clientSecret != "secret" {
+		t.Fatalf("unexpected credentials %q, %q", clientID, clientSecret)
+	}
+
+	if _, _, err := ClientCredentialsFromSecret(&corev1.Secret{
+		Data: map[string][]byte{
+			v1beta1.OAuth2ClientIDKey: []byte("id"),
+		},
+	}); err == nil {
+		t.Fatal("expected an error for a secret without a client secret")
+	}
+}
@@ -0,0 +1,445 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalogconversion converts the catalog returned by a broker into
+// service classes and plans, and filters them through the broker's catalog
+// restrictions. It is shared by the controller, which reconciles the result
+// during a relist, and by svcat, which previews what a relist would change.
+package catalogconversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/filter"
+)
+
+// ConvertAndFilterCatalogToNamespacedTypes converts a service broker catalog
+// into an array of ServiceClasses and an array of ServicePlans and filters
+// these through the restrictions provided. The ServiceClasses and
+// ServicePlans returned by this method are named in K8S with the OSB ID.
+func ConvertAndFilterCatalogToNamespacedTypes(namespace string, in *osb.CatalogResponse, restrictions *v1beta1.CatalogRestrictions) ([]*v1beta1.ServiceClass, []*v1beta1.ServicePlan, error) {
+	var predicate filter.Predicate
+	var err error
+	if restrictions != nil && len(restrictions.ServiceClass) > 0 {
+		predicate, err = filter.CreatePredicate(restrictions.ServiceClass)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		predicate = filter.NewPredicate()
+	}
+
+	serviceClasses := []*v1beta1.ServiceClass(nil)
+	servicePlans := []*v1beta1.ServicePlan(nil)
+	for _, svc := range in.Services {
+		serviceClass := &v1beta1.ServiceClass{
+			Spec: v1beta1.ServiceClassSpec{
+				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+					Bindable:             svc.Bindable,
+					InstancesRetrievable: svc.InstancesRetrievable,
+					PlanUpdatable:        svc.PlanUpdatable != nil && *svc.PlanUpdatable,
+					ExternalID:           svc.ID,
+					ExternalName:         svc.Name,
+					Tags:                 svc.Tags,
+					Description:          svc.Description,
+					Requires:             svc.Requires,
+				},
+			},
+		}
+
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
+			serviceClass.Spec.BindingRetrievable = svc.BindingsRetrievable
+		}
+
+		if svc.Metadata != nil {
+			metadata, err := json.Marshal(svc.Metadata)
+			if err != nil {
+				err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", svc.Metadata, err)
+				glog.Error(err)
+				return nil, nil, err
+			}
+			serviceClass.Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
+		}
+		serviceClass.SetName(svc.ID)
+		serviceClass.SetNamespace(namespace)
+
+		// If this service class passes the predicate, process the plans for the class.
+		if fields := v1beta1.ConvertServiceClassToProperties(serviceClass); predicate.Accepts(fields) {
+			// set up the plans using the ServiceClass Name
+			plans, err := convertServicePlans(namespace, svc.Plans, serviceClass.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			acceptedPlans, _, err := FilterNamespacedServicePlans(restrictions, plans)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// If there are accepted plans, then append the class and all of the accepted plans to the master list.
+			if len(acceptedPlans) > 0 {
+				serviceClasses = append(serviceClasses, serviceClass)
+				servicePlans = append(servicePlans, acceptedPlans...)
+			}
+		}
+	}
+	return serviceClasses, servicePlans, nil
+}
+
+// ConvertAndFilterCatalog converts a service broker catalog into an array of
+// ClusterServiceClasses and an array of ClusterServicePlans and filters these
+// through the restrictions provided. The ClusterServiceClasses and
+// ClusterServicePlans returned by this method are named in K8S with the OSB ID.
+func ConvertAndFilterCatalog(in *osb.CatalogResponse, restrictions *v1beta1.CatalogRestrictions) ([]*v1beta1.ClusterServiceClass, []*v1beta1.ClusterServicePlan, error) {
+	var predicate filter.Predicate
+	var err error
+	if restrictions != nil && len(restrictions.ServiceClass) > 0 {
+		predicate, err = filter.CreatePredicate(restrictions.ServiceClass)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		predicate = filter.NewPredicate()
+	}
+
+	serviceClasses := []*v1beta1.ClusterServiceClass(nil)
+	servicePlans := []*v1beta1.ClusterServicePlan(nil)
+	for _, svc := range in.Services {
+		serviceClass := &v1beta1.ClusterServiceClass{
+			Spec: v1beta1.ClusterServiceClassSpec{
+				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+					Bindable:             svc.Bindable,
+					InstancesRetrievable: svc.InstancesRetrievable,
+					PlanUpdatable:        svc.PlanUpdatable != nil && *svc.PlanUpdatable,
+					ExternalID:           svc.ID,
+					ExternalName:         svc.Name,
+					Tags:                 svc.Tags,
+					Description:          svc.Description,
+					Requires:             svc.Requires,
+				},
+			},
+		}
+
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
+			serviceClass.Spec.BindingRetrievable = svc.BindingsRetrievable
+		}
+
+		if svc.Metadata != nil {
+			metadata, err := json.Marshal(svc.Metadata)
+			if err != nil {
+				err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", svc.Metadata, err)
+				glog.Error(err)
+				return nil, nil, err
+			}
+			serviceClass.Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
+		}
+		serviceClass.SetName(svc.ID)
+
+		// If this service class passes the predicate, process the plans for the class.
+		if fields := v1beta1.ConvertClusterServiceClassToProperties(serviceClass); predicate.Accepts(fields) {
+			// set up the plans using the ClusterServiceClass Name
+			plans, err := convertClusterServicePlans(svc.Plans, serviceClass.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			acceptedPlans, _, err := FilterServicePlans(restrictions, plans)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// If there are accepted plans, then append the class and all of the accepted plans to the master list.
+			if len(acceptedPlans) > 0 {
+				serviceClasses = append(serviceClasses, serviceClass)
+				servicePlans = append(servicePlans, acceptedPlans...)
+			}
+		}
+	}
+	return serviceClasses, servicePlans, nil
+}
+
+// FilterNamespacedServicePlans splits ServicePlans into those accepted and
+// those rejected by the plan restrictions.
+func FilterNamespacedServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans []*v1beta1.ServicePlan) ([]*v1beta1.ServicePlan, []*v1beta1.ServicePlan, error) {
+	var predicate filter.Predicate
+	var err error
+	if restrictions != nil && len(restrictions.ServicePlan) > 0 {
+		predicate, err = filter.CreatePredicate(restrictions.ServicePlan)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		predicate = filter.NewPredicate()
+	}
+
+	// If the predicate is empty, all plans will pass. No need to run through the list.
+	if predicate.Empty() {
+		return servicePlans, []*v1beta1.ServicePlan(nil), nil
+	}
+
+	accepted := []*v1beta1.ServicePlan(nil)
+	rejected := []*v1beta1.ServicePlan(nil)
+	for _, sp := range servicePlans {
+		fields := v1beta1.ConvertServicePlanToProperties(sp)
+		if predicate.Accepts(fields) {
+			accepted = append(accepted, sp)
+		} else {
+			rejected = append(rejected, sp)
+		}
+	}
+
+	return accepted, rejected, nil
+}
+
+// FilterServicePlans splits ClusterServicePlans into those accepted and those
+// rejected by the plan restrictions.
+func FilterServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans []*v1beta1.ClusterServicePlan) ([]*v1beta1.ClusterServicePlan, []*v1beta1.ClusterServicePlan, error) {
+	var predicate filter.Predicate
+	var err error
+	if restrictions != nil && len(restrictions.ServicePlan) > 0 {
+		predicate, err = filter.CreatePredicate(restrictions.ServicePlan)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		predicate = filter.NewPredicate()
+	}
+
+	// If the predicate is empty, all plans will pass. No need to run through the list.
+	if predicate.Empty() {
+		return servicePlans, []*v1beta1.ClusterServicePlan(nil), nil
+	}
+
+	accepted := []*v1beta1.ClusterServicePlan(nil)
+	rejected := []*v1beta1.ClusterServicePlan(nil)
+	for _, sp := range servicePlans {
+		fields := v1beta1.ConvertClusterServicePlanToProperties(sp)
+		if predicate.Accepts(fields) {
+			accepted = append(accepted, sp)
+		} else {
+			rejected = append(rejected, sp)
+		}
+	}
+
+	return accepted, rejected, nil
+}
+
+func convertServicePlans(namespace string, plans []osb.Plan, serviceClassID string) ([]*v1beta1.ServicePlan, error) {
+	if 0 == len(plans) {
+		return nil, fmt.Errorf("ServiceClass (K8S: %q) must have at least one plan", serviceClassID)
+	}
+	servicePlans := make([]*v1beta1.ServicePlan, len(plans))
+	for i, plan := range plans {
+		servicePlan := &v1beta1.ServicePlan{
+			Spec: v1beta1.ServicePlanSpec{
+				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+					ExternalName: plan.Name,
+					ExternalID:   plan.ID,
+					Free:         plan.Free != nil && *plan.Free,
+					Description:  plan.Description,
+				},
+				ServiceClassRef: v1beta1.LocalObjectReference{Name: serviceClassID},
+			},
+		}
+		servicePlans[i] = servicePlan
+		servicePlan.SetName(plan.ID)
+		servicePlan.SetNamespace(namespace)
+
+		err := convertCommonServicePlan(plan, &servicePlan.Spec.CommonServicePlanSpec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return servicePlans, nil
+}
+
+func convertCommonServicePlan(plan osb.Plan, commonServicePlanSpec *v1beta1.CommonServicePlanSpec) error {
+	if plan.Bindable != nil {
+		b := plan.Bindable
+		commonServicePlanSpec.Bindable = b
+	}
+
+	if plan.Metadata != nil {
+		metadata, err := json.Marshal(plan.Metadata)
+		if err != nil {
+			err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", plan.Metadata, err)
+			glog.Error(err)
+			return err
+		}
+		commonServicePlanSpec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
+	}
+
+	if info := plan.MaintenanceInfo; info != nil {
+		commonServicePlanSpec.MaintenanceInfo = &v1beta1.MaintenanceInfo{Version: info.Version}
+		if info.Description != nil {
+			commonServicePlanSpec.MaintenanceInfo.Description = *info.Description
+		}
+	}
+
+	if seconds := plan.MaximumPollingDuration; seconds != nil && *seconds > 0 {
+		commonServicePlanSpec.MaximumPollingDuration = &metav1.Duration{Duration: time.Duration(*seconds) * time.Second}
+	}
+
+	if schemas := plan.Schemas; schemas != nil {
+		if instanceSchemas := schemas.ServiceInstance; instanceSchemas != nil {
+			if instanceCreateSchema := instanceSchemas.Create; instanceCreateSchema != nil && instanceCreateSchema.Parameters != nil {
+				schema, err := json.Marshal(instanceCreateSchema.Parameters)
+				if err != nil {
+					err = fmt.Errorf("Failed to marshal instance create schema \n%+v\n %v", instanceCreateSchema.Parameters, err)
+					glog.Error(err)
+					return err
+				}
+				commonServicePlanSpec.ServiceInstanceCreateParameterSchema = &runtime.RawExtension{Raw: schema}
+			}
+			if instanceUpdateSchema := instanceSchemas.Update; instanceUpdateSchema != nil && instanceUpdateSchema.Parameters != nil {
+				schema, err := json.Marshal(instanceUpdateSchema.Parameters)
+				if err != nil {
+					err = fmt.Errorf("Failed to marshal instance update schema \n%+v\n %v", instanceUpdateSchema.Parameters, err)
+					glog.Error(err)
+					return err
+				}
+				commonServicePlanSpec.ServiceInstanceUpdateParameterSchema = &runtime.RawExtension{Raw: schema}
+			}
+		}
+		if bindingSchemas := schemas.ServiceBinding; bindingSchemas != nil {
+			if bindingCreateSchema := bindingSchemas.Create; bindingCreateSchema != nil {
+				if bindingCreateSchema.Parameters != nil {
+					schema, err := json.Marshal(bindingCreateSchema.Parameters)
+					if err != nil {
+						err = fmt.Errorf("Failed to marshal binding create schema \n%+v\n %v", bindingCreateSchema.Parameters, err)
+						glog.Error(err)
+						return err
+					}
+					commonServicePlanSpec.ServiceBindingCreateParameterSchema = &runtime.RawExtension{Raw: schema}
+				}
+				if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ResponseSchema) && bindingCreateSchema.Response != nil {
+					schema, err := json.Marshal(bindingCreateSchema.Response)
+					if err != nil {
+						err = fmt.Errorf("Failed to marshal binding create response schema \n%+v\n %v", bindingCreateSchema.Response, err)
+						glog.Error(err)
+						return err
+					}
+					commonServicePlanSpec.ServiceBindingCreateResponseSchema = &runtime.RawExtension{Raw: schema}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func convertClusterServicePlans(plans []osb.Plan, serviceClassID string) ([]*v1beta1.ClusterServicePlan, error) {
+	if 0 == len(plans) {
+		return nil, fmt.Errorf("ClusterServiceClass (K8S: %q) must have at least one plan", serviceClassID)
+	}
+	servicePlans := make([]*v1beta1.ClusterServicePlan, len(plans))
+	for i, plan := range plans {
+		servicePlans[i] = &v1beta1.ClusterServicePlan{
+			Spec: v1beta1.ClusterServicePlanSpec{
+				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+					ExternalName: plan.Name,
+					ExternalID:   plan.ID,
+					Free:         plan.Free != nil && *plan.Free,
+					Description:  plan.Description,
+				},
+				ClusterServiceClassRef: v1beta1.ClusterObjectReference{Name: serviceClassID},
+			},
+		}
+		servicePlans[i].SetName(plan.ID)
+
+		if plan.Bindable != nil {
+			b := *plan.Bindable
+			servicePlans[i].Spec.Bindable = &b
+		}
+
+		if plan.Metadata != nil {
+			metadata, err := json.Marshal(plan.Metadata)
+			if err != nil {
+				err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", plan.Metadata, err)
+				glog.Error(err)
+				return nil, err
+			}
+			servicePlans[i].Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
+		}
+
+		if info := plan.MaintenanceInfo; info != nil {
+			servicePlans[i].Spec.MaintenanceInfo = &v1beta1.MaintenanceInfo{Version: info.Version}
+			if info.Description != nil {
+				servicePlans[i].Spec.MaintenanceInfo.Description = *info.Description
+			}
+		}
+
+		if seconds := plan.MaximumPollingDuration; seconds != nil && *seconds > 0 {
+			servicePlans[i].Spec.MaximumPollingDuration = &metav1.Duration{Duration: time.Duration(*seconds) * time.Second}
+		}
+
+		if schemas := plan.Schemas; schemas != nil {
+			if instanceSchemas := schemas.ServiceInstance; instanceSchemas != nil {
+				if instanceCreateSchema := instanceSchemas.Create; instanceCreateSchema != nil && instanceCreateSchema.Parameters != nil {
+					schema, err := json.Marshal(instanceCreateSchema.Parameters)
+					if err != nil {
+						err = fmt.Errorf("Failed to marshal instance create schema \n%+v\n %v", instanceCreateSchema.Parameters, err)
+						glog.Error(err)
+						return nil, err
+					}
+					servicePlans[i].Spec.ServiceInstanceCreateParameterSchema = &runtime.RawExtension{Raw: schema}
+				}
+				if instanceUpdateSchema := instanceSchemas.Update; instanceUpdateSchema != nil && instanceUpdateSchema.Parameters != nil {
+					schema, err := json.Marshal(instanceUpdateSchema.Parameters)
+					if err != nil {
+						err = fmt.Errorf("Failed to marshal instance update schema \n%+v\n %v", instanceUpdateSchema.Parameters, err)
+						glog.Error(err)
+						return nil, err
+					}
+					servicePlans[i].Spec.ServiceInstanceUpdateParameterSchema = &runtime.RawExtension{Raw: schema}
+				}
+			}
+			if bindingSchemas := schemas.ServiceBinding; bindingSchemas != nil {
+				if bindingCreateSchema := bindingSchemas.Create; bindingCreateSchema != nil {
+					if bindingCreateSchema.Parameters != nil {
+						schema, err := json.Marshal(bindingCreateSchema.Parameters)
+						if err != nil {
+							err = fmt.Errorf("Failed to marshal binding create schema \n%+v\n %v", bindingCreateSchema.Parameters, err)
+							glog.Error(err)
+							return nil, err
+						}
+						servicePlans[i].Spec.ServiceBindingCreateParameterSchema = &runtime.RawExtension{Raw: schema}
+					}
+					if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ResponseSchema) && bindingCreateSchema.Response != nil {
+						schema, err := json.Marshal(bindingCreateSchema.Response)
+						if err != nil {
+							err = fmt.Errorf("Failed to marshal binding create response schema \n%+v\n %v", bindingCreateSchema.Response, err)
+							glog.Error(err)
+							return nil, err
+						}
+						servicePlans[i].Spec.ServiceBindingCreateResponseSchema = &runtime.RawExtension{Raw: schema}
+					}
+				}
+			}
+		}
+	}
+	return servicePlans, nil
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalogdiff compares the catalog returned by a broker with the
+// service classes and plans that service catalog currently holds for that
+// broker.
+package catalogdiff
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// Diff lists the classes and plans that a relist of a broker's catalog
+// adds, changes or marks as removed. Classes are identified by their
+// external name and plans by "<class external name>/<plan external name>".
+type Diff struct {
+	AddedClasses   []string
+	ChangedClasses []string
+	RemovedClasses []string
+	AddedPlans     []string
+	ChangedPlans   []string
+	RemovedPlans   []string
+}
+
+// IsEmpty returns whether the diff contains no changes.
+func (d *Diff) IsEmpty() bool {
+	return len(d.AddedClasses) == 0 && len(d.ChangedClasses) == 0 && len(d.RemovedClasses) == 0 &&
+		len(d.AddedPlans) == 0 && len(d.ChangedPlans) == 0 && len(d.RemovedPlans) == 0
+}
+
+// HasRemovals returns whether the diff removes any class or plan.
+func (d *Diff) HasRemovals() bool {
+	return len(d.RemovedClasses) > 0 || len(d.RemovedPlans) > 0
+}
+
+// String returns a one-line summary of the diff suitable for an event
+// message.
+func (d *Diff) String() string {
+	if d.IsEmpty() {
+		return "no changes"
+	}
+	var parts []string
+	add := func(what string, names []string) {
+		if len(names) > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %s", what, strings.Join(names, ", ")))
+		}
+	}
+	add("added classes", d.AddedClasses)
+	add("changed classes", d.ChangedClasses)
+	add("removed classes", d.RemovedClasses)
+	add("added plans", d.AddedPlans)
+	add("changed plans", d.ChangedPlans)
+	add("removed plans", d.RemovedPlans)
+	return strings.Join(parts, "; ")
+}
+
+// Hash returns the hex encoded SHA-256 digest of a broker's catalog.
+func Hash(catalog *osb.CatalogResponse) (string, error) {
+	b, err := json.Marshal(catalog)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// class and plan are the scope-independent views of service classes and
+// plans that the diff is computed on.
+type class struct {
+	name    string
+	managed bool
+	removed bool
+	spec    *v1beta1.CommonServiceClassSpec
+}
+
+type plan struct {
+	name      string
+	className string
+	managed   bool
+	removed   bool
+	spec      *v1beta1.CommonServicePlanSpec
+}
+
+// ForClusterServiceBroker computes the diff between the converted and
+// filtered catalog of a ClusterServiceBroker and its existing
+// ClusterServiceClasses and ClusterServicePlans.
+func ForClusterServiceBroker(
+	payloadClasses []*v1beta1.ClusterServiceClass,
+	payloadPlans []*v1beta1.ClusterServicePlan,
+	existingClasses []v1beta1.ClusterServiceClass,
+	existingPlans []v1beta1.ClusterServicePlan,
+) *Diff {
+	var newClasses, oldClasses []class
+	var newPlans, oldPlans []plan
+	for _, c := range payloadClasses {
+		newClasses = append(newClasses, class{name: c.Name, managed: true, spec: &c.Spec.CommonServiceClassSpec})
+	}
+	for i := range existingClasses {
+		c := &existingClasses[i]
+		oldClasses = append(oldClasses, class{name: c.Name, managed: isManaged(c), removed: c.Status.RemovedFromBrokerCatalog, spec: &c.Spec.CommonServiceClassSpec})
+	}
+	for _, p := range payloadPlans {
+		newPlans = append(newPlans, plan{name: p.Name, className: p.Spec.ClusterServiceClassRef.Name, managed: true, spec: &p.Spec.CommonServicePlanSpec})
+	}
+	for i := range existingPlans {
+		p := &existingPlans[i]
+		oldPlans = append(oldPlans, plan{name: p.Name, className: p.Spec.ClusterServiceClassRef.Name, managed: isManaged(p), removed: p.Status.RemovedFromBrokerCatalog, spec: &p.Spec.CommonServicePlanSpec})
+	}
+	return compute(newClasses, oldClasses, newPlans, oldPlans)
+}
+
+// ForServiceBroker computes the diff between the converted and filtered
+// catalog of a ServiceBroker and its existing ServiceClasses and
+// ServicePlans.
+func ForServiceBroker(
+	payloadClasses []*v1beta1.ServiceClass,
+	payloadPlans []*v1beta1.ServicePlan,
+	existingClasses []v1beta1.ServiceClass,
+	existingPlans []v1beta1.ServicePlan,
+) *Diff {
+	var newClasses, oldClasses []class
+	var newPlans, oldPlans []plan
+	for _, c := range payloadClasses {
+		newClasses = append(newClasses, class{name: c.Name, managed: true, spec: &c.Spec.CommonServiceClassSpec})
+	}
+	for i := range existingClasses {
+		c := &existingClasses[i]
+		oldClasses = append(oldClasses, class{name: c.Name, managed: isManaged(c), removed: c.Status.RemovedFromBrokerCatalog, spec: &c.Spec.CommonServiceClassSpec})
+	}
+	for _, p := range payloadPlans {
+		newPlans = append(newPlans, plan{name: p.Name, className: p.Spec.ServiceClassRef.Name, managed: true, spec: &p.Spec.CommonServicePlanSpec})
+	}
+	for i := range existingPlans {
+		p := &existingPlans[i]
+		oldPlans = append(oldPlans, plan{name: p.Name, className: p.Spec.ServiceClassRef.Name, managed: isManaged(p), removed: p.Status.RemovedFromBrokerCatalog, spec: &p.Spec.CommonServicePlanSpec})
+	}
+	return compute(newClasses, oldClasses, newPlans, oldPlans)
+}
+
+func compute(newClasses, oldClasses []class, newPlans, oldPlans []plan) *Diff {
+	d := &Diff{}
+
+	// class external names are looked up by k8s name to label plans; prefer
+	// the name from the broker's payload
+	classNames := map[string]string{}
+	oldClassMap := map[string]class{}
+	for _, c := range oldClasses {
+		oldClassMap[c.name] = c
+		classNames[c.name] = c.spec.ExternalName
+	}
+	for _, c := range newClasses {
+		classNames[c.name] = c.spec.ExternalName
+	}
+	planLabel := func(p plan) string {
+		className, ok := classNames[p.className]
+		if !ok {
+			className = p.className
+		}
+		return className + "/" + p.spec.ExternalName
+	}
+
+	for _, c := range newClasses {
+		old, ok := oldClassMap[c.name]
+		delete(oldClassMap, c.name)
+		switch {
+		case !ok || old.removed:
+			d.AddedClasses = append(d.AddedClasses, c.spec.ExternalName)
+		case !old.managed:
+			// user-defined classes are never touched by a relist
+		case !classSpecsEqual(c.spec, old.spec):
+			d.ChangedClasses = append(d.ChangedClasses, c.spec.ExternalName)
+		}
+	}
+	for _, old := range oldClassMap {
+		if old.managed && !old.removed {
+			d.RemovedClasses = append(d.RemovedClasses, old.spec.ExternalName)
+		}
+	}
+
+	oldPlanMap := map[string]plan{}
+	for _, p := range oldPlans {
+		oldPlanMap[p.name] = p
+	}
+	for _, p := range newPlans {
+		old, ok := oldPlanMap[p.name]
+		delete(oldPlanMap, p.name)
+		switch {
+		case !ok || old.removed:
+			d.AddedPlans = append(d.AddedPlans, planLabel(p))
+		case !old.managed:
+			// user-defined plans are never touched by a relist
+		case !planSpecsEqual(p.spec, old.spec):
+			d.ChangedPlans = append(d.ChangedPlans, planLabel(p))
+		}
+	}
+	for _, old := range oldPlanMap {
+		if old.managed && !old.removed {
+			d.RemovedPlans = append(d.RemovedPlans, planLabel(old))
+		}
+	}
+
+	for _, names := range [][]string{d.AddedClasses, d.ChangedClasses, d.RemovedClasses, d.AddedPlans, d.ChangedPlans, d.RemovedPlans} {
+		sort.Strings(names)
+	}
+	return d
+}
+
+// isManaged mirrors the controller's check for classes and plans that were
+// created from a broker's catalog rather than by a user.
+func isManaged(obj metav1.Object) bool {
+	c := metav1.GetControllerOf(obj)
+	return c != nil && strings.HasPrefix(c.APIVersion, v1beta1.GroupName)
+}
+
+// classSpecsEqual compares the fields of a class that are populated from the
+// broker's catalog. BindingRetrievable is skipped since it is only populated
+// when the controller has the AsyncBindingOperations feature enabled.
+func classSpecsEqual(a, b *v1beta1.CommonServiceClassSpec) bool {
+	return a.ExternalName == b.ExternalName &&
+		a.ExternalID == b.ExternalID &&
+		a.Description == b.Description &&
+		a.Bindable == b.Bindable &&
+		a.InstancesRetrievable == b.InstancesRetrievable &&
+		a.PlanUpdatable == b.PlanUpdatable &&
+		stringsEqual(a.Tags, b.Tags) &&
+		stringsEqual(a.Requires, b.Requires) &&
+		rawEqual(a.ExternalMetadata, b.ExternalMetadata)
+}
+
+// planSpecsEqual compares the fields of a plan that are populated from the
+// broker's catalog. The binding response schema is skipped since it is only
+// populated when the controller has the ResponseSchema feature enabled.
+func planSpecsEqual(a, b *v1beta1.CommonServicePlanSpec) bool {
+	return a.ExternalName == b.ExternalName &&
+		a.ExternalID == b.ExternalID &&
+		a.Description == b.Description &&
+		a.Free == b.Free &&
+		boolPtrEqual(a.Bindable, b.Bindable) &&
+		durationPtrEqual(a.MaximumPollingDuration, b.MaximumPollingDuration) &&
+		rawEqual(a.ExternalMetadata, b.ExternalMetadata) &&
+		rawEqual(a.ServiceInstanceCreateParameterSchema, b.ServiceInstanceCreateParameterSchema) &&
+		rawEqual(a.ServiceInstanceUpdateParameterSchema, b.ServiceInstanceUpdateParameterSchema) &&
+		rawEqual(a.ServiceBindingCreateParameterSchema, b.ServiceBindingCreateParameterSchema)
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func boolPtrEqual(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func durationPtrEqual(a, b *metav1.Duration) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Duration == b.Duration
+}
+
+// rawEqual compares two raw JSON documents semantically, so that a document
+// re-encoded by the API server is not reported as changed.
+func rawEqual(a, b *runtime.RawExtension) bool {
+	var aRaw, bRaw []byte
+	if a != nil {
+		aRaw = a.Raw
+	}
+	if b != nil {
+		bRaw = b.Raw
+	}
+	if len(aRaw) == 0 || len(bRaw) == 0 {
+		return len(aRaw) == len(bRaw)
+	}
+	var aVal, bVal interface{}
+	if json.Unmarshal(aRaw, &aVal) != nil || json.Unmarshal(bRaw, &bVal) != nil {
+		return bytes.Equal(aRaw, bRaw)
+	}
+	return reflect.DeepEqual(aVal, bVal)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogdiff
+
+import (
+	"reflect"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func managed(obj metav1.Object) {
+	isController := true
+	obj.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: v1beta1.SchemeGroupVersion.String(),
+		Kind:       "ClusterServiceBroker",
+		Name:       "broker",
+		Controller: &isController,
+	}})
+}
+
+func clusterClass(id, name string) *v1beta1.ClusterServiceClass {
+	c := &v1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: id},
+		Spec: v1beta1.ClusterServiceClassSpec{
+			CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{ExternalID: id, ExternalName: name},
+		},
+	}
+	managed(c)
+	return c
+}
+
+func clusterPlan(id, name, classID string) *v1beta1.ClusterServicePlan {
+	p := &v1beta1.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: id},
+		Spec: v1beta1.ClusterServicePlanSpec{
+			CommonServicePlanSpec:  v1beta1.CommonServicePlanSpec{ExternalID: id, ExternalName: name},
+			ClusterServiceClassRef: v1beta1.ClusterObjectReference{Name: classID},
+		},
+	}
+	managed(p)
+	return p
+}
+
+func TestForClusterServiceBroker(t *testing.T) {
+	cases := []struct {
+		name            string
+		payloadClasses  []*v1beta1.ClusterServiceClass
+		payloadPlans    []*v1beta1.ClusterServicePlan
+		existingClasses func() []v1beta1.ClusterServiceClass
+		existingPlans   func() []v1beta1.ClusterServicePlan
+		expected        Diff
+	}{
+		{
+			name:           "new broker",
+			payloadClasses: []*v1beta1.ClusterServiceClass{clusterClass("c1", "mysql")},
+			payloadPlans:   []*v1beta1.ClusterServicePlan{clusterPlan("p1", "small", "c1")},
+			expected: Diff{
+				AddedClasses: []string{"mysql"},
+				AddedPlans:   []string{"mysql/small"},
+			},
+		},
+		{
+			name:           "unchanged",
+			payloadClasses: []*v1beta1.ClusterServiceClass{clusterClass("c1", "mysql")},
+			payloadPlans:   []*v1beta1.ClusterServicePlan{clusterPlan("p1", "small", "c1")},
+			existingClasses: func() []v1beta1.ClusterServiceClass {
+				return []v1beta1.ClusterServiceClass{*clusterClass("c1", "mysql")}
+			},
+			existingPlans: func() []v1beta1.ClusterServicePlan {
+				return []v1beta1.ClusterServicePlan{*clusterPlan("p1", "small", "c1")}
+			},
+		},
+		{
+			name:           "renamed class and removed plan",
+			payloadClasses: []*v1beta1.ClusterServiceClass{clusterClass("c1", "mysql-v2")},
+			payloadPlans:   []*v1beta1.ClusterServicePlan{clusterPlan("p1", "small", "c1")},
+			existingClasses: func() []v1beta1.ClusterServiceClass {
+				return []v1beta1.ClusterServiceClass{*clusterClass("c1", "mysql")}
+			},
+			existingPlans: func() []v1beta1.ClusterServicePlan {
+				return []v1beta1.ClusterServicePlan{*clusterPlan("p1", "small", "c1"), *clusterPlan("p2", "large", "c1")}
+			},
+			expected: Diff{
+				ChangedClasses: []string{"mysql-v2"},
+				RemovedPlans:   []string{"mysql-v2/large"},
+			},
+		},
+		{
+			name:           "previously removed plan is added back",
+			payloadClasses: []*v1beta1.ClusterServiceClass{clusterClass("c1", "mysql")},
+			payloadPlans:   []*v1beta1.ClusterServicePlan{clusterPlan("p1", "small", "c1")},
+			existingClasses: func() []v1beta1.ClusterServiceClass {
+				return []v1beta1.ClusterServiceClass{*clusterClass("c1", "mysql")}
+			},
+			existingPlans: func() []v1beta1.ClusterServicePlan {
+				p := clusterPlan("p1", "small", "c1")
+				p.Status.RemovedFromBrokerCatalog = true
+				return []v1beta1.ClusterServicePlan{*p}
+			},
+			expected: Diff{
+				AddedPlans: []string{"mysql/small"},
+			},
+		},
+		{
+			name: "user-defined and already removed entries are ignored",
+			existingClasses: func() []v1beta1.ClusterServiceClass {
+				userDefined := clusterClass("c1", "mysql")
+				userDefined.OwnerReferences = nil
+				removed := clusterClass("c2", "redis")
+				removed.Status.RemovedFromBrokerCatalog = true
+				return []v1beta1.ClusterServiceClass{*userDefined, *removed}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var existingClasses []v1beta1.ClusterServiceClass
+			var existingPlans []v1beta1.ClusterServicePlan
+			if tc.existingClasses != nil {
+				existingClasses = tc.existingClasses()
+			}
+			if tc.existingPlans != nil {
+				existingPlans = tc.existingPlans()
+			}
+			diff := ForClusterServiceBroker(tc.payloadClasses, tc.payloadPlans, existingClasses, existingPlans)
+			if !reflect.DeepEqual(tc.expected, *diff) {
+				t.Fatalf("Unexpected diff: expected %+v, got %+v", tc.expected, *diff)
+			}
+		})
+	}
+}
+
+func TestPlanSpecsEqualComparesSchemasSemantically(t *testing.T) {
+	a := &v1beta1.CommonServicePlanSpec{
+		ServiceInstanceCreateParameterSchema: &runtime.RawExtension{Raw: []byte(`{"type":"object","required":["a"]}`)},
+	}
+	b := &v1beta1.CommonServicePlanSpec{
+		ServiceInstanceCreateParameterSchema: &runtime.RawExtension{Raw: []byte(`{ "required": ["a"], "type": "object" }`)},
+	}
+	if !planSpecsEqual(a, b) {
+		t.Fatalf("Expected re-encoded schemas to be equal")
+	}
+
+	b.ServiceInstanceCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`{"type":"object","required":["b"]}`)}
+	if planSpecsEqual(a, b) {
+		t.Fatalf("Expected different schemas to be reported as changed")
+	}
+
+	b.ServiceInstanceCreateParameterSchema = nil
+	if planSpecsEqual(a, b) {
+		t.Fatalf("Expected a removed schema to be reported as changed")
+	}
+}
+
+func TestDiffString(t *testing.T) {
+	d := &Diff{}
+	if e, a := "no changes", d.String(); e != a {
+		t.Fatalf("Unexpected string: expected %q, got %q", e, a)
+	}
+
+	d = &Diff{
+		AddedClasses: []string{"mysql"},
+		RemovedPlans: []string{"redis/large", "redis/small"},
+	}
+	if e, a := "added classes: mysql; removed plans: redis/large, redis/small", d.String(); e != a {
+		t.Fatalf("Unexpected string: expected %q, got %q", e, a)
+	}
+	if !d.HasRemovals() {
+		t.Fatalf("Expected the diff to have removals")
+	}
+}
+
+func TestHash(t *testing.T) {
+	catalog := &osb.CatalogResponse{Services: []osb.Service{{ID: "c1", Name: "mysql"}}}
+	first, err := Hash(catalog)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, _ := Hash(catalog)
+	if first != second {
+		t.Fatalf("Expected the hash of an unchanged catalog to be stable")
+	}
+
+	catalog.Services[0].Name = "mysql-v2"
+	if changed, _ := Hash(catalog); changed == first {
+		t.Fatalf("Expected the hash of a changed catalog to change")
+	}
+}
@@ -28,6 +28,10 @@ type FakeServicecatalogV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeServicecatalogV1beta1) ClusterNotificationSinks() v1beta1.ClusterNotificationSinkInterface {
+	return &FakeClusterNotificationSinks{c}
+}
+
 func (c *FakeServicecatalogV1beta1) ClusterServiceBrokers() v1beta1.ClusterServiceBrokerInterface {
 	return &FakeClusterServiceBrokers{c}
 }
@@ -40,6 +44,14 @@ func (c *FakeServicecatalogV1beta1) ClusterServicePlans() v1beta1.ClusterService
 	return &FakeClusterServicePlans{c}
 }
 
+func (c *FakeServicecatalogV1beta1) ClusterServicePlanTransitionPolicies() v1beta1.ClusterServicePlanTransitionPolicyInterface {
+	return &FakeClusterServicePlanTransitionPolicies{c}
+}
+
+func (c *FakeServicecatalogV1beta1) NotificationSinks(namespace string) v1beta1.NotificationSinkInterface {
+	return &FakeNotificationSinks{c, namespace}
+}
+
 func (c *FakeServicecatalogV1beta1) ServiceBindings(namespace string) v1beta1.ServiceBindingInterface {
 	return &FakeServiceBindings{c, namespace}
 }
@@ -27,9 +27,12 @@ import (
 
 type ServicecatalogV1beta1Interface interface {
 	RESTClient() rest.Interface
+	ClusterNotificationSinksGetter
 	ClusterServiceBrokersGetter
 	ClusterServiceClassesGetter
 	ClusterServicePlansGetter
+	ClusterServicePlanTransitionPoliciesGetter
+	NotificationSinksGetter
 	ServiceBindingsGetter
 	ServiceBrokersGetter
 	ServiceClassesGetter
@@ -42,6 +45,10 @@ type ServicecatalogV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ServicecatalogV1beta1Client) ClusterNotificationSinks() ClusterNotificationSinkInterface {
+	return newClusterNotificationSinks(c)
+}
+
 func (c *ServicecatalogV1beta1Client) ClusterServiceBrokers() ClusterServiceBrokerInterface {
 	return newClusterServiceBrokers(c)
 }
@@ -54,6 +61,14 @@ func (c *ServicecatalogV1beta1Client) ClusterServicePlans() ClusterServicePlanIn
 	return newClusterServicePlans(c)
 }
 
+func (c *ServicecatalogV1beta1Client) ClusterServicePlanTransitionPolicies() ClusterServicePlanTransitionPolicyInterface {
+	return newClusterServicePlanTransitionPolicies(c)
+}
+
+func (c *ServicecatalogV1beta1Client) NotificationSinks(namespace string) NotificationSinkInterface {
+	return newNotificationSinks(c, namespace)
+}
+
 func (c *ServicecatalogV1beta1Client) ServiceBindings(namespace string) ServiceBindingInterface {
 	return newServiceBindings(c, namespace)
 }
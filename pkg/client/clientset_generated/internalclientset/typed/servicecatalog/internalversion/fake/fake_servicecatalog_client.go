@@ -28,6 +28,10 @@ type FakeServicecatalog struct {
 	*testing.Fake
 }
 
+func (c *FakeServicecatalog) ClusterNotificationSinks() internalversion.ClusterNotificationSinkInterface {
+	return &FakeClusterNotificationSinks{c}
+}
+
 func (c *FakeServicecatalog) ClusterServiceBrokers() internalversion.ClusterServiceBrokerInterface {
 	return &FakeClusterServiceBrokers{c}
 }
@@ -40,6 +44,14 @@ func (c *FakeServicecatalog) ClusterServicePlans() internalversion.ClusterServic
 	return &FakeClusterServicePlans{c}
 }
 
+func (c *FakeServicecatalog) ClusterServicePlanTransitionPolicies() internalversion.ClusterServicePlanTransitionPolicyInterface {
+	return &FakeClusterServicePlanTransitionPolicies{c}
+}
+
+func (c *FakeServicecatalog) NotificationSinks(namespace string) internalversion.NotificationSinkInterface {
+	return &FakeNotificationSinks{c, namespace}
+}
+
 func (c *FakeServicecatalog) ServiceBindings(namespace string) internalversion.ServiceBindingInterface {
 	return &FakeServiceBindings{c, namespace}
 }
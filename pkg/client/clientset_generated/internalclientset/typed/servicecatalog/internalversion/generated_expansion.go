@@ -18,12 +18,18 @@ limitations under the License.
 
 package internalversion
 
+type ClusterNotificationSinkExpansion interface{}
+
 type ClusterServiceBrokerExpansion interface{}
 
 type ClusterServiceClassExpansion interface{}
 
 type ClusterServicePlanExpansion interface{}
 
+type ClusterServicePlanTransitionPolicyExpansion interface{}
+
+type NotificationSinkExpansion interface{}
+
 type ServiceBindingExpansion interface{}
 
 type ServiceBrokerExpansion interface{}
@@ -25,9 +25,12 @@ import (
 
 type ServicecatalogInterface interface {
 	RESTClient() rest.Interface
+	ClusterNotificationSinksGetter
 	ClusterServiceBrokersGetter
 	ClusterServiceClassesGetter
 	ClusterServicePlansGetter
+	ClusterServicePlanTransitionPoliciesGetter
+	NotificationSinksGetter
 	ServiceBindingsGetter
 	ServiceBrokersGetter
 	ServiceClassesGetter
@@ -40,6 +43,10 @@ type ServicecatalogClient struct {
 	restClient rest.Interface
 }
 
+func (c *ServicecatalogClient) ClusterNotificationSinks() ClusterNotificationSinkInterface {
+	return newClusterNotificationSinks(c)
+}
+
 func (c *ServicecatalogClient) ClusterServiceBrokers() ClusterServiceBrokerInterface {
 	return newClusterServiceBrokers(c)
 }
@@ -52,6 +59,14 @@ func (c *ServicecatalogClient) ClusterServicePlans() ClusterServicePlanInterface
 	return newClusterServicePlans(c)
 }
 
+func (c *ServicecatalogClient) ClusterServicePlanTransitionPolicies() ClusterServicePlanTransitionPolicyInterface {
+	return newClusterServicePlanTransitionPolicies(c)
+}
+
+func (c *ServicecatalogClient) NotificationSinks(namespace string) NotificationSinkInterface {
+	return newNotificationSinks(c, namespace)
+}
+
 func (c *ServicecatalogClient) ServiceBindings(namespace string) ServiceBindingInterface {
 	return newServiceBindings(c, namespace)
 }
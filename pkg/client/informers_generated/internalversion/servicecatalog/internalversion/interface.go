@@ -24,12 +24,18 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterNotificationSinks returns a ClusterNotificationSinkInformer.
+	ClusterNotificationSinks() ClusterNotificationSinkInformer
 	// ClusterServiceBrokers returns a ClusterServiceBrokerInformer.
 	ClusterServiceBrokers() ClusterServiceBrokerInformer
 	// ClusterServiceClasses returns a ClusterServiceClassInformer.
 	ClusterServiceClasses() ClusterServiceClassInformer
 	// ClusterServicePlans returns a ClusterServicePlanInformer.
 	ClusterServicePlans() ClusterServicePlanInformer
+	// ClusterServicePlanTransitionPolicies returns a ClusterServicePlanTransitionPolicyInformer.
+	ClusterServicePlanTransitionPolicies() ClusterServicePlanTransitionPolicyInformer
+	// NotificationSinks returns a NotificationSinkInformer.
+	NotificationSinks() NotificationSinkInformer
 	// ServiceBindings returns a ServiceBindingInformer.
 	ServiceBindings() ServiceBindingInformer
 	// ServiceBrokers returns a ServiceBrokerInformer.
@@ -53,6 +59,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterNotificationSinks returns a ClusterNotificationSinkInformer.
+func (v *version) ClusterNotificationSinks() ClusterNotificationSinkInformer {
+	return &clusterNotificationSinkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterServiceBrokers returns a ClusterServiceBrokerInformer.
 func (v *version) ClusterServiceBrokers() ClusterServiceBrokerInformer {
 	return &clusterServiceBrokerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -68,6 +79,16 @@ func (v *version) ClusterServicePlans() ClusterServicePlanInformer {
 	return &clusterServicePlanInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterServicePlanTransitionPolicies returns a ClusterServicePlanTransitionPolicyInformer.
+func (v *version) ClusterServicePlanTransitionPolicies() ClusterServicePlanTransitionPolicyInformer {
+	return &clusterServicePlanTransitionPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// NotificationSinks returns a NotificationSinkInformer.
+func (v *version) NotificationSinks() NotificationSinkInformer {
+	return &notificationSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ServiceBindings returns a ServiceBindingInformer.
 func (v *version) ServiceBindings() ServiceBindingInformer {
 	return &serviceBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
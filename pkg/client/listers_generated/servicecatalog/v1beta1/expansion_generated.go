@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1beta1
 
+// ClusterNotificationSinkListerExpansion allows custom methods to be added to
+// ClusterNotificationSinkLister.
+type ClusterNotificationSinkListerExpansion interface{}
+
 // ClusterServiceBrokerListerExpansion allows custom methods to be added to
 // ClusterServiceBrokerLister.
 type ClusterServiceBrokerListerExpansion interface{}
@@ -30,6 +34,18 @@ type ClusterServiceClassListerExpansion interface{}
 // ClusterServicePlanLister.
 type ClusterServicePlanListerExpansion interface{}
 
+// ClusterServicePlanTransitionPolicyListerExpansion allows custom methods to be added to
+// ClusterServicePlanTransitionPolicyLister.
+type ClusterServicePlanTransitionPolicyListerExpansion interface{}
+
+// NotificationSinkListerExpansion allows custom methods to be added to
+// NotificationSinkLister.
+type NotificationSinkListerExpansion interface{}
+
+// NotificationSinkNamespaceListerExpansion allows custom methods to be added to
+// NotificationSinkNamespaceLister.
+type NotificationSinkNamespaceListerExpansion interface{}
+
 // ServiceBindingListerExpansion allows custom methods to be added to
 // ServiceBindingLister.
 type ServiceBindingListerExpansion interface{}
@@ -0,0 +1,245 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+)
+
+const (
+	// BrokerCallbackPath is the path the broker callback endpoint is
+	// served under. A broker reports the completion of an operation on an
+	// instance or binding by posting to
+	// <BrokerCallbackPath>namespaces/<namespace>/serviceinstances/<name> or
+	// <BrokerCallbackPath>namespaces/<namespace>/servicebindings/<name>.
+	BrokerCallbackPath = "/callbacks/v1/"
+
+	// callbackURLContextKey and callbackTokenContextKey are the keys of the
+	// callback URL and token in the context of the requests sent to
+	// brokers.
+	callbackURLContextKey   = "callback_url"
+	callbackTokenContextKey = "callback_token"
+
+	callbackInstancesResource = "serviceinstances"
+	callbackBindingsResource  = "servicebindings"
+)
+
+// BrokerCallbacks serves the endpoint brokers call to report the completion
+// of asynchronous operations. A call authenticated with the token sent in the
+// context of the request that started the operation is recorded on the
+// instance or binding with the BrokerCallbackAnnotation. The update reaches
+// the informers of every replica, and the replica that reconciles the
+// resource polls it right away, instead of when its polling backoff expires.
+// A token is only valid while its operation is in progress. Polling continues
+// as usual for brokers that never call back.
+type BrokerCallbacks struct {
+	// url is the base URL brokers reach the endpoint at.
+	url string
+	// key is the key the callback tokens are derived from.
+	key []byte
+
+	// controllerLock protects controller, which is set once the
+	// controller is created. Calls made before then are rejected.
+	controllerLock sync.RWMutex
+	controller     *controller
+}
+
+// NewBrokerCallbacks creates the broker callback endpoint. The URL is the
+// base URL brokers reach the server the endpoint is installed on at, and the
+// key is the key tokens are derived from.
+func NewBrokerCallbacks(url string, key []byte) *BrokerCallbacks {
+	return &BrokerCallbacks{
+		url: strings.TrimSuffix(url, "/"),
+		key: key,
+	}
+}
+
+// setController sets the controller whose queues calls are routed to.
+func (b *BrokerCallbacks) setController(c *controller) {
+	b.controllerLock.Lock()
+	defer b.controllerLock.Unlock()
+	b.controller = c
+}
+
+func (b *BrokerCallbacks) getController() *controller {
+	b.controllerLock.RLock()
+	defer b.controllerLock.RUnlock()
+	return b.controller
+}
+
+// token derives the callback token of an operation on the resource with the
+// given UID. The generation ties the token of an instance to the spec the
+// operation was started for, and the operation and its start time tie it to
+// one operation, so that a token cannot be replayed once the operation has
+// finished or for a later operation.
+func (b *BrokerCallbacks) token(resource string, uid string, generation int64, operation string, operationStartTime *metav1.Time) string {
+	// The start time is stored with a precision of seconds.
+	var started int64
+	if operationStartTime != nil {
+		started = operationStartTime.Unix()
+	}
+	mac := hmac.New(sha256.New, b.key)
+	fmt.Fprintf(mac, "%s/%s/%d/%s/%d", resource, uid, generation, operation, started)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validToken returns whether the given token is the token of the operation in
+// progress on the resource with the given UID and generation. No token is
+// valid when no operation is in progress.
+func (b *BrokerCallbacks) validToken(token string, resource string, uid string, generation int64, operation string, operationStartTime *metav1.Time) bool {
+	if operation == "" || operationStartTime == nil {
+		return false
+	}
+	return hmac.Equal([]byte(token), []byte(b.token(resource, uid, generation, operation, operationStartTime)))
+}
+
+// serviceInstanceContext returns the callback URL and token of the operation
+// in progress on the given instance, to add to the context of the request
+// sent to the broker.
+func (b *BrokerCallbacks) serviceInstanceContext(instance *v1beta1.ServiceInstance) map[string]interface{} {
+	return map[string]interface{}{
+		callbackURLContextKey: b.callbackURL(instance.Namespace, callbackInstancesResource, instance.Name),
+		callbackTokenContextKey: b.token(callbackInstancesResource, string(instance.UID), instance.Generation,
+			string(instance.Status.CurrentOperation), instance.Status.OperationStartTime),
+	}
+}
+
+// serviceBindingContext returns the callback URL and token of the operation
+// in progress on the given binding, to add to the context of the request sent
+// to the broker.
+func (b *BrokerCallbacks) serviceBindingContext(binding *v1beta1.ServiceBinding) map[string]interface{} {
+	return map[string]interface{}{
+		callbackURLContextKey: b.callbackURL(binding.Namespace, callbackBindingsResource, binding.Name),
+		callbackTokenContextKey: b.token(callbackBindingsResource, string(binding.UID), 0,
+			string(binding.Status.CurrentOperation), binding.Status.OperationStartTime),
+	}
+}
+
+func (b *BrokerCallbacks) callbackURL(namespace, resource, name string) string {
+	return fmt.Sprintf("%s%snamespaces/%s/%s/%s", b.url, BrokerCallbackPath, namespace, resource, name)
+}
+
+// brokerCallbackPatch returns the merge patch that records a broker callback
+// on the resource with the given UID. The UID makes the patch fail if the
+// resource has been recreated since the lister saw it.
+func brokerCallbackPatch(uid types.UID) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"uid": uid,
+			"annotations": map[string]string{
+				v1beta1.BrokerCallbackAnnotation: time.Now().UTC().Format(time.RFC3339Nano),
+			},
+		},
+	})
+}
+
+// brokerCallbackRecorded returns whether an update of a resource recorded a
+// broker callback.
+func brokerCallbackRecorded(oldMeta, newMeta metav1.ObjectMeta) bool {
+	return newMeta.Annotations[v1beta1.BrokerCallbackAnnotation] != oldMeta.Annotations[v1beta1.BrokerCallbackAnnotation]
+}
+
+// ServeHTTP handles a call from a broker. It responds 202 Accepted when the
+// callback was recorded on the resource, 401 Unauthorized when the resource
+// does not exist or the token is not the token of the operation in progress
+// on the resource, so that callers cannot probe for resources, and 503
+// Service Unavailable when the callback could not be recorded.
+func (b *BrokerCallbacks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// namespaces/<namespace>/<resource>/<name>
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, BrokerCallbackPath), "/")
+	if len(parts) != 4 || parts[0] != "namespaces" || parts[1] == "" || parts[3] == "" ||
+		(parts[2] != callbackInstancesResource && parts[2] != callbackBindingsResource) {
+		http.NotFound(w, r)
+		return
+	}
+	namespace, resource, name := parts[1], parts[2], parts[3]
+
+	c := b.getController()
+	if c == nil {
+		http.Error(w, "the controller is not running yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	key := namespace + "/" + name
+	var uid types.UID
+	switch resource {
+	case callbackInstancesResource:
+		instance, err := c.instanceLister.ServiceInstances(namespace).Get(name)
+		// The instance's ObservedGeneration is the generation the
+		// in-flight operation was started for, even if the spec has
+		// changed since.
+		if err == nil && b.validToken(token, resource, string(instance.UID), instance.Status.ObservedGeneration,
+			string(instance.Status.CurrentOperation), instance.Status.OperationStartTime) {
+			uid = instance.UID
+		}
+	case callbackBindingsResource:
+		binding, err := c.bindingLister.ServiceBindings(namespace).Get(name)
+		if err == nil && b.validToken(token, resource, string(binding.UID), 0,
+			string(binding.Status.CurrentOperation), binding.Status.OperationStartTime) {
+			uid = binding.UID
+		}
+	}
+
+	if uid == "" {
+		glog.V(4).Infof("Rejected broker callback for %s %q: invalid token", resource, key)
+		metrics.BrokerCallbackCount.WithLabelValues(resource, "rejected").Inc()
+		http.Error(w, "invalid callback token", http.StatusUnauthorized)
+		return
+	}
+
+	// Another replica may reconcile the resource, so the callback is
+	// recorded on the resource rather than queued here.
+	patch, err := brokerCallbackPatch(uid)
+	if err == nil {
+		if resource == callbackInstancesResource {
+			_, err = c.serviceCatalogClient.ServiceInstances(namespace).Patch(name, types.MergePatchType, patch)
+		} else {
+			_, err = c.serviceCatalogClient.ServiceBindings(namespace).Patch(name, types.MergePatchType, patch)
+		}
+	}
+	if err != nil {
+		glog.Warningf("Failed to record broker callback for %s %q: %v", resource, key, err)
+		metrics.BrokerCallbackCount.WithLabelValues(resource, "failed").Inc()
+		http.Error(w, "the callback could not be recorded", http.StatusServiceUnavailable)
+		return
+	}
+	glog.V(4).Infof("Recorded broker callback for %s %q", resource, key)
+	metrics.BrokerCallbackCount.WithLabelValues(resource, "accepted").Inc()
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/test/fake"
+)
+
+const testBrokerCallbackURL = "https://catalog.example.com:8444/"
+
+// newTestControllerWithCallbacks creates a test controller serving broker
+// callbacks, with the given instance and binding in its listers.
+func newTestControllerWithCallbacks(t *testing.T, instance *v1beta1.ServiceInstance, binding *v1beta1.ServiceBinding) (*fake.Clientset, *controller, *BrokerCallbacks) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{})
+	addGetNamespaceReaction(fakeKubeClient)
+
+	callbacks := NewBrokerCallbacks(testBrokerCallbackURL, []byte("test-key"))
+	testController.callbacks = callbacks
+	callbacks.setController(testController)
+
+	if instance != nil {
+		sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	}
+	if binding != nil {
+		sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+	}
+	return fakeCatalogClient, testController, callbacks
+}
+
+// assertBrokerCallbackPatch asserts that the action records a broker callback
+// on the named resource with the given UID.
+func assertBrokerCallbackPatch(t *testing.T, action clientgotesting.Action, resource, name string, uid types.UID) {
+	patchAction, ok := action.(clientgotesting.PatchAction)
+	if !ok || action.GetVerb() != "patch" {
+		t.Fatalf("unexpected action: expected a patch, got %+v", action)
+	}
+	if e, a := resource, action.GetResource().Resource; e != a {
+		t.Fatalf("unexpected resource: expected %q, got %q", e, a)
+	}
+	if e, a := name, patchAction.GetName(); e != a {
+		t.Fatalf("unexpected name: expected %q, got %q", e, a)
+	}
+	var patch struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal(patchAction.GetPatch(), &patch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := uid, patch.Metadata.UID; e != a {
+		t.Fatalf("unexpected UID precondition: expected %q, got %q", e, a)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, patch.Metadata.Annotations[v1beta1.BrokerCallbackAnnotation]); err != nil {
+		t.Fatalf("unexpected broker callback annotation: %v", err)
+	}
+}
+
+func postBrokerCallback(callbacks *BrokerCallbacks, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	callbacks.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestBrokerCallbackRequestContext(t *testing.T) {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.UID = "test-instance-uid"
+	instance.Generation = 2
+	startTime := metav1.NewTime(time.Unix(1000, 0))
+	instance.Status.CurrentOperation = v1beta1.ServiceInstanceOperationUpdate
+	instance.Status.OperationStartTime = &startTime
+	_, testController, callbacks := newTestControllerWithCallbacks(t, nil, nil)
+
+	rh, err := testController.prepareRequestHelper(instance, testClusterServicePlanName, testClusterServicePlanGUID, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, a := "https://catalog.example.com:8444/callbacks/v1/namespaces/test-ns/serviceinstances/test-instance", rh.requestContext[callbackURLContextKey]; e != a {
+		t.Errorf("unexpected callback URL: expected %q, got %q", e, a)
+	}
+	if e, a := callbacks.token(callbackInstancesResource, "test-instance-uid", 2, "Update", &startTime), rh.requestContext[callbackTokenContextKey]; e != a {
+		t.Errorf("unexpected callback token: expected %q, got %q", e, a)
+	}
+	if e, a := ContextProfilePlatformKubernetes, rh.requestContext["platform"]; e != a {
+		t.Errorf("unexpected platform: expected %q, got %q", e, a)
+	}
+}
+
+func TestBrokerCallbackServiceInstance(t *testing.T) {
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	instance.UID = "test-instance-uid"
+	instance.Generation = 1
+	instance.Status.ObservedGeneration = 1
+	path := "/callbacks/v1/namespaces/test-ns/serviceinstances/test-instance"
+	validToken := func(b *BrokerCallbacks) string {
+		return b.serviceInstanceContext(instance)[callbackTokenContextKey].(string)
+	}
+	earlierStartTime := metav1.NewTime(instance.Status.OperationStartTime.Add(-time.Minute))
+	finished := instance.DeepCopy()
+	finished.Status.CurrentOperation = ""
+	finished.Status.OperationStartTime = nil
+
+	cases := []struct {
+		name     string
+		instance *v1beta1.ServiceInstance
+		method   string
+		path     string
+		token    func(*BrokerCallbacks) string
+		status   int
+		recorded bool
+	}{
+		{
+			name:     "valid token",
+			method:   http.MethodPost,
+			path:     path,
+			token:    validToken,
+			status:   http.StatusAccepted,
+			recorded: true,
+		},
+		{
+			name:   "token of another generation",
+			method: http.MethodPost,
+			path:   path,
+			token: func(b *BrokerCallbacks) string {
+				return b.token(callbackInstancesResource, "test-instance-uid", 2, "Provision", instance.Status.OperationStartTime)
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "token of an earlier operation",
+			method: http.MethodPost,
+			path:   path,
+			token: func(b *BrokerCallbacks) string {
+				return b.token(callbackInstancesResource, "test-instance-uid", 1, "Provision", &earlierStartTime)
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:     "token of a finished operation",
+			instance: finished,
+			method:   http.MethodPost,
+			path:     path,
+			token:    validToken,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			name:   "token of another key",
+			method: http.MethodPost,
+			path:   path,
+			token: func(*BrokerCallbacks) string {
+				return NewBrokerCallbacks(testBrokerCallbackURL, []byte("other-key")).serviceInstanceContext(instance)[callbackTokenContextKey].(string)
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "no token",
+			method: http.MethodPost,
+			path:   path,
+			token:  func(*BrokerCallbacks) string { return "" },
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "unknown instance",
+			method: http.MethodPost,
+			path:   "/callbacks/v1/namespaces/test-ns/serviceinstances/other-instance",
+			token:  validToken,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "unknown resource",
+			method: http.MethodPost,
+			path:   "/callbacks/v1/namespaces/test-ns/servicebrokers/test-instance",
+			token:  validToken,
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "GET",
+			method: http.MethodGet,
+			path:   path,
+			token:  validToken,
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			stored := instance
+			if tc.instance != nil {
+				stored = tc.instance
+			}
+			fakeCatalogClient, _, callbacks := newTestControllerWithCallbacks(t, stored, nil)
+
+			recorder := postBrokerCallback(callbacks, tc.method, tc.path, tc.token(callbacks))
+
+			if e, a := tc.status, recorder.Code; e != a {
+				t.Fatalf("unexpected status: expected %v, got %v", e, a)
+			}
+			actions := fakeCatalogClient.Actions()
+			if !tc.recorded {
+				assertNumberOfActions(t, actions, 0)
+				return
+			}
+			assertNumberOfActions(t, actions, 1)
+			assertBrokerCallbackPatch(t, actions[0], callbackInstancesResource, "test-instance", "test-instance-uid")
+		})
+	}
+}
+
+func TestBrokerCallbackServiceBinding(t *testing.T) {
+	binding := getTestServiceBindingAsyncBinding(testOperation)
+	binding.UID = "test-binding-uid"
+	fakeCatalogClient, _, callbacks := newTestControllerWithCallbacks(t, nil, binding)
+
+	context := callbacks.serviceBindingContext(binding)
+	url := context[callbackURLContextKey].(string)
+	if e, a := "https://catalog.example.com:8444/callbacks/v1/namespaces/test-ns/servicebindings/test-binding", url; e != a {
+		t.Fatalf("unexpected callback URL: expected %q, got %q", e, a)
+	}
+
+	recorder := postBrokerCallback(callbacks, http.MethodPost, url, context[callbackTokenContextKey].(string))
+
+	if e, a := http.StatusAccepted, recorder.Code; e != a {
+		t.Fatalf("unexpected status: expected %v, got %v", e, a)
+	}
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertBrokerCallbackPatch(t, actions[0], callbackBindingsResource, "test-binding", "test-binding-uid")
+}
+
+func TestBrokerCallbackNotRecorded(t *testing.T) {
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	instance.UID = "test-instance-uid"
+	fakeCatalogClient, _, callbacks := newTestControllerWithCallbacks(t, instance, nil)
+	fakeCatalogClient.PrependReactor("patch", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("patch failed")
+	})
+
+	token := callbacks.serviceInstanceContext(instance)[callbackTokenContextKey].(string)
+	recorder := postBrokerCallback(callbacks, http.MethodPost, "/callbacks/v1/namespaces/test-ns/serviceinstances/test-instance", token)
+
+	if e, a := http.StatusServiceUnavailable, recorder.Code; e != a {
+		t.Fatalf("unexpected status: expected %v, got %v", e, a)
+	}
+}
+
+// TestBrokerCallbackRequeues ensures that the replica reconciling a resource
+// requeues it at a high priority when a broker callback is recorded on it,
+// even while an asynchronous operation is in progress.
+func TestBrokerCallbackRequeues(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	oldInstance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	newInstance := oldInstance.DeepCopy()
+	newInstance.ResourceVersion = "2"
+	testController.instanceUpdate(oldInstance, newInstance)
+	if e, a := 0, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("unexpected instance queue length without a callback: expected %v, got %v", e, a)
+	}
+
+	newInstance.Annotations = map[string]string{v1beta1.BrokerCallbackAnnotation: "2018-01-01T00:00:00Z"}
+	testController.instanceUpdate(oldInstance, newInstance)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("unexpected instance queue length: expected %v, got %v", e, a)
+	}
+
+	oldBinding := getTestServiceBindingAsyncBinding(testOperation)
+	newBinding := oldBinding.DeepCopy()
+	newBinding.Annotations = map[string]string{v1beta1.BrokerCallbackAnnotation: "2018-01-01T00:00:00Z"}
+	testController.bindingUpdate(oldBinding, newBinding)
+	if e, a := 1, testController.bindingQueue.Len(); e != a {
+		t.Fatalf("unexpected binding queue length: expected %v, got %v", e, a)
+	}
+}
+
+func TestBrokerCallbackBindRequestContext(t *testing.T) {
+	utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.AsyncBindingOperations))
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.AsyncBindingOperations))
+
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	callbacks := NewBrokerCallbacks(testBrokerCallbackURL, []byte("test-key"))
+	testController.callbacks = callbacks
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestBindingRetrievableClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingAsyncBinding(testOperation)
+	binding.UID = "test-binding-uid"
+	request, _, err := testController.prepareBindRequest(binding, getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for k, v := range callbacks.serviceBindingContext(binding) {
+		if e, a := v, request.Context[k]; e != a {
+			t.Errorf("unexpected context %q: expected %q, got %q", k, e, a)
+		}
+	}
+}
+
+func TestBrokerCallbackBeforeControllerRuns(t *testing.T) {
+	callbacks := NewBrokerCallbacks(testBrokerCallbackURL, []byte("test-key"))
+
+	recorder := postBrokerCallback(callbacks, http.MethodPost, "/callbacks/v1/namespaces/test-ns/serviceinstances/test-instance", "token")
+
+	if e, a := http.StatusServiceUnavailable, recorder.Code; e != a {
+		t.Fatalf("unexpected status: expected %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// brokerClientCache holds one OSB client per broker so that the client's HTTP
+// transport, and the connections it keeps open, are reused across reconciles
+// instead of being rebuilt, with a new TLS handshake, on every call.
+//
+// Entries are keyed on the broker's UID. A cached client is only returned
+// while the broker's generation, the OSB API version and the version of its
+// credentials match the ones the client was created with, so changes to the
+// broker's spec, its negotiated API version or its credentials result in a
+// new client. The credentials version is the
+// resourceVersion of the broker's auth secret, plus the time the current
+// token was obtained for brokers using OAuth2 client credentials.
+//
+// The cache also holds each broker's request limiter. Limiters outlive the
+// clients that use them, so that requests sent by a replaced client still
+// count against the broker's limits, and are only replaced when the limits
+// themselves change.
+//
+// A client that is replaced or removed has its idle connections closed, so
+// that the connections of clients that are no longer used are not left open
+// until the broker closes them.
+type brokerClientCache struct {
+	mutex    sync.Mutex
+	clients  map[types.UID]*cachedBrokerClient
+	limiters map[types.UID]*brokerRequestLimiter
+}
+
+type cachedBrokerClient struct {
+	generation         int64
+	apiVersion         string
+	credentialsVersion string
+	client             osb.Client
+}
+
+func newBrokerClientCache() *brokerClientCache {
+	return &brokerClientCache{
+		clients:  make(map[types.UID]*cachedBrokerClient),
+		limiters: make(map[types.UID]*brokerRequestLimiter),
+	}
+}
+
+// get returns the cached client for the broker, or nil if there is none that
+// was created for the given generation, API version and credentials version.
+func (c *brokerClientCache) get(uid types.UID, generation int64, apiVersion, credentialsVersion string) osb.Client {
+	if uid == "" {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached, ok := c.clients[uid]
+	if !ok || cached.generation != generation || cached.apiVersion != apiVersion || cached.credentialsVersion != credentialsVersion {
+		return nil
+	}
+	return cached.client
+}
+
+// set caches the client for the broker, replacing any previous client.
+// Brokers without a UID have not been persisted and are never cached.
+func (c *brokerClientCache) set(uid types.UID, generation int64, apiVersion, credentialsVersion string, client osb.Client) {
+	if uid == "" {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if old, ok := c.clients[uid]; ok && old.client != client {
+		closeIdleConnections(old.client)
+	}
+	c.clients[uid] = &cachedBrokerClient{
+		generation:         generation,
+		apiVersion:         apiVersion,
+		credentialsVersion: credentialsVersion,
+		client:             client,
+	}
+}
+
+// limiter returns the request limiter for the broker's limits, reusing the
+// cached one if its limits are unchanged. It returns nil if the broker has no
+// limits. The namespace is empty for ClusterServiceBrokers.
+func (c *brokerClientCache) limiter(uid types.UID, brokerNamespace, brokerName string, spec *v1beta1.CommonServiceBrokerSpec) *brokerRequestLimiter {
+	if uid == "" {
+		return newBrokerRequestLimiter(brokerNamespace, brokerName, spec)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if cached, ok := c.limiters[uid]; ok && cached.matches(spec) {
+		return cached
+	}
+	limiter := newBrokerRequestLimiter(brokerNamespace, brokerName, spec)
+	if limiter == nil {
+		delete(c.limiters, uid)
+	} else {
+		c.limiters[uid] = limiter
+	}
+	return limiter
+}
+
+// remove drops the cached client and request limiter for the broker.
+func (c *brokerClientCache) remove(uid types.UID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if old, ok := c.clients[uid]; ok {
+		closeIdleConnections(old.client)
+	}
+	delete(c.clients, uid)
+	delete(c.limiters, uid)
+}
+
+// idleConnectionsCloser is implemented by OSB clients that keep connections
+// open for reuse.
+type idleConnectionsCloser interface {
+	CloseIdleConnections()
+}
+
+// closeIdleConnections closes the idle connections of the client, if it keeps
+// any.
+func closeIdleConnections(client osb.Client) {
+	if closer, ok := client.(idleConnectionsCloser); ok {
+		closer.CloseIdleConnections()
+	}
+}
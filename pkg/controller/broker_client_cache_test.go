@@ -0,0 +1,313 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/cert"
+)
+
+func TestBrokerClientCache(t *testing.T) {
+	clientCache := newBrokerClientCache()
+	client := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{})
+
+	if c := clientCache.get("uid", 1, "2.14", "10"); c != nil {
+		t.Fatalf("expected no client in an empty cache, got %v", c)
+	}
+
+	clientCache.set("uid", 1, "2.14", "10", client)
+	if c := clientCache.get("uid", 1, "2.14", "10"); c != client {
+		t.Fatalf("expected the cached client, got %v", c)
+	}
+	if c := clientCache.get("uid", 2, "2.14", "10"); c != nil {
+		t.Fatalf("expected no client for a new generation, got %v", c)
+	}
+	if c := clientCache.get("uid", 1, "2.14", "11"); c != nil {
+		t.Fatalf("expected no client for a new secret resourceVersion, got %v", c)
+	}
+	if c := clientCache.get("uid", 1, "2.13", "10"); c != nil {
+		t.Fatalf("expected no client for another OSB API version, got %v", c)
+	}
+	if c := clientCache.get("other-uid", 1, "2.14", "10"); c != nil {
+		t.Fatalf("expected no client for another broker, got %v", c)
+	}
+
+	clientCache.remove("uid")
+	if c := clientCache.get("uid", 1, "2.14", "10"); c != nil {
+		t.Fatalf("expected no client after removal, got %v", c)
+	}
+
+	clientCache.set("", 1, "2.14", "10", client)
+	if c := clientCache.get("", 1, "2.14", "10"); c != nil {
+		t.Fatalf("expected brokers without a UID not to be cached, got %v", c)
+	}
+}
+
+// closeTrackingClient is an osb.Client that records calls to
+// CloseIdleConnections.
+type closeTrackingClient struct {
+	osb.Client
+	closed int
+}
+
+func (c *closeTrackingClient) CloseIdleConnections() {
+	c.closed++
+}
+
+// TestBrokerClientCacheClosesIdleConnections ensures that the idle
+// connections of a client are closed when it is replaced or removed, and
+// through the limitedBrokerClient wrapper.
+func TestBrokerClientCacheClosesIdleConnections(t *testing.T) {
+	clientCache := newBrokerClientCache()
+	first := &closeTrackingClient{Client: fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{})}
+	second := &closeTrackingClient{Client: fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{})}
+
+	clientCache.set("uid", 1, "2.14", "10", first)
+	clientCache.set("uid", 1, "2.14", "10", first)
+	if e, a := 0, first.closed; e != a {
+		t.Fatalf("expected a client set again not to be closed: expected %d closes, got %d", e, a)
+	}
+
+	clientCache.set("uid", 2, "2.14", "10", &limitedBrokerClient{client: second})
+	if e, a := 1, first.closed; e != a {
+		t.Fatalf("expected the replaced client to be closed: expected %d closes, got %d", e, a)
+	}
+
+	clientCache.remove("uid")
+	if e, a := 1, second.closed; e != a {
+		t.Fatalf("expected the removed client to be closed: expected %d closes, got %d", e, a)
+	}
+}
+
+// TestGetBrokerClientReusesClient ensures that the controller reuses a
+// broker's client until the broker's spec or credentials change, or the
+// broker is deleted.
+func TestGetBrokerClientReusesClient(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	created := 0
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		created++
+		return fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{}), nil
+	}
+
+	broker := getTestClusterServiceBroker()
+	broker.UID = types.UID("broker-uid")
+	broker.Generation = 1
+
+	getClient := func(secretResourceVersion string) osb.Client {
+		client, err := testController.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, osb.LatestAPIVersion(), &brokerCredentials{version: secretResourceVersion})
+		if err != nil {
+			t.Fatalf("unexpected error getting broker client: %v", err)
+		}
+		return client
+	}
+
+	first := getClient("1")
+	if second := getClient("1"); second != first || created != 1 {
+		t.Fatalf("expected the client to be reused; created %d clients", created)
+	}
+
+	broker.Generation = 2
+	if c := getClient("1"); c == first || created != 2 {
+		t.Fatalf("expected a new client after a spec change; created %d clients", created)
+	}
+
+	if getClient("2"); created != 3 {
+		t.Fatalf("expected a new client after a credentials change; created %d clients", created)
+	}
+
+	testController.clusterServiceBrokerDelete(broker)
+	if getClient("2"); created != 4 {
+		t.Fatalf("expected a new client after the broker was deleted; created %d clients", created)
+	}
+}
+
+// TestGetAuthCredentialsFromSecretLister ensures that broker credentials are
+// read from the shared secret informer when it has the secret, and from the
+// API server otherwise.
+func TestGetAuthCredentialsFromSecretLister(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "test-ns",
+			Name:            "auth-secret",
+			ResourceVersion: "42",
+		},
+		Data: map[string][]byte{
+			v1beta1.BearerTokenKey: []byte("token"),
+		},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if err := indexer.Add(secret); err != nil {
+		t.Fatalf("unexpected error adding secret to indexer: %v", err)
+	}
+	testController.secretLister = corelisters.NewSecretLister(indexer)
+
+	broker := getTestClusterServiceBrokerWithAuth(&v1beta1.ClusterServiceBrokerAuthInfo{
+		Bearer: &v1beta1.ClusterBearerTokenAuthConfig{
+			SecretRef: &v1beta1.ObjectReference{
+				Namespace: "test-ns",
+				Name:      "auth-secret",
+			},
+		},
+	})
+
+	credentials, err := testController.getAuthCredentialsFromClusterServiceBroker(broker)
+	if err != nil {
+		t.Fatalf("unexpected error getting auth credentials: %v", err)
+	}
+	if credentials == nil || credentials.authConfig == nil || credentials.authConfig.BearerConfig == nil || credentials.authConfig.BearerConfig.Token != "token" {
+		t.Fatalf("unexpected credentials: %+v", credentials)
+	}
+	if e, a := "42", credentials.version; e != a {
+		t.Fatalf("unexpected secret resourceVersion: expected %q, got %q", e, a)
+	}
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	broker.Spec.AuthInfo.Bearer.SecretRef.Name = "other-secret"
+	if _, err := testController.getAuthCredentialsFromClusterServiceBroker(broker); err == nil {
+		t.Fatal("expected an error for a secret that does not exist")
+	}
+	actions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	if e, a := "get", actions[0].GetVerb(); e != a {
+		t.Fatalf("unexpected action: expected %v, got %v", e, a)
+	}
+}
+
+// TestGetBrokerClientWithClientCertificate ensures that a broker's client
+// certificate is presented through the client's TLS config.
+func TestGetBrokerClientWithClientCertificate(t *testing.T) {
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	certPEM, keyPEM, err := cert.GenerateSelfSignedCertKey("client.example.com", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error generating certificate: %v", err)
+	}
+	addGetSecretReaction(fakeKubeClient, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+		Data: map[string][]byte{
+			v1beta1.ClientCertificateCertKey: certPEM,
+			v1beta1.ClientCertificateKeyKey:  keyPEM,
+		},
+	})
+
+	var clientConfig *osb.ClientConfiguration
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		clientConfig = config
+		return fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{}), nil
+	}
+
+	broker := getTestClusterServiceBrokerWithAuth(&v1beta1.ClusterServiceBrokerAuthInfo{
+		ClientCertificate: &v1beta1.ClusterClientCertificateAuthConfig{
+			SecretRef: &v1beta1.ObjectReference{
+				Namespace: "test-ns",
+				Name:      "auth-secret",
+			},
+		},
+	})
+
+	credentials, err := testController.getAuthCredentialsFromClusterServiceBroker(broker)
+	if err != nil {
+		t.Fatalf("unexpected error getting auth credentials: %v", err)
+	}
+	if _, err := testController.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, osb.LatestAPIVersion(), credentials); err != nil {
+		t.Fatalf("unexpected error getting broker client: %v", err)
+	}
+
+	if clientConfig.AuthConfig != nil {
+		t.Fatalf("expected no auth config, got %+v", clientConfig.AuthConfig)
+	}
+	if clientConfig.TLSConfig == nil || len(clientConfig.TLSConfig.Certificates) != 1 {
+		t.Fatalf("expected the client certificate in the TLS config, got %+v", clientConfig.TLSConfig)
+	}
+}
+
+// TestGetAuthCredentialsWithOAuth2ClientCredentials ensures that the token
+// obtained from a broker's token endpoint is sent as a bearer token and reused
+// across reconciles.
+func TestGetAuthCredentialsWithOAuth2ClientCredentials(t *testing.T) {
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		if id, secret, ok := r.BasicAuth(); !ok || id != "id" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer","expires_in":3600}`, tokenRequests)
+	}))
+	defer server.Close()
+
+	fakeKubeClient, _, _, testController, _ := newTestController(t, noFakeActions())
+	addGetSecretReaction(fakeKubeClient, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+		Data: map[string][]byte{
+			v1beta1.OAuth2ClientIDKey:     []byte("id"),
+			v1beta1.OAuth2ClientSecretKey: []byte("secret"),
+		},
+	})
+
+	broker := getTestClusterServiceBrokerWithAuth(&v1beta1.ClusterServiceBrokerAuthInfo{
+		OAuth2ClientCredentials: &v1beta1.ClusterOAuth2ClientCredentialsAuthConfig{
+			TokenURL: server.URL,
+			SecretRef: &v1beta1.ObjectReference{
+				Namespace: "test-ns",
+				Name:      "auth-secret",
+			},
+		},
+	})
+	broker.UID = types.UID("broker-uid")
+
+	for i := 0; i < 2; i++ {
+		credentials, err := testController.getAuthCredentialsFromClusterServiceBroker(broker)
+		if err != nil {
+			t.Fatalf("unexpected error getting auth credentials: %v", err)
+		}
+		if credentials.authConfig == nil || credentials.authConfig.BearerConfig == nil {
+			t.Fatalf("expected bearer credentials, got %+v", credentials.authConfig)
+		}
+		if e, a := "token-1", credentials.authConfig.BearerConfig.Token; e != a {
+			t.Fatalf("unexpected token: expected %q, got %q", e, a)
+		}
+	}
+	if e, a := 1, tokenRequests; e != a {
+		t.Fatalf("expected the token to be reused: expected %d token requests, got %d", e, a)
+	}
+
+	testController.clusterServiceBrokerDelete(broker)
+	if _, err := testController.getAuthCredentialsFromClusterServiceBroker(broker); err != nil {
+		t.Fatalf("unexpected error getting auth credentials: %v", err)
+	}
+	if e, a := 2, tokenRequests; e != a {
+		t.Fatalf("expected a new token after the broker was deleted: expected %d token requests, got %d", e, a)
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+)
+
+const (
+	// brokerRequestLimitedRetryDelay is how long a resource whose request
+	// was over a broker's concurrency limit waits before it is reconciled
+	// again. The delay is jittered by up to the same amount so that the
+	// resources held back by a burst are spread out when they are retried.
+	brokerRequestLimitedRetryDelay = time.Second
+
+	brokerRequestLimitConcurrency = "concurrency"
+	brokerRequestLimitRate        = "rate"
+
+	brokerScopeCluster   = "cluster"
+	brokerScopeNamespace = "namespace"
+)
+
+// brokerRequestLimiter enforces a broker's maxConcurrentRequests and
+// requestsPerSecond. Requests over either limit are not sent and do not
+// wait: they fail with a brokerRequestLimitedError, and the worker requeues
+// the resource being reconciled after a delay, so that a heavily limited
+// broker does not hold on to the workers shared by all brokers.
+type brokerRequestLimiter struct {
+	brokerName            string
+	brokerNamespace       string
+	maxConcurrentRequests int32
+	requestsPerSecond     int32
+
+	// concurrency has a slot for each request that may be in flight, or is
+	// nil if the number of concurrent requests is not limited.
+	concurrency chan struct{}
+	// rateLimiter is nil if the request rate is not limited.
+	rateLimiter flowcontrol.RateLimiter
+}
+
+// newBrokerRequestLimiter returns a limiter for the limits in a broker's
+// spec, or nil if the broker has no limits. The namespace is empty for
+// ClusterServiceBrokers.
+func newBrokerRequestLimiter(brokerNamespace, brokerName string, spec *v1beta1.CommonServiceBrokerSpec) *brokerRequestLimiter {
+	if spec.MaxConcurrentRequests == nil && spec.RequestsPerSecond == nil {
+		return nil
+	}
+
+	l := &brokerRequestLimiter{brokerName: brokerName, brokerNamespace: brokerNamespace}
+	if spec.MaxConcurrentRequests != nil && *spec.MaxConcurrentRequests > 0 {
+		l.maxConcurrentRequests = *spec.MaxConcurrentRequests
+		l.concurrency = make(chan struct{}, l.maxConcurrentRequests)
+	}
+	if spec.RequestsPerSecond != nil && *spec.RequestsPerSecond > 0 {
+		l.requestsPerSecond = *spec.RequestsPerSecond
+		l.rateLimiter = flowcontrol.NewTokenBucketRateLimiter(float32(l.requestsPerSecond), int(l.requestsPerSecond))
+	}
+	return l
+}
+
+// matches returns whether the limiter enforces the limits in a broker's spec.
+func (l *brokerRequestLimiter) matches(spec *v1beta1.CommonServiceBrokerSpec) bool {
+	return l.maxConcurrentRequests == int32Value(spec.MaxConcurrentRequests) &&
+		l.requestsPerSecond == int32Value(spec.RequestsPerSecond)
+}
+
+// metricLabels returns the broker, namespace and scope labels of the
+// limiter's metrics.
+func (l *brokerRequestLimiter) metricLabels() []string {
+	scope := brokerScopeCluster
+	if l.brokerNamespace != "" {
+		scope = brokerScopeNamespace
+	}
+	return []string{l.brokerName, l.brokerNamespace, scope}
+}
+
+// tryAcquire reserves the right to send a request to the broker without
+// waiting. It returns a brokerRequestLimitedError if the request is over
+// one of the broker's limits. Every successful call must be followed by a
+// call to release once the request completes.
+func (l *brokerRequestLimiter) tryAcquire() error {
+	labels := l.metricLabels()
+	if l.concurrency != nil {
+		select {
+		case l.concurrency <- struct{}{}:
+		default:
+			metrics.OSBRequestsLimited.WithLabelValues(append(labels, brokerRequestLimitConcurrency)...).Inc()
+			return &brokerRequestLimitedError{
+				brokerName: l.brokerName,
+				limit:      brokerRequestLimitConcurrency,
+				retryAfter: wait.Jitter(brokerRequestLimitedRetryDelay, 1.0),
+			}
+		}
+	}
+	if l.rateLimiter != nil && !l.rateLimiter.TryAccept() {
+		if l.concurrency != nil {
+			<-l.concurrency
+		}
+		metrics.OSBRequestsLimited.WithLabelValues(append(labels, brokerRequestLimitRate)...).Inc()
+		return &brokerRequestLimitedError{
+			brokerName: l.brokerName,
+			limit:      brokerRequestLimitRate,
+			retryAfter: wait.Jitter(time.Second/time.Duration(l.requestsPerSecond), 1.0),
+		}
+	}
+	metrics.OSBRequestsInFlight.WithLabelValues(labels...).Inc()
+	return nil
+}
+
+// release marks a request acquired with tryAcquire as completed.
+func (l *brokerRequestLimiter) release() {
+	if l.concurrency != nil {
+		<-l.concurrency
+	}
+	metrics.OSBRequestsInFlight.WithLabelValues(l.metricLabels()...).Dec()
+}
+
+// brokerRequestLimitedError is returned instead of sending a request that is
+// over one of a broker's limits. Reconcilers return it unchanged, without
+// recording a failure, since the broker was not contacted, and the worker
+// requeues the resource after retryAfter.
+type brokerRequestLimitedError struct {
+	brokerName string
+	limit      string
+	retryAfter time.Duration
+}
+
+func (e *brokerRequestLimitedError) Error() string {
+	return fmt.Sprintf("request to broker %q is over its %s limit; retrying after %v", e.brokerName, e.limit, e.retryAfter)
+}
+
+// isBrokerRequestLimitedError returns whether the error is a
+// brokerRequestLimitedError.
+func isBrokerRequestLimitedError(err error) bool {
+	_, ok := err.(*brokerRequestLimitedError)
+	return ok
+}
+
+func int32Value(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// limitedBrokerClient is an osb.Client that sends requests through a
+// brokerRequestLimiter.
+type limitedBrokerClient struct {
+	client  osb.Client
+	limiter *brokerRequestLimiter
+}
+
+var _ osb.Client = &limitedBrokerClient{}
+
+// CloseIdleConnections closes the idle connections of the wrapped client.
+func (c *limitedBrokerClient) CloseIdleConnections() {
+	closeIdleConnections(c.client)
+}
+
+func (c *limitedBrokerClient) GetCatalog() (*osb.CatalogResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.GetCatalog()
+}
+
+func (c *limitedBrokerClient) GetCatalogIfNoneMatch(etag string) (*osb.CatalogResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.GetCatalogIfNoneMatch(etag)
+}
+
+func (c *limitedBrokerClient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.ProvisionResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.ProvisionInstance(r)
+}
+
+func (c *limitedBrokerClient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateInstanceResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.UpdateInstance(r)
+}
+
+func (c *limitedBrokerClient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.DeprovisionResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.DeprovisionInstance(r)
+}
+
+func (c *limitedBrokerClient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastOperationResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.PollLastOperation(r)
+}
+
+func (c *limitedBrokerClient) PollBindingLastOperation(r *osb.BindingLastOperationRequest) (*osb.LastOperationResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.PollBindingLastOperation(r)
+}
+
+func (c *limitedBrokerClient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.Bind(r)
+}
+
+func (c *limitedBrokerClient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.Unbind(r)
+}
+
+func (c *limitedBrokerClient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.GetBinding(r)
+}
+
+func (c *limitedBrokerClient) GetInstance(r *osb.GetInstanceRequest) (*osb.GetInstanceResponse, error) {
+	if err := c.limiter.tryAcquire(); err != nil {
+		return nil, err
+	}
+	defer c.limiter.release()
+	return c.client.GetInstance(r)
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestNewBrokerRequestLimiter(t *testing.T) {
+	if l := newBrokerRequestLimiter("", "broker", &v1beta1.CommonServiceBrokerSpec{}); l != nil {
+		t.Fatalf("expected no limiter for a broker without limits, got %+v", l)
+	}
+
+	l := newBrokerRequestLimiter("", "broker", &v1beta1.CommonServiceBrokerSpec{MaxConcurrentRequests: int32Ptr(2)})
+	if l == nil || l.concurrency == nil || l.rateLimiter != nil {
+		t.Fatalf("expected a concurrency limit only, got %+v", l)
+	}
+
+	l = newBrokerRequestLimiter("", "broker", &v1beta1.CommonServiceBrokerSpec{RequestsPerSecond: int32Ptr(5)})
+	if l == nil || l.concurrency != nil || l.rateLimiter == nil {
+		t.Fatalf("expected a rate limit only, got %+v", l)
+	}
+}
+
+// TestBrokerRequestLimiterConcurrency ensures that no more than
+// maxConcurrentRequests requests are in flight at once, and that requests
+// over the limit fail instead of waiting.
+func TestBrokerRequestLimiterConcurrency(t *testing.T) {
+	l := newBrokerRequestLimiter("", "broker", &v1beta1.CommonServiceBrokerSpec{MaxConcurrentRequests: int32Ptr(2)})
+
+	for i := 0; i < 2; i++ {
+		if err := l.tryAcquire(); err != nil {
+			t.Fatalf("unexpected error acquiring request %d: %v", i, err)
+		}
+	}
+	err := l.tryAcquire()
+	limitedErr, ok := err.(*brokerRequestLimitedError)
+	if !ok {
+		t.Fatalf("expected a brokerRequestLimitedError over the limit, got %v", err)
+	}
+	if e, a := brokerRequestLimitConcurrency, limitedErr.limit; e != a {
+		t.Fatalf("unexpected limit: expected %q, got %q", e, a)
+	}
+	if limitedErr.retryAfter < brokerRequestLimitedRetryDelay || limitedErr.retryAfter > 2*brokerRequestLimitedRetryDelay {
+		t.Fatalf("unexpected retry delay %v", limitedErr.retryAfter)
+	}
+
+	l.release()
+	if err := l.tryAcquire(); err != nil {
+		t.Fatalf("unexpected error after a request completed: %v", err)
+	}
+}
+
+// TestBrokerRequestLimiterRate ensures that requests over requestsPerSecond
+// fail without taking a concurrency slot.
+func TestBrokerRequestLimiterRate(t *testing.T) {
+	l := newBrokerRequestLimiter("test-ns", "broker", &v1beta1.CommonServiceBrokerSpec{
+		MaxConcurrentRequests: int32Ptr(10),
+		RequestsPerSecond:     int32Ptr(2),
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := l.tryAcquire(); err != nil {
+			t.Fatalf("unexpected error acquiring request %d: %v", i, err)
+		}
+		l.release()
+	}
+	err := l.tryAcquire()
+	limitedErr, ok := err.(*brokerRequestLimitedError)
+	if !ok {
+		t.Fatalf("expected a brokerRequestLimitedError over the rate limit, got %v", err)
+	}
+	if e, a := brokerRequestLimitRate, limitedErr.limit; e != a {
+		t.Fatalf("unexpected limit: expected %q, got %q", e, a)
+	}
+	if e, a := 0, len(l.concurrency); e != a {
+		t.Fatalf("expected no concurrency slots to be held: expected %d, got %d", e, a)
+	}
+}
+
+// recordingQueue is a workqueue that records how keys are requeued.
+type recordingQueue struct {
+	workqueue.RateLimitingInterface
+	addedAfter  map[interface{}]time.Duration
+	rateLimited int
+}
+
+func (q *recordingQueue) AddAfter(item interface{}, duration time.Duration) {
+	q.addedAfter[item] = duration
+}
+
+func (q *recordingQueue) AddRateLimited(item interface{}) {
+	q.rateLimited++
+}
+
+// TestWorkerRequeuesLimitedKeys ensures that keys whose reconcile was over a
+// broker's request limits are requeued after the limiter's delay, without
+// counting as a retry.
+func TestWorkerRequeuesLimitedKeys(t *testing.T) {
+	queue := &recordingQueue{
+		RateLimitingInterface: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "test"),
+		addedAfter:            make(map[interface{}]time.Duration),
+	}
+	queue.Add("instance")
+	queue.ShutDown()
+
+	worker(queue, "ServiceInstance", maxRetries, true, func(key string) error {
+		return &brokerRequestLimitedError{brokerName: "broker", limit: brokerRequestLimitRate, retryAfter: 3 * time.Second}
+	}, nil)()
+
+	if e, a := 3*time.Second, queue.addedAfter["instance"]; e != a {
+		t.Fatalf("expected the key to be requeued after %v, got %v", e, a)
+	}
+	if queue.rateLimited != 0 || queue.NumRequeues("instance") != 0 {
+		t.Fatalf("expected the limited key not to count as a retry")
+	}
+}
+
+// TestReconcileServiceInstanceBrokerRequestLimited ensures that a provision
+// over the broker's request limits is not sent, records no failure and
+// returns the limiter's error so that the instance is requeued.
+func TestReconcileServiceInstanceBrokerRequestLimited(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
+	addGetNamespaceReaction(fakeKubeClient)
+
+	broker := getTestClusterServiceBroker()
+	broker.UID = types.UID("broker-uid")
+	broker.Spec.MaxConcurrentRequests = int32Ptr(1)
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	// Take the broker's only request slot.
+	limiter := testController.brokerClients.limiter(broker.UID, "", broker.Name, &broker.Spec.CommonServiceBrokerSpec)
+	if err := limiter.tryAcquire(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Status.CurrentOperation = v1beta1.ServiceInstanceOperationProvision
+	instance.Status.InProgressProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+	}
+
+	err := reconcileServiceInstance(t, testController, instance)
+	if !isBrokerRequestLimitedError(err) {
+		t.Fatalf("expected a brokerRequestLimitedError, got %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	limiter.release()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+}
+
+func TestBrokerClientCacheLimiter(t *testing.T) {
+	clientCache := newBrokerClientCache()
+	spec := &v1beta1.CommonServiceBrokerSpec{MaxConcurrentRequests: int32Ptr(1)}
+
+	l := clientCache.limiter("uid", "", "broker", spec)
+	if l == nil {
+		t.Fatal("expected a limiter")
+	}
+	if l2 := clientCache.limiter("uid", "", "broker", spec); l2 != l {
+		t.Fatal("expected the limiter to be reused while the limits are unchanged")
+	}
+
+	spec.MaxConcurrentRequests = int32Ptr(2)
+	l2 := clientCache.limiter("uid", "", "broker", spec)
+	if l2 == l {
+		t.Fatal("expected a new limiter after the limits changed")
+	}
+
+	clientCache.remove("uid")
+	if l3 := clientCache.limiter("uid", "", "broker", spec); l3 == l2 {
+		t.Fatal("expected a new limiter after removal")
+	}
+
+	if l := clientCache.limiter("uid", "", "broker", &v1beta1.CommonServiceBrokerSpec{}); l != nil {
+		t.Fatalf("expected no limiter once the limits are removed, got %+v", l)
+	}
+}
+
+// TestGetBrokerClientLimited ensures that the controller wraps the clients of
+// brokers with limits, and that the wrapped client calls through to the broker.
+func TestGetBrokerClientLimited(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+
+	fakeClient := fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Response: &osb.CatalogResponse{},
+		},
+	})
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		return fakeClient, nil
+	}
+
+	meta := metav1.ObjectMeta{Name: "broker", UID: "uid", Generation: 1}
+	client, err := testController.getBrokerClient(meta, &v1beta1.CommonServiceBrokerSpec{}, osb.LatestAPIVersion(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client != fakeClient {
+		t.Fatalf("expected the client of a broker without limits not to be wrapped, got %T", client)
+	}
+
+	meta.Generation = 2
+	spec := &v1beta1.CommonServiceBrokerSpec{RequestsPerSecond: int32Ptr(10)}
+	client, err = testController.getBrokerClient(meta, spec, osb.LatestAPIVersion(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := client.(*limitedBrokerClient); !ok {
+		t.Fatalf("expected a limited client, got %T", client)
+	}
+	if _, err := client.GetCatalog(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := fakeClient.Actions(); len(actions) != 1 || actions[0].Type != fakeosb.GetCatalog {
+		t.Fatalf("expected one GetCatalog call, got %+v", actions)
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package controller
 
 import (
-	"encoding/json"
+	"crypto/tls"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,25 +30,33 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	corev1 "k8s.io/api/core/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/brokerauth"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
 	servicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
 	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
 	listers "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
-	"github.com/kubernetes-incubator/service-catalog/pkg/filter"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+	"github.com/kubernetes-incubator/service-catalog/pkg/notifications"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+	"github.com/kubernetes-incubator/service-catalog/pkg/util"
 )
 
 const (
@@ -80,6 +90,7 @@ func NewController(
 	bindingInformer informers.ServiceBindingInformer,
 	clusterServicePlanInformer informers.ClusterServicePlanInformer,
 	servicePlanInformer informers.ServicePlanInformer,
+	secretInformer coreinformers.SecretInformer,
 	brokerClientCreateFunc osb.CreateFunc,
 	brokerRelistInterval time.Duration,
 	osbAPIPreferredVersion string,
@@ -88,11 +99,18 @@ func NewController(
 	operationPollingMaximumBackoffDuration time.Duration,
 	clusterIDConfigMapName string,
 	clusterIDConfigMapNamespace string,
+	shards ShardFilter,
+	notifier Notifier,
+	callbacks *BrokerCallbacks,
 ) (Controller, error) {
 	controller := &controller{
 		kubeClient:                  kubeClient,
 		serviceCatalogClient:        serviceCatalogClient,
 		brokerClientCreateFunc:      brokerClientCreateFunc,
+		brokerClients:               newBrokerClientCache(),
+		oauth2Tokens:                brokerauth.NewTokenCache(),
+		secretLister:                secretInformer.Lister(),
+		missingSecrets:              util.NewSecretNotFoundCache(),
 		brokerRelistInterval:        brokerRelistInterval,
 		OSBAPIPreferredVersion:      osbAPIPreferredVersion,
 		recorder:                    recorder,
@@ -103,34 +121,59 @@ func NewController(
 		serviceClassQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-class"),
 		clusterServicePlanQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-plan"),
 		servicePlanQueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-plan"),
-		instanceQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-instance"),
-		bindingQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-binding"),
-		instancePollingQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "instance-poller"),
-		bindingPollingQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "binding-poller"),
+		instanceQueue:               newNamedPriorityQueue(workqueue.DefaultControllerRateLimiter(), "service-instance"),
+		bindingQueue:                newNamedPriorityQueue(workqueue.DefaultControllerRateLimiter(), "service-binding"),
 		clusterIDConfigMapName:      clusterIDConfigMapName,
 		clusterIDConfigMapNamespace: clusterIDConfigMapNamespace,
+		shards:                      shards,
+		notifier:                    notifier,
+		callbacks:                   callbacks,
 	}
 
-	controller.clusterServiceBrokerLister = clusterServiceBrokerInformer.Lister()
-	clusterServiceBrokerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    controller.clusterServiceBrokerAdd,
-		UpdateFunc: controller.clusterServiceBrokerUpdate,
-		DeleteFunc: controller.clusterServiceBrokerDelete,
-	})
+	// The polling queues' rate limiters are not bounded; the maximum backoff,
+	// which may be overridden per broker, is applied when adding to them.
+	controller.operationPollingMaximumBackoffDuration = operationPollingMaximumBackoffDuration
+	controller.instancePollingRateLimiter = workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, unlimitedPollingBackoff)
+	controller.instancePollingQueue = workqueue.NewNamedRateLimitingQueue(controller.instancePollingRateLimiter, "instance-poller")
+	controller.bindingPollingRateLimiter = workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, unlimitedPollingBackoff)
+	controller.bindingPollingQueue = workqueue.NewNamedRateLimitingQueue(controller.bindingPollingRateLimiter, "binding-poller")
 
-	controller.clusterServiceClassLister = clusterServiceClassInformer.Lister()
-	clusterServiceClassInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    controller.clusterServiceClassAdd,
-		UpdateFunc: controller.clusterServiceClassUpdate,
-		DeleteFunc: controller.clusterServiceClassDelete,
-	})
+	preferredOSBAPIVersion, err := parseOSBAPIVersion(osbAPIPreferredVersion)
+	if err != nil {
+		return nil, err
+	}
+	controller.preferredOSBAPIVersion = preferredOSBAPIVersion
+
+	// Cluster-scoped resources are ignored when their informers are not
+	// given, such as by a controller restricted to a set of namespaces; the
+	// listers are left empty so that nothing refers to them.
+	if clusterServiceBrokerInformer == nil || clusterServiceClassInformer == nil || clusterServicePlanInformer == nil {
+		controller.ignoreClusterScoped = true
+		controller.clusterServiceBrokerLister = listers.NewClusterServiceBrokerLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+		controller.clusterServiceClassLister = listers.NewClusterServiceClassLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+		controller.clusterServicePlanLister = listers.NewClusterServicePlanLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	} else {
+		controller.clusterServiceBrokerLister = clusterServiceBrokerInformer.Lister()
+		clusterServiceBrokerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    controller.clusterServiceBrokerAdd,
+			UpdateFunc: controller.clusterServiceBrokerUpdate,
+			DeleteFunc: controller.clusterServiceBrokerDelete,
+		})
 
-	controller.clusterServicePlanLister = clusterServicePlanInformer.Lister()
-	clusterServicePlanInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    controller.clusterServicePlanAdd,
-		UpdateFunc: controller.clusterServicePlanUpdate,
-		DeleteFunc: controller.clusterServicePlanDelete,
-	})
+		controller.clusterServiceClassLister = clusterServiceClassInformer.Lister()
+		clusterServiceClassInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    controller.clusterServiceClassAdd,
+			UpdateFunc: controller.clusterServiceClassUpdate,
+			DeleteFunc: controller.clusterServiceClassDelete,
+		})
+
+		controller.clusterServicePlanLister = clusterServicePlanInformer.Lister()
+		clusterServicePlanInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    controller.clusterServicePlanAdd,
+			UpdateFunc: controller.clusterServicePlanUpdate,
+			DeleteFunc: controller.clusterServicePlanDelete,
+		})
+	}
 
 	controller.instanceLister = instanceInformer.Lister()
 	instanceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -166,6 +209,10 @@ func NewController(
 			DeleteFunc: controller.servicePlanDelete,
 		})
 	}
+	if shards != nil {
+		shards.AddAcquiredHandler(controller.enqueueShard)
+	}
+
 	controller.instanceOperationRetryQueue.instances = make(map[string]backoffEntry)
 	controller.instanceOperationRetryQueue.rateLimiter = workqueue.NewItemExponentialFailureRateLimiter(minBrokerOperationRetryDelay, maxBrokerOperationRetryDelay)
 	return controller, nil
@@ -180,11 +227,38 @@ type Controller interface {
 	Run(workers int, stopCh <-chan struct{})
 }
 
+// ShardFilter restricts the keys a controller reconciles to those of the
+// shards of the keyspace it owns, when several controllers run at the same
+// time.
+type ShardFilter interface {
+	// ShardForKey returns the shard of a workqueue key.
+	ShardForKey(key string) int
+	// TryLock returns whether the controller owns the shard. If it does,
+	// the shard is not given up until Unlock is called.
+	TryLock(shard int) bool
+	// Unlock allows a shard locked with TryLock to be given up.
+	Unlock(shard int)
+	// AddAcquiredHandler registers a function called with each shard the
+	// controller takes.
+	AddAcquiredHandler(handler func(shard int))
+}
+
+// Notifier is notified of the lifecycle events of the resources the
+// controller reconciles.
+type Notifier interface {
+	// Notify delivers an event. It must not block.
+	Notify(event *notifications.Event)
+}
+
 // controller is a concrete Controller.
 type controller struct {
 	kubeClient                  kubernetes.Interface
 	serviceCatalogClient        servicecatalogclientset.ServicecatalogV1beta1Interface
 	brokerClientCreateFunc      osb.CreateFunc
+	brokerClients               *brokerClientCache
+	oauth2Tokens                *brokerauth.TokenCache
+	secretLister                corelisters.SecretLister
+	missingSecrets              *util.SecretNotFoundCache
 	clusterServiceBrokerLister  listers.ClusterServiceBrokerLister
 	serviceBrokerLister         listers.ServiceBrokerLister
 	clusterServiceClassLister   listers.ClusterServiceClassLister
@@ -203,10 +277,21 @@ type controller struct {
 	serviceClassQueue           workqueue.RateLimitingInterface
 	clusterServicePlanQueue     workqueue.RateLimitingInterface
 	servicePlanQueue            workqueue.RateLimitingInterface
-	instanceQueue               workqueue.RateLimitingInterface
-	bindingQueue                workqueue.RateLimitingInterface
+	instanceQueue               priorityRateLimitingInterface
+	bindingQueue                priorityRateLimitingInterface
 	instancePollingQueue        workqueue.RateLimitingInterface
 	bindingPollingQueue         workqueue.RateLimitingInterface
+	// instancePollingRateLimiter and bindingPollingRateLimiter are the
+	// rate limiters of the polling queues. Their maximum delay is applied
+	// per broker when adding to the queues.
+	instancePollingRateLimiter workqueue.RateLimiter
+	bindingPollingRateLimiter  workqueue.RateLimiter
+	// operationPollingMaximumBackoffDuration is the default maximum amount
+	// of time between polls of an asynchronous operation.
+	operationPollingMaximumBackoffDuration time.Duration
+	// preferredOSBAPIVersion is the newest OSB API version negotiated with
+	// brokers.
+	preferredOSBAPIVersion osb.APIVersion
 	// clusterIDConfigMapName is the k8s name that the clusterid
 	// configmap will have.
 	clusterIDConfigMapName string
@@ -223,6 +308,19 @@ type controller struct {
 	// readers passing the clusterID to a broker.
 	clusterIDLock               sync.RWMutex
 	instanceOperationRetryQueue instanceOperationBackoff
+	// shards restricts the keys reconciled to those of the shards this
+	// controller owns. It is nil when the controller is not sharded.
+	shards ShardFilter
+	// notifier is notified of lifecycle events. It is nil when lifecycle
+	// events are not delivered.
+	notifier Notifier
+	// callbacks is the endpoint brokers call to report the completion of
+	// asynchronous operations. It is nil when broker callbacks are
+	// disabled.
+	callbacks *BrokerCallbacks
+	// ignoreClusterScoped is whether instances and bindings of cluster-scoped
+	// classes and plans are left for another controller to reconcile.
+	ignoreClusterScoped bool
 }
 
 // Run runs the controller until the given stop channel can be read from.
@@ -231,24 +329,28 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 
 	glog.Info("Starting service-catalog controller")
 
+	if c.callbacks != nil {
+		c.callbacks.setController(c)
+	}
+
 	var waitGroup sync.WaitGroup
 
 	for i := 0; i < workers; i++ {
-		createWorker(c.clusterServiceBrokerQueue, "ClusterServiceBroker", maxRetries, true, c.reconcileClusterServiceBrokerKey, stopCh, &waitGroup)
-		createWorker(c.clusterServiceClassQueue, "ClusterServiceClass", maxRetries, true, c.reconcileClusterServiceClassKey, stopCh, &waitGroup)
-		createWorker(c.clusterServicePlanQueue, "ClusterServicePlan", maxRetries, true, c.reconcileClusterServicePlanKey, stopCh, &waitGroup)
-		createWorker(c.instanceQueue, "ServiceInstance", maxRetries, true, c.reconcileServiceInstanceKey, stopCh, &waitGroup)
-		createWorker(c.bindingQueue, "ServiceBinding", maxRetries, true, c.reconcileServiceBindingKey, stopCh, &waitGroup)
-		createWorker(c.instancePollingQueue, "InstancePoller", maxRetries, false, c.requeueServiceInstanceForPoll, stopCh, &waitGroup)
+		createWorker(c.clusterServiceBrokerQueue, "ClusterServiceBroker", maxRetries, true, c.reconcileClusterServiceBrokerKey, c.shards, stopCh, &waitGroup)
+		createWorker(c.clusterServiceClassQueue, "ClusterServiceClass", maxRetries, true, c.reconcileClusterServiceClassKey, c.shards, stopCh, &waitGroup)
+		createWorker(c.clusterServicePlanQueue, "ClusterServicePlan", maxRetries, true, c.reconcileClusterServicePlanKey, c.shards, stopCh, &waitGroup)
+		createWorker(c.instanceQueue, "ServiceInstance", maxRetries, true, c.reconcileServiceInstanceKey, c.shards, stopCh, &waitGroup)
+		createWorker(c.bindingQueue, "ServiceBinding", maxRetries, true, c.reconcileServiceBindingKey, c.shards, stopCh, &waitGroup)
+		createWorker(c.instancePollingQueue, "InstancePoller", maxRetries, false, c.requeueServiceInstanceForPoll, c.shards, stopCh, &waitGroup)
 
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
-			createWorker(c.serviceBrokerQueue, "ServiceBroker", maxRetries, true, c.reconcileServiceBrokerKey, stopCh, &waitGroup)
-			createWorker(c.serviceClassQueue, "ServiceClass", maxRetries, true, c.reconcileServiceClassKey, stopCh, &waitGroup)
-			createWorker(c.servicePlanQueue, "ServicePlan", maxRetries, true, c.reconcileServicePlanKey, stopCh, &waitGroup)
+			createWorker(c.serviceBrokerQueue, "ServiceBroker", maxRetries, true, c.reconcileServiceBrokerKey, c.shards, stopCh, &waitGroup)
+			createWorker(c.serviceClassQueue, "ServiceClass", maxRetries, true, c.reconcileServiceClassKey, c.shards, stopCh, &waitGroup)
+			createWorker(c.servicePlanQueue, "ServicePlan", maxRetries, true, c.reconcileServicePlanKey, c.shards, stopCh, &waitGroup)
 		}
 
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
-			createWorker(c.bindingPollingQueue, "BindingPoller", maxRetries, false, c.requeueServiceBindingForPoll, stopCh, &waitGroup)
+			createWorker(c.bindingPollingQueue, "BindingPoller", maxRetries, false, c.requeueServiceBindingForPoll, c.shards, stopCh, &waitGroup)
 		}
 	}
 
@@ -286,10 +388,10 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 // createWorker creates and runs a worker thread that just processes items in the
 // specified queue. The worker will run until stopCh is closed. The worker will be
 // added to the wait group when started and marked done when finished.
-func createWorker(queue workqueue.RateLimitingInterface, resourceType string, maxRetries int, forgetAfterSuccess bool, reconciler func(key string) error, stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+func createWorker(queue workqueue.RateLimitingInterface, resourceType string, maxRetries int, forgetAfterSuccess bool, reconciler func(key string) error, shards ShardFilter, stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
 	waitGroup.Add(1)
 	go func() {
-		wait.Until(worker(queue, resourceType, maxRetries, forgetAfterSuccess, reconciler), time.Second, stopCh)
+		wait.Until(worker(queue, resourceType, maxRetries, forgetAfterSuccess, reconciler, shards), time.Second, stopCh)
 		waitGroup.Done()
 	}()
 }
@@ -362,7 +464,9 @@ func (c *controller) monitorConfigMap() {
 // It enforces that the reconciler is never invoked concurrently with the same key.
 // If forgetAfterSuccess is true, it will cause the queue to forget the item should reconciliation
 // have no error.
-func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetries int, forgetAfterSuccess bool, reconciler func(key string) error) func() {
+// If shards is not nil, keys of shards the controller does not own are dropped; the controller
+// that owns them reconciles them.
+func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetries int, forgetAfterSuccess bool, reconciler func(key string) error, shards ShardFilter) func() {
 	return func() {
 		exit := false
 		for !exit {
@@ -373,7 +477,35 @@ func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetri
 				}
 				defer queue.Done(key)
 
+				shard := 0
+				if shards != nil {
+					shard = shards.ShardForKey(key.(string))
+					if !shards.TryLock(shard) {
+						glog.V(5).Infof("Skipping %s %q of shard %d, which is owned by another controller", resourceType, key, shard)
+						metrics.WorkQueueSkippedCount.WithLabelValues(resourceType, strconv.Itoa(shard)).Inc()
+						queue.Forget(key)
+						return false
+					}
+					defer shards.Unlock(shard)
+				}
+
+				startTime := time.Now()
 				err := reconciler(key.(string))
+				metrics.WorkQueueProcessingDuration.WithLabelValues(resourceType, strconv.Itoa(shard)).Observe(time.Since(startTime).Seconds())
+				result := "success"
+				if limitedErr, ok := err.(*brokerRequestLimitedError); ok {
+					result = "limited"
+					metrics.WorkQueueProcessedCount.WithLabelValues(resourceType, strconv.Itoa(shard), result).Inc()
+					// The broker was not contacted, so this does not count
+					// as a retry.
+					glog.V(5).Infof("Requeueing %s %q: %v", resourceType, key, err)
+					queue.AddAfter(key, limitedErr.retryAfter)
+					return false
+				}
+				if err != nil {
+					result = "error"
+				}
+				metrics.WorkQueueProcessedCount.WithLabelValues(resourceType, strconv.Itoa(shard), result).Inc()
 				if err == nil {
 					if forgetAfterSuccess {
 						queue.Forget(key)
@@ -396,6 +528,67 @@ func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetri
 	}
 }
 
+// enqueueShard adds the keys of every resource in a shard the controller has
+// just taken to the workqueues. Their events may have been dropped while
+// another controller owned the shard.
+func (c *controller) enqueueShard(shard int) {
+	glog.V(4).Infof("Enqueueing the resources of shard %d", shard)
+	enqueue := func(queue workqueue.RateLimitingInterface, obj interface{}) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+			return
+		}
+		if c.shards.ShardForKey(key) == shard {
+			queue.Add(key)
+		}
+	}
+
+	if brokers, err := c.clusterServiceBrokerLister.List(labels.Everything()); err == nil {
+		for _, broker := range brokers {
+			enqueue(c.clusterServiceBrokerQueue, broker)
+		}
+	}
+	if classes, err := c.clusterServiceClassLister.List(labels.Everything()); err == nil {
+		for _, class := range classes {
+			enqueue(c.clusterServiceClassQueue, class)
+		}
+	}
+	if plans, err := c.clusterServicePlanLister.List(labels.Everything()); err == nil {
+		for _, plan := range plans {
+			enqueue(c.clusterServicePlanQueue, plan)
+		}
+	}
+	if instances, err := c.instanceLister.List(labels.Everything()); err == nil {
+		for _, instance := range instances {
+			enqueue(c.instanceQueue, instance)
+		}
+	}
+	if bindings, err := c.bindingLister.List(labels.Everything()); err == nil {
+		for _, binding := range bindings {
+			enqueue(c.bindingQueue, binding)
+		}
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
+		if brokers, err := c.serviceBrokerLister.List(labels.Everything()); err == nil {
+			for _, broker := range brokers {
+				enqueue(c.serviceBrokerQueue, broker)
+			}
+		}
+		if classes, err := c.serviceClassLister.List(labels.Everything()); err == nil {
+			for _, class := range classes {
+				enqueue(c.serviceClassQueue, class)
+			}
+		}
+		if plans, err := c.servicePlanLister.List(labels.Everything()); err == nil {
+			for _, plan := range plans {
+				enqueue(c.servicePlanQueue, plan)
+			}
+		}
+	}
+}
+
 // operationError is a user-facing error that can be easily embedded in a
 // resource's Condition.
 type operationError struct {
@@ -485,7 +678,7 @@ func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1b
 
 	}
 
-	authConfig, err := getAuthCredentialsFromClusterServiceBroker(c.kubeClient, broker)
+	credentials, err := c.getAuthCredentialsFromClusterServiceBroker(broker)
 	if err != nil {
 		return nil, "", nil, &operationError{
 			reason: errorAuthCredentialsReason,
@@ -496,9 +689,8 @@ func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1b
 		}
 	}
 
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-	glog.V(4).Info(pcb.Messagef("Creating client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL))
-	brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+	glog.V(4).Info(pcb.Messagef("Getting client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL))
+	brokerClient, err := c.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, c.osbAPIVersionForBroker(&broker.Status.CommonServiceBrokerStatus), credentials)
 	if err != nil {
 		return nil, "", nil, err
 	}
@@ -534,7 +726,7 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 
 	}
 
-	authConfig, err := getAuthCredentialsFromServiceBroker(c.kubeClient, broker)
+	credentials, err := c.getAuthCredentialsFromServiceBroker(broker)
 	if err != nil {
 		return nil, "", nil, &operationError{
 			reason: errorAuthCredentialsReason,
@@ -545,9 +737,8 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 		}
 	}
 
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-	glog.V(4).Info(pcb.Messagef("Creating client for ServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL))
-	brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+	glog.V(4).Info(pcb.Messagef("Getting client for ServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL))
+	brokerClient, err := c.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, c.osbAPIVersionForBroker(&broker.Status.CommonServiceBrokerStatus), credentials)
 	if err != nil {
 		return nil, "", nil, err
 	}
@@ -672,7 +863,7 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 		}
 
 		pcb := pretty.NewInstanceContextBuilder(instance)
-		authConfig, err := getAuthCredentialsFromClusterServiceBroker(c.kubeClient, broker)
+		credentials, err := c.getAuthCredentialsFromClusterServiceBroker(broker)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker auth credentials for broker %q: %s", broker.Name, err)
 			glog.Warning(pcb.Message(s))
@@ -687,10 +878,8 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 			return nil, err
 		}
 
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
-		glog.V(4).Infof("Creating client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL)
-		brokerClient, err = c.brokerClientCreateFunc(clientConfig)
+		glog.V(4).Infof("Getting client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL)
+		brokerClient, err = c.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, c.osbAPIVersionForBroker(&broker.Status.CommonServiceBrokerStatus), credentials)
 		if err != nil {
 			return nil, err
 		}
@@ -708,7 +897,7 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 		}
 
 		pcb := pretty.NewInstanceContextBuilder(instance)
-		authConfig, err := getAuthCredentialsFromServiceBroker(c.kubeClient, broker)
+		credentials, err := c.getAuthCredentialsFromServiceBroker(broker)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker auth credentials for broker %q: %s", broker.Name, err)
 			glog.Warning(pcb.Message(s))
@@ -723,10 +912,8 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 			return nil, err
 		}
 
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
-		glog.V(4).Infof("Creating client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL)
-		brokerClient, err = c.brokerClientCreateFunc(clientConfig)
+		glog.V(4).Infof("Getting client for ClusterServiceBroker %v, URL: %v", broker.Name, broker.Spec.URL)
+		brokerClient, err = c.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, c.osbAPIVersionForBroker(&broker.Status.CommonServiceBrokerStatus), credentials)
 		if err != nil {
 			return nil, err
 		}
@@ -736,10 +923,23 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 }
 
 // Broker utility methods - move?
-// getAuthCredentialsFromClusterServiceBroker returns the auth credentials, if any, or
-// returns an error. If the AuthInfo field is nil, empty values are
-// returned.
-func getAuthCredentialsFromClusterServiceBroker(client kubernetes.Interface, broker *v1beta1.ClusterServiceBroker) (*osb.AuthConfig, error) {
+
+// brokerCredentials are the credentials the controller presents to a broker.
+type brokerCredentials struct {
+	// authConfig holds the basic auth or bearer token credentials sent with
+	// each request.
+	authConfig *osb.AuthConfig
+	// clientCertificate is the TLS client certificate presented to the
+	// broker.
+	clientCertificate *tls.Certificate
+	// version identifies the credentials. A broker's cached client is
+	// replaced when it changes.
+	version string
+}
+
+// getAuthCredentialsFromClusterServiceBroker returns the auth credentials, if
+// any, or returns an error. If the AuthInfo field is nil, nil is returned.
+func (c *controller) getAuthCredentialsFromClusterServiceBroker(broker *v1beta1.ClusterServiceBroker) (*brokerCredentials, error) {
 	if broker.Spec.AuthInfo == nil {
 		return nil, nil
 	}
@@ -747,7 +947,7 @@ func getAuthCredentialsFromClusterServiceBroker(client kubernetes.Interface, bro
 	authInfo := broker.Spec.AuthInfo
 	if authInfo.Basic != nil {
 		secretRef := authInfo.Basic.SecretRef
-		secret, err := client.CoreV1().Secrets(secretRef.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+		secret, err := c.getBrokerAuthSecret(secretRef.Namespace, secretRef.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -755,12 +955,15 @@ func getAuthCredentialsFromClusterServiceBroker(client kubernetes.Interface, bro
 		if err != nil {
 			return nil, err
 		}
-		return &osb.AuthConfig{
-			BasicAuthConfig: basicAuthConfig,
+		return &brokerCredentials{
+			authConfig: &osb.AuthConfig{
+				BasicAuthConfig: basicAuthConfig,
+			},
+			version: secret.ResourceVersion,
 		}, nil
 	} else if authInfo.Bearer != nil {
 		secretRef := authInfo.Bearer.SecretRef
-		secret, err := client.CoreV1().Secrets(secretRef.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+		secret, err := c.getBrokerAuthSecret(secretRef.Namespace, secretRef.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -768,16 +971,40 @@ func getAuthCredentialsFromClusterServiceBroker(client kubernetes.Interface, bro
 		if err != nil {
 			return nil, err
 		}
-		return &osb.AuthConfig{
-			BearerConfig: bearerConfig,
+		return &brokerCredentials{
+			authConfig: &osb.AuthConfig{
+				BearerConfig: bearerConfig,
+			},
+			version: secret.ResourceVersion,
 		}, nil
+	} else if authInfo.ClientCertificate != nil {
+		secretRef := authInfo.ClientCertificate.SecretRef
+		secret, err := c.getBrokerAuthSecret(secretRef.Namespace, secretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		clientCertificate, err := brokerauth.ClientCertificateFromSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		return &brokerCredentials{
+			clientCertificate: clientCertificate,
+			version:           secret.ResourceVersion,
+		}, nil
+	} else if authInfo.OAuth2ClientCredentials != nil {
+		oauth2Config := authInfo.OAuth2ClientCredentials
+		secret, err := c.getBrokerAuthSecret(oauth2Config.SecretRef.Namespace, oauth2Config.SecretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return c.getOAuth2Credentials(broker.UID, &broker.Spec.CommonServiceBrokerSpec, oauth2Config.TokenURL, oauth2Config.Scopes, secret)
 	}
 	return nil, fmt.Errorf("empty auth info or unsupported auth mode: %s", authInfo)
 }
 
 // getAuthCredentialsFromServiceBroker returns the auth credentials, if any, or
-// returns an error. If the AuthInfo field is nil, empty values are returned.
-func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1beta1.ServiceBroker) (*osb.AuthConfig, error) {
+// returns an error. If the AuthInfo field is nil, nil is returned.
+func (c *controller) getAuthCredentialsFromServiceBroker(broker *v1beta1.ServiceBroker) (*brokerCredentials, error) {
 	if broker.Spec.AuthInfo == nil {
 		return nil, nil
 	}
@@ -785,7 +1012,7 @@ func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1
 	authInfo := broker.Spec.AuthInfo
 	if authInfo.Basic != nil {
 		secretRef := authInfo.Basic.SecretRef
-		secret, err := client.CoreV1().Secrets(broker.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+		secret, err := c.getBrokerAuthSecret(broker.Namespace, secretRef.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -793,12 +1020,15 @@ func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1
 		if err != nil {
 			return nil, err
 		}
-		return &osb.AuthConfig{
-			BasicAuthConfig: basicAuthConfig,
+		return &brokerCredentials{
+			authConfig: &osb.AuthConfig{
+				BasicAuthConfig: basicAuthConfig,
+			},
+			version: secret.ResourceVersion,
 		}, nil
 	} else if authInfo.Bearer != nil {
 		secretRef := authInfo.Bearer.SecretRef
-		secret, err := client.CoreV1().Secrets(broker.Namespace).Get(secretRef.Name, metav1.GetOptions{})
+		secret, err := c.getBrokerAuthSecret(broker.Namespace, secretRef.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -806,418 +1036,145 @@ func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1
 		if err != nil {
 			return nil, err
 		}
-		return &osb.AuthConfig{
-			BearerConfig: bearerConfig,
+		return &brokerCredentials{
+			authConfig: &osb.AuthConfig{
+				BearerConfig: bearerConfig,
+			},
+			version: secret.ResourceVersion,
 		}, nil
-	}
-	return nil, fmt.Errorf("empty auth info or unsupported auth mode: %s", authInfo)
-}
-
-func getBasicAuthConfig(secret *corev1.Secret) (*osb.BasicAuthConfig, error) {
-	usernameBytes, ok := secret.Data["username"]
-	if !ok {
-		return nil, fmt.Errorf("auth secret didn't contain username")
-	}
-
-	passwordBytes, ok := secret.Data["password"]
-	if !ok {
-		return nil, fmt.Errorf("auth secret didn't contain password")
-	}
-
-	return &osb.BasicAuthConfig{
-		Username: string(usernameBytes),
-		Password: string(passwordBytes),
-	}, nil
-}
-
-func getBearerConfig(secret *corev1.Secret) (*osb.BearerConfig, error) {
-	tokenBytes, ok := secret.Data["token"]
-	if !ok {
-		return nil, fmt.Errorf("auth secret didn't contain token")
-	}
-
-	return &osb.BearerConfig{
-		Token: string(tokenBytes),
-	}, nil
-}
-
-// convertAndFilterCatalogToNamespacedTypes converts a service broker catalog
-// into an array of ServiceClasses and an array of ServicePlans and filters
-// these through the restrictions provided. The ServiceClasses and
-// ServicePlans returned by this method are named in K8S with the OSB ID.
-func convertAndFilterCatalogToNamespacedTypes(namespace string, in *osb.CatalogResponse, restrictions *v1beta1.CatalogRestrictions) ([]*v1beta1.ServiceClass, []*v1beta1.ServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServiceClass) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServiceClass)
+	} else if authInfo.ClientCertificate != nil {
+		secretRef := authInfo.ClientCertificate.SecretRef
+		secret, err := c.getBrokerAuthSecret(broker.Namespace, secretRef.Name)
 		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		predicate = filter.NewPredicate()
-	}
-
-	serviceClasses := []*v1beta1.ServiceClass(nil)
-	servicePlans := []*v1beta1.ServicePlan(nil)
-	for _, svc := range in.Services {
-		serviceClass := &v1beta1.ServiceClass{
-			Spec: v1beta1.ServiceClassSpec{
-				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
-					Bindable:      svc.Bindable,
-					PlanUpdatable: svc.PlanUpdatable != nil && *svc.PlanUpdatable,
-					ExternalID:    svc.ID,
-					ExternalName:  svc.Name,
-					Tags:          svc.Tags,
-					Description:   svc.Description,
-					Requires:      svc.Requires,
-				},
-			},
-		}
-
-		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
-			serviceClass.Spec.BindingRetrievable = svc.BindingsRetrievable
+			return nil, err
 		}
-
-		if svc.Metadata != nil {
-			metadata, err := json.Marshal(svc.Metadata)
-			if err != nil {
-				err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", svc.Metadata, err)
-				glog.Error(err)
-				return nil, nil, err
-			}
-			serviceClass.Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
+		clientCertificate, err := brokerauth.ClientCertificateFromSecret(secret)
+		if err != nil {
+			return nil, err
 		}
-		serviceClass.SetName(svc.ID)
-		serviceClass.SetNamespace(namespace)
-
-		// If this service class passes the predicate, process the plans for the class.
-		if fields := v1beta1.ConvertServiceClassToProperties(serviceClass); predicate.Accepts(fields) {
-			// set up the plans using the ServiceClass Name
-			plans, err := convertServicePlans(namespace, svc.Plans, serviceClass.Name)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			acceptedPlans, _, err := filterNamespacedServicePlans(restrictions, plans)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			// If there are accepted plans, then append the class and all of the accepted plans to the master list.
-			if len(acceptedPlans) > 0 {
-				serviceClasses = append(serviceClasses, serviceClass)
-				servicePlans = append(servicePlans, acceptedPlans...)
-			}
+		return &brokerCredentials{
+			clientCertificate: clientCertificate,
+			version:           secret.ResourceVersion,
+		}, nil
+	} else if authInfo.OAuth2ClientCredentials != nil {
+		oauth2Config := authInfo.OAuth2ClientCredentials
+		secret, err := c.getBrokerAuthSecret(broker.Namespace, oauth2Config.SecretRef.Name)
+		if err != nil {
+			return nil, err
 		}
+		return c.getOAuth2Credentials(broker.UID, &broker.Spec.CommonServiceBrokerSpec, oauth2Config.TokenURL, oauth2Config.Scopes, secret)
 	}
-	return serviceClasses, servicePlans, nil
+	return nil, fmt.Errorf("empty auth info or unsupported auth mode: %s", authInfo)
 }
 
-// convertAndFilterCatalog converts a service broker catalog into an array of
-// ClusterServiceClasses and an array of ClusterServicePlans and filters these
-// through the restrictions provided. The ClusterServiceClasses and
-// ClusterServicePlans returned by this method are named in K8S with the OSB ID.
-func convertAndFilterCatalog(in *osb.CatalogResponse, restrictions *v1beta1.CatalogRestrictions) ([]*v1beta1.ClusterServiceClass, []*v1beta1.ClusterServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServiceClass) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServiceClass)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		predicate = filter.NewPredicate()
-	}
-
-	serviceClasses := []*v1beta1.ClusterServiceClass(nil)
-	servicePlans := []*v1beta1.ClusterServicePlan(nil)
-	for _, svc := range in.Services {
-		serviceClass := &v1beta1.ClusterServiceClass{
-			Spec: v1beta1.ClusterServiceClassSpec{
-				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
-					Bindable:      svc.Bindable,
-					PlanUpdatable: svc.PlanUpdatable != nil && *svc.PlanUpdatable,
-					ExternalID:    svc.ID,
-					ExternalName:  svc.Name,
-					Tags:          svc.Tags,
-					Description:   svc.Description,
-					Requires:      svc.Requires,
-				},
-			},
-		}
-
-		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
-			serviceClass.Spec.BindingRetrievable = svc.BindingsRetrievable
-		}
-
-		if svc.Metadata != nil {
-			metadata, err := json.Marshal(svc.Metadata)
-			if err != nil {
-				err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", svc.Metadata, err)
-				glog.Error(err)
-				return nil, nil, err
-			}
-			serviceClass.Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
-		}
-		serviceClass.SetName(svc.ID)
-
-		// If this service class passes the predicate, process the plans for the class.
-		if fields := v1beta1.ConvertClusterServiceClassToProperties(serviceClass); predicate.Accepts(fields) {
-			// set up the plans using the ClusterServiceClass Name
-			plans, err := convertClusterServicePlans(svc.Plans, serviceClass.Name)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			acceptedPlans, _, err := filterServicePlans(restrictions, plans)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			// If there are accepted plans, then append the class and all of the accepted plans to the master list.
-			if len(acceptedPlans) > 0 {
-				serviceClasses = append(serviceClasses, serviceClass)
-				servicePlans = append(servicePlans, acceptedPlans...)
-			}
-		}
+// getOAuth2Credentials returns bearer token credentials for a broker using
+// OAuth2 client credentials auth. The token is obtained from the token
+// endpoint with the client ID and secret held in the given secret, and is
+// cached until shortly before it expires. The token endpoint is contacted
+// with the broker's TLS settings, through an HTTP client that is reused for
+// as long as they do not change.
+func (c *controller) getOAuth2Credentials(uid types.UID, commonSpec *v1beta1.CommonServiceBrokerSpec, tokenURL string, scopes []string, secret *corev1.Secret) (*brokerCredentials, error) {
+	clientID, clientSecret, err := brokerauth.ClientCredentialsFromSecret(secret)
+	if err != nil {
+		return nil, err
 	}
-	return serviceClasses, servicePlans, nil
-}
 
-func filterNamespacedServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans []*v1beta1.ServicePlan) ([]*v1beta1.ServicePlan, []*v1beta1.ServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServicePlan) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServicePlan)
+	source := fmt.Sprintf("%s/%s@%s %s %s", secret.Namespace, secret.Name, secret.ResourceVersion, tokenURL, strings.Join(scopes, " "))
+	token, err := c.oauth2Tokens.Token(string(uid), source, func() (*brokerauth.Token, error) {
+		httpClient, err := c.oauth2Tokens.HTTPClient(string(uid), commonSpec.CABundle, commonSpec.InsecureSkipTLSVerify)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-	} else {
-		predicate = filter.NewPredicate()
+		return brokerauth.FetchClientCredentialsToken(httpClient, tokenURL, clientID, clientSecret, scopes)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// If the predicate is empty, all plans will pass. No need to run through the list.
-	if predicate.Empty() {
-		return servicePlans, []*v1beta1.ServicePlan(nil), nil
-	}
+	return &brokerCredentials{
+		authConfig: &osb.AuthConfig{
+			BearerConfig: &osb.BearerConfig{
+				Token: token.AccessToken,
+			},
+		},
+		version: fmt.Sprintf("%s@%d", secret.ResourceVersion, token.Obtained.UnixNano()),
+	}, nil
+}
 
-	accepted := []*v1beta1.ServicePlan(nil)
-	rejected := []*v1beta1.ServicePlan(nil)
-	for _, sp := range servicePlans {
-		fields := v1beta1.ConvertServicePlanToProperties(sp)
-		if predicate.Accepts(fields) {
-			accepted = append(accepted, sp)
-		} else {
-			rejected = append(rejected, sp)
-		}
+// getBrokerAuthSecret returns a broker's auth secret from the shared secret
+// informer. The secret is read from the API server if the informer has not
+// seen it yet, which happens when a broker is created right after its secret.
+// A secret the API server does not have either is briefly remembered as
+// missing, so that brokers referencing it do not read it on every sync.
+func (c *controller) getBrokerAuthSecret(namespace, name string) (*corev1.Secret, error) {
+	secret, err := c.secretLister.Secrets(namespace).Get(name)
+	if err == nil {
+		return secret, nil
 	}
-
-	return accepted, rejected, nil
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return c.missingSecrets.Get(c.kubeClient, namespace, name)
 }
 
-func filterServicePlans(restrictions *v1beta1.CatalogRestrictions, servicePlans []*v1beta1.ClusterServicePlan) ([]*v1beta1.ClusterServicePlan, []*v1beta1.ClusterServicePlan, error) {
-	var predicate filter.Predicate
-	var err error
-	if restrictions != nil && len(restrictions.ServicePlan) > 0 {
-		predicate, err = filter.CreatePredicate(restrictions.ServicePlan)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		predicate = filter.NewPredicate()
+// getBrokerClient returns the OSB client for a broker that sends requests
+// with the given OSB API version. The client is cached and reused for as long
+// as the broker's generation, the API version and its credentials do not
+// change. Requests sent by the client are subject to the broker's
+// maxConcurrentRequests and requestsPerSecond limits, if it has any.
+func (c *controller) getBrokerClient(meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, apiVersion osb.APIVersion, credentials *brokerCredentials) (osb.Client, error) {
+	var authConfig *osb.AuthConfig
+	var clientCertificate *tls.Certificate
+	credentialsVersion := ""
+	if credentials != nil {
+		authConfig = credentials.authConfig
+		clientCertificate = credentials.clientCertificate
+		credentialsVersion = credentials.version
 	}
 
-	// If the predicate is empty, all plans will pass. No need to run through the list.
-	if predicate.Empty() {
-		return servicePlans, []*v1beta1.ClusterServicePlan(nil), nil
+	if brokerClient := c.brokerClients.get(meta.UID, meta.Generation, apiVersion.HeaderValue(), credentialsVersion); brokerClient != nil {
+		return brokerClient, nil
 	}
 
-	accepted := []*v1beta1.ClusterServicePlan(nil)
-	rejected := []*v1beta1.ClusterServicePlan(nil)
-	for _, sp := range servicePlans {
-		fields := v1beta1.ConvertClusterServicePlanToProperties(sp)
-		if predicate.Accepts(fields) {
-			accepted = append(accepted, sp)
-		} else {
-			rejected = append(rejected, sp)
-		}
+	clientConfig := NewClientConfigurationForBroker(meta, commonSpec, authConfig, clientCertificate)
+	clientConfig.APIVersion = apiVersion
+	brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+	if err != nil {
+		return nil, err
 	}
-
-	return accepted, rejected, nil
-}
-
-func convertServicePlans(namespace string, plans []osb.Plan, serviceClassID string) ([]*v1beta1.ServicePlan, error) {
-	if 0 == len(plans) {
-		return nil, fmt.Errorf("ServiceClass (K8S: %q) must have at least one plan", serviceClassID)
-	}
-	servicePlans := make([]*v1beta1.ServicePlan, len(plans))
-	for i, plan := range plans {
-		servicePlan := &v1beta1.ServicePlan{
-			Spec: v1beta1.ServicePlanSpec{
-				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
-					ExternalName: plan.Name,
-					ExternalID:   plan.ID,
-					Free:         plan.Free != nil && *plan.Free,
-					Description:  plan.Description,
-				},
-				ServiceClassRef: v1beta1.LocalObjectReference{Name: serviceClassID},
-			},
-		}
-		servicePlans[i] = servicePlan
-		servicePlan.SetName(plan.ID)
-		servicePlan.SetNamespace(namespace)
-
-		err := convertCommonServicePlan(plan, &servicePlan.Spec.CommonServicePlanSpec)
-		if err != nil {
-			return nil, err
-		}
+	if limiter := c.brokerClients.limiter(meta.UID, meta.Namespace, meta.Name, commonSpec); limiter != nil {
+		brokerClient = &limitedBrokerClient{client: brokerClient, limiter: limiter}
 	}
-	return servicePlans, nil
+	c.brokerClients.set(meta.UID, meta.Generation, apiVersion.HeaderValue(), credentialsVersion, brokerClient)
+	return brokerClient, nil
 }
 
-func convertCommonServicePlan(plan osb.Plan, commonServicePlanSpec *v1beta1.CommonServicePlanSpec) error {
-	if plan.Bindable != nil {
-		b := plan.Bindable
-		commonServicePlanSpec.Bindable = b
+func getBasicAuthConfig(secret *corev1.Secret) (*osb.BasicAuthConfig, error) {
+	usernameBytes, ok := secret.Data["username"]
+	if !ok {
+		return nil, fmt.Errorf("auth secret didn't contain username")
 	}
 
-	if plan.Metadata != nil {
-		metadata, err := json.Marshal(plan.Metadata)
-		if err != nil {
-			err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", plan.Metadata, err)
-			glog.Error(err)
-			return err
-		}
-		commonServicePlanSpec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
+	passwordBytes, ok := secret.Data["password"]
+	if !ok {
+		return nil, fmt.Errorf("auth secret didn't contain password")
 	}
 
-	if schemas := plan.Schemas; schemas != nil {
-		if instanceSchemas := schemas.ServiceInstance; instanceSchemas != nil {
-			if instanceCreateSchema := instanceSchemas.Create; instanceCreateSchema != nil && instanceCreateSchema.Parameters != nil {
-				schema, err := json.Marshal(instanceCreateSchema.Parameters)
-				if err != nil {
-					err = fmt.Errorf("Failed to marshal instance create schema \n%+v\n %v", instanceCreateSchema.Parameters, err)
-					glog.Error(err)
-					return err
-				}
-				commonServicePlanSpec.ServiceInstanceCreateParameterSchema = &runtime.RawExtension{Raw: schema}
-			}
-			if instanceUpdateSchema := instanceSchemas.Update; instanceUpdateSchema != nil && instanceUpdateSchema.Parameters != nil {
-				schema, err := json.Marshal(instanceUpdateSchema.Parameters)
-				if err != nil {
-					err = fmt.Errorf("Failed to marshal instance update schema \n%+v\n %v", instanceUpdateSchema.Parameters, err)
-					glog.Error(err)
-					return err
-				}
-				commonServicePlanSpec.ServiceInstanceUpdateParameterSchema = &runtime.RawExtension{Raw: schema}
-			}
-		}
-		if bindingSchemas := schemas.ServiceBinding; bindingSchemas != nil {
-			if bindingCreateSchema := bindingSchemas.Create; bindingCreateSchema != nil {
-				if bindingCreateSchema.Parameters != nil {
-					schema, err := json.Marshal(bindingCreateSchema.Parameters)
-					if err != nil {
-						err = fmt.Errorf("Failed to marshal binding create schema \n%+v\n %v", bindingCreateSchema.Parameters, err)
-						glog.Error(err)
-						return err
-					}
-					commonServicePlanSpec.ServiceBindingCreateParameterSchema = &runtime.RawExtension{Raw: schema}
-				}
-				if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ResponseSchema) && bindingCreateSchema.Response != nil {
-					schema, err := json.Marshal(bindingCreateSchema.Response)
-					if err != nil {
-						err = fmt.Errorf("Failed to marshal binding create response schema \n%+v\n %v", bindingCreateSchema.Response, err)
-						glog.Error(err)
-						return err
-					}
-					commonServicePlanSpec.ServiceBindingCreateResponseSchema = &runtime.RawExtension{Raw: schema}
-				}
-			}
-		}
-	}
-	return nil
+	return &osb.BasicAuthConfig{
+		Username: string(usernameBytes),
+		Password: string(passwordBytes),
+	}, nil
 }
 
-func convertClusterServicePlans(plans []osb.Plan, serviceClassID string) ([]*v1beta1.ClusterServicePlan, error) {
-	if 0 == len(plans) {
-		return nil, fmt.Errorf("ClusterServiceClass (K8S: %q) must have at least one plan", serviceClassID)
-	}
-	servicePlans := make([]*v1beta1.ClusterServicePlan, len(plans))
-	for i, plan := range plans {
-		servicePlans[i] = &v1beta1.ClusterServicePlan{
-			Spec: v1beta1.ClusterServicePlanSpec{
-				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
-					ExternalName: plan.Name,
-					ExternalID:   plan.ID,
-					Free:         plan.Free != nil && *plan.Free,
-					Description:  plan.Description,
-				},
-				ClusterServiceClassRef: v1beta1.ClusterObjectReference{Name: serviceClassID},
-			},
-		}
-		servicePlans[i].SetName(plan.ID)
-
-		if plan.Bindable != nil {
-			b := *plan.Bindable
-			servicePlans[i].Spec.Bindable = &b
-		}
-
-		if plan.Metadata != nil {
-			metadata, err := json.Marshal(plan.Metadata)
-			if err != nil {
-				err = fmt.Errorf("Failed to marshal metadata\n%+v\n %v", plan.Metadata, err)
-				glog.Error(err)
-				return nil, err
-			}
-			servicePlans[i].Spec.ExternalMetadata = &runtime.RawExtension{Raw: metadata}
-		}
-
-		if schemas := plan.Schemas; schemas != nil {
-			if instanceSchemas := schemas.ServiceInstance; instanceSchemas != nil {
-				if instanceCreateSchema := instanceSchemas.Create; instanceCreateSchema != nil && instanceCreateSchema.Parameters != nil {
-					schema, err := json.Marshal(instanceCreateSchema.Parameters)
-					if err != nil {
-						err = fmt.Errorf("Failed to marshal instance create schema \n%+v\n %v", instanceCreateSchema.Parameters, err)
-						glog.Error(err)
-						return nil, err
-					}
-					servicePlans[i].Spec.ServiceInstanceCreateParameterSchema = &runtime.RawExtension{Raw: schema}
-				}
-				if instanceUpdateSchema := instanceSchemas.Update; instanceUpdateSchema != nil && instanceUpdateSchema.Parameters != nil {
-					schema, err := json.Marshal(instanceUpdateSchema.Parameters)
-					if err != nil {
-						err = fmt.Errorf("Failed to marshal instance update schema \n%+v\n %v", instanceUpdateSchema.Parameters, err)
-						glog.Error(err)
-						return nil, err
-					}
-					servicePlans[i].Spec.ServiceInstanceUpdateParameterSchema = &runtime.RawExtension{Raw: schema}
-				}
-			}
-			if bindingSchemas := schemas.ServiceBinding; bindingSchemas != nil {
-				if bindingCreateSchema := bindingSchemas.Create; bindingCreateSchema != nil {
-					if bindingCreateSchema.Parameters != nil {
-						schema, err := json.Marshal(bindingCreateSchema.Parameters)
-						if err != nil {
-							err = fmt.Errorf("Failed to marshal binding create schema \n%+v\n %v", bindingCreateSchema.Parameters, err)
-							glog.Error(err)
-							return nil, err
-						}
-						servicePlans[i].Spec.ServiceBindingCreateParameterSchema = &runtime.RawExtension{Raw: schema}
-					}
-					if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ResponseSchema) && bindingCreateSchema.Response != nil {
-						schema, err := json.Marshal(bindingCreateSchema.Response)
-						if err != nil {
-							err = fmt.Errorf("Failed to marshal binding create response schema \n%+v\n %v", bindingCreateSchema.Response, err)
-							glog.Error(err)
-							return nil, err
-						}
-						servicePlans[i].Spec.ServiceBindingCreateResponseSchema = &runtime.RawExtension{Raw: schema}
-					}
-				}
-			}
-		}
+func getBearerConfig(secret *corev1.Secret) (*osb.BearerConfig, error) {
+	tokenBytes, ok := secret.Data["token"]
+	if !ok {
+		return nil, fmt.Errorf("auth secret didn't contain token")
 	}
-	return servicePlans, nil
+
+	return &osb.BearerConfig{
+		Token: string(tokenBytes),
+	}, nil
 }
 
 // isServiceInstanceConditionTrue returns whether the given instance has a given condition
@@ -1252,24 +1209,90 @@ func isServiceInstanceOrphanMitigation(instance *v1beta1.ServiceInstance) bool {
 
 // NewClientConfigurationForBroker creates a new ClientConfiguration for connecting
 // to the specified Broker
-func NewClientConfigurationForBroker(meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, authConfig *osb.AuthConfig) *osb.ClientConfiguration {
+func NewClientConfigurationForBroker(meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, authConfig *osb.AuthConfig, clientCertificate *tls.Certificate) *osb.ClientConfiguration {
 	clientConfig := osb.DefaultClientConfiguration()
 	clientConfig.Name = meta.Name
 	clientConfig.URL = commonSpec.URL
 	clientConfig.AuthConfig = authConfig
+	if clientCertificate != nil {
+		clientConfig.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{*clientCertificate},
+		}
+	}
 	clientConfig.EnableAlphaFeatures = true
 	clientConfig.Insecure = commonSpec.InsecureSkipTLSVerify
 	clientConfig.CAData = commonSpec.CABundle
 	return clientConfig
 }
 
-// reconciliationRetryDurationExceeded returns whether the given operation
-// start time has exceeded the controller's set reconciliation retry duration.
-func (c *controller) reconciliationRetryDurationExceeded(operationStartTime *metav1.Time) bool {
-	if operationStartTime == nil || time.Now().Before(operationStartTime.Time.Add(c.reconciliationRetryDuration)) {
+// catalogRevisionFor returns the catalog revision to record for a broker
+// whose catalog was just reconciled. The timestamp of the current revision is
+// kept as long as the catalog has not changed.
+func catalogRevisionFor(current *v1beta1.CatalogRevision, catalogHash, etag string, classCount, planCount int) *v1beta1.CatalogRevision {
+	if catalogHash == "" {
+		return current
+	}
+	if current != nil && current.Hash == catalogHash && current.ServiceClassCount == classCount && current.ServicePlanCount == planCount {
+		if current.ETag == etag {
+			return current
+		}
+		revision := *current
+		revision.ETag = etag
+		return &revision
+	}
+	return &v1beta1.CatalogRevision{
+		Hash:              catalogHash,
+		ETag:              etag,
+		Timestamp:         metav1.Now(),
+		ServiceClassCount: classCount,
+		ServicePlanCount:  planCount,
+	}
+}
+
+// isCatalogReconciled returns whether the catalog revision in a broker's
+// status was reconciled successfully for the broker's current spec.
+func isCatalogReconciled(generation int64, status *v1beta1.CommonServiceBrokerStatus) bool {
+	if status.CatalogRevision == nil || status.ReconciledGeneration != generation {
 		return false
 	}
-	return true
+	for _, condition := range status.Conditions {
+		if condition.Type == v1beta1.ServiceBrokerConditionReady {
+			return condition.Status == v1beta1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// isCatalogUnchanged returns whether a broker's catalog has the same hash as
+// the revision that was last reconciled successfully for the broker's current
+// spec. In that case its classes and plans are already up to date and do not
+// need to be reconciled again.
+func isCatalogUnchanged(generation int64, status *v1beta1.CommonServiceBrokerStatus, catalogHash string) bool {
+	return catalogHash != "" && isCatalogReconciled(generation, status) && status.CatalogRevision.Hash == catalogHash
+}
+
+// catalogETagFor returns the entity tag to send when relisting a broker's
+// catalog, which is empty unless the catalog it belongs to was reconciled
+// successfully for the broker's current spec.
+func catalogETagFor(generation int64, status *v1beta1.CommonServiceBrokerStatus) string {
+	if !isCatalogReconciled(generation, status) {
+		return ""
+	}
+	return status.CatalogRevision.ETag
+}
+
+// recordCatalogChanges emits an event on the broker listing the classes and
+// plans that a relist added, changed or removed. Removals are reported as
+// warnings since they may break existing instances.
+func (c *controller) recordCatalogChanges(broker runtime.Object, diff *catalogdiff.Diff) {
+	if diff.IsEmpty() {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if diff.HasRemovals() {
+		eventType = corev1.EventTypeWarning
+	}
+	c.recorder.Event(broker, eventType, catalogChangedReason, catalogChangedMessage+diff.String())
 }
 
 // shouldStartOrphanMitigation returns whether an error with the given status
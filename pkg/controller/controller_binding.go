@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/golang/glog"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
@@ -53,6 +54,13 @@ const (
 	successInjectedBindResultReason  string = "InjectedBindResult"
 	successInjectedBindResultMessage string = "Injected bind result"
 	successUnboundReason             string = "UnboundSuccessfully"
+	credentialsRotatedReason         string = "CredentialsRotated"
+	credentialsRotatedMessage        string = "The credentials injected for the binding changed"
+	successRetainBindingReason       string = "BindingRetained"
+	successRetainBindingMessage      string = "The binding was removed without unbinding it at the broker because its deletion policy is Retain"
+	successAdoptBindingReason        string = "BindingAdopted"
+	successAdoptBindingMessage       string = "The binding was adopted after the broker confirmed that it exists"
+	successTrustBindingMessage       string = "The binding was adopted without confirming it with the broker, which does not support fetching bindings"
 	asyncBindingReason               string = "Binding"
 	asyncBindingMessage              string = "The binding is being created asynchronously"
 	asyncUnbindingReason             string = "Unbinding"
@@ -69,6 +77,16 @@ var bindingControllerKind = v1beta1.SchemeGroupVersion.WithKind("ServiceBinding"
 // ServiceBinding handlers and control-loop
 
 func (c *controller) bindingAdd(obj interface{}) {
+	priority := priorityNormal
+	if binding, ok := obj.(*v1beta1.ServiceBinding); ok && binding.Generation != binding.Status.ReconciledGeneration {
+		priority = priorityHigh
+	}
+	c.bindingAddWithPriority(obj, priority)
+}
+
+// bindingAddWithPriority adds the binding key to the work queue at the given
+// priority
+func (c *controller) bindingAddWithPriority(obj interface{}, priority queuePriority) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		pcb := pretty.NewContextBuilder(pretty.ServiceBinding, "", "", "")
@@ -88,16 +106,20 @@ func (c *controller) bindingAdd(obj interface{}) {
 		acc.GetResourceVersion()),
 	)
 
-	c.bindingQueue.Add(key)
+	c.bindingQueue.AddWithPriority(key, priority)
 }
 
 func (c *controller) bindingUpdate(oldObj, newObj interface{}) {
 	// Bindings with ongoing asynchronous operations will be manually added
 	// to the polling queue by the reconciler. They should be ignored here in
-	// order to enforce polling rate-limiting.
+	// order to enforce polling rate-limiting, unless the broker called back
+	// to report the completion of the operation.
 	binding := newObj.(*v1beta1.ServiceBinding)
-	if !binding.Status.AsyncOpInProgress {
-		c.bindingAdd(newObj)
+	oldBinding := oldObj.(*v1beta1.ServiceBinding)
+	if brokerCallbackRecorded(oldBinding.ObjectMeta, binding.ObjectMeta) {
+		c.bindingAddWithPriority(newObj, priorityHigh)
+	} else if !binding.Status.AsyncOpInProgress {
+		c.bindingAddWithPriority(newObj, updatePriority(oldBinding.ObjectMeta, binding.ObjectMeta))
 	}
 }
 
@@ -126,6 +148,13 @@ func (c *controller) reconcileServiceBindingKey(key string) error {
 		glog.Info(pcb.Messagef("Unable to retrieve store: %v", err))
 		return err
 	}
+	if c.ignoreClusterScoped {
+		instance, err := c.instanceLister.ServiceInstances(namespace).Get(binding.Spec.ServiceInstanceRef.Name)
+		if err == nil && instance.Spec.ClusterServiceClassSpecified() {
+			glog.V(4).Info(pcb.Message("Not doing work because the ServiceBinding refers to an instance of a cluster-scoped class, which is ignored"))
+			return nil
+		}
+	}
 
 	return c.reconcileServiceBinding(binding)
 }
@@ -139,6 +168,13 @@ func isServiceBindingFailed(binding *v1beta1.ServiceBinding) bool {
 	return false
 }
 
+// isServiceBindingAdopted returns true if the binding carries the adoption
+// annotation and has not been bound yet.
+func isServiceBindingAdopted(binding *v1beta1.ServiceBinding) bool {
+	return binding.Annotations[v1beta1.AdoptionAnnotation] == "true" &&
+		binding.Status.ExternalProperties == nil
+}
+
 // getReconciliationActionForServiceBinding gets the action the reconciler
 // should be taking on the given binding.
 func getReconciliationActionForServiceBinding(binding *v1beta1.ServiceBinding) ReconciliationAction {
@@ -177,7 +213,9 @@ func (c *controller) reconcileServiceBinding(binding *v1beta1.ServiceBinding) er
 func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding) error {
 	pcb := pretty.NewBindingContextBuilder(binding)
 
-	if isServiceBindingFailed(binding) {
+	// A failed binding is only processed again when the user asks for a
+	// retry, which bumps the generation.
+	if isServiceBindingFailed(binding) && binding.Status.ReconciledGeneration == binding.Generation {
 		glog.V(4).Info(pcb.Message("not processing event; status showed that it has failed"))
 		return nil
 	}
@@ -191,6 +229,11 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 
 	binding = binding.DeepCopy()
 
+	if isServiceBindingFailed(binding) {
+		glog.V(4).Info(pcb.Message("Retrying the failed binding"))
+		removeServiceBindingCondition(binding, v1beta1.ServiceBindingConditionFailed)
+	}
+
 	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.ServiceInstanceRef.Name)
 	if err != nil {
 		msg := fmt.Sprintf(`References a non-existent %s "%s/%s"`, pretty.ServiceInstance, binding.Namespace, binding.Spec.ServiceInstanceRef.Name)
@@ -202,6 +245,8 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 	var brokerClient osb.Client
 	var request *osb.BindRequest
 	var inProgressProperties *v1beta1.ServiceBindingPropertiesState
+	var brokerName string
+	var bindingsRetrievable bool
 
 	if instance.Spec.ClusterServiceClassSpecified() {
 		if instance.Spec.ClusterServiceClassRef == nil || instance.Spec.ClusterServicePlanRef == nil {
@@ -211,12 +256,14 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
-		serviceClass, servicePlan, brokerName, bClient, err := c.getClusterServiceClassPlanAndClusterServiceBrokerForServiceBinding(instance, binding)
+		serviceClass, servicePlan, bName, bClient, err := c.getClusterServiceClassPlanAndClusterServiceBrokerForServiceBinding(instance, binding)
 		if err != nil {
 			return c.handleServiceBindingReconciliationError(binding, err)
 		}
 
-		brokerClient = bClient
+		brokerName, brokerClient = bName, bClient
+		bindingsRetrievable = serviceClass.Spec.BindingRetrievable &&
+			supportsAsyncBindingOperations(c.osbAPIVersionForClusterServiceBroker(bName))
 
 		if !isClusterServicePlanBindable(serviceClass, servicePlan) {
 			msg := fmt.Sprintf(`References a non-bindable %s and Plan (%q) combination`, pretty.ClusterServiceClassName(serviceClass), instance.Spec.ClusterServicePlanExternalName)
@@ -248,12 +295,14 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
-		serviceClass, servicePlan, brokerName, bClient, err := c.getServiceClassPlanAndServiceBrokerForServiceBinding(instance, binding)
+		serviceClass, servicePlan, bName, bClient, err := c.getServiceClassPlanAndServiceBrokerForServiceBinding(instance, binding)
 		if err != nil {
 			return c.handleServiceBindingReconciliationError(binding, err)
 		}
 
-		brokerClient = bClient
+		brokerName, brokerClient = bName, bClient
+		bindingsRetrievable = serviceClass.Spec.BindingRetrievable &&
+			supportsAsyncBindingOperations(c.osbAPIVersionForServiceBroker(instance.Namespace, bName))
 
 		if !isServicePlanBindable(serviceClass, servicePlan) {
 			msg := fmt.Sprintf(`References a non-bindable %s and Plan (%q) combination`, pretty.ServiceClassName(serviceClass), instance.Spec.ClusterServicePlanExternalName)
@@ -278,6 +327,12 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		prettyName = pretty.FromServiceInstanceOfServiceClassAtBrokerName(instance, serviceClass, brokerName)
 	}
 
+	// An adopted binding already exists at the broker and its credentials
+	// are provided with the binding, so only the status needs to be recorded.
+	if isServiceBindingAdopted(binding) && binding.Status.CurrentOperation == "" {
+		return c.adoptServiceBinding(binding, request, inProgressProperties, brokerName, brokerClient, bindingsRetrievable)
+	}
+
 	if binding.Status.CurrentOperation == "" {
 		binding, err = c.recordStartOfServiceBindingOperation(binding, v1beta1.ServiceBindingOperationBind, inProgressProperties)
 		if err != nil {
@@ -290,6 +345,9 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 	}
 
 	response, err := brokerClient.Bind(request)
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
 	if err != nil {
 		if httpErr, ok := osb.IsHTTPError(err); ok {
 			msg := fmt.Sprintf("ServiceBroker returned failure; bind operation will not be retried: %v", err.Error())
@@ -307,7 +365,7 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		msg := fmt.Sprintf(`Error creating ServiceBinding for %s: %s`, prettyName, err)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorBindCallReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.bindingRetryDurationExceeded(binding) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, false)
@@ -331,7 +389,7 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		msg := fmt.Sprintf(`Error injecting bind result: %s`, err)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorInjectingBindResultReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.bindingRetryDurationExceeded(binding) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, true)
@@ -381,6 +439,17 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
+	// With the Retain deletion policy the binding is left in place at the
+	// broker. Orphan mitigation and unbind requests that have already been
+	// started are still carried through.
+	if binding.DeletionTimestamp != nil &&
+		binding.Spec.DeletionPolicy == v1beta1.DeletionPolicyRetain &&
+		!binding.Status.OrphanMitigationInProgress &&
+		binding.Status.CurrentOperation != v1beta1.ServiceBindingOperationUnbind {
+
+		return c.processServiceBindingRetained(binding)
+	}
+
 	if binding.DeletionTimestamp == nil {
 		if binding.Status.OperationStartTime == nil {
 			now := metav1.Now()
@@ -462,13 +531,16 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 	}
 
 	response, err := brokerClient.Unbind(request)
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
 	if err != nil {
 		msg := fmt.Sprintf(
 			`Error unbinding from %s: %s`, prettyBrokerName, err,
 		)
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.bindingRetryDurationExceeded(binding) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
 			failedCond := newServiceBindingReadyCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processUnbindFailure(binding, readyCond, failedCond)
@@ -543,6 +615,7 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			controllerRef := metav1.GetControllerOf(existingSecret)
 			return fmt.Errorf(`Secret "%s/%s" is not owned by ServiceBinding, controllerRef: %v`, binding.Namespace, existingSecret.Name, controllerRef)
 		}
+		rotated := len(existingSecret.Data) != 0 && !secretDataEqual(existingSecret.Data, secretData)
 		existingSecret.Data = secretData
 		_, err = secretClient.Update(existingSecret)
 		if err != nil {
@@ -552,6 +625,9 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			}
 			return fmt.Errorf(`Unexpected error updating Secret "%s/%s": %v`, binding.Namespace, existingSecret.Name, err)
 		}
+		if rotated {
+			c.notifyServiceBinding(v1beta1.NotificationEventCredentialsRotated, binding, credentialsRotatedReason, credentialsRotatedMessage)
+		}
 	} else {
 		if !apierrors.IsNotFound(err) {
 			// Terminal error
@@ -585,6 +661,21 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 	return err
 }
 
+// secretDataEqual returns whether two sets of Secret data hold the same
+// entries.
+func secretDataEqual(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		w, ok := b[k]
+		if !ok || !bytes.Equal(v, w) {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *controller) transformCredentials(transforms []v1beta1.SecretTransform, credentials map[string]interface{}) error {
 	for _, t := range transforms {
 		switch {
@@ -668,6 +759,28 @@ func setServiceBindingCondition(toUpdate *v1beta1.ServiceBinding,
 	setServiceBindingConditionInternal(toUpdate, conditionType, status, reason, message, metav1.Now())
 }
 
+// removeServiceBindingCondition removes a condition of a given type from a
+// binding's status if it exists.
+func removeServiceBindingCondition(toUpdate *v1beta1.ServiceBinding,
+	conditionType v1beta1.ServiceBindingConditionType) {
+	pcb := pretty.NewBindingContextBuilder(toUpdate)
+	glog.V(5).Info(pcb.Messagef(
+		"Removing condition %q", conditionType,
+	))
+
+	newStatusConditions := make([]v1beta1.ServiceBindingCondition, 0, len(toUpdate.Status.Conditions))
+	for _, cond := range toUpdate.Status.Conditions {
+		if cond.Type == conditionType {
+			glog.V(5).Info(pcb.Messagef("Found existing condition %q: %q; removing it",
+				conditionType, cond.Status,
+			))
+			continue
+		}
+		newStatusConditions = append(newStatusConditions, cond)
+	}
+	toUpdate.Status.Conditions = newStatusConditions
+}
+
 // setServiceBindingConditionInternal is
 // setServiceBindingCondition but allows the time to be parameterized
 // for testing.
@@ -818,6 +931,7 @@ func clearServiceBindingCurrentOperation(toUpdate *v1beta1.ServiceBinding) {
 	toUpdate.Status.OperationStartTime = nil
 	toUpdate.Status.AsyncOpInProgress = false
 	toUpdate.Status.LastOperation = nil
+	toUpdate.Status.NextPollTime = nil
 	toUpdate.Status.ReconciledGeneration = toUpdate.Generation
 	toUpdate.Status.InProgressProperties = nil
 	toUpdate.Status.OrphanMitigationInProgress = false
@@ -835,29 +949,49 @@ func rollbackBindingReconciledGenerationOnDeletion(binding *v1beta1.ServiceBindi
 }
 
 func (c *controller) requeueServiceBindingForPoll(key string) error {
-	c.bindingQueue.Add(key)
+	c.bindingQueue.AddWithPriority(key, priorityHigh)
 
 	return nil
 }
 
-// beginPollingServiceBinding does a rate-limited add of the key for the given
-// binding to the controller's binding polling queue.
-func (c *controller) beginPollingServiceBinding(binding *v1beta1.ServiceBinding) error {
+// scheduleServiceBindingPoll decides how long to wait before next polling
+// the binding's asynchronous operation, honouring the Retry-After the broker
+// sent with its last response, if any. It records the time of the poll in
+// the binding's status, for the caller to update, and returns the delay.
+func (c *controller) scheduleServiceBindingPoll(binding *v1beta1.ServiceBinding, retryAfter *time.Duration) time.Duration {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(binding)
+	if err != nil {
+		key = binding.Namespace + "/" + binding.Name
+	}
+
+	timeouts := c.operationTimeoutsForServiceBinding(binding)
+	delay := pollDelay(c.bindingPollingRateLimiter, key, timeouts, binding.Status.OperationStartTime, retryAfter)
+	nextPollTime := metav1.NewTime(time.Now().Add(delay))
+	binding.Status.NextPollTime = &nextPollTime
+
+	return delay
+}
+
+// beginPollingServiceBinding adds the key for the given binding to the
+// controller's binding polling queue after the given delay, returned by
+// scheduleServiceBindingPoll.
+func (c *controller) beginPollingServiceBinding(binding *v1beta1.ServiceBinding, delay time.Duration) error {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(binding)
 	if err != nil {
 		glog.Errorf("Couldn't create a key for object %+v: %v", binding, err)
 		return fmt.Errorf("Couldn't create a key for object %+v: %v", binding, err)
 	}
 
-	c.bindingPollingQueue.AddRateLimited(key)
+	c.bindingPollingQueue.AddAfter(key, delay)
 
 	return nil
 }
 
-// continuePollingServiceBinding does a rate-limited add of the key for the
-// given binding to the controller's binding polling queue.
+// continuePollingServiceBinding schedules the next poll of the given binding
+// and adds its key to the controller's binding polling queue. The time of the
+// poll is only recorded in the binding's status if the caller updates it.
 func (c *controller) continuePollingServiceBinding(binding *v1beta1.ServiceBinding) error {
-	return c.beginPollingServiceBinding(binding)
+	return c.beginPollingServiceBinding(binding, c.scheduleServiceBindingPoll(binding, nil))
 }
 
 // finishPollingServiceBinding removes the binding's key from the controller's
@@ -906,6 +1040,9 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 	glog.V(5).Info(pcb.Message("Polling last operation"))
 
 	response, err := brokerClient.PollBindingLastOperation(request)
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
 	if err != nil {
 		// If the operation was for delete and we receive a http.StatusGone,
 		// this is considered a success as per the spec.
@@ -925,7 +1062,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		glog.V(4).Info(pcb.Message(s))
 		c.recorder.Event(binding, corev1.EventTypeWarning, errorPollingLastOperationReason, s)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.bindingPollingDurationExceeded(binding) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
@@ -940,11 +1077,12 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 
 	switch response.State {
 	case osb.StateInProgress:
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.bindingPollingDurationExceeded(binding) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
-		// if the description is non-nil, then update the instance condition with it
+		// if the description is non-nil, then update the binding condition
+		// with it, along with when the operation will next be polled
 		if response.Description != nil {
 			reason := asyncBindingReason
 			message := asyncBindingMessage
@@ -956,14 +1094,14 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 			message = fmt.Sprintf("%s (%s)", message, *response.Description)
 			setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, reason, message)
 			c.recorder.Event(binding, corev1.EventTypeNormal, reason, message)
-
-			if _, err := c.updateServiceBindingStatus(binding); err != nil {
-				return err
-			}
+		}
+		delay := c.scheduleServiceBindingPoll(binding, response.RetryAfter)
+		if _, err := c.updateServiceBindingStatus(binding); err != nil {
+			return err
 		}
 
-		glog.V(4).Info(pcb.Message("Last operation not completed (still in progress)"))
-		return c.continuePollingServiceBinding(binding)
+		glog.V(4).Info(pcb.Messagef("Last operation not completed (still in progress); polling again in %v", delay))
+		return c.beginPollingServiceBinding(binding, delay)
 	case osb.StateSucceeded:
 		if deleting {
 			if err := c.processUnbindSuccess(binding); err != nil {
@@ -983,6 +1121,9 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 
 		// TODO(mkibbe): Break this logic out so that GET and inject are retried separately on error
 		getBindingResponse, err := brokerClient.GetBinding(getBindingRequest)
+		if isBrokerRequestLimitedError(err) {
+			return err
+		}
 		if err != nil {
 			reason := errorFetchingBindingFailedReason
 			msg := fmt.Sprintf("Could not do a GET on binding resource: %v", err)
@@ -1030,7 +1171,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		msg := "Unbind call failed: " + description
 		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.bindingPollingDurationExceeded(binding) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, readyCond)
 		}
 
@@ -1043,6 +1184,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		// standard binding queue.
 		binding.Status.AsyncOpInProgress = false
 		binding.Status.LastOperation = nil
+		binding.Status.NextPollTime = nil
 
 		if _, err := c.updateServiceBindingStatus(binding); err != nil {
 			return err
@@ -1053,7 +1195,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 	default:
 		glog.Warning(pcb.Messagef("Got invalid state in LastOperationResponse: %q", response.State))
 
-		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
+		if c.bindingPollingDurationExceeded(binding) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
 		}
 
@@ -1142,7 +1284,7 @@ func (c *controller) prepareBindRequest(
 
 	var scExternalID string
 	var spExternalID string
-	var scBindingRetrievable bool
+	var asyncBindingSupported bool
 
 	if instance.Spec.ClusterServiceClassSpecified() {
 
@@ -1164,7 +1306,8 @@ func (c *controller) prepareBindRequest(
 
 		scExternalID = serviceClass.Spec.ExternalID
 		spExternalID = servicePlan.Spec.ExternalID
-		scBindingRetrievable = serviceClass.Spec.BindingRetrievable
+		asyncBindingSupported = serviceClass.Spec.BindingRetrievable &&
+			supportsAsyncBindingOperations(c.osbAPIVersionForClusterServiceBroker(serviceClass.Spec.ClusterServiceBrokerName))
 
 	} else if instance.Spec.ServiceClassSpecified() {
 
@@ -1186,7 +1329,8 @@ func (c *controller) prepareBindRequest(
 
 		scExternalID = serviceClass.Spec.ExternalID
 		spExternalID = servicePlan.Spec.ExternalID
-		scBindingRetrievable = serviceClass.Spec.BindingRetrievable
+		asyncBindingSupported = serviceClass.Spec.BindingRetrievable &&
+			supportsAsyncBindingOperations(c.osbAPIVersionForServiceBroker(serviceClass.Namespace, serviceClass.Spec.ServiceBrokerName))
 	}
 
 	ns, err := c.kubeClient.CoreV1().Namespaces().Get(instance.Namespace, metav1.GetOptions{})
@@ -1231,11 +1375,20 @@ func (c *controller) prepareBindRequest(
 	// enabled by default. To use this feature, you must enable the
 	// AsyncBindingOperations feature gate. This may be easily set
 	// by setting `asyncBindingOperationsEnabled=true` when
-	// deploying the Service Catalog via the Helm charts.
-	if scBindingRetrievable &&
+	// deploying the Service Catalog via the Helm charts. The broker
+	// must also have negotiated OSB API version 2.13 or newer.
+	if asyncBindingSupported &&
 		utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
 
 		request.AcceptsIncomplete = true
+		if c.callbacks != nil {
+			if request.Context == nil {
+				request.Context = map[string]interface{}{}
+			}
+			for k, v := range c.callbacks.serviceBindingContext(binding) {
+				request.Context[k] = v
+			}
+		}
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
@@ -1259,7 +1412,7 @@ func (c *controller) prepareUnbindRequest(
 	*osb.UnbindRequest, error) {
 
 	var scExternalID string
-	var scBindingRetrievable bool
+	var asyncBindingSupported bool
 	var planExternalID string
 
 	if instance.Spec.ClusterServiceClassSpecified() {
@@ -1270,7 +1423,8 @@ func (c *controller) prepareUnbindRequest(
 		}
 
 		scExternalID = serviceClass.Spec.ExternalID
-		scBindingRetrievable = serviceClass.Spec.BindingRetrievable
+		asyncBindingSupported = serviceClass.Spec.BindingRetrievable &&
+			supportsAsyncBindingOperations(c.osbAPIVersionForClusterServiceBroker(serviceClass.Spec.ClusterServiceBrokerName))
 		planExternalID = instance.Status.ExternalProperties.ClusterServicePlanExternalID
 
 	} else if instance.Spec.ServiceClassSpecified() {
@@ -1281,7 +1435,8 @@ func (c *controller) prepareUnbindRequest(
 		}
 
 		scExternalID = serviceClass.Spec.ExternalID
-		scBindingRetrievable = serviceClass.Spec.BindingRetrievable
+		asyncBindingSupported = serviceClass.Spec.BindingRetrievable &&
+			supportsAsyncBindingOperations(c.osbAPIVersionForServiceBroker(serviceClass.Namespace, serviceClass.Spec.ServiceBrokerName))
 		planExternalID = instance.Status.ExternalProperties.ServicePlanExternalID
 	}
 
@@ -1296,8 +1451,9 @@ func (c *controller) prepareUnbindRequest(
 	// enabled by default. To use this feature, you must enable the
 	// AsyncBindingOperations feature gate. This may be easily set
 	// by setting `asyncBindingOperationsEnabled=true` when
-	// deploying the Service Catalog via the Helm charts.
-	if scBindingRetrievable &&
+	// deploying the Service Catalog via the Helm charts. The broker
+	// must also have negotiated OSB API version 2.13 or newer.
+	if asyncBindingSupported &&
 		utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
 
 		request.AcceptsIncomplete = true
@@ -1407,6 +1563,66 @@ func (c *controller) processBindSuccess(binding *v1beta1.ServiceBinding) error {
 	}
 
 	c.recorder.Event(binding, corev1.EventTypeNormal, successInjectedBindResultReason, successInjectedBindResultMessage)
+	c.notifyServiceBinding(v1beta1.NotificationEventBindingBound, binding, successInjectedBindResultReason, successInjectedBindResultMessage)
+	return nil
+}
+
+// adoptServiceBinding records a binding that carries the adoption annotation
+// as bound without sending a bind request. As with instances, the binding is
+// first fetched from the broker when the class declares bindings_retrievable
+// and the broker negotiated OSB API 2.13 or later, and only adopted if the
+// broker knows it. Otherwise the annotation is trusted: setting it requires the
+// adopt verb on the binding, which the ServiceAdoptionSarCheck admission plugin
+// checks.
+func (c *controller) adoptServiceBinding(binding *v1beta1.ServiceBinding, request *osb.BindRequest, inProgressProperties *v1beta1.ServiceBindingPropertiesState, brokerName string, brokerClient osb.Client, bindingsRetrievable bool) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+
+	if !bindingsRetrievable {
+		glog.V(4).Info(pcb.Messagef("Broker %q does not support fetching bindings; trusting the adoption annotation", brokerName))
+		return c.processServiceBindingAdoption(binding, inProgressProperties, successTrustBindingMessage)
+	}
+
+	glog.V(4).Info(pcb.Messagef("Confirming the binding to adopt with Broker %q", brokerName))
+
+	_, err := brokerClient.GetBinding(&osb.GetBindingRequest{
+		InstanceID: request.InstanceID,
+		BindingID:  request.BindingID,
+	})
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
+	if err != nil {
+		if isUnknownToBrokerError(err) {
+			msg := fmt.Sprintf("Broker %q does not know the binding to adopt", brokerName)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorAdoptionFailedReason, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorAdoptionFailedReason, msg)
+			return c.processBindFailure(binding, readyCond, failedCond, false)
+		}
+		msg := fmt.Sprintf("Error confirming the binding to adopt with Broker %q: %v", brokerName, err)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorAdoptionNotConfirmedReason, msg)
+		return c.processServiceBindingOperationError(binding, readyCond)
+	}
+
+	return c.processServiceBindingAdoption(binding, inProgressProperties, successAdoptBindingMessage)
+}
+
+// processServiceBindingAdoption handles the logging and updating of a
+// ServiceBinding that is recorded as bound without sending a bind request.
+// The message tells whether the broker confirmed the binding.
+func (c *controller) processServiceBindingAdoption(binding *v1beta1.ServiceBinding, externalProperties *v1beta1.ServiceBindingPropertiesState, message string) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+	glog.V(4).Info(pcb.Message("Adopting binding without sending a bind request"))
+
+	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue, successAdoptBindingReason, message)
+	clearServiceBindingCurrentOperation(binding)
+	binding.Status.ExternalProperties = externalProperties
+	binding.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusRequired
+
+	if _, err := c.updateServiceBindingStatus(binding); err != nil {
+		return err
+	}
+
+	c.recorder.Event(binding, corev1.EventTypeNormal, successAdoptBindingReason, message)
 	return nil
 }
 
@@ -1430,6 +1646,7 @@ func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCo
 
 		binding.Status.OrphanMitigationInProgress = true
 		binding.Status.AsyncOpInProgress = false
+		binding.Status.NextPollTime = nil
 		binding.Status.OperationStartTime = nil
 	} else {
 		clearServiceBindingCurrentOperation(binding)
@@ -1440,6 +1657,7 @@ func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCo
 		return err
 	}
 
+	c.notifyServiceBinding(v1beta1.NotificationEventBindingFailed, binding, failedCond.Reason, failedCond.Message)
 	return nil
 }
 
@@ -1451,12 +1669,13 @@ func (c *controller) processBindAsyncResponse(binding *v1beta1.ServiceBinding, r
 	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, asyncBindingReason, asyncBindingMessage)
 	binding.Status.AsyncOpInProgress = true
 
+	delay := c.scheduleServiceBindingPoll(binding, nil)
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
 
 	c.recorder.Event(binding, corev1.EventTypeNormal, asyncBindingReason, asyncBindingMessage)
-	return c.beginPollingServiceBinding(binding)
+	return c.beginPollingServiceBinding(binding, delay)
 }
 
 // handleServiceBindingReconciliationError is a helper function that handles on
@@ -1488,6 +1707,23 @@ func (c *controller) processServiceBindingGracefulDeletionSuccess(binding *v1bet
 	return nil
 }
 
+// processServiceBindingRetained handles the logging and updating of a
+// ServiceBinding that is being deleted without unbinding it at the broker.
+func (c *controller) processServiceBindingRetained(binding *v1beta1.ServiceBinding) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+	glog.V(4).Info(pcb.Message("Skipping unbind request because the deletion policy is Retain"))
+
+	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, successRetainBindingReason, successRetainBindingMessage)
+	clearServiceBindingCurrentOperation(binding)
+
+	if err := c.processServiceBindingGracefulDeletionSuccess(binding); err != nil {
+		return err
+	}
+
+	c.recorder.Event(binding, corev1.EventTypeNormal, successRetainBindingReason, successRetainBindingMessage)
+	return nil
+}
+
 // processUnbindSuccess handles the logging and updating of a ServiceBinding
 // that has successfully been deleted at the broker.
 func (c *controller) processUnbindSuccess(binding *v1beta1.ServiceBinding) error {
@@ -1518,6 +1754,9 @@ func (c *controller) processUnbindSuccess(binding *v1beta1.ServiceBinding) error
 	}
 
 	c.recorder.Event(binding, corev1.EventTypeNormal, reason, msg)
+	if !mitigatingOrphan {
+		c.notifyServiceBinding(v1beta1.NotificationEventBindingUnbound, binding, reason, msg)
+	}
 	return nil
 }
 
@@ -1552,6 +1791,7 @@ func (c *controller) processUnbindFailure(binding *v1beta1.ServiceBinding, ready
 		return err
 	}
 
+	c.notifyServiceBinding(v1beta1.NotificationEventBindingFailed, binding, failedCond.Reason, failedCond.Message)
 	return nil
 }
 
@@ -1563,12 +1803,13 @@ func (c *controller) processUnbindAsyncResponse(binding *v1beta1.ServiceBinding,
 	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, asyncUnbindingReason, asyncUnbindingMessage)
 	binding.Status.AsyncOpInProgress = true
 
+	delay := c.scheduleServiceBindingPoll(binding, nil)
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
 
 	c.recorder.Event(binding, corev1.EventTypeNormal, asyncUnbindingReason, asyncUnbindingMessage)
-	return c.beginPollingServiceBinding(binding)
+	return c.beginPollingServiceBinding(binding, delay)
 }
 
 // handleServiceBindingPollingError is a helper function that handles logic for
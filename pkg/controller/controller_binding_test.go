@@ -1158,6 +1158,198 @@ func TestReconcileServiceBindingDelete(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceBindingDeleteWithRetainPolicy tests reconcileBinding to
+// ensure that deleting a binding whose deletion policy is Retain removes the
+// finalizer without sending an unbind request to the broker.
+func TestReconcileServiceBindingDeleteWithRetainPolicy(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithRefsAndExternalProperties())
+
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              testServiceBindingName,
+			Namespace:         testNamespace,
+			DeletionTimestamp: &metav1.Time{},
+			Finalizers:        []string{v1beta1.FinalizerServiceCatalog},
+			Generation:        2,
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			ServiceInstanceRef: v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+			ExternalID:         testServiceBindingGUID,
+			SecretName:         testServiceBindingSecretName,
+			DeletionPolicy:     v1beta1.DeletionPolicyRetain,
+		},
+		Status: v1beta1.ServiceBindingStatus{
+			ReconciledGeneration: 1,
+			ExternalProperties:   &v1beta1.ServiceBindingPropertiesState{},
+			UnbindStatus:         v1beta1.ServiceBindingUnbindStatusRequired,
+		},
+	}
+	fakeCatalogClient.AddReactor("get", "servicebindings", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, binding, nil
+	})
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	// The secret is still removed
+	assertDeleteSecretAction(t, fakeKubeClient.Actions(), binding.Spec.SecretName)
+
+	actions := fakeCatalogClient.Actions()
+	// The action should be removing the finalizer
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertServiceBindingReadyFalse(t, updatedServiceBinding, successRetainBindingReason)
+	assertEmptyFinalizers(t, updatedServiceBinding)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := normalEventBuilder(successRetainBindingReason).msg(successRetainBindingMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceBindingAdopted tests reconcileBinding to ensure that a
+// binding carrying the adoption annotation is recorded as bound without
+// sending a bind request to the broker or writing the credential secret. The
+// broker confirms the binding when the class is retrievable; otherwise the
+// annotation is trusted.
+func TestReconcileServiceBindingAdopted(t *testing.T) {
+	cases := []struct {
+		name                string
+		bindingRetrievable  bool
+		reaction            *fakeosb.GetBindingReaction
+		expectedError       bool
+		expectedReadyReason string
+		expectedFailed      bool
+		expectedMessage     string
+	}{
+		{
+			name:               "confirmed",
+			bindingRetrievable: true,
+			reaction: &fakeosb.GetBindingReaction{
+				Response: &osb.GetBindingResponse{},
+			},
+			expectedReadyReason: successAdoptBindingReason,
+			expectedMessage:     successAdoptBindingMessage,
+		},
+		{
+			name:                "class not retrievable",
+			expectedReadyReason: successAdoptBindingReason,
+			expectedMessage:     successTrustBindingMessage,
+		},
+		{
+			name:               "unknown to the broker",
+			bindingRetrievable: true,
+			reaction: &fakeosb.GetBindingReaction{
+				Error: osb.HTTPStatusCodeError{StatusCode: http.StatusNotFound},
+			},
+			expectedReadyReason: errorAdoptionFailedReason,
+			expectedFailed:      true,
+		},
+		{
+			name:               "broker error",
+			bindingRetrievable: true,
+			reaction: &fakeosb.GetBindingReaction{
+				Error: osb.HTTPStatusCodeError{StatusCode: http.StatusInternalServerError},
+			},
+			expectedError:       true,
+			expectedReadyReason: errorAdoptionNotConfirmedReason,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := fakeosb.FakeClientConfiguration{}
+			if tc.reaction != nil {
+				config.GetBindingReaction = tc.reaction
+			}
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, config)
+
+			addGetNamespaceReaction(fakeKubeClient)
+
+			serviceClass := getTestClusterServiceClass()
+			serviceClass.Spec.BindingRetrievable = tc.bindingRetrievable
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(serviceClass)
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			binding := &v1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        testServiceBindingName,
+					Namespace:   testNamespace,
+					Finalizers:  []string{v1beta1.FinalizerServiceCatalog},
+					Generation:  1,
+					Annotations: map[string]string{v1beta1.AdoptionAnnotation: "true"},
+				},
+				Spec: v1beta1.ServiceBindingSpec{
+					ServiceInstanceRef: v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+					ExternalID:         testServiceBindingGUID,
+					SecretName:         testServiceBindingSecretName,
+				},
+				Status: v1beta1.ServiceBindingStatus{
+					UnbindStatus: v1beta1.ServiceBindingUnbindStatusNotRequired,
+				},
+			}
+
+			err := reconcileServiceBinding(t, testController, binding)
+			if tc.expectedError && err == nil {
+				t.Fatal("expected an error")
+			} else if !tc.expectedError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			if tc.reaction == nil {
+				assertNumberOfBrokerActions(t, brokerActions, 0)
+			} else {
+				assertNumberOfBrokerActions(t, brokerActions, 1)
+				assertGetBinding(t, brokerActions[0], &osb.GetBindingRequest{
+					InstanceID: testServiceInstanceGUID,
+					BindingID:  testServiceBindingGUID,
+				})
+			}
+
+			// Only the namespace is read to build the bind request; the
+			// credential secret is left to the importer.
+			assertGetNamespaceAction(t, fakeKubeClient.Actions())
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+
+			updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+			if tc.expectedMessage == "" {
+				assertServiceBindingReadyFalse(t, updatedServiceBinding, tc.expectedReadyReason)
+				if tc.expectedFailed {
+					assertServiceBindingCondition(t, updatedServiceBinding, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, tc.expectedReadyReason)
+				}
+				return
+			}
+
+			assertServiceBindingReadyTrue(t, updatedServiceBinding)
+			assertServiceBindingCurrentOperationClear(t, updatedServiceBinding)
+			assertServiceBindingUnbindStatus(t, updatedServiceBinding, v1beta1.ServiceBindingUnbindStatusRequired)
+
+			events := getRecordedEvents(testController)
+
+			expectedEvent := normalEventBuilder(successAdoptBindingReason).msg(tc.expectedMessage)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // TestReconcileServiceBindingDeleteUnresolvedClusterServiceClassReference
 // tests reconcileBinding to ensure a binding delete succeeds when a ClusterServiceClassRef
 // has not been resolved and no action has accrued for the binding.
@@ -1597,6 +1789,34 @@ func TestReconcileServiceBindingWithFailureCondition(t *testing.T) {
 	assertNumEvents(t, events, 0)
 }
 
+// TestReconcileServiceBindingRetryFailed tests that a failed binding whose
+// generation was bumped by a retry request has its Failed condition cleared
+// and the bind driven again.
+func TestReconcileServiceBindingRetryFailed(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingWithFailedStatus()
+	binding.Spec.RetryRequests = 1
+	binding.Generation = binding.Generation + 1
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updatedServiceBinding := assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding)
+	if isServiceBindingFailed(updatedServiceBinding) {
+		t.Fatal("expected the Failed condition to be cleared by the retry")
+	}
+
+	assertGetNamespaceAction(t, fakeKubeClient.Actions())
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+}
+
 // TestReconcileServiceBindingWithServiceBindingCallFailure tests reconcileServiceBinding to ensure
 // a bind creation failure is handled properly.
 func TestReconcileServiceBindingWithServiceBindingCallFailure(t *testing.T) {
@@ -3220,6 +3440,7 @@ func TestPollServiceBinding(t *testing.T) {
 	goneError := osb.HTTPStatusCodeError{
 		StatusCode: http.StatusGone,
 	}
+	bindingRetryAfter := time.Hour
 
 	validatePollBindingLastOperationAction := func(t *testing.T, actions []fakeosb.Action) {
 		assertNumberOfBrokerActions(t, actions, 1)
@@ -3345,6 +3566,22 @@ func TestPollServiceBinding(t *testing.T) {
 			shouldFinishPolling:       false,
 			expectedEvents:            []string{}, // does not record event
 		},
+		{
+			name:    "bind - in progress - retry after",
+			binding: getTestServiceBindingAsyncBinding(testOperation),
+			pollReaction: &fakeosb.PollBindingLastOperationReaction{
+				Response: &osb.LastOperationResponse{
+					State:      osb.StateInProgress,
+					RetryAfter: &bindingRetryAfter,
+				},
+			},
+			validateBrokerActionsFunc: validatePollBindingLastOperationAction,
+			validateConditionsFunc: func(t *testing.T, updatedBinding *v1beta1.ServiceBinding, originalBinding *v1beta1.ServiceBinding) {
+				assertServiceBindingCurrentOperation(t, updatedBinding, v1beta1.ServiceBindingOperationBind)
+				assertNextPollTimeBetween(t, updatedBinding.Status.NextPollTime, time.Now(), bindingRetryAfter-time.Minute, time.Duration(float64(bindingRetryAfter)*(1+pollingJitter)))
+			},
+			shouldFinishPolling: false,
+		},
 		{
 			name:    "bind - in progress - retry duration exceeded",
 			binding: getTestServiceBindingAsyncBindingRetryDurationExceeded(testOperation),
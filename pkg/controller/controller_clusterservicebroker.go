@@ -31,6 +31,8 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogconversion"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
 	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 )
@@ -58,6 +60,9 @@ const (
 	errorSyncingCatalogMessage            string = "Error syncing catalog from ClusterServiceBroker."
 	successFetchedCatalogReason           string = "FetchedCatalog"
 	successFetchedCatalogMessage          string = "Successfully fetched catalog entries from broker."
+	successCatalogUnchangedMessage        string = "Successfully fetched catalog entries from broker; the catalog is unchanged."
+	catalogChangedReason                  string = "CatalogChanged"
+	catalogChangedMessage                 string = "The broker's catalog changed: "
 	errorReconciliationRetryTimeoutReason string = "ErrorReconciliationRetryTimeout"
 )
 
@@ -86,6 +91,8 @@ func (c *controller) clusterServiceBrokerDelete(obj interface{}) {
 		return
 	}
 
+	c.brokerClients.remove(broker.UID)
+	c.oauth2Tokens.Remove(string(broker.UID))
 	glog.V(4).Infof("Received delete event for ClusterServiceBroker %v; no further processing will occur", broker.Name)
 }
 
@@ -181,7 +188,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 	}
 
 	if broker.DeletionTimestamp == nil { // Add or update
-		authConfig, err := getAuthCredentialsFromClusterServiceBroker(c.kubeClient, broker)
+		credentials, err := c.getAuthCredentialsFromClusterServiceBroker(broker)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker auth credentials: %s", err)
 			glog.Info(pcb.Message(s))
@@ -192,10 +199,8 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			return err
 		}
 
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
-		glog.V(4).Info(pcb.Messagef("Creating client, URL: %v", broker.Spec.URL))
-		brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+		glog.V(4).Info(pcb.Messagef("Getting client, URL: %v", broker.Spec.URL))
+		brokerClient, err := c.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, c.preferredOSBAPIVersion, credentials)
 		if err != nil {
 			s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 			glog.Info(pcb.Message(s))
@@ -208,9 +213,12 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 
 		glog.V(4).Info(pcb.Message("Processing adding/update event"))
 
-		// get the broker's catalog
+		// get the broker's catalog, negotiating the OSB API version
 		now := metav1.Now()
-		brokerCatalog, err := brokerClient.GetCatalog()
+		brokerCatalog, apiVersion, err := c.getCatalogNegotiatingOSBAPIVersion(brokerClient, broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, credentials, &broker.Status.CommonServiceBrokerStatus)
+		if isBrokerRequestLimitedError(err) {
+			return err
+		}
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			glog.Warning(pcb.Message(s))
@@ -225,7 +233,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 					glog.Error(pcb.Messagef("Error updating operation start time: %v", err))
 					return err
 				}
-			} else if !time.Now().Before(broker.Status.OperationStartTime.Time.Add(c.reconciliationRetryDuration)) {
+			} else if durationExceeded(broker.Status.OperationStartTime, c.reconciliationRetryDurationForBroker(&broker.Spec.CommonServiceBrokerSpec)) {
 				s := "Stopping reconciliation retries because too much time has elapsed"
 				glog.Info(pcb.Message(s))
 				c.recorder.Event(broker, corev1.EventTypeWarning, errorReconciliationRetryTimeoutReason, s)
@@ -241,7 +249,11 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			return err
 		}
 
-		glog.V(5).Info(pcb.Messagef("Successfully fetched %v catalog entries", len(brokerCatalog.Services)))
+		if brokerCatalog == nil {
+			glog.V(5).Info(pcb.Message("Catalog not modified since the last relist"))
+		} else {
+			glog.V(5).Info(pcb.Messagef("Successfully fetched %v catalog entries", len(brokerCatalog.Services)))
+		}
 
 		// set the operation start time if not already set
 		if broker.Status.OperationStartTime != nil {
@@ -253,10 +265,38 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			}
 		}
 
+		// skip reconciling the classes and plans when the catalog has not
+		// changed since it was last reconciled
+		var catalogHash string
+		if brokerCatalog != nil {
+			catalogHash, err = catalogdiff.Hash(brokerCatalog)
+			if err != nil {
+				glog.Warning(pcb.Messagef("Error computing catalog hash: %v", err))
+			}
+		}
+		if brokerCatalog == nil || isCatalogUnchanged(broker.Generation, &broker.Status.CommonServiceBrokerStatus, catalogHash) {
+			glog.V(4).Info(pcb.Message("Catalog is unchanged since the last relist; not reconciling classes and plans"))
+			toUpdate := broker.DeepCopy()
+			toUpdate.Status.OSBAPIVersion = apiVersion.HeaderValue()
+			if brokerCatalog != nil {
+				revision := toUpdate.Status.CatalogRevision
+				toUpdate.Status.CatalogRevision = catalogRevisionFor(revision, catalogHash, brokerCatalog.ETag, revision.ServiceClassCount, revision.ServicePlanCount)
+			}
+			if err := c.updateClusterServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+				return err
+			}
+			c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successCatalogUnchangedMessage)
+			c.recordOSBAPIVersionChange(broker, &broker.Status.CommonServiceBrokerStatus, apiVersion)
+
+			metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(broker.Status.CatalogRevision.ServiceClassCount))
+			metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(broker.Status.CatalogRevision.ServicePlanCount))
+			return nil
+		}
+
 		// convert the broker's catalog payload into our API objects
 		glog.V(4).Info(pcb.Message("Converting catalog response into service-catalog API"))
 
-		payloadServiceClasses, payloadServicePlans, err := convertAndFilterCatalog(brokerCatalog, broker.Spec.CatalogRestrictions)
+		payloadServiceClasses, payloadServicePlans, err := catalogconversion.ConvertAndFilterCatalog(brokerCatalog, broker.Spec.CatalogRestrictions)
 		if err != nil {
 			s := fmt.Sprintf("Error converting catalog payload for broker %q to service-catalog API: %s", broker.Name, err)
 			glog.Warning(pcb.Message(s))
@@ -277,6 +317,8 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			return err
 		}
 
+		catalogDiff := catalogdiff.ForClusterServiceBroker(payloadServiceClasses, payloadServicePlans, existingServiceClasses, existingServicePlans)
+
 		existingServiceClassMap := convertClusterServiceClassListToMap(existingServiceClasses)
 		existingServicePlanMap := convertClusterServicePlanListToMap(existingServicePlans)
 
@@ -332,6 +374,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 				}
 				return err
 			}
+			c.notifyClusterServiceClassRemoved(existingServiceClass)
 		}
 
 		// reconcile the plans that were part of the broker's catalog payload
@@ -389,13 +432,18 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			}
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// everything worked correctly; record the catalog revision and update
+		// the broker's ready condition to status true
+		toUpdate := broker.DeepCopy()
+		toUpdate.Status.CatalogRevision = catalogRevisionFor(broker.Status.CatalogRevision, catalogHash, brokerCatalog.ETag, len(payloadServiceClasses), len(payloadServicePlans))
+		toUpdate.Status.OSBAPIVersion = apiVersion.HeaderValue()
+		if err := c.updateClusterServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
 		c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successFetchedCatalogMessage)
+		c.recordCatalogChanges(broker, catalogDiff)
+		c.recordOSBAPIVersionChange(broker, &broker.Status.CommonServiceBrokerStatus, apiVersion)
 
 		// Update metrics with the number of serviceclass and serviceplans from this broker
 		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(len(payloadServiceClasses)))
@@ -536,6 +584,7 @@ func (c *controller) reconcileClusterServiceClassFromClusterServiceBrokerCatalog
 	// update it.
 	toUpdate := existingServiceClass.DeepCopy()
 	toUpdate.Spec.BindingRetrievable = serviceClass.Spec.BindingRetrievable
+	toUpdate.Spec.InstancesRetrievable = serviceClass.Spec.InstancesRetrievable
 	toUpdate.Spec.Bindable = serviceClass.Spec.Bindable
 	toUpdate.Spec.PlanUpdatable = serviceClass.Spec.PlanUpdatable
 	toUpdate.Spec.Tags = serviceClass.Spec.Tags
@@ -632,6 +681,8 @@ func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(
 	toUpdate.Spec.ServiceInstanceCreateParameterSchema = servicePlan.Spec.ServiceInstanceCreateParameterSchema
 	toUpdate.Spec.ServiceInstanceUpdateParameterSchema = servicePlan.Spec.ServiceInstanceUpdateParameterSchema
 	toUpdate.Spec.ServiceBindingCreateParameterSchema = servicePlan.Spec.ServiceBindingCreateParameterSchema
+	toUpdate.Spec.MaintenanceInfo = servicePlan.Spec.MaintenanceInfo
+	toUpdate.Spec.MaximumPollingDuration = servicePlan.Spec.MaximumPollingDuration
 
 	markAsServiceCatalogManagedResource(toUpdate, broker)
 
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"errors"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -26,6 +27,7 @@ import (
 	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
 	"github.com/kubernetes-incubator/service-catalog/test/fake"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -320,6 +322,183 @@ func TestReconcileClusterServiceBrokerRemovedClusterServiceClass(t *testing.T) {
 	assertNumberOfActions(t, kubeActions, 0)
 }
 
+// TestReconcileClusterServiceBrokerRecordsCatalogChanges verifies that a
+// relist records the catalog revision in the broker's status and emits an
+// event listing the classes and plans that were added or removed.
+func TestReconcileClusterServiceBrokerRecordsCatalogChanges(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, getTestCatalogConfig())
+
+	testRemovedClusterServiceClass := getTestRemovedClusterServiceClass()
+	fakeCatalogClient.AddReactor("list", "clusterserviceclasses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServiceClassList{
+			Items: []v1beta1.ClusterServiceClass{
+				*testRemovedClusterServiceClass,
+			},
+		}, nil
+	})
+
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], getTestClusterServiceBroker())
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+	revision := updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status.CatalogRevision
+	if revision == nil {
+		t.Fatalf("Expected the catalog revision to be recorded")
+	}
+	if revision.Hash == "" {
+		t.Fatalf("Expected the catalog revision to have a hash")
+	}
+	if e, a := 1, revision.ServiceClassCount; e != a {
+		t.Fatalf("Unexpected class count: expected %v, got %v", e, a)
+	}
+	if e, a := 2, revision.ServicePlanCount; e != a {
+		t.Fatalf("Unexpected plan count: expected %v, got %v", e, a)
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvents := []string{
+		normalEventBuilder(successFetchedCatalogReason).msg(successFetchedCatalogMessage).String(),
+		warningEventBuilder(catalogChangedReason).msg(catalogChangedMessage +
+			"added classes: test-clusterserviceclass; " +
+			"removed classes: removed-test-clusterserviceclass; " +
+			"added plans: test-clusterserviceclass/test-clusterserviceplan, test-clusterserviceclass/test-unbindable-clusterserviceplan").String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCatalogRevisionFor verifies that the catalog revision keeps its
+// timestamp until the broker's catalog changes.
+func TestCatalogRevisionFor(t *testing.T) {
+	first := catalogRevisionFor(nil, "abc", "", 1, 2)
+	if first == nil || first.Hash != "abc" {
+		t.Fatalf("Expected a catalog revision with a hash, got %+v", first)
+	}
+	first.Timestamp = metav1.NewTime(first.Timestamp.Add(-time.Hour))
+
+	if e, a := first, catalogRevisionFor(first, "abc", "", 1, 2); e != a {
+		t.Fatalf("Expected the current revision to be kept for an unchanged catalog, got %+v", a)
+	}
+	if e, a := first, catalogRevisionFor(first, "", "", 3, 4); e != a {
+		t.Fatalf("Expected the current revision to be kept when the hash is unknown, got %+v", a)
+	}
+
+	tagged := catalogRevisionFor(first, "abc", "\"v2\"", 1, 2)
+	if e, a := "\"v2\"", tagged.ETag; e != a {
+		t.Fatalf("Expected the entity tag to be recorded: %v", expectedGot(e, a))
+	}
+	if !tagged.Timestamp.Equal(&first.Timestamp) {
+		t.Fatalf("Expected the timestamp to be kept when only the entity tag changes")
+	}
+	if first.ETag != "" {
+		t.Fatalf("Expected the current revision not to be modified")
+	}
+
+	changed := catalogRevisionFor(first, "def", "", 1, 2)
+	if changed.Hash != "def" {
+		t.Fatalf("Expected a new hash for a changed catalog")
+	}
+	if !changed.Timestamp.After(first.Timestamp.Time) {
+		t.Fatalf("Expected a new timestamp for a changed catalog")
+	}
+}
+
+// TestReconcileClusterServiceBrokerUnchangedCatalog verifies that a relist
+// does not reconcile classes and plans when the broker's catalog has the same
+// hash as the revision that was last reconciled for the broker's spec.
+func TestReconcileClusterServiceBrokerUnchangedCatalog(t *testing.T) {
+	catalogHash, err := catalogdiff.Hash(getTestCatalog())
+	if err != nil {
+		t.Fatalf("Unexpected error hashing the catalog: %v", err)
+	}
+	lastRelist := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	cases := []struct {
+		name         string
+		hash         string
+		generation   int64
+		readyStatus  v1beta1.ConditionStatus
+		expectedSkip bool
+	}{
+		{
+			name:         "unchanged catalog",
+			hash:         catalogHash,
+			readyStatus:  v1beta1.ConditionTrue,
+			expectedSkip: true,
+		},
+		{
+			name:        "changed catalog",
+			hash:        "a-previous-hash",
+			readyStatus: v1beta1.ConditionTrue,
+		},
+		{
+			name:        "changed spec",
+			hash:        catalogHash,
+			generation:  1,
+			readyStatus: v1beta1.ConditionTrue,
+		},
+		{
+			name:        "failed last relist",
+			hash:        catalogHash,
+			readyStatus: v1beta1.ConditionFalse,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, getTestCatalogConfig())
+
+			broker := getTestClusterServiceBrokerWithStatus(tc.readyStatus)
+			broker.Generation = tc.generation
+			broker.Status.LastCatalogRetrievalTime = &lastRelist
+			broker.Status.CatalogRevision = &v1beta1.CatalogRevision{
+				Hash:              tc.hash,
+				Timestamp:         lastRelist,
+				ServiceClassCount: 1,
+				ServicePlanCount:  2,
+			}
+
+			if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			assertNumberOfBrokerActions(t, brokerActions, 1)
+			assertGetCatalog(t, brokerActions[0])
+
+			actions := fakeCatalogClient.Actions()
+			if !tc.expectedSkip {
+				if len(actions) < 2 {
+					t.Fatalf("Expected the classes and plans to be reconciled, got actions %+v", actions)
+				}
+				assertList(t, actions[0], &v1beta1.ClusterServiceClass{}, clientgotesting.ListRestrictions{
+					Labels: labels.Everything(),
+					Fields: fields.OneTermEqualSelector("spec.clusterServiceBrokerName", "test-clusterservicebroker"),
+				})
+				return
+			}
+
+			assertNumberOfActions(t, actions, 1)
+			updatedClusterServiceBroker := assertUpdateStatus(t, actions[0], broker)
+			assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+			if e, a := broker.Status.CatalogRevision, updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status.CatalogRevision; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Expected the catalog revision to be kept: %v", expectedGot(e, a))
+			}
+
+			events := getRecordedEvents(testController)
+			expectedEvent := normalEventBuilder(successFetchedCatalogReason).msg(successCatalogUnchangedMessage)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // TestReconcileClusterServiceBrokerRemovedAndRestoredClusterServiceClass
 // validates where Service Catalog has a class and plan that is marked as
 // RemovedFromBrokerCatalog but then the ServiceBroker adds the class and plan
@@ -742,6 +921,23 @@ func TestReconcileClusterServiceBrokerWithAuth(t *testing.T) {
 			},
 		},
 	}
+	clientCertificateAuthInfo := &v1beta1.ClusterServiceBrokerAuthInfo{
+		ClientCertificate: &v1beta1.ClusterClientCertificateAuthConfig{
+			SecretRef: &v1beta1.ObjectReference{
+				Namespace: "test-ns",
+				Name:      "auth-secret",
+			},
+		},
+	}
+	oauth2AuthInfo := &v1beta1.ClusterServiceBrokerAuthInfo{
+		OAuth2ClientCredentials: &v1beta1.ClusterOAuth2ClientCredentialsAuthConfig{
+			TokenURL: "https://auth.example.com/token",
+			SecretRef: &v1beta1.ObjectReference{
+				Namespace: "test-ns",
+				Name:      "auth-secret",
+			},
+		},
+	}
 	basicAuthSecret := &corev1.Secret{
 		Data: map[string][]byte{
 			v1beta1.BasicAuthUsernameKey: []byte("foo"),
@@ -803,6 +999,30 @@ func TestReconcileClusterServiceBrokerWithAuth(t *testing.T) {
 			secret:        nil,
 			shouldSucceed: false,
 		},
+		{
+			name:          "client certificate auth - invalid secret",
+			authInfo:      clientCertificateAuthInfo,
+			secret:        bearerAuthSecret,
+			shouldSucceed: false,
+		},
+		{
+			name:          "client certificate auth - secret not found",
+			authInfo:      clientCertificateAuthInfo,
+			secret:        nil,
+			shouldSucceed: false,
+		},
+		{
+			name:          "oauth2 client credentials auth - invalid secret",
+			authInfo:      oauth2AuthInfo,
+			secret:        bearerAuthSecret,
+			shouldSucceed: false,
+		},
+		{
+			name:          "oauth2 client credentials auth - secret not found",
+			authInfo:      oauth2AuthInfo,
+			secret:        nil,
+			shouldSucceed: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1581,3 +1801,85 @@ func TestMarkAsServiceCatalogManagedResource(t *testing.T) {
 		})
 	}
 }
+
+// TestReconcileClusterServiceBrokerCatalogNotModified verifies that a relist
+// sends the entity tag of the catalog that was last reconciled for the
+// broker's spec, and that a 304 Not Modified response is treated as an
+// unchanged catalog.
+func TestReconcileClusterServiceBrokerCatalogNotModified(t *testing.T) {
+	lastRelist := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	cases := []struct {
+		name                string
+		generation          int64
+		osbAPIVersion       string
+		expectedIfNoneMatch bool
+	}{
+		{
+			name:                "reconciled catalog",
+			osbAPIVersion:       osb.LatestAPIVersion().HeaderValue(),
+			expectedIfNoneMatch: true,
+		},
+		{
+			name:          "changed spec",
+			generation:    1,
+			osbAPIVersion: osb.LatestAPIVersion().HeaderValue(),
+		},
+		{
+			name:          "different OSB API version",
+			osbAPIVersion: osb.Version2_12().HeaderValue(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, getTestCatalogConfig())
+			if tc.expectedIfNoneMatch {
+				fakeClusterServiceBrokerClient.CatalogReaction = &fakeosb.CatalogReaction{
+					Error: osb.HTTPStatusCodeError{StatusCode: http.StatusNotModified},
+				}
+			}
+
+			broker := getTestClusterServiceBrokerWithStatus(v1beta1.ConditionTrue)
+			broker.Generation = tc.generation
+			broker.Status.OSBAPIVersion = tc.osbAPIVersion
+			broker.Status.LastCatalogRetrievalTime = &lastRelist
+			broker.Status.CatalogRevision = &v1beta1.CatalogRevision{
+				Hash:              "a-previous-hash",
+				ETag:              "\"v1\"",
+				Timestamp:         lastRelist,
+				ServiceClassCount: 1,
+				ServicePlanCount:  2,
+			}
+
+			if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			assertNumberOfBrokerActions(t, brokerActions, 1)
+			assertGetCatalog(t, brokerActions[0])
+
+			actions := fakeCatalogClient.Actions()
+			if !tc.expectedIfNoneMatch {
+				if brokerActions[0].Request != nil {
+					t.Fatalf("Expected the catalog to be requested without an entity tag, got %v", brokerActions[0].Request)
+				}
+				if len(actions) < 2 {
+					t.Fatalf("Expected the classes and plans to be reconciled, got actions %+v", actions)
+				}
+				return
+			}
+
+			if e, a := "\"v1\"", brokerActions[0].Request; e != a {
+				t.Fatalf("Unexpected If-None-Match entity tag: %v", expectedGot(e, a))
+			}
+			assertNumberOfActions(t, actions, 1)
+			updatedClusterServiceBroker := assertUpdateStatus(t, actions[0], broker)
+			assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+			if e, a := broker.Status.CatalogRevision, updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status.CatalogRevision; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Expected the catalog revision to be kept: %v", expectedGot(e, a))
+			}
+		})
+	}
+}
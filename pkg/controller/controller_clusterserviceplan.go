@@ -72,6 +72,13 @@ func (c *controller) reconcileClusterServicePlanKey(key string) error {
 func (c *controller) reconcileClusterServicePlan(clusterServicePlan *v1beta1.ClusterServicePlan) error {
 	glog.Infof("ClusterServicePlan %q (ExternalName: %q): processing", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
 
+	err := c.updateUpgradeAvailableConditionsForPlan(clusterServicePlan.Spec.MaintenanceInfo, func(instance *v1beta1.ServiceInstance) bool {
+		return instance.Spec.ClusterServicePlanRef != nil && instance.Spec.ClusterServicePlanRef.Name == clusterServicePlan.Name
+	})
+	if err != nil {
+		return err
+	}
+
 	if !clusterServicePlan.Status.RemovedFromBrokerCatalog {
 		return nil
 	}
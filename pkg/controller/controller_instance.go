@@ -19,6 +19,7 @@ package controller
 import (
 	stderrors "errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"sync"
 	"time"
@@ -51,6 +52,11 @@ const (
 	successProvisionMessage        string = "The instance was provisioned successfully"
 	successOrphanMitigationReason  string = "OrphanMitigationSuccessful"
 	successOrphanMitigationMessage string = "Orphan mitigation was completed successfully"
+	successRetainInstanceReason    string = "InstanceRetained"
+	successRetainInstanceMessage   string = "The instance was removed without deprovisioning it at the broker because its deletion policy is Retain"
+	successAdoptInstanceReason     string = "InstanceAdopted"
+	successAdoptInstanceMessage    string = "The instance was adopted after the broker confirmed that it exists"
+	successTrustInstanceMessage    string = "The instance was adopted without confirming it with the broker, which does not support fetching instances"
 
 	errorWithParameters                        string = "ErrorWithParameters"
 	errorProvisionCallFailedReason             string = "ProvisionCallFailed"
@@ -60,6 +66,8 @@ const (
 	errorDeprovisionCalledReason               string = "DeprovisionCallFailed"
 	errorDeprovisionBlockedByCredentialsReason string = "DeprovisionBlockedByExistingCredentials"
 	errorPollingLastOperationReason            string = "ErrorPollingLastOperation"
+	errorAdoptionNotConfirmedReason            string = "AdoptionNotConfirmed"
+	errorAdoptionFailedReason                  string = "AdoptionFailed"
 	errorWithOriginatingIdentity               string = "Error with Originating Identity"
 	errorWithOngoingAsyncOperation             string = "ErrorAsyncOperationInProgress"
 	errorWithOngoingAsyncOperationMessage      string = "Another operation for this service instance is in progress. "
@@ -123,12 +131,22 @@ type instanceOperationBackoff struct {
 
 // instanceAdd adds the instance key to the work queue
 func (c *controller) instanceAdd(obj interface{}) {
+	priority := priorityNormal
+	if instance, ok := obj.(*v1beta1.ServiceInstance); ok && instance.Generation != instance.Status.ReconciledGeneration {
+		priority = priorityHigh
+	}
+	c.instanceAddWithPriority(obj, priority)
+}
+
+// instanceAddWithPriority adds the instance key to the work queue at the
+// given priority
+func (c *controller) instanceAddWithPriority(obj interface{}, priority queuePriority) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
 		return
 	}
-	c.instanceQueue.Add(key)
+	c.instanceQueue.AddWithPriority(key, priority)
 }
 
 // instanceAddAfter adds the instance key to the work queue after the specified
@@ -145,10 +163,13 @@ func (c *controller) instanceAddAfter(obj interface{}, d time.Duration) {
 func (c *controller) instanceUpdate(oldObj, newObj interface{}) {
 	// Instances with ongoing asynchronous operations will be manually added
 	// to the polling queue by the reconciler. They should be ignored here in
-	// order to enforce polling rate-limiting.
+	// order to enforce polling rate-limiting, unless the user cancelled the
+	// operation or the broker called back to report its completion.
 	instance := newObj.(*v1beta1.ServiceInstance)
-	if !instance.Status.AsyncOpInProgress {
-		c.instanceAdd(newObj)
+	if isServiceInstanceCancelRequested(instance) || brokerCallbackRecorded(oldObj.(*v1beta1.ServiceInstance).ObjectMeta, instance.ObjectMeta) {
+		c.instanceAddWithPriority(newObj, priorityHigh)
+	} else if !instance.Status.AsyncOpInProgress {
+		c.instanceAddWithPriority(newObj, updatePriority(oldObj.(*v1beta1.ServiceInstance).ObjectMeta, instance.ObjectMeta))
 	}
 }
 
@@ -167,9 +188,11 @@ func (c *controller) instanceDelete(obj interface{}) {
 // The flow is:
 //
 // 1.  When the controller wants to begin polling the state of an operation on
-//     an instance, it calls its beginPollingServiceInstance method (or
-//     calls continuePollingServiceInstance, an alias of that method)
-// 2.  begin/continuePollingServiceInstance do a rate-limited add to the polling queue
+//     an instance, it calls scheduleServiceInstancePoll to decide when to
+//     poll and record it in the instance's status, and then its
+//     beginPollingServiceInstance method (or calls
+//     continuePollingServiceInstance, which does both)
+// 2.  begin/continuePollingServiceInstance do a delayed add to the polling queue
 // 3.  the instancePollingQueue calls requeueServiceInstanceForPoll, which adds the instance's
 //     key to the instance work queue
 // 4.  the worker servicing the instance polling queue forgets the instances key,
@@ -187,14 +210,33 @@ func (c *controller) instanceDelete(obj interface{}) {
 // forgets the key from the polling queue, so the controller must call
 // continuePollingServiceInstance if the instance requires additional polling.
 func (c *controller) requeueServiceInstanceForPoll(key string) error {
-	c.instanceQueue.Add(key)
+	c.instanceQueue.AddWithPriority(key, priorityHigh)
 
 	return nil
 }
 
-// beginPollingServiceInstance does a rate-limited add of the key for the given
-// instance to the controller's instance polling queue.
-func (c *controller) beginPollingServiceInstance(instance *v1beta1.ServiceInstance) error {
+// scheduleServiceInstancePoll decides how long to wait before next polling
+// the instance's asynchronous operation, honouring the Retry-After the broker
+// sent with its last response, if any. It records the time of the poll in
+// the instance's status, for the caller to update, and returns the delay.
+func (c *controller) scheduleServiceInstancePoll(instance *v1beta1.ServiceInstance, retryAfter *time.Duration) time.Duration {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(instance)
+	if err != nil {
+		key = instance.Namespace + "/" + instance.Name
+	}
+
+	timeouts := c.operationTimeoutsForServiceInstance(instance)
+	delay := pollDelay(c.instancePollingRateLimiter, key, timeouts, instance.Status.OperationStartTime, retryAfter)
+	nextPollTime := metav1.NewTime(time.Now().Add(delay))
+	instance.Status.NextPollTime = &nextPollTime
+
+	return delay
+}
+
+// beginPollingServiceInstance adds the key for the given instance to the
+// controller's instance polling queue after the given delay, returned by
+// scheduleServiceInstancePoll.
+func (c *controller) beginPollingServiceInstance(instance *v1beta1.ServiceInstance, delay time.Duration) error {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(instance)
 	if err != nil {
 		pcb := pretty.NewInstanceContextBuilder(instance)
@@ -203,15 +245,17 @@ func (c *controller) beginPollingServiceInstance(instance *v1beta1.ServiceInstan
 		return fmt.Errorf(s)
 	}
 
-	c.instancePollingQueue.AddRateLimited(key)
+	c.instancePollingQueue.AddAfter(key, delay)
 
 	return nil
 }
 
-// continuePollingServiceInstance does a rate-limited add of the key for the given
-// instance to the controller's instance polling queue.
+// continuePollingServiceInstance schedules the next poll of the given
+// instance and adds its key to the controller's instance polling queue. The
+// time of the poll is only recorded in the instance's status if the caller
+// updates it.
 func (c *controller) continuePollingServiceInstance(instance *v1beta1.ServiceInstance) error {
-	return c.beginPollingServiceInstance(instance)
+	return c.beginPollingServiceInstance(instance, c.scheduleServiceInstancePoll(instance, nil))
 }
 
 // finishPollingServiceInstance removes the instance's key from the controller's instance
@@ -276,6 +320,10 @@ func (c *controller) reconcileServiceInstanceKey(key string) error {
 		glog.Errorf(pcb.Messagef("Unable to retrieve %v from store: %v", key, err))
 		return err
 	}
+	if c.ignoreClusterScoped && instance.Spec.ClusterServiceClassSpecified() {
+		glog.V(4).Info(pcb.Message("Not doing work because the instance refers to a cluster-scoped class, which is ignored"))
+		return nil
+	}
 
 	return c.reconcileServiceInstance(instance)
 }
@@ -302,6 +350,9 @@ func (c *controller) reconcileServiceInstance(instance *v1beta1.ServiceInstance)
 		// and processed again
 		return nil
 	}
+	if isServiceInstanceCancelRequested(instance) {
+		return c.cancelServiceInstanceOperation(instance)
+	}
 	reconciliationAction := getReconciliationActionForServiceInstance(instance)
 	switch reconciliationAction {
 
@@ -516,6 +567,12 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		return c.handleServiceInstanceReconciliationError(instance, err)
 	}
 
+	// An adopted instance already exists at the broker, so once the broker
+	// has confirmed that, only the status needs to be recorded.
+	if isServiceInstanceAdopted(instance) && instance.Status.CurrentOperation == "" {
+		return c.adoptServiceInstance(instance, request, inProgressProperties)
+	}
+
 	if instance.Status.CurrentOperation == "" || !isServiceInstancePropertiesStateEqual(instance.Status.InProgressProperties, inProgressProperties) {
 		instance, err = c.recordStartOfServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationProvision, inProgressProperties)
 		if err != nil {
@@ -545,8 +602,11 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		prettyClass, brokerName,
 	))
 
-	c.setRetryBackoffRequired(instance)
 	response, err := brokerClient.ProvisionInstance(request)
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
+	c.setRetryBackoffRequired(instance)
 	if err != nil {
 		if httpErr, ok := osb.IsHTTPError(err); ok {
 			msg := fmt.Sprintf(
@@ -580,7 +640,7 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		msg := fmt.Sprintf("The provision call failed and will be retried: Error communicating with broker for provisioning: %v", err)
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
 
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.instanceRetryDurationExceeded(instance) {
 			msg := "Stopping reconciliation retries because too much time has elapsed"
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
@@ -612,6 +672,12 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 		return nil
 	}
 
+	// Spec changes wait for the instance's maintenance window, or for the
+	// instance to be resumed.
+	if held, err := c.holdPendingServiceInstanceUpdate(instance); held || err != nil {
+		return err
+	}
+
 	instance = instance.DeepCopy()
 	// Any status updates from this point should have an updated observed generation
 	if instance.Status.ObservedGeneration != instance.Generation {
@@ -708,8 +774,11 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 		))
 	}
 
-	c.setRetryBackoffRequired(instance)
 	response, err := brokerClient.UpdateInstance(request)
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
+	c.setRetryBackoffRequired(instance)
 	if err != nil {
 		if httpErr, ok := osb.IsHTTPError(err); ok {
 			msg := fmt.Sprintf("ServiceBroker returned a failure for update call; update will not be retried: %v", httpErr)
@@ -734,7 +803,7 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 
 		msg := fmt.Sprintf("The update call failed and will be retried: Error communicating with broker for updating: %s", err)
 
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.instanceRetryDurationExceeded(instance) {
 			// log and record the real error, but process as a
 			// failure with reconciliation retry timeout
 			glog.Info(pcb.Message(msg))
@@ -814,6 +883,17 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 		return c.handleServiceInstanceReconciliationError(instance, err)
 	}
 
+	// With the Retain deletion policy the instance is left in place at the
+	// broker. Orphan mitigation and deprovision requests that have already
+	// been started are still carried through.
+	if instance.DeletionTimestamp != nil &&
+		instance.Spec.DeletionPolicy == v1beta1.DeletionPolicyRetain &&
+		!instance.Status.OrphanMitigationInProgress &&
+		instance.Status.CurrentOperation != v1beta1.ServiceInstanceOperationDeprovision {
+
+		return c.processServiceInstanceRetained(instance)
+	}
+
 	var prettyName string
 	var brokerName string
 	var brokerClient osb.Client
@@ -872,6 +952,9 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 
 	glog.V(4).Info(pcb.Message("Sending deprovision request to broker"))
 	response, err := brokerClient.DeprovisionInstance(request)
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
 	if err != nil {
 		msg := fmt.Sprintf(
 			`Error deprovisioning, %s at ClusterServiceBroker %q: %v`,
@@ -883,7 +966,7 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCalledReason, msg)
 
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.instanceRetryDurationExceeded(instance) {
 			msg := "Stopping reconciliation retries because too much time has elapsed"
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
 			return c.processDeprovisionFailure(instance, readyCond, failedCond)
@@ -931,6 +1014,9 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 	glog.V(5).Info(pcb.Message("Polling last operation"))
 
 	response, err := brokerClient.PollLastOperation(request)
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
 	if err != nil {
 		// If the operation was for delete and we receive a http.StatusGone,
 		// this is considered a success as per the spec
@@ -950,7 +1036,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		glog.V(4).Info(pcb.Message(message))
 		c.recorder.Event(instance, corev1.EventTypeWarning, reason, message)
 
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.instancePollingDurationExceeded(instance) {
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
@@ -985,22 +1071,24 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		}
 
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.instancePollingDurationExceeded(instance) {
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
 
-		// only need to update the resource if there was a description for the operation provided
+		// record when the operation will next be polled, and the
+		// description of its progress, if the broker provided one
 		if response.Description != nil {
 			c.recorder.Event(instance, corev1.EventTypeNormal, readyCond.Reason, readyCond.Message)
 
 			setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
-			if _, err := c.updateServiceInstanceStatus(instance); err != nil {
-				return c.handleServiceInstancePollingError(instance, err)
-			}
+		}
+		delay := c.scheduleServiceInstancePoll(instance, response.RetryAfter)
+		if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+			return c.handleServiceInstancePollingError(instance, err)
 		}
 
-		glog.V(4).Info(pcb.Message("Last operation not completed (still in progress)"))
-		return c.continuePollingServiceInstance(instance)
+		glog.V(4).Info(pcb.Messagef("Last operation not completed (still in progress); polling again in %v", delay))
+		return c.beginPollingServiceInstance(instance, delay)
 	case osb.StateSucceeded:
 		var err error
 		switch {
@@ -1023,7 +1111,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 			msg := "Deprovision call failed: " + description
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCalledReason, msg)
 
-			if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+			if c.instancePollingDurationExceeded(instance) {
 				return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 			}
 
@@ -1049,7 +1137,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		return c.finishPollingServiceInstance(instance)
 	default:
 		glog.Warning(pcb.Messagef("Got invalid state in LastOperationResponse: %q", response.State))
-		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
+		if c.instancePollingDurationExceeded(instance) {
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, nil)
 		}
 
@@ -1065,6 +1153,14 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 func clearServiceInstanceAsyncOsbOperation(instance *v1beta1.ServiceInstance) {
 	instance.Status.AsyncOpInProgress = false
 	instance.Status.LastOperation = nil
+	instance.Status.NextPollTime = nil
+}
+
+// isServiceInstanceAdopted returns true if the instance carries the adoption
+// annotation and has not been provisioned yet.
+func isServiceInstanceAdopted(instance *v1beta1.ServiceInstance) bool {
+	return instance.Annotations[v1beta1.AdoptionAnnotation] == "true" &&
+		instance.Status.ProvisionStatus != v1beta1.ServiceInstanceProvisionStatusProvisioned
 }
 
 // isServiceInstanceProcessedAlready returns true if there is no further processing
@@ -1508,7 +1604,8 @@ func (c *controller) prepareProvisionRequest(instance *v1beta1.ServiceInstance)
 		if err = c.checkForRemovedClusterClassAndPlan(instance, serviceClass, servicePlan); err != nil {
 			return nil, nil, err
 		}
-		request, inProgressProperties, err := c.innerPrepareProvisionRequest(instance, serviceClass.Spec.CommonServiceClassSpec, servicePlan.Spec.CommonServicePlanSpec)
+		maintenanceInfoSupported := supportsMaintenanceInfo(c.osbAPIVersionForClusterServiceBroker(serviceClass.Spec.ClusterServiceBrokerName))
+		request, inProgressProperties, err := c.innerPrepareProvisionRequest(instance, serviceClass.Spec.CommonServiceClassSpec, servicePlan.Spec.CommonServicePlanSpec, maintenanceInfoSupported)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1523,7 +1620,8 @@ func (c *controller) prepareProvisionRequest(instance *v1beta1.ServiceInstance)
 		if err = c.checkForRemovedClassAndPlan(instance, serviceClass, servicePlan); err != nil {
 			return nil, nil, err
 		}
-		request, inProgressProperties, err := c.innerPrepareProvisionRequest(instance, serviceClass.Spec.CommonServiceClassSpec, servicePlan.Spec.CommonServicePlanSpec)
+		maintenanceInfoSupported := supportsMaintenanceInfo(c.osbAPIVersionForServiceBroker(serviceClass.Namespace, serviceClass.Spec.ServiceBrokerName))
+		request, inProgressProperties, err := c.innerPrepareProvisionRequest(instance, serviceClass.Spec.CommonServiceClassSpec, servicePlan.Spec.CommonServicePlanSpec, maintenanceInfoSupported)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1762,6 +1860,9 @@ func (c *controller) prepareObservedGeneration(toUpdate *v1beta1.ServiceInstance
 	removeServiceInstanceCondition(
 		toUpdate,
 		v1beta1.ServiceInstanceConditionFailed)
+	removeServiceInstanceCondition(
+		toUpdate,
+		v1beta1.ServiceInstanceConditionPendingUpdate)
 }
 
 // isServiceInstancePropertiesStateEqual checks whether two ServiceInstancePropertiesState objects are equal
@@ -1781,6 +1882,9 @@ func isServiceInstancePropertiesStateEqual(s1 *v1beta1.ServiceInstanceProperties
 	if s1.ParametersChecksum != s2.ParametersChecksum {
 		return false
 	}
+	if !isMaintenanceInfoVersionEqual(s1.MaintenanceInfo, s2.MaintenanceInfo) {
+		return false
+	}
 	if s1.UserInfo != nil || s2.UserInfo != nil {
 		u1 := s1.UserInfo
 		u2 := s2.UserInfo
@@ -1924,6 +2028,7 @@ func clearServiceInstanceCurrentOperation(toUpdate *v1beta1.ServiceInstance) {
 	toUpdate.Status.OperationStartTime = nil
 	toUpdate.Status.AsyncOpInProgress = false
 	toUpdate.Status.LastOperation = nil
+	toUpdate.Status.NextPollTime = nil
 	toUpdate.Status.InProgressProperties = nil
 }
 
@@ -2032,13 +2137,19 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 		"namespace":          instance.Namespace,
 		clusterIdentifierKey: id,
 	}
+	if c.callbacks != nil {
+		for k, v := range c.callbacks.serviceInstanceContext(instance) {
+			rh.requestContext[k] = v
+		}
+	}
 	return rh, nil
 }
 
 // innerPrepareProvisionRequest creates a provision request object to be passed to
 // the broker client to provision the given instance, with a cluster scoped
-// class and plan
-func (c *controller) innerPrepareProvisionRequest(instance *v1beta1.ServiceInstance, classCommon v1beta1.CommonServiceClassSpec, planCommon v1beta1.CommonServicePlanSpec) (*osb.ProvisionRequest, *v1beta1.ServiceInstancePropertiesState, error) {
+// class and plan. The plan's maintenance info is only sent if the broker
+// supports it.
+func (c *controller) innerPrepareProvisionRequest(instance *v1beta1.ServiceInstance, classCommon v1beta1.CommonServiceClassSpec, planCommon v1beta1.CommonServicePlanSpec, maintenanceInfoSupported bool) (*osb.ProvisionRequest, *v1beta1.ServiceInstancePropertiesState, error) {
 	rh, err := c.prepareRequestHelper(instance, planCommon.ExternalName, planCommon.ExternalID, true)
 	if err != nil {
 		return nil, nil, err
@@ -2061,6 +2172,10 @@ func (c *controller) innerPrepareProvisionRequest(instance *v1beta1.ServiceInsta
 		Context:             rh.requestContext,
 		OriginatingIdentity: rh.originatingIdentity,
 	}
+	if maintenanceInfoSupported {
+		request.MaintenanceInfo = toOSBMaintenanceInfo(planCommon.MaintenanceInfo)
+		rh.inProgressProperties.MaintenanceInfo = planCommon.MaintenanceInfo
+	}
 
 	return request, rh.inProgressProperties, nil
 }
@@ -2106,6 +2221,10 @@ func (c *controller) prepareUpdateInstanceRequest(instance *v1beta1.ServiceInsta
 				request.Parameters = make(map[string]interface{})
 			}
 		}
+		maintenanceInfoSupported := supportsMaintenanceInfo(c.osbAPIVersionForClusterServiceBroker(serviceClass.Spec.ClusterServiceBrokerName))
+		if err := prepareUpdateMaintenanceInfo(instance, &servicePlan.Spec.CommonServicePlanSpec, request, rh.inProgressProperties, maintenanceInfoSupported); err != nil {
+			return nil, nil, err
+		}
 
 	} else if instance.Spec.ServiceClassSpecified() {
 		serviceClass, servicePlan, _, _, err := c.getServiceClassPlanAndServiceBroker(instance)
@@ -2141,6 +2260,10 @@ func (c *controller) prepareUpdateInstanceRequest(instance *v1beta1.ServiceInsta
 				request.Parameters = make(map[string]interface{})
 			}
 		}
+		maintenanceInfoSupported := supportsMaintenanceInfo(c.osbAPIVersionForServiceBroker(serviceClass.Namespace, serviceClass.Spec.ServiceBrokerName))
+		if err := prepareUpdateMaintenanceInfo(instance, &servicePlan.Spec.CommonServicePlanSpec, request, rh.inProgressProperties, maintenanceInfoSupported); err != nil {
+			return nil, nil, err
+		}
 
 	}
 
@@ -2296,6 +2419,24 @@ func (c *controller) processServiceInstanceGracefulDeletionSuccess(instance *v1b
 	return nil
 }
 
+// processServiceInstanceRetained handles the logging and updating of a
+// ServiceInstance that is being deleted without deprovisioning it at the
+// broker.
+func (c *controller) processServiceInstanceRetained(instance *v1beta1.ServiceInstance) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	glog.V(4).Info(pcb.Message("Skipping deprovision request because the deletion policy is Retain"))
+
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, successRetainInstanceReason, successRetainInstanceMessage)
+	clearServiceInstanceCurrentOperation(instance)
+
+	if err := c.processServiceInstanceGracefulDeletionSuccess(instance); err != nil {
+		return err
+	}
+
+	c.recorder.Event(instance, corev1.EventTypeNormal, successRetainInstanceReason, successRetainInstanceMessage)
+	return nil
+}
+
 func (c *controller) removeFinalizer(instance *v1beta1.ServiceInstance) {
 	finalizers := sets.NewString(instance.Finalizers...)
 	finalizers.Delete(v1beta1.FinalizerServiceCatalog)
@@ -2340,6 +2481,7 @@ func (c *controller) processProvisionSuccess(instance *v1beta1.ServiceInstance,
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successProvisionReason, successProvisionMessage)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
 	clearServiceInstanceCurrentOperation(instance)
+	c.setServiceInstanceUpgradeAvailableConditionFromPlan(instance)
 	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
 	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
 
@@ -2349,6 +2491,112 @@ func (c *controller) processProvisionSuccess(instance *v1beta1.ServiceInstance,
 
 	c.removeInstanceFromRetryMap(instance)
 	c.recorder.Eventf(instance, corev1.EventTypeNormal, successProvisionReason, successProvisionMessage)
+	c.notifyServiceInstance(v1beta1.NotificationEventInstanceProvisioned, instance, successProvisionReason, successProvisionMessage)
+	return nil
+}
+
+// adoptServiceInstance records an instance that carries the adoption
+// annotation as provisioned without sending a provision request. When the
+// class declares instances_retrievable and the broker negotiated OSB API 2.14
+// or later, the instance is first fetched from the broker, and only adopted
+// if the broker knows it with the service and plan of the provision request.
+// Otherwise the annotation is trusted: setting it requires the adopt verb on
+// the instance, which the ServiceAdoptionSarCheck admission plugin checks.
+func (c *controller) adoptServiceInstance(instance *v1beta1.ServiceInstance, request *osb.ProvisionRequest, inProgressProperties *v1beta1.ServiceInstancePropertiesState) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	var brokerName string
+	var brokerClient osb.Client
+	var instancesRetrievable bool
+	if instance.Spec.ClusterServiceClassSpecified() {
+		serviceClass, _, bName, bClient, err := c.getClusterServiceClassPlanAndClusterServiceBroker(instance)
+		if err != nil {
+			return c.handleServiceInstanceReconciliationError(instance, err)
+		}
+		brokerName, brokerClient = bName, bClient
+		instancesRetrievable = serviceClass.Spec.InstancesRetrievable &&
+			supportsGetInstance(c.osbAPIVersionForClusterServiceBroker(bName))
+	} else {
+		serviceClass, _, bName, bClient, err := c.getServiceClassPlanAndServiceBroker(instance)
+		if err != nil {
+			return c.handleServiceInstanceReconciliationError(instance, err)
+		}
+		brokerName, brokerClient = bName, bClient
+		instancesRetrievable = serviceClass.Spec.InstancesRetrievable &&
+			supportsGetInstance(c.osbAPIVersionForServiceBroker(instance.Namespace, bName))
+	}
+
+	if !instancesRetrievable {
+		glog.V(4).Info(pcb.Messagef("Broker %q does not support fetching instances; trusting the adoption annotation", brokerName))
+		return c.processServiceInstanceAdoption(instance, inProgressProperties, successTrustInstanceMessage)
+	}
+
+	glog.V(4).Info(pcb.Messagef("Confirming the instance to adopt with Broker %q", brokerName))
+
+	serviceID := request.ServiceID
+	planID := request.PlanID
+	response, err := brokerClient.GetInstance(&osb.GetInstanceRequest{
+		InstanceID:          request.InstanceID,
+		ServiceID:           &serviceID,
+		PlanID:              &planID,
+		OriginatingIdentity: request.OriginatingIdentity,
+	})
+	if isBrokerRequestLimitedError(err) {
+		return err
+	}
+	c.setRetryBackoffRequired(instance)
+	if err != nil {
+		if isUnknownToBrokerError(err) {
+			msg := fmt.Sprintf("Broker %q does not know the instance to adopt", brokerName)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorAdoptionFailedReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorAdoptionFailedReason, msg)
+			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
+		}
+		msg := fmt.Sprintf("Error confirming the instance to adopt with Broker %q: %v", brokerName, err)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorAdoptionNotConfirmedReason, msg)
+		return c.processTemporaryProvisionFailure(instance, readyCond, false)
+	}
+
+	// Brokers before OSB API 2.15 do not return the service and plan.
+	if (response.ServiceID != "" && response.ServiceID != serviceID) || (response.PlanID != "" && response.PlanID != planID) {
+		msg := fmt.Sprintf("Broker %q reported that the instance to adopt has service %q and plan %q, not %q and %q", brokerName, response.ServiceID, response.PlanID, serviceID, planID)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorAdoptionFailedReason, msg)
+		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorAdoptionFailedReason, msg)
+		return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
+	}
+
+	c.removeInstanceFromRetryMap(instance)
+	setServiceInstanceDashboardURL(instance, response.DashboardURL)
+	return c.processServiceInstanceAdoption(instance, inProgressProperties, successAdoptInstanceMessage)
+}
+
+// isUnknownToBrokerError returns whether the error is a 404 Not Found or
+// 410 Gone response, which a broker returns when fetching an instance or
+// binding it does not know.
+func isUnknownToBrokerError(err error) bool {
+	statusCodeError, ok := osb.IsHTTPError(err)
+	return ok && (statusCodeError.StatusCode == http.StatusNotFound || statusCodeError.StatusCode == http.StatusGone)
+}
+
+// processServiceInstanceAdoption handles the logging and updating of a
+// ServiceInstance that is recorded as provisioned without sending a provision
+// request. The message tells whether the broker confirmed the instance.
+func (c *controller) processServiceInstanceAdoption(instance *v1beta1.ServiceInstance, externalProperties *v1beta1.ServiceInstancePropertiesState, message string) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	glog.V(4).Info(pcb.Message("Adopting instance without sending a provision request"))
+
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successAdoptInstanceReason, message)
+	instance.Status.ExternalProperties = externalProperties
+	clearServiceInstanceCurrentOperation(instance)
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
+
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return err
+	}
+
+	c.recorder.Event(instance, corev1.EventTypeNormal, successAdoptInstanceReason, message)
 	return nil
 }
 
@@ -2416,6 +2664,10 @@ func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance,
 		return err
 	}
 
+	if failedCond != nil {
+		c.notifyServiceInstance(v1beta1.NotificationEventInstanceFailed, instance, failedCond.Reason, failedCond.Message)
+	}
+
 	// The instance will be requeued in any case, since we updated the status
 	// a few lines above.
 	// But we still need to return a non-nil error for retriable errors and
@@ -2435,12 +2687,13 @@ func (c *controller) processProvisionAsyncResponse(instance *v1beta1.ServiceInst
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncProvisioningReason, asyncProvisioningMessage)
 	instance.Status.AsyncOpInProgress = true
 
+	delay := c.scheduleServiceInstancePoll(instance, nil)
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
 
 	c.recorder.Event(instance, corev1.EventTypeNormal, asyncProvisioningReason, asyncProvisioningMessage)
-	return c.beginPollingServiceInstance(instance)
+	return c.beginPollingServiceInstance(instance, delay)
 }
 
 // processUpdateServiceInstanceSuccess handles the logging and updating of a
@@ -2449,6 +2702,7 @@ func (c *controller) processUpdateServiceInstanceSuccess(instance *v1beta1.Servi
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successUpdateInstanceReason, successUpdateInstanceMessage)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
 	clearServiceInstanceCurrentOperation(instance)
+	c.setServiceInstanceUpgradeAvailableConditionFromPlan(instance)
 	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
@@ -2504,6 +2758,7 @@ func (c *controller) processUpdateServiceInstanceFailure(instance *v1beta1.Servi
 	if failedCond == nil {
 		return fmt.Errorf(readyCond.Message)
 	}
+	c.notifyServiceInstance(v1beta1.NotificationEventInstanceFailed, instance, failedCond.Reason, failedCond.Message)
 	return nil
 }
 
@@ -2515,12 +2770,13 @@ func (c *controller) processUpdateServiceInstanceAsyncResponse(instance *v1beta1
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncUpdatingInstanceReason, asyncUpdatingInstanceMessage)
 	instance.Status.AsyncOpInProgress = true
 
+	delay := c.scheduleServiceInstancePoll(instance, nil)
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
 
 	c.recorder.Event(instance, corev1.EventTypeNormal, asyncUpdatingInstanceReason, asyncUpdatingInstanceMessage)
-	return c.beginPollingServiceInstance(instance)
+	return c.beginPollingServiceInstance(instance, delay)
 }
 
 // processDeprovisionSuccess handles the logging and updating of
@@ -2556,6 +2812,9 @@ func (c *controller) processDeprovisionSuccess(instance *v1beta1.ServiceInstance
 	}
 
 	c.recorder.Event(instance, corev1.EventTypeNormal, reason, msg)
+	if !mitigatingOrphan {
+		c.notifyServiceInstance(v1beta1.NotificationEventInstanceDeprovisioned, instance, reason, msg)
+	}
 	return nil
 }
 
@@ -2591,6 +2850,7 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 		return err
 	}
 
+	c.notifyServiceInstance(v1beta1.NotificationEventInstanceFailed, instance, failedCond.Reason, failedCond.Message)
 	return nil
 }
 
@@ -2602,12 +2862,13 @@ func (c *controller) processDeprovisionAsyncResponse(instance *v1beta1.ServiceIn
 	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncDeprovisioningReason, asyncDeprovisioningMessage)
 	instance.Status.AsyncOpInProgress = true
 
+	delay := c.scheduleServiceInstancePoll(instance, nil)
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
 
 	c.recorder.Event(instance, corev1.EventTypeNormal, asyncDeprovisioningReason, asyncDeprovisioningMessage)
-	return c.beginPollingServiceInstance(instance)
+	return c.beginPollingServiceInstance(instance, delay)
 }
 
 // handleServiceInstancePollingError is a helper function that handles logic for
@@ -2170,6 +2170,241 @@ func TestReconcileServiceInstanceDeleteDoesNotInvokeClusterServiceBroker(t *test
 	assertNumEvents(t, events, 0)
 }
 
+// TestReconcileServiceInstanceDeleteWithRetainPolicy verifies that deleting
+// an instance whose deletion policy is Retain removes the finalizer without
+// sending a deprovision request to the broker.
+func TestReconcileServiceInstanceDeleteWithRetainPolicy(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Spec.DeletionPolicy = v1beta1.DeletionPolicyRetain
+	instance.Generation = 2
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+	}
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+
+	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, instance, nil
+	})
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	// Verify no core kube actions occurred
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	// The one action should be:
+	// 0. Removing the finalizer
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertEmptyFinalizers(t, updatedServiceInstance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, successRetainInstanceReason)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := normalEventBuilder(successRetainInstanceReason).msg(successRetainInstanceMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceAdopted tests reconcileInstance to ensure that
+// an instance carrying the adoption annotation is recorded as provisioned
+// without sending a provision request. The broker confirms the instance when
+// the class is retrievable and OSB API 2.14 was negotiated; otherwise the
+// annotation is trusted.
+func TestReconcileServiceInstanceAdopted(t *testing.T) {
+	dashboardURL := "http://dashboard"
+
+	cases := []struct {
+		name                  string
+		instancesRetrievable  bool
+		negotiated            string
+		reaction              *fakeosb.GetInstanceReaction
+		expectedError         bool
+		expectedReadyReason   string
+		expectedFailedReason  string
+		expectedProvisioned   bool
+		expectedReadyCondTrue bool
+		expectedMessage       string
+		expectedDashboardURL  string
+	}{
+		{
+			name:                 "confirmed",
+			instancesRetrievable: true,
+			reaction: &fakeosb.GetInstanceReaction{
+				Response: &osb.GetInstanceResponse{
+					ServiceID:    testClusterServiceClassGUID,
+					PlanID:       testClusterServicePlanGUID,
+					DashboardURL: &dashboardURL,
+				},
+			},
+			expectedReadyReason:   successAdoptInstanceReason,
+			expectedProvisioned:   true,
+			expectedReadyCondTrue: true,
+			expectedMessage:       successAdoptInstanceMessage,
+			expectedDashboardURL:  dashboardURL,
+		},
+		{
+			name:                 "confirmed without service and plan",
+			instancesRetrievable: true,
+			reaction: &fakeosb.GetInstanceReaction{
+				Response: &osb.GetInstanceResponse{},
+			},
+			expectedReadyReason:   successAdoptInstanceReason,
+			expectedProvisioned:   true,
+			expectedReadyCondTrue: true,
+			expectedMessage:       successAdoptInstanceMessage,
+		},
+		{
+			name:                  "class not retrievable",
+			expectedReadyReason:   successAdoptInstanceReason,
+			expectedProvisioned:   true,
+			expectedReadyCondTrue: true,
+			expectedMessage:       successTrustInstanceMessage,
+		},
+		{
+			name:                  "OSB API 2.13 negotiated",
+			instancesRetrievable:  true,
+			negotiated:            "2.13",
+			expectedReadyReason:   successAdoptInstanceReason,
+			expectedProvisioned:   true,
+			expectedReadyCondTrue: true,
+			expectedMessage:       successTrustInstanceMessage,
+		},
+		{
+			name:                 "unknown to the broker",
+			instancesRetrievable: true,
+			reaction: &fakeosb.GetInstanceReaction{
+				Error: osb.HTTPStatusCodeError{StatusCode: http.StatusNotFound},
+			},
+			expectedReadyReason:  errorAdoptionFailedReason,
+			expectedFailedReason: errorAdoptionFailedReason,
+		},
+		{
+			name:                 "gone from the broker",
+			instancesRetrievable: true,
+			reaction: &fakeosb.GetInstanceReaction{
+				Error: osb.HTTPStatusCodeError{StatusCode: http.StatusGone},
+			},
+			expectedReadyReason:  errorAdoptionFailedReason,
+			expectedFailedReason: errorAdoptionFailedReason,
+		},
+		{
+			name:                 "different plan at the broker",
+			instancesRetrievable: true,
+			reaction: &fakeosb.GetInstanceReaction{
+				Response: &osb.GetInstanceResponse{
+					ServiceID: testClusterServiceClassGUID,
+					PlanID:    "other-plan",
+				},
+			},
+			expectedReadyReason:  errorAdoptionFailedReason,
+			expectedFailedReason: errorAdoptionFailedReason,
+		},
+		{
+			name:                 "broker error",
+			instancesRetrievable: true,
+			reaction: &fakeosb.GetInstanceReaction{
+				Error: osb.HTTPStatusCodeError{StatusCode: http.StatusInternalServerError},
+			},
+			expectedError:       true,
+			expectedReadyReason: errorAdoptionNotConfirmedReason,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				GetInstanceReaction: tc.reaction,
+			})
+			testController.preferredOSBAPIVersion = osb.Version2_14()
+
+			addGetNamespaceReaction(fakeKubeClient)
+
+			broker := getTestClusterServiceBroker()
+			broker.Status.OSBAPIVersion = tc.negotiated
+			serviceClass := getTestClusterServiceClass()
+			serviceClass.Spec.InstancesRetrievable = tc.instancesRetrievable
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(serviceClass)
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.Annotations = map[string]string{v1beta1.AdoptionAnnotation: "true"}
+
+			err := reconcileServiceInstance(t, testController, instance)
+			if tc.expectedError && err == nil {
+				t.Fatal("expected an error")
+			} else if !tc.expectedError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			if tc.reaction == nil {
+				assertNumberOfBrokerActions(t, brokerActions, 0)
+			} else {
+				assertNumberOfBrokerActions(t, brokerActions, 1)
+				assertGetInstance(t, brokerActions[0], &osb.GetInstanceRequest{
+					InstanceID: testServiceInstanceGUID,
+					ServiceID:  strPtr(testClusterServiceClassGUID),
+					PlanID:     strPtr(testClusterServicePlanGUID),
+				})
+			}
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			if tc.expectedReadyCondTrue {
+				assertServiceInstanceReadyTrue(t, updatedServiceInstance, tc.expectedReadyReason)
+			} else {
+				assertServiceInstanceReadyFalse(t, updatedServiceInstance, tc.expectedReadyReason)
+			}
+			if tc.expectedFailedReason != "" {
+				assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, tc.expectedFailedReason)
+			}
+			if !tc.expectedProvisioned {
+				assertServiceInstanceProvisioned(t, updatedServiceInstance, "")
+				return
+			}
+
+			assertServiceInstanceCurrentOperationClear(t, updatedServiceInstance)
+			assertServiceInstanceProvisioned(t, updatedServiceInstance, v1beta1.ServiceInstanceProvisionStatusProvisioned)
+			assertServiceInstanceDeprovisionStatus(t, updatedServiceInstance, v1beta1.ServiceInstanceDeprovisionStatusRequired)
+			assertServiceInstanceExternalPropertiesParameters(t, updatedServiceInstance, nil, "")
+			if tc.expectedDashboardURL != "" {
+				assertServiceInstanceDashboardURL(t, updatedServiceInstance, tc.expectedDashboardURL)
+			}
+
+			events := getRecordedEvents(testController)
+
+			expectedEvent := normalEventBuilder(successAdoptInstanceReason).msg(tc.expectedMessage)
+			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // TestFinalizerClearedWhen409ConflictEncounteredOnStatusUpdate verfies that the finalizer
 // is removed even when the status update gets back a 409 Conflict from the API server
 // because the controller is working with an old version of the ServiceInstance
@@ -2360,6 +2595,96 @@ func TestPollServiceInstanceInProgressProvisioningWithOperation(t *testing.T) {
 	assertNumberOfActions(t, kubeActions, 0)
 }
 
+// assertNextPollTimeBetween asserts that the instance's next poll time is
+// between the given delays from now, allowing for jitter.
+func assertNextPollTimeBetween(t *testing.T, nextPollTime *metav1.Time, now time.Time, min, max time.Duration) {
+	if nextPollTime == nil {
+		t.Fatalf("expected the next poll time to be set")
+	}
+	if nextPollTime.Time.Before(now.Add(min).Truncate(time.Second)) || nextPollTime.Time.After(now.Add(max+time.Second)) {
+		t.Fatalf("expected the next poll time to be between %v and %v from now, got %v", min, max, nextPollTime.Time.Sub(now))
+	}
+}
+
+// TestPollServiceInstanceInProgressNextPollTime tests that polling an
+// instance whose operation is still in progress records when it is next
+// polled, honouring the broker's Retry-After and the plan's expected
+// operation duration.
+func TestPollServiceInstanceInProgressNextPollTime(t *testing.T) {
+	retryAfter := 10 * time.Minute
+
+	cases := []struct {
+		name       string
+		metadata   string
+		retryAfter *time.Duration
+		min        time.Duration
+		max        time.Duration
+	}{
+		{
+			name: "backoff",
+			min:  pollingStartInterval,
+			max:  time.Duration(float64(pollingStartInterval) * (1 + pollingJitter)),
+		},
+		{
+			name:       "retry after",
+			retryAfter: &retryAfter,
+			min:        retryAfter,
+			max:        time.Duration(float64(retryAfter) * (1 + pollingJitter)),
+		},
+		{
+			// the operation started an hour ago
+			name:     "expected duration",
+			metadata: `{"expected_operation_duration":7200}`,
+			min:      time.Hour,
+			max:      time.Duration(float64(time.Hour) * (1 + pollingJitter)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				PollLastOperationReaction: &fakeosb.PollLastOperationReaction{
+					Response: &osb.LastOperationResponse{
+						State:      osb.StateInProgress,
+						RetryAfter: tc.retryAfter,
+					},
+				},
+			})
+			testController.operationPollingMaximumBackoffDuration = 2 * time.Hour
+
+			plan := getTestClusterServicePlan()
+			if tc.metadata != "" {
+				plan.Spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(tc.metadata)}
+			}
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+
+			instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+			instanceKey := testNamespace + "/" + testServiceInstanceName
+
+			now := time.Now()
+			if err := testController.pollServiceInstance(instance); err != nil {
+				t.Fatalf("pollServiceInstance failed: %s", err)
+			}
+
+			if testController.instancePollingQueue.NumRequeues(instanceKey) != 1 {
+				t.Fatalf("Expected polling queue to have record of seeing test instance once")
+			}
+
+			// there should have been 1 action to record the next poll time,
+			// even without a description of the operation
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+			assertServiceInstanceCurrentOperation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision)
+			assertServiceInstanceLastOperation(t, updatedServiceInstance, testOperation)
+			assertNextPollTimeBetween(t, updatedServiceInstance.Status.NextPollTime, now, tc.min, tc.max)
+		})
+	}
+}
+
 // TestPollServiceInstanceSuccessProvisioningWithOperation tests polling an
 // instance that is already in process of provisioning (background/
 // asynchronously) and is found to be ready
@@ -2378,6 +2703,8 @@ func TestPollServiceInstanceSuccessProvisioningWithOperation(t *testing.T) {
 	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
 
 	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	nextPollTime := metav1.NewTime(time.Now())
+	instance.Status.NextPollTime = &nextPollTime
 	instanceKey := testNamespace + "/" + testServiceInstanceName
 
 	if testController.instancePollingQueue.NumRequeues(instanceKey) != 0 {
@@ -2413,6 +2740,9 @@ func TestPollServiceInstanceSuccessProvisioningWithOperation(t *testing.T) {
 
 	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
 	assertServiceInstanceOperationSuccess(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, testClusterServicePlanName, testClusterServicePlanGUID, instance)
+	if updatedServiceInstance.(*v1beta1.ServiceInstance).Status.NextPollTime != nil {
+		t.Fatalf("expected the next poll time to be cleared once the operation completed")
+	}
 }
 
 // TestPollServiceInstanceFailureProvisioningWithOperation tests polling an
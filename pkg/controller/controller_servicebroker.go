@@ -30,6 +30,8 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogconversion"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
 	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 )
@@ -75,6 +77,8 @@ func (c *controller) serviceBrokerDelete(obj interface{}) {
 		return
 	}
 
+	c.brokerClients.remove(broker.UID)
+	c.oauth2Tokens.Remove(string(broker.UID))
 	glog.V(4).Infof("Received delete event for ServiceBroker %v; no further processing will occur", broker.Name)
 }
 
@@ -176,7 +180,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 	}
 
 	if broker.DeletionTimestamp == nil { // Add or update
-		authConfig, err := getAuthCredentialsFromServiceBroker(c.kubeClient, broker)
+		credentials, err := c.getAuthCredentialsFromServiceBroker(broker)
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker auth credentials: %s", err)
 			glog.Info(pcb.Message(s))
@@ -187,11 +191,8 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			return err
 		}
 
-		// clientConfig := NewClientConfigurationForBroker(broker, authConfig)
-		clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-
-		glog.V(4).Info(pcb.Messagef("Creating client, URL: %v", broker.Spec.URL))
-		brokerClient, err := c.brokerClientCreateFunc(clientConfig)
+		glog.V(4).Info(pcb.Messagef("Getting client, URL: %v", broker.Spec.URL))
+		brokerClient, err := c.getBrokerClient(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, c.preferredOSBAPIVersion, credentials)
 		if err != nil {
 			s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 			glog.Info(pcb.Message(s))
@@ -204,9 +205,12 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 
 		glog.V(4).Info(pcb.Message("Processing adding/update event"))
 
-		// get the broker's catalog
+		// get the broker's catalog, negotiating the OSB API version
 		now := metav1.Now()
-		brokerCatalog, err := brokerClient.GetCatalog()
+		brokerCatalog, apiVersion, err := c.getCatalogNegotiatingOSBAPIVersion(brokerClient, broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, credentials, &broker.Status.CommonServiceBrokerStatus)
+		if isBrokerRequestLimitedError(err) {
+			return err
+		}
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			glog.Warning(pcb.Message(s))
@@ -221,7 +225,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 					glog.Error(pcb.Messagef("Error updating operation start time: %v", err))
 					return err
 				}
-			} else if !time.Now().Before(broker.Status.OperationStartTime.Time.Add(c.reconciliationRetryDuration)) {
+			} else if durationExceeded(broker.Status.OperationStartTime, c.reconciliationRetryDurationForBroker(&broker.Spec.CommonServiceBrokerSpec)) {
 				s := "Stopping reconciliation retries because too much time has elapsed"
 				glog.Info(pcb.Message(s))
 				c.recorder.Event(broker, corev1.EventTypeWarning, errorReconciliationRetryTimeoutReason, s)
@@ -237,7 +241,11 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			return err
 		}
 
-		glog.V(5).Info(pcb.Messagef("Successfully fetched %v catalog entries", len(brokerCatalog.Services)))
+		if brokerCatalog == nil {
+			glog.V(5).Info(pcb.Message("Catalog not modified since the last relist"))
+		} else {
+			glog.V(5).Info(pcb.Messagef("Successfully fetched %v catalog entries", len(brokerCatalog.Services)))
+		}
 
 		// set the operation start time if not already set
 		if broker.Status.OperationStartTime != nil {
@@ -249,10 +257,38 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			}
 		}
 
+		// skip reconciling the classes and plans when the catalog has not
+		// changed since it was last reconciled
+		var catalogHash string
+		if brokerCatalog != nil {
+			catalogHash, err = catalogdiff.Hash(brokerCatalog)
+			if err != nil {
+				glog.Warning(pcb.Messagef("Error computing catalog hash: %v", err))
+			}
+		}
+		if brokerCatalog == nil || isCatalogUnchanged(broker.Generation, &broker.Status.CommonServiceBrokerStatus, catalogHash) {
+			glog.V(4).Info(pcb.Message("Catalog is unchanged since the last relist; not reconciling classes and plans"))
+			toUpdate := broker.DeepCopy()
+			toUpdate.Status.OSBAPIVersion = apiVersion.HeaderValue()
+			if brokerCatalog != nil {
+				revision := toUpdate.Status.CatalogRevision
+				toUpdate.Status.CatalogRevision = catalogRevisionFor(revision, catalogHash, brokerCatalog.ETag, revision.ServiceClassCount, revision.ServicePlanCount)
+			}
+			if err := c.updateServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+				return err
+			}
+			c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successCatalogUnchangedMessage)
+			c.recordOSBAPIVersionChange(broker, &broker.Status.CommonServiceBrokerStatus, apiVersion)
+
+			metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(broker.Status.CatalogRevision.ServiceClassCount))
+			metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(broker.Status.CatalogRevision.ServicePlanCount))
+			return nil
+		}
+
 		// convert the broker's catalog payload into our API objects
 		glog.V(4).Info(pcb.Message("Converting catalog response into service-catalog API"))
 
-		payloadServiceClasses, payloadServicePlans, err := convertAndFilterCatalogToNamespacedTypes(broker.Namespace, brokerCatalog, broker.Spec.CatalogRestrictions)
+		payloadServiceClasses, payloadServicePlans, err := catalogconversion.ConvertAndFilterCatalogToNamespacedTypes(broker.Namespace, brokerCatalog, broker.Spec.CatalogRestrictions)
 		if err != nil {
 			s := fmt.Sprintf("Error converting catalog payload for broker %q to service-catalog API: %s", broker.Name, err)
 			glog.Warning(pcb.Message(s))
@@ -273,6 +309,8 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			return err
 		}
 
+		catalogDiff := catalogdiff.ForServiceBroker(payloadServiceClasses, payloadServicePlans, existingServiceClasses, existingServicePlans)
+
 		existingServiceClassMap := convertServiceClassListToMap(existingServiceClasses)
 		existingServicePlanMap := convertServicePlanListToMap(existingServicePlans)
 
@@ -323,6 +361,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 				}
 				return err
 			}
+			c.notifyServiceClassRemoved(existingServiceClass)
 		}
 
 		// reconcile the plans that were part of the broker's catalog payload
@@ -374,13 +413,18 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			}
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// everything worked correctly; record the catalog revision and update
+		// the broker's ready condition to status true
+		toUpdate := broker.DeepCopy()
+		toUpdate.Status.CatalogRevision = catalogRevisionFor(broker.Status.CatalogRevision, catalogHash, brokerCatalog.ETag, len(payloadServiceClasses), len(payloadServicePlans))
+		toUpdate.Status.OSBAPIVersion = apiVersion.HeaderValue()
+		if err := c.updateServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
 		c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successFetchedCatalogMessage)
+		c.recordCatalogChanges(broker, catalogDiff)
+		c.recordOSBAPIVersionChange(broker, &broker.Status.CommonServiceBrokerStatus, apiVersion)
 
 		// Update metrics with the number of serviceclass and serviceplans from this broker
 		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(len(payloadServiceClasses)))
@@ -519,6 +563,7 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 	// update it.
 	toUpdate := existingServiceClass.DeepCopy()
 	toUpdate.Spec.BindingRetrievable = serviceClass.Spec.BindingRetrievable
+	toUpdate.Spec.InstancesRetrievable = serviceClass.Spec.InstancesRetrievable
 	toUpdate.Spec.Bindable = serviceClass.Spec.Bindable
 	toUpdate.Spec.PlanUpdatable = serviceClass.Spec.PlanUpdatable
 	toUpdate.Spec.Tags = serviceClass.Spec.Tags
@@ -611,6 +656,8 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 	toUpdate.Spec.ServiceInstanceCreateParameterSchema = servicePlan.Spec.ServiceInstanceCreateParameterSchema
 	toUpdate.Spec.ServiceInstanceUpdateParameterSchema = servicePlan.Spec.ServiceInstanceUpdateParameterSchema
 	toUpdate.Spec.ServiceBindingCreateParameterSchema = servicePlan.Spec.ServiceBindingCreateParameterSchema
+	toUpdate.Spec.MaintenanceInfo = servicePlan.Spec.MaintenanceInfo
+	toUpdate.Spec.MaximumPollingDuration = servicePlan.Spec.MaximumPollingDuration
 
 	updatedPlan, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).Update(toUpdate)
 	if err != nil {
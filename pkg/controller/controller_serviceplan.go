@@ -78,6 +78,13 @@ func (c *controller) reconcileServicePlan(servicePlan *v1beta1.ServicePlan) erro
 	pcb := pretty.NewContextBuilder(pretty.ServicePlan, servicePlan.Namespace, servicePlan.Name, "")
 	glog.Infof("ServicePlan %q (ExternalName: %q): processing", servicePlan.Name, servicePlan.Spec.ExternalName)
 
+	err := c.updateUpgradeAvailableConditionsForPlan(servicePlan.Spec.MaintenanceInfo, func(instance *v1beta1.ServiceInstance) bool {
+		return instance.Namespace == servicePlan.Namespace && instance.Spec.ServicePlanRef != nil && instance.Spec.ServicePlanRef.Name == servicePlan.Name
+	})
+	if err != nil {
+		return err
+	}
+
 	if !servicePlan.Status.RemovedFromBrokerCatalog {
 		return nil
 	}
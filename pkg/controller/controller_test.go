@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,12 +31,14 @@ import (
 	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogconversion"
 	servicecataloginformers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions"
 	v1beta1informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
 
 	servicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/diff"
@@ -45,9 +49,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/util/sets"
+	kubeinformers "k8s.io/client-go/informers"
 	clientgofake "k8s.io/client-go/kubernetes/fake"
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 )
 
 // NOTE:
@@ -1129,7 +1135,8 @@ func getTestServiceBindingWithFailedStatus() *v1beta1.ServiceBinding {
 			Type:   v1beta1.ServiceBindingConditionFailed,
 			Status: v1beta1.ConditionTrue,
 		}},
-		UnbindStatus: v1beta1.ServiceBindingUnbindStatusNotRequired,
+		UnbindStatus:         v1beta1.ServiceBindingUnbindStatusNotRequired,
+		ReconciledGeneration: binding.Generation,
 	}
 
 	return binding
@@ -1261,7 +1268,7 @@ type bindingParameters struct {
 }
 
 func TestEmptyCatalogConversion(t *testing.T) {
-	serviceClasses, servicePlans, err := convertAndFilterCatalog(&osb.CatalogResponse{}, nil)
+	serviceClasses, servicePlans, err := catalogconversion.ConvertAndFilterCatalog(&osb.CatalogResponse{}, nil)
 	if err != nil {
 		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
 	}
@@ -1279,7 +1286,7 @@ func TestCatalogConversion(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to unmarshal test catalog: %v", err)
 	}
-	serviceClasses, servicePlans, err := convertAndFilterCatalog(catalog, nil)
+	serviceClasses, servicePlans, err := catalogconversion.ConvertAndFilterCatalog(catalog, nil)
 	if err != nil {
 		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
 	}
@@ -1294,6 +1301,41 @@ func TestCatalogConversion(t *testing.T) {
 	checkPlan(servicePlans[1], "0f4008b5-XXXX-XXXX-XXXX-dace631cd648", "fake-plan-2", "Shared fake Server, 5tb persistent disk, 40 max concurrent connections. 100 async", t)
 }
 
+// TestCatalogConversionMaximumPollingDuration ensures that a plan's
+// maximum_polling_duration, in seconds, is converted to a duration, and that
+// a non-positive one is dropped.
+func TestCatalogConversionMaximumPollingDuration(t *testing.T) {
+	seconds, zero := 5400, 0
+	catalog := &osb.CatalogResponse{
+		Services: []osb.Service{
+			{
+				ID:   "service-id",
+				Name: "service",
+				Plans: []osb.Plan{
+					{ID: "plan-1", Name: "plan-1", MaximumPollingDuration: &seconds},
+					{ID: "plan-2", Name: "plan-2", MaximumPollingDuration: &zero},
+					{ID: "plan-3", Name: "plan-3"},
+				},
+			},
+		},
+	}
+	_, servicePlans, err := catalogconversion.ConvertAndFilterCatalog(catalog, nil)
+	if err != nil {
+		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
+	}
+	if len(servicePlans) != 3 {
+		t.Fatalf("Expected 3 plans, but got: %d", len(servicePlans))
+	}
+	if d := servicePlans[0].Spec.MaximumPollingDuration; d == nil || d.Duration != 90*time.Minute {
+		t.Fatalf("Expected a maximum polling duration of 90m, got %v", d)
+	}
+	for _, plan := range servicePlans[1:] {
+		if d := plan.Spec.MaximumPollingDuration; d != nil {
+			t.Fatalf("Expected no maximum polling duration for plan %q, got %v", plan.Spec.ExternalName, d)
+		}
+	}
+}
+
 func TestCatalogConversionWithParameterSchemas(t *testing.T) {
 	utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ResponseSchema))
 	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ResponseSchema))
@@ -1303,7 +1345,7 @@ func TestCatalogConversionWithParameterSchemas(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to unmarshal test catalog: %v", err)
 	}
-	serviceClasses, servicePlans, err := convertAndFilterCatalog(catalog, nil)
+	serviceClasses, servicePlans, err := catalogconversion.ConvertAndFilterCatalog(catalog, nil)
 	if err != nil {
 		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
 	}
@@ -1605,7 +1647,7 @@ func TestConvertAndFilterCatalog(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to unmarshal test catalog: %v", err)
 			}
-			classes, plans, err := convertAndFilterCatalog(catalog, tc.restrictions)
+			classes, plans, err := catalogconversion.ConvertAndFilterCatalog(catalog, tc.restrictions)
 			if err != nil {
 				if tc.error {
 					return
@@ -1681,7 +1723,7 @@ func TestFilterServicePlans(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to unmarshal test catalog: %v", err)
 			}
-			_, servicePlans, err := convertAndFilterCatalog(catalog, nil)
+			_, servicePlans, err := catalogconversion.ConvertAndFilterCatalog(catalog, nil)
 			if err != nil {
 				t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
 			}
@@ -1694,7 +1736,7 @@ func TestFilterServicePlans(t *testing.T) {
 				ServicePlan: tc.requirements,
 			}
 
-			acceptedServiceClass, rejectedServiceClasses, err := filterServicePlans(restrictions, servicePlans)
+			acceptedServiceClass, rejectedServiceClasses, err := catalogconversion.FilterServicePlans(restrictions, servicePlans)
 			if len(acceptedServiceClass) != tc.accepted {
 				t.Fatalf("Unexpected number of accepted service plans after filtering, %s", expectedGot(tc.accepted, len(acceptedServiceClass)))
 			}
@@ -1759,7 +1801,7 @@ func TestCatalogConversionClusterServicePlanBindable(t *testing.T) {
 		t.Fatalf("Failed to unmarshal test catalog: %v", err)
 	}
 
-	aclasses, aplans, err := convertAndFilterCatalog(catalog, nil)
+	aclasses, aplans, err := catalogconversion.ConvertAndFilterCatalog(catalog, nil)
 	if err != nil {
 		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
 	}
@@ -1990,6 +2032,7 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 	// create informers
 	informerFactory := servicecataloginformers.NewSharedInformerFactory(fakeCatalogClient, 0)
 	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
+	coreInformerFactory := kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0)
 
 	fakeRecorder := record.NewFakeRecorder(5)
 
@@ -2005,6 +2048,7 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		coreInformerFactory.Core().V1().Secrets(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -2013,6 +2057,9 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 		7*24*time.Hour,
 		DefaultClusterIDConfigMapName,
 		DefaultClusterIDConfigMapNamespace,
+		nil,
+		nil,
+		nil,
 	)
 
 	if c, ok := testController.(*controller); ok {
@@ -3642,6 +3689,16 @@ func assertPollLastOperation(t *testing.T, action fakeosb.Action, request *osb.L
 	}
 }
 
+func assertGetInstance(t *testing.T, action fakeosb.Action, request *osb.GetInstanceRequest) {
+	if e, a := fakeosb.GetInstance, action.Type; e != a {
+		fatalf(t, "unexpected action type; expected %v, got %v", e, a)
+	}
+
+	if e, a := request, action.Request; !reflect.DeepEqual(e, a) {
+		fatalf(t, "unexpected diff in GET instance request: %v\nexpected %+v\ngot      %+v", diff.ObjectReflectDiff(e, a), e, a)
+	}
+}
+
 func assertPollBindingLastOperation(t *testing.T, action fakeosb.Action, request *osb.BindingLastOperationRequest) {
 	if e, a := fakeosb.PollBindingLastOperation, action.Type; e != a {
 		fatalf(t, "unexpected action type; expected %v, got %v", e, a)
@@ -3753,3 +3810,175 @@ func addGetSecretReaction(fakeKubeClient *clientgofake.Clientset, secret *corev1
 		return true, secret, nil
 	})
 }
+
+// fakeShardFilter owns the shards in owned, and assigns keys to shards by
+// the number before the first "/".
+type fakeShardFilter struct {
+	owned    map[int]bool
+	locked   int
+	handlers []func(shard int)
+}
+
+func (f *fakeShardFilter) ShardForKey(key string) int {
+	shard, _ := strconv.Atoi(strings.SplitN(key, "/", 2)[0])
+	return shard
+}
+
+func (f *fakeShardFilter) TryLock(shard int) bool {
+	if !f.owned[shard] {
+		return false
+	}
+	f.locked++
+	return true
+}
+
+func (f *fakeShardFilter) Unlock(shard int) {
+	f.locked--
+}
+
+func (f *fakeShardFilter) AddAcquiredHandler(handler func(shard int)) {
+	f.handlers = append(f.handlers, handler)
+}
+
+func TestWorkerSkipsKeysOfUnownedShards(t *testing.T) {
+	shards := &fakeShardFilter{owned: map[int]bool{1: true}}
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "test")
+	queue.Add("0/instance")
+	queue.Add("1/instance")
+	queue.Add("2/instance")
+	queue.ShutDown()
+
+	reconciled := []string{}
+	worker(queue, "ServiceInstance", maxRetries, true, func(key string) error {
+		if shards.locked != 1 {
+			t.Errorf("expected the shard of %q to be locked while reconciling", key)
+		}
+		reconciled = append(reconciled, key)
+		return nil
+	}, shards)()
+
+	if e, a := []string{"1/instance"}, reconciled; !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected only the keys of owned shards to be reconciled: expected %v, got %v", e, a)
+	}
+	if shards.locked != 0 {
+		t.Fatalf("expected every locked shard to be unlocked, %d are still locked", shards.locked)
+	}
+}
+
+func TestEnqueueShard(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	shards := &fakeShardFilter{}
+	testController.shards = shards
+
+	instance := getTestServiceInstance()
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	binding := getTestServiceBinding()
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+
+	// Every key of the test namespace is in shard 0.
+	testController.enqueueShard(1)
+	if testController.instanceQueue.Len() != 0 || testController.bindingQueue.Len() != 0 {
+		t.Fatal("expected no keys of other shards to be enqueued")
+	}
+
+	testController.enqueueShard(0)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("expected %d instance keys to be enqueued, got %d", e, a)
+	}
+	if e, a := 1, testController.bindingQueue.Len(); e != a {
+		t.Fatalf("expected %d binding keys to be enqueued, got %d", e, a)
+	}
+}
+
+func TestNewControllerWithoutClusterScopedInformers(t *testing.T) {
+	fakeKubeClient := &clientgofake.Clientset{}
+	fakeCatalogClient := &fake.Clientset{Clientset: &servicecatalogclientset.Clientset{}}
+	informerFactory := servicecataloginformers.NewSharedInformerFactory(fakeCatalogClient, 0)
+	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
+	coreInformerFactory := kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0)
+
+	testController, err := NewController(
+		fakeKubeClient,
+		fakeCatalogClient.ServicecatalogV1beta1(),
+		nil,
+		serviceCatalogSharedInformers.ServiceBrokers(),
+		nil,
+		serviceCatalogSharedInformers.ServiceClasses(),
+		serviceCatalogSharedInformers.ServiceInstances(),
+		serviceCatalogSharedInformers.ServiceBindings(),
+		nil,
+		serviceCatalogSharedInformers.ServicePlans(),
+		coreInformerFactory.Core().V1().Secrets(),
+		fakeosb.ReturnFakeClientFunc(fakeosb.NewFakeClient(noFakeActions())),
+		24*time.Hour,
+		osb.LatestAPIVersion().HeaderValue(),
+		record.NewFakeRecorder(5),
+		7*24*time.Hour,
+		7*24*time.Hour,
+		DefaultClusterIDConfigMapName,
+		DefaultClusterIDConfigMapNamespace,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := testController.(*controller)
+	if !c.ignoreClusterScoped {
+		t.Fatal("expected cluster-scoped resources to be ignored")
+	}
+	brokers, err := c.clusterServiceBrokerLister.List(labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error listing cluster service brokers: %v", err)
+	}
+	if len(brokers) != 0 {
+		t.Fatalf("expected no cluster service brokers, got %d", len(brokers))
+	}
+}
+
+func TestReconcileKeysIgnoringClusterScopedResources(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.ignoreClusterScoped = true
+
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstance())
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(getTestServiceBinding())
+
+	if err := testController.reconcileServiceInstanceKey(testNamespace + "/" + testServiceInstanceName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := testController.reconcileServiceBindingKey(testNamespace + "/" + testServiceBindingName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+}
+
+func TestInstanceUpdatesArePrioritized(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	queue := testController.instanceQueue.(*priorityQueue)
+
+	resynced := getTestServiceInstance()
+	resynced.ResourceVersion = "1"
+	testController.instanceUpdate(resynced, resynced)
+	if e, a := 1, queue.LenWithPriority(priorityLow); e != a {
+		t.Fatalf("expected a resync to be queued at low priority: expected %d keys, got %d", e, a)
+	}
+
+	updated := resynced.DeepCopy()
+	updated.ResourceVersion = "2"
+	updated.Generation = 2
+	testController.instanceUpdate(resynced, updated)
+	if e, a := 1, queue.LenWithPriority(priorityHigh); e != a {
+		t.Fatalf("expected a spec change to be queued at high priority: expected %d keys, got %d", e, a)
+	}
+	if _, shutdown := queue.Get(); shutdown {
+		t.Fatal("expected a key to be handed out")
+	}
+	if e, a := 0, queue.LenWithPriority(priorityHigh); e != a {
+		t.Fatalf("expected the key to be handed out at high priority first: expected %d keys left, got %d", e, a)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/golang/glog"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	cancelledReason                 string = "Cancelled"
+	cancelledProvisionMessage       string = "The provision was cancelled by the user"
+	cancelledUpdateMessage          string = "The update was cancelled by the user"
+	cancelIgnoredReason             string = "CancelIgnored"
+	cancelIgnoredNoOperationMessage string = "Cancel requested, but there is no operation in progress"
+	cancelIgnoredDeprovisionMessage string = "Cancel requested, but deprovisions cannot be cancelled"
+)
+
+// isServiceInstanceCancelRequested returns whether the user asked to cancel
+// the operation in progress on the instance, and the controller has not yet
+// acted on it.
+func isServiceInstanceCancelRequested(instance *v1beta1.ServiceInstance) bool {
+	return instance.Spec.CancelRequests > instance.Status.ObservedCancelRequests
+}
+
+// cancelServiceInstanceOperation abandons the operation in progress on an
+// instance. Polling the broker stops. A provision fails with the Cancelled
+// reason and the instance is deprovisioned, as its resources may already
+// exist at the broker; an update fails with the Cancelled reason and the
+// instance keeps its last applied properties. Deprovisions are not
+// cancelled.
+func (c *controller) cancelServiceInstanceOperation(instance *v1beta1.ServiceInstance) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	instance = instance.DeepCopy()
+	instance.Status.ObservedCancelRequests = instance.Spec.CancelRequests
+
+	mitigatingOrphan := instance.Status.OrphanMitigationInProgress
+	provisioning := instance.Status.CurrentOperation == v1beta1.ServiceInstanceOperationProvision && !mitigatingOrphan
+	updating := instance.Status.CurrentOperation == v1beta1.ServiceInstanceOperationUpdate && !mitigatingOrphan
+	deleting := instance.Status.CurrentOperation == v1beta1.ServiceInstanceOperationDeprovision || mitigatingOrphan ||
+		instance.DeletionTimestamp != nil
+
+	switch {
+	case deleting || (!provisioning && !updating):
+		message := cancelIgnoredNoOperationMessage
+		if deleting {
+			message = cancelIgnoredDeprovisionMessage
+		}
+		glog.V(4).Info(pcb.Message(message))
+		c.recorder.Event(instance, corev1.EventTypeWarning, cancelIgnoredReason, message)
+		_, err := c.updateServiceInstanceStatus(instance)
+		return err
+	case provisioning:
+		glog.V(4).Info(pcb.Message(cancelledProvisionMessage))
+		c.finishPollingServiceInstance(instance)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, cancelledReason, cancelledProvisionMessage)
+		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, cancelledReason, cancelledProvisionMessage)
+		return c.processTerminalProvisionFailure(instance, readyCond, failedCond, true)
+	default:
+		glog.V(4).Info(pcb.Message(cancelledUpdateMessage))
+		c.finishPollingServiceInstance(instance)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, cancelledReason, cancelledUpdateMessage)
+		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, cancelledReason, cancelledUpdateMessage)
+		return c.processTerminalUpdateServiceInstanceFailure(instance, readyCond, failedCond)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+)
+
+func assertServiceInstanceObservedCancelRequests(t *testing.T, obj runtime.Object, cancelRequests int64) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceInstance", obj)
+	}
+	if e, a := cancelRequests, instance.Status.ObservedCancelRequests; e != a {
+		fatalf(t, "Unexpected observed cancel requests: expected %v, got %v", e, a)
+	}
+}
+
+// TestReconcileServiceInstanceCancelAsyncProvisioning tests that cancelling
+// an asynchronous provision stops polling without asking the broker, fails
+// the provision and starts orphan mitigation.
+func TestReconcileServiceInstanceCancelAsyncProvisioning(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{})
+
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	instance.Spec.CancelRequests = 1
+
+	if err := testController.reconcileServiceInstance(instance); err == nil {
+		t.Fatal("expected an error to be returned in order to requeue the instance for orphan mitigation")
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceObservedCancelRequests(t, updatedServiceInstance, 1)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, cancelledReason)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, startingInstanceOrphanMitigationReason)
+	assertServiceInstanceOrphanMitigationTrue(t, updatedServiceInstance, cancelledReason)
+	assertServiceInstanceOrphanMitigationInProgressTrue(t, updatedServiceInstance)
+	if updatedServiceInstance.(*v1beta1.ServiceInstance).Status.AsyncOpInProgress {
+		t.Fatal("expected the asynchronous operation to be cleared")
+	}
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 3)
+}
+
+// TestReconcileServiceInstanceCancelAsyncUpdating tests that cancelling an
+// asynchronous update stops polling and fails the update.
+func TestReconcileServiceInstanceCancelAsyncUpdating(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{})
+
+	instance := getTestServiceInstanceAsyncUpdating(testOperation)
+	instance.Spec.CancelRequests = 1
+
+	if err := testController.reconcileServiceInstance(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceObservedCancelRequests(t, updatedServiceInstance, 1)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, cancelledReason)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, cancelledReason)
+	assertServiceInstanceCurrentOperationClear(t, updatedServiceInstance)
+	assertServiceInstanceOrphanMitigationInProgressFalse(t, updatedServiceInstance)
+}
+
+// TestReconcileServiceInstanceCancelIgnored tests that a cancellation is
+// only recorded when there is no operation to cancel, or the operation is a
+// deprovision.
+func TestReconcileServiceInstanceCancelIgnored(t *testing.T) {
+	cases := []struct {
+		name     string
+		instance *v1beta1.ServiceInstance
+	}{
+		{
+			name:     "no operation",
+			instance: getTestServiceInstanceWithClusterRefs(),
+		},
+		{
+			name:     "deprovision",
+			instance: getTestServiceInstanceAsyncDeprovisioning(testOperation),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{})
+
+			instance := tc.instance
+			instance.Spec.CancelRequests = 2
+			instance.Status.ObservedCancelRequests = 1
+
+			if err := testController.reconcileServiceInstance(instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertServiceInstanceObservedCancelRequests(t, updatedServiceInstance, 2)
+			assertServiceInstanceConditionsCount(t, updatedServiceInstance, len(instance.Status.Conditions))
+
+			events := getRecordedEvents(testController)
+			assertNumEvents(t, events, 1)
+			if e, a := cancelIgnoredReason, events[0]; !strings.Contains(a, e) {
+				t.Fatalf("expected event with reason %q, got %q", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	upgradeAvailableReason                    string = "UpgradeAvailable"
+	upgradeNotAvailableReason                 string = "UpToDate"
+	errorMaintenanceInfoVersionMismatchReason string = "MaintenanceInfoVersionMismatch"
+	errorMaintenanceInfoNotSupportedReason    string = "MaintenanceInfoNotSupported"
+)
+
+// toOSBMaintenanceInfo converts maintenance info to the form sent to brokers.
+func toOSBMaintenanceInfo(info *v1beta1.MaintenanceInfo) *osb.MaintenanceInfo {
+	if info == nil {
+		return nil
+	}
+	osbInfo := &osb.MaintenanceInfo{Version: info.Version}
+	if info.Description != "" {
+		description := info.Description
+		osbInfo.Description = &description
+	}
+	return osbInfo
+}
+
+// appliedMaintenanceInfo returns the maintenance info that the broker has
+// applied to the instance, or nil if there is none.
+func appliedMaintenanceInfo(instance *v1beta1.ServiceInstance) *v1beta1.MaintenanceInfo {
+	if instance.Status.ExternalProperties == nil {
+		return nil
+	}
+	return instance.Status.ExternalProperties.MaintenanceInfo
+}
+
+// isMaintenanceInfoVersionEqual returns whether both maintenance infos are
+// unset or have the same version.
+func isMaintenanceInfoVersionEqual(i1, i2 *v1beta1.MaintenanceInfo) bool {
+	if i1 == nil || i2 == nil {
+		return i1 == i2
+	}
+	return i1.Version == i2.Version
+}
+
+// prepareUpdateMaintenanceInfo sets the maintenance info of an update request
+// for the instance, and records the maintenance info the instance will be on
+// once the update succeeds in its in-progress properties.
+//
+// The plan's maintenance info is sent when the plan changes, or when the
+// instance's spec requests a maintenance version other than the applied
+// one. The requested version must be the plan's current one, as brokers only
+// upgrade instances to their plans' current versions. Brokers that do not
+// support maintenance info are never sent it, so their instances cannot be
+// upgraded.
+func prepareUpdateMaintenanceInfo(instance *v1beta1.ServiceInstance, planSpec *v1beta1.CommonServicePlanSpec, request *osb.UpdateInstanceRequest, inProgressProperties *v1beta1.ServiceInstancePropertiesState, maintenanceInfoSupported bool) error {
+	applied := appliedMaintenanceInfo(instance)
+	info := applied
+
+	requested := instance.Spec.MaintenanceInfoVersion
+	if !maintenanceInfoSupported {
+		if requested != "" && (applied == nil || applied.Version != requested) {
+			return &operationError{
+				reason:  errorMaintenanceInfoNotSupportedReason,
+				message: fmt.Sprintf("Cannot upgrade the instance to maintenance version %q: the broker has not negotiated OSB API version %s or newer", requested, osb.Version2_15().HeaderValue()),
+			}
+		}
+		inProgressProperties.MaintenanceInfo = applied
+		return nil
+	}
+
+	switch {
+	case request.PlanID != nil:
+		info = planSpec.MaintenanceInfo
+	case requested != "" && (applied == nil || applied.Version != requested):
+		if planSpec.MaintenanceInfo == nil || planSpec.MaintenanceInfo.Version != requested {
+			current := "none"
+			if planSpec.MaintenanceInfo != nil {
+				current = fmt.Sprintf("%q", planSpec.MaintenanceInfo.Version)
+			}
+			return &operationError{
+				reason:  errorMaintenanceInfoVersionMismatchReason,
+				message: fmt.Sprintf("Cannot upgrade the instance to maintenance version %q: the current maintenance version of its plan is %s", requested, current),
+			}
+		}
+		info = planSpec.MaintenanceInfo
+	}
+
+	inProgressProperties.MaintenanceInfo = info
+	if info != nil && (request.PlanID != nil || !isMaintenanceInfoVersionEqual(info, applied)) {
+		request.MaintenanceInfo = toOSBMaintenanceInfo(info)
+		if applied != nil {
+			request.PreviousValues = &osb.PreviousValues{MaintenanceInfo: toOSBMaintenanceInfo(applied)}
+		}
+	}
+	return nil
+}
+
+// newServiceInstanceUpgradeAvailableCondition returns the UpgradeAvailable
+// condition of an instance on a plan with the given maintenance info, or nil
+// if the plan has no maintenance info.
+func newServiceInstanceUpgradeAvailableCondition(instance *v1beta1.ServiceInstance, planInfo *v1beta1.MaintenanceInfo) *v1beta1.ServiceInstanceCondition {
+	if planInfo == nil {
+		return nil
+	}
+	if isMaintenanceInfoVersionEqual(appliedMaintenanceInfo(instance), planInfo) {
+		msg := fmt.Sprintf("The instance is on the current maintenance version %q of its plan", planInfo.Version)
+		return newServiceInstanceCondition(v1beta1.ConditionFalse, v1beta1.ServiceInstanceConditionUpgradeAvailable, upgradeNotAvailableReason, msg)
+	}
+	msg := fmt.Sprintf("Maintenance version %q of the instance's plan is available", planInfo.Version)
+	if planInfo.Description != "" {
+		msg = fmt.Sprintf("%s: %s", msg, planInfo.Description)
+	}
+	return newServiceInstanceCondition(v1beta1.ConditionTrue, v1beta1.ServiceInstanceConditionUpgradeAvailable, upgradeAvailableReason, msg)
+}
+
+// setServiceInstanceUpgradeAvailableCondition sets the instance's
+// UpgradeAvailable condition for a plan with the given maintenance info, and
+// returns whether the condition changed.
+//
+// Note: objects coming from informers should never be mutated; always pass a
+// deep copy as the instance parameter.
+func setServiceInstanceUpgradeAvailableCondition(toUpdate *v1beta1.ServiceInstance, planInfo *v1beta1.MaintenanceInfo) bool {
+	var existing *v1beta1.ServiceInstanceCondition
+	for i, cond := range toUpdate.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionUpgradeAvailable {
+			existing = &toUpdate.Status.Conditions[i]
+			break
+		}
+	}
+
+	cond := newServiceInstanceUpgradeAvailableCondition(toUpdate, planInfo)
+	switch {
+	case cond == nil && existing == nil:
+		return false
+	case cond == nil:
+		removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionUpgradeAvailable)
+		return true
+	case existing != nil && existing.Status == cond.Status && existing.Reason == cond.Reason && existing.Message == cond.Message:
+		return false
+	}
+	setServiceInstanceCondition(toUpdate, cond.Type, cond.Status, cond.Reason, cond.Message)
+	return true
+}
+
+// setServiceInstanceUpgradeAvailableConditionFromPlan sets the instance's
+// UpgradeAvailable condition for its current plan, if the plan can be found.
+//
+// Note: objects coming from informers should never be mutated; always pass a
+// deep copy as the instance parameter.
+func (c *controller) setServiceInstanceUpgradeAvailableConditionFromPlan(toUpdate *v1beta1.ServiceInstance) {
+	if _, planSpec := c.getBrokerAndPlanSpecsForServiceInstance(toUpdate); planSpec != nil {
+		setServiceInstanceUpgradeAvailableCondition(toUpdate, planSpec.MaintenanceInfo)
+	}
+}
+
+// updateUpgradeAvailableConditionsForPlan updates the UpgradeAvailable
+// condition of the provisioned instances accepted by onPlan, which are on a
+// plan with the given maintenance info. Instances with an operation in
+// progress are skipped; their condition is set when the operation completes.
+func (c *controller) updateUpgradeAvailableConditionsForPlan(planInfo *v1beta1.MaintenanceInfo, onPlan func(*v1beta1.ServiceInstance) bool) error {
+	instances, err := c.instanceLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range instances {
+		if !onPlan(instance) || instance.Status.ExternalProperties == nil || instance.Status.CurrentOperation != "" {
+			continue
+		}
+
+		toUpdate := instance.DeepCopy()
+		if !setServiceInstanceUpgradeAvailableCondition(toUpdate, planInfo) {
+			continue
+		}
+		pcb := pretty.NewInstanceContextBuilder(toUpdate)
+		glog.V(4).Info(pcb.Message("Updating UpgradeAvailable condition"))
+		if _, err := c.updateServiceInstanceStatus(toUpdate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
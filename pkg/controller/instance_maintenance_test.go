@@ -0,0 +1,233 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestPrepareUpdateMaintenanceInfo(t *testing.T) {
+	v1 := &v1beta1.MaintenanceInfo{Version: "1.0.0"}
+	v2 := &v1beta1.MaintenanceInfo{Version: "2.0.0", Description: "Security patches"}
+
+	cases := []struct {
+		name             string
+		applied          *v1beta1.MaintenanceInfo
+		requested        string
+		planInfo         *v1beta1.MaintenanceInfo
+		planChanged      bool
+		expectedSent     *v1beta1.MaintenanceInfo
+		expectedPrevious *v1beta1.MaintenanceInfo
+		expectedApplied  *v1beta1.MaintenanceInfo
+		unsupported      bool
+		expectedErr      string
+	}{
+		{
+			name: "no maintenance info",
+		},
+		{
+			name:            "newer plan version not requested",
+			applied:         v1,
+			planInfo:        v2,
+			expectedApplied: v1,
+		},
+		{
+			name:            "requested version already applied",
+			applied:         v2,
+			requested:       "2.0.0",
+			planInfo:        v2,
+			expectedApplied: v2,
+		},
+		{
+			name:             "upgrade requested",
+			applied:          v1,
+			requested:        "2.0.0",
+			planInfo:         v2,
+			expectedSent:     v2,
+			expectedPrevious: v1,
+			expectedApplied:  v2,
+		},
+		{
+			name:            "upgrade requested for an instance without maintenance info",
+			requested:       "2.0.0",
+			planInfo:        v2,
+			expectedSent:    v2,
+			expectedApplied: v2,
+		},
+		{
+			name:        "requested version is not the plan's",
+			applied:     v1,
+			requested:   "3.0.0",
+			planInfo:    v2,
+			expectedErr: errorMaintenanceInfoVersionMismatchReason,
+		},
+		{
+			name:        "requested version for a plan without maintenance info",
+			requested:   "2.0.0",
+			expectedErr: errorMaintenanceInfoVersionMismatchReason,
+		},
+		{
+			name:             "plan changed",
+			applied:          v1,
+			planInfo:         v2,
+			planChanged:      true,
+			expectedSent:     v2,
+			expectedPrevious: v1,
+			expectedApplied:  v2,
+		},
+		{
+			name:        "plan changed to a plan without maintenance info",
+			applied:     v1,
+			planChanged: true,
+		},
+		{
+			name:        "upgrade requested from a broker without maintenance info support",
+			applied:     v1,
+			requested:   "2.0.0",
+			planInfo:    v2,
+			unsupported: true,
+			expectedErr: errorMaintenanceInfoNotSupportedReason,
+		},
+		{
+			name:            "plan changed for a broker without maintenance info support",
+			applied:         v1,
+			planInfo:        v2,
+			planChanged:     true,
+			unsupported:     true,
+			expectedApplied: v1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.Spec.MaintenanceInfoVersion = tc.requested
+			instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{MaintenanceInfo: tc.applied}
+			planSpec := &v1beta1.CommonServicePlanSpec{MaintenanceInfo: tc.planInfo}
+			request := &osb.UpdateInstanceRequest{}
+			if tc.planChanged {
+				planID := "new-plan"
+				request.PlanID = &planID
+			}
+			inProgressProperties := &v1beta1.ServiceInstancePropertiesState{}
+
+			err := prepareUpdateMaintenanceInfo(instance, planSpec, request, inProgressProperties, !tc.unsupported)
+			if tc.expectedErr != "" {
+				opErr, ok := err.(*operationError)
+				if !ok || opErr.reason != tc.expectedErr {
+					t.Fatalf("expected a %s error, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if e, a := toOSBMaintenanceInfo(tc.expectedSent), request.MaintenanceInfo; !isOSBMaintenanceInfoEqual(e, a) {
+				t.Errorf("expected maintenance info %+v to be sent, got %+v", e, a)
+			}
+			var previous *osb.MaintenanceInfo
+			if request.PreviousValues != nil {
+				previous = request.PreviousValues.MaintenanceInfo
+			}
+			if e, a := toOSBMaintenanceInfo(tc.expectedPrevious), previous; !isOSBMaintenanceInfoEqual(e, a) {
+				t.Errorf("expected previous maintenance info %+v, got %+v", e, a)
+			}
+			if e, a := tc.expectedApplied, inProgressProperties.MaintenanceInfo; !isMaintenanceInfoVersionEqual(e, a) {
+				t.Errorf("expected in-progress maintenance info %+v, got %+v", e, a)
+			}
+		})
+	}
+}
+
+func isOSBMaintenanceInfoEqual(i1, i2 *osb.MaintenanceInfo) bool {
+	if i1 == nil || i2 == nil {
+		return i1 == i2
+	}
+	if i1.Version != i2.Version || (i1.Description == nil) != (i2.Description == nil) {
+		return false
+	}
+	return i1.Description == nil || *i1.Description == *i2.Description
+}
+
+func TestSetServiceInstanceUpgradeAvailableCondition(t *testing.T) {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		MaintenanceInfo: &v1beta1.MaintenanceInfo{Version: "1.0.0"},
+	}
+
+	if setServiceInstanceUpgradeAvailableCondition(instance, nil) {
+		t.Fatal("expected no change for a plan without maintenance info")
+	}
+
+	planInfo := &v1beta1.MaintenanceInfo{Version: "2.0.0"}
+	if !setServiceInstanceUpgradeAvailableCondition(instance, planInfo) {
+		t.Fatal("expected the condition to be set")
+	}
+	assertServiceInstanceCondition(t, instance, v1beta1.ServiceInstanceConditionUpgradeAvailable, v1beta1.ConditionTrue, upgradeAvailableReason)
+	if setServiceInstanceUpgradeAvailableCondition(instance, planInfo) {
+		t.Fatal("expected no change when setting the same condition again")
+	}
+
+	instance.Status.ExternalProperties.MaintenanceInfo = planInfo
+	if !setServiceInstanceUpgradeAvailableCondition(instance, planInfo) {
+		t.Fatal("expected the condition to change once the instance is upgraded")
+	}
+	assertServiceInstanceCondition(t, instance, v1beta1.ServiceInstanceConditionUpgradeAvailable, v1beta1.ConditionFalse, upgradeNotAvailableReason)
+
+	if !setServiceInstanceUpgradeAvailableCondition(instance, nil) {
+		t.Fatal("expected the condition to be removed")
+	}
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionUpgradeAvailable {
+			t.Fatalf("expected no %s condition, got %+v", cond.Type, cond)
+		}
+	}
+}
+
+// TestReconcileClusterServicePlanUpgradeAvailable ensures that reconciling a
+// plan with a newer maintenance version flags its instances as upgradable.
+func TestReconcileClusterServicePlanUpgradeAvailable(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	plan := getTestClusterServicePlan()
+	plan.Spec.MaintenanceInfo = &v1beta1.MaintenanceInfo{Version: "2.0.0"}
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		MaintenanceInfo: &v1beta1.MaintenanceInfo{Version: "1.0.0"},
+	}
+	otherInstance := getTestServiceInstanceWithClusterRefs()
+	otherInstance.Name = "other-instance"
+	otherInstance.Spec.ClusterServicePlanRef.Name = "other-plan"
+	otherInstance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{}
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(otherInstance)
+
+	if err := testController.reconcileClusterServicePlan(plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceCondition(t, updatedInstance, v1beta1.ServiceInstanceConditionUpgradeAvailable, v1beta1.ConditionTrue, upgradeAvailableReason)
+}
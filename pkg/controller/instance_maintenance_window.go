@@ -0,0 +1,132 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/cron"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
+)
+
+const (
+	outsideMaintenanceWindowReason string = "OutsideMaintenanceWindow"
+	updatesPausedReason            string = "UpdatesPaused"
+)
+
+// isServiceInstancePaused returns whether the updates of the instance have
+// been paused with the paused annotation.
+func isServiceInstancePaused(instance *v1beta1.ServiceInstance) bool {
+	return instance.Annotations[v1beta1.PausedAnnotation] == "true"
+}
+
+// maintenanceWindowOpen returns whether the maintenance window is open at
+// now and, if it is not, when it opens next. The next opening is the zero
+// time if the window's schedule never activates.
+//
+// A schedule that cannot be parsed does not restrict updates, as it would
+// otherwise hold them forever; the API server rejects such schedules.
+func maintenanceWindowOpen(window *v1beta1.MaintenanceWindow, now time.Time) (bool, time.Time) {
+	schedule, err := cron.Parse(window.Schedule)
+	if err != nil {
+		glog.Warningf("Ignoring the maintenance window with invalid schedule %q: %v", window.Schedule, err)
+		return true, time.Time{}
+	}
+
+	// The window is open if it opened within its duration before now.
+	opened := schedule.Next(now.Add(-window.Duration.Duration))
+	if !opened.IsZero() && !opened.After(now) {
+		return true, time.Time{}
+	}
+	return false, schedule.Next(now)
+}
+
+// newServiceInstancePendingUpdateCondition returns the PendingUpdate
+// condition of an instance whose spec change cannot be applied at now, and
+// how long to wait before trying again. It returns nil if the change can be
+// applied. A zero wait means the change waits for an event on the instance,
+// such as it being resumed.
+func newServiceInstancePendingUpdateCondition(instance *v1beta1.ServiceInstance, now time.Time) (*v1beta1.ServiceInstanceCondition, time.Duration) {
+	if isServiceInstancePaused(instance) {
+		msg := fmt.Sprintf("The update to generation %d is pending until updates of the instance are resumed", instance.Generation)
+		return newServiceInstanceCondition(v1beta1.ConditionTrue, v1beta1.ServiceInstanceConditionPendingUpdate, updatesPausedReason, msg), 0
+	}
+
+	window := instance.Spec.MaintenanceWindow
+	if window == nil {
+		return nil, 0
+	}
+	open, next := maintenanceWindowOpen(window, now)
+	if open {
+		return nil, 0
+	}
+	if next.IsZero() {
+		msg := fmt.Sprintf("The update to generation %d is pending, but the maintenance window never opens", instance.Generation)
+		return newServiceInstanceCondition(v1beta1.ConditionTrue, v1beta1.ServiceInstanceConditionPendingUpdate, outsideMaintenanceWindowReason, msg), 0
+	}
+	msg := fmt.Sprintf("The update to generation %d is pending until the maintenance window opens at %s", instance.Generation, next.Format(time.RFC3339))
+	return newServiceInstanceCondition(v1beta1.ConditionTrue, v1beta1.ServiceInstanceConditionPendingUpdate, outsideMaintenanceWindowReason, msg), next.Sub(now)
+}
+
+// holdPendingServiceInstanceUpdate keeps a spec change of a provisioned
+// instance from being sent to the broker while the instance is paused or
+// outside of its maintenance window. It reports the held change with the
+// PendingUpdate condition, requeues the instance for when its window opens,
+// and returns whether the change is held.
+//
+// Only changes that have not been started are held; an update in progress
+// runs to completion.
+func (c *controller) holdPendingServiceInstanceUpdate(instance *v1beta1.ServiceInstance) (bool, error) {
+	if instance.Status.ObservedGeneration == instance.Generation || instance.Status.CurrentOperation != "" {
+		return false, nil
+	}
+
+	cond, requeueAfter := newServiceInstancePendingUpdateCondition(instance, time.Now())
+	if cond == nil {
+		return false, nil
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	glog.V(4).Info(pcb.Message(cond.Message))
+
+	if requeueAfter > 0 {
+		key, err := cache.MetaNamespaceKeyFunc(instance)
+		if err != nil {
+			return true, err
+		}
+		c.instanceQueue.AddAfter(key, requeueAfter)
+	}
+
+	for _, existing := range instance.Status.Conditions {
+		if existing.Type == cond.Type && existing.Status == cond.Status && existing.Reason == cond.Reason && existing.Message == cond.Message {
+			return true, nil
+		}
+	}
+
+	toUpdate := instance.DeepCopy()
+	setServiceInstanceCondition(toUpdate, cond.Type, cond.Status, cond.Reason, cond.Message)
+	c.recorder.Event(toUpdate, corev1.EventTypeNormal, cond.Reason, cond.Message)
+	_, err := c.updateServiceInstanceStatus(toUpdate)
+	return true, err
+}
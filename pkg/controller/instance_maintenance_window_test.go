@@ -0,0 +1,249 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestMaintenanceWindowOpen(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2018, time.August, 15, 10, 30, 0, 0, time.UTC)
+	cases := []struct {
+		name         string
+		schedule     string
+		duration     time.Duration
+		expectedOpen bool
+		expectedNext time.Time
+	}{
+		{
+			name:         "opened within its duration",
+			schedule:     "0 10 * * *",
+			duration:     time.Hour,
+			expectedOpen: true,
+		},
+		{
+			name:         "opens now",
+			schedule:     "30 10 * * *",
+			duration:     time.Minute,
+			expectedOpen: true,
+		},
+		{
+			name:         "closed",
+			schedule:     "0 10 * * *",
+			duration:     30 * time.Minute,
+			expectedNext: time.Date(2018, time.August, 16, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "weekly",
+			schedule:     "0 2 * * 6",
+			duration:     2 * time.Hour,
+			expectedNext: time.Date(2018, time.August, 18, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "never opens",
+			schedule: "0 0 30 2 *",
+			duration: time.Hour,
+		},
+		{
+			name:         "invalid schedule",
+			schedule:     "0 25 * * *",
+			duration:     time.Hour,
+			expectedOpen: true,
+		},
+	}
+	for _, tc := range cases {
+		window := &v1beta1.MaintenanceWindow{
+			Schedule: tc.schedule,
+			Duration: metav1.Duration{Duration: tc.duration},
+		}
+		open, next := maintenanceWindowOpen(window, now)
+		if e, a := tc.expectedOpen, open; e != a {
+			t.Errorf("%v: expected open %v, got %v", tc.name, e, a)
+		}
+		if e, a := tc.expectedNext, next; !e.Equal(a) {
+			t.Errorf("%v: expected next opening %v, got %v", tc.name, e, a)
+		}
+	}
+}
+
+func TestNewServiceInstancePendingUpdateCondition(t *testing.T) {
+	now := time.Date(2018, time.August, 15, 10, 30, 0, 0, time.UTC)
+
+	instance := getTestServiceInstanceUpdatingPlan()
+	if cond, _ := newServiceInstancePendingUpdateCondition(instance, now); cond != nil {
+		t.Fatalf("expected no condition without a window, got %+v", cond)
+	}
+
+	instance.Spec.MaintenanceWindow = &v1beta1.MaintenanceWindow{
+		Schedule: "0 12 * * *",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+	cond, requeueAfter := newServiceInstancePendingUpdateCondition(instance, now)
+	if cond == nil {
+		t.Fatal("expected a condition outside of the window")
+	}
+	if e, a := outsideMaintenanceWindowReason, cond.Reason; e != a {
+		t.Fatalf("expected reason %v, got %v", e, a)
+	}
+	if e, a := "The update to generation 2 is pending until the maintenance window opens at 2018-08-15T12:00:00Z", cond.Message; e != a {
+		t.Fatalf("expected message %q, got %q", e, a)
+	}
+	if e, a := 90*time.Minute, requeueAfter; e != a {
+		t.Fatalf("expected to requeue after %v, got %v", e, a)
+	}
+
+	instance.Spec.MaintenanceWindow.Schedule = "0 10 * * *"
+	if cond, _ := newServiceInstancePendingUpdateCondition(instance, now); cond != nil {
+		t.Fatalf("expected no condition inside of the window, got %+v", cond)
+	}
+
+	instance.Annotations = map[string]string{v1beta1.PausedAnnotation: "true"}
+	cond, requeueAfter = newServiceInstancePendingUpdateCondition(instance, now)
+	if cond == nil {
+		t.Fatal("expected a condition while paused")
+	}
+	if e, a := updatesPausedReason, cond.Reason; e != a {
+		t.Fatalf("expected reason %v, got %v", e, a)
+	}
+	if requeueAfter != 0 {
+		t.Fatalf("expected no requeue while paused, got %v", requeueAfter)
+	}
+}
+
+// TestReconcileServiceInstanceUpdateOutsideMaintenanceWindow tests that a
+// spec change is not sent to the broker outside of the instance's
+// maintenance window, and that it is reported by the PendingUpdate
+// condition.
+func TestReconcileServiceInstanceUpdateOutsideMaintenanceWindow(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	// A daily window that opened two hours ago and closed after a minute.
+	opened := time.Now().UTC().Add(-2 * time.Hour)
+	instance := getTestServiceInstanceUpdatingPlan()
+	instance.Spec.MaintenanceWindow = &v1beta1.MaintenanceWindow{
+		Schedule: fmt.Sprintf("%d %d * * *", opened.Minute(), opened.Hour()),
+		Duration: metav1.Duration{Duration: time.Minute},
+	}
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionPendingUpdate, v1beta1.ConditionTrue, outsideMaintenanceWindowReason)
+	assertServiceInstanceReadyTrue(t, updatedServiceInstance)
+	if e, a := int64(1), updatedServiceInstance.Status.ObservedGeneration; e != a {
+		t.Fatalf("expected the held generation not to be observed: expected %v, got %v", e, a)
+	}
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 1)
+
+	// Reconciling the held change again does not update the status.
+	fakeCatalogClient.ClearActions()
+	if err := reconcileServiceInstance(t, testController, updatedServiceInstance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
+
+// TestReconcileServiceInstanceUpdateInsideMaintenanceWindow tests that a
+// spec change is applied inside of the instance's maintenance window, and
+// that the PendingUpdate condition is removed.
+func TestReconcileServiceInstanceUpdateInsideMaintenanceWindow(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceUpdatingPlan()
+	instance.Spec.MaintenanceWindow = &v1beta1.MaintenanceWindow{
+		Schedule: "* * * * *",
+		Duration: metav1.Duration{Duration: 2 * time.Minute},
+	}
+	instance.Status.Conditions = append(instance.Status.Conditions, v1beta1.ServiceInstanceCondition{
+		Type:   v1beta1.ServiceInstanceConditionPendingUpdate,
+		Status: v1beta1.ConditionTrue,
+		Reason: outsideMaintenanceWindowReason,
+	})
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if e, a := v1beta1.ServiceInstanceOperationUpdate, updatedServiceInstance.Status.CurrentOperation; e != a {
+		t.Fatalf("expected the update to start: expected operation %v, got %v", e, a)
+	}
+	assertServiceInstanceConditionMissing(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionPendingUpdate)
+}
+
+// TestReconcileServiceInstanceUpdatePaused tests that a spec change of a
+// paused instance is held, while its provisioning is not.
+func TestReconcileServiceInstanceUpdatePaused(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceUpdatingPlan()
+	instance.Annotations = map[string]string{v1beta1.PausedAnnotation: "true"}
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionPendingUpdate, v1beta1.ConditionTrue, updatesPausedReason)
+
+	// Provisioning is not paused.
+	fakeCatalogClient.ClearActions()
+	instance = getTestServiceInstanceWithClusterRefs()
+	instance.Annotations = map[string]string{v1beta1.PausedAnnotation: "true"}
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if e, a := v1beta1.ServiceInstanceOperationProvision, updatedServiceInstance.Status.CurrentOperation; e != a {
+		t.Fatalf("expected the provision to start: expected operation %v, got %v", e, a)
+	}
+}
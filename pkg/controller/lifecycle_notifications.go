@@ -0,0 +1,58 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/notifications"
+)
+
+// notifyServiceInstance notifies the notifier, if any, of a lifecycle event
+// of an instance.
+func (c *controller) notifyServiceInstance(notificationType v1beta1.NotificationEventType, instance *v1beta1.ServiceInstance, reason, message string) {
+	if c.notifier == nil {
+		return
+	}
+	c.notifier.Notify(notifications.NewEvent(notificationType, "ServiceInstance", "serviceinstances", instance, reason, message))
+}
+
+// notifyServiceBinding notifies the notifier, if any, of a lifecycle event of
+// a binding.
+func (c *controller) notifyServiceBinding(notificationType v1beta1.NotificationEventType, binding *v1beta1.ServiceBinding, reason, message string) {
+	if c.notifier == nil {
+		return
+	}
+	c.notifier.Notify(notifications.NewEvent(notificationType, "ServiceBinding", "servicebindings", binding, reason, message))
+}
+
+// notifyClusterServiceClassRemoved notifies the notifier, if any, that a
+// ClusterServiceClass was removed from the catalog of its broker.
+func (c *controller) notifyClusterServiceClassRemoved(class *v1beta1.ClusterServiceClass) {
+	if c.notifier == nil {
+		return
+	}
+	c.notifier.Notify(notifications.NewEvent(v1beta1.NotificationEventClassRemoved, "ClusterServiceClass", "clusterserviceclasses", class, "", "Removed from the catalog of ClusterServiceBroker "+class.Spec.ClusterServiceBrokerName))
+}
+
+// notifyServiceClassRemoved notifies the notifier, if any, that a
+// ServiceClass was removed from the catalog of its broker.
+func (c *controller) notifyServiceClassRemoved(class *v1beta1.ServiceClass) {
+	if c.notifier == nil {
+		return
+	}
+	c.notifier.Notify(notifications.NewEvent(v1beta1.NotificationEventClassRemoved, "ServiceClass", "serviceclasses", class, "", "Removed from the catalog of ServiceBroker "+class.Spec.ServiceBrokerName))
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/notifications"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+)
+
+// recordingNotifier records the events it is notified of.
+type recordingNotifier struct {
+	events []*notifications.Event
+}
+
+func (n *recordingNotifier) Notify(event *notifications.Event) {
+	n.events = append(n.events, event)
+}
+
+func (n *recordingNotifier) types() []v1beta1.NotificationEventType {
+	var types []v1beta1.NotificationEventType
+	for _, event := range n.events {
+		types = append(types, event.NotificationType)
+	}
+	return types
+}
+
+func assertNotifications(t *testing.T, n *recordingNotifier, expected ...v1beta1.NotificationEventType) {
+	actual := n.types()
+	if len(actual) != len(expected) {
+		t.Fatalf("expected notifications %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if expected[i] != actual[i] {
+			t.Fatalf("expected notifications %v, got %v", expected, actual)
+		}
+	}
+}
+
+func newTestControllerWithNotifier(t *testing.T) (*controller, *recordingNotifier) {
+	_, _, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{})
+	notifier := &recordingNotifier{}
+	testController.notifier = notifier
+	return testController, notifier
+}
+
+func TestNotifyServiceInstanceProvisioned(t *testing.T) {
+	testController, notifier := newTestControllerWithNotifier(t)
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := testController.processProvisionSuccess(instance, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNotifications(t, notifier, v1beta1.NotificationEventInstanceProvisioned)
+	event := notifier.events[0]
+	if e, a := testServiceInstanceName, event.Subject; e != a {
+		t.Errorf("unexpected subject: expected %q, got %q", e, a)
+	}
+	if e, a := testNamespace, event.Data.Namespace; e != a {
+		t.Errorf("unexpected namespace: expected %q, got %q", e, a)
+	}
+}
+
+func TestNotifyServiceInstanceFailed(t *testing.T) {
+	readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorProvisionCallFailedReason, "failed")
+	failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorProvisionCallFailedReason, "failed")
+
+	t.Run("temporary failure", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		testController.processTemporaryProvisionFailure(getTestServiceInstanceWithClusterRefs(), readyCond, false)
+		assertNotifications(t, notifier)
+	})
+
+	t.Run("terminal provision failure", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		if err := testController.processTerminalProvisionFailure(getTestServiceInstanceWithClusterRefs(), readyCond, failedCond, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier, v1beta1.NotificationEventInstanceFailed)
+		if e, a := errorProvisionCallFailedReason, notifier.events[0].Data.Reason; e != a {
+			t.Errorf("unexpected reason: expected %q, got %q", e, a)
+		}
+	})
+
+	t.Run("terminal update failure", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		if err := testController.processTerminalUpdateServiceInstanceFailure(getTestServiceInstanceWithClusterRefs(), readyCond, failedCond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier, v1beta1.NotificationEventInstanceFailed)
+	})
+
+	t.Run("deprovision failure", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		if err := testController.processDeprovisionFailure(getTestServiceInstanceWithClusterRefs(), readyCond, failedCond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier, v1beta1.NotificationEventInstanceFailed)
+	})
+}
+
+func TestNotifyServiceInstanceDeprovisioned(t *testing.T) {
+	t.Run("deprovisioned", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		if err := testController.processDeprovisionSuccess(getTestServiceInstanceWithClusterRefs()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier, v1beta1.NotificationEventInstanceDeprovisioned)
+	})
+
+	t.Run("orphan mitigated", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		instance := getTestServiceInstanceWithClusterRefs()
+		instance.Status.OrphanMitigationInProgress = true
+		if err := testController.processDeprovisionSuccess(instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier)
+	})
+}
+
+func TestNotifyServiceBindingLifecycle(t *testing.T) {
+	t.Run("bound", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		if err := testController.processBindSuccess(getTestServiceBinding()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier, v1beta1.NotificationEventBindingBound)
+		if e, a := "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/servicebindings", notifier.events[0].Source; e != a {
+			t.Errorf("unexpected source: expected %q, got %q", e, a)
+		}
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorBindCallReason, "failed")
+		if err := testController.processBindFailure(getTestServiceBinding(), nil, failedCond, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier, v1beta1.NotificationEventBindingFailed)
+	})
+
+	t.Run("unbound", func(t *testing.T) {
+		testController, notifier := newTestControllerWithNotifier(t)
+		if err := testController.processUnbindSuccess(getTestServiceBinding()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNotifications(t, notifier, v1beta1.NotificationEventBindingUnbound)
+	})
+}
+
+func TestNotifyCredentialsRotated(t *testing.T) {
+	cases := []struct {
+		name          string
+		existingData  map[string][]byte
+		credentials   map[string]interface{}
+		notifications []v1beta1.NotificationEventType
+	}{
+		{
+			name:         "credentials changed",
+			existingData: map[string][]byte{"password": []byte("old")},
+			credentials:  map[string]interface{}{"password": "new"},
+			notifications: []v1beta1.NotificationEventType{
+				v1beta1.NotificationEventCredentialsRotated,
+			},
+		},
+		{
+			name:         "credentials unchanged",
+			existingData: map[string][]byte{"password": []byte("same")},
+			credentials:  map[string]interface{}{"password": "same"},
+		},
+		{
+			name:        "secret filled for the first time",
+			credentials: map[string]interface{}{"password": "new"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, _, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{})
+			notifier := &recordingNotifier{}
+			testController.notifier = notifier
+
+			binding := getTestServiceBinding()
+			binding.UID = "test-binding-uid"
+			binding.Spec.SecretName = "test-secret"
+			fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            binding.Spec.SecretName,
+						Namespace:       binding.Namespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+					},
+					Data: tc.existingData,
+				}, nil
+			})
+			fakeKubeClient.AddReactor("update", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, action.(clientgotesting.UpdateAction).GetObject(), nil
+			})
+
+			if err := testController.injectServiceBinding(binding, tc.credentials); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertNotifications(t, notifier, tc.notifications...)
+		})
+	}
+}
+
+func TestNotifyClassRemoved(t *testing.T) {
+	testController, notifier := newTestControllerWithNotifier(t)
+	testController.notifyClusterServiceClassRemoved(getTestClusterServiceClass())
+
+	assertNotifications(t, notifier, v1beta1.NotificationEventClassRemoved)
+	if e, a := "", notifier.events[0].Data.Namespace; e != a {
+		t.Errorf("unexpected namespace: expected %q, got %q", e, a)
+	}
+}
+
+func TestNotifyWithoutNotifier(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{})
+	if err := testController.processProvisionSuccess(getTestServiceInstanceWithClusterRefs(), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+// planMaximumPollingDurationKey is the key in a plan's external metadata
+// holding the maximum number of seconds to poll an asynchronous operation on
+// an instance or binding of the plan. It is only consulted for brokers that
+// report the duration in their plans' metadata rather than in the OSB API's
+// maximum_polling_duration plan field.
+const planMaximumPollingDurationKey = "maximum_polling_duration"
+
+// planExpectedOperationDurationKey is the key in a plan's external metadata
+// holding the number of seconds asynchronous operations on instances of the
+// plan are expected to take. Such operations are not polled before then.
+const planExpectedOperationDurationKey = "expected_operation_duration"
+
+// maximumBrokerPollingDelay is the longest that a broker's Retry-After, or
+// a plan's expected operation duration, can delay the next poll.
+const maximumBrokerPollingDelay = time.Hour
+
+// pollingJitter is the maximum fraction by which the delay before a poll is
+// randomly lengthened, so that operations started at the same time are not
+// polled in lockstep.
+const pollingJitter = 0.2
+
+// unlimitedPollingBackoff is the maximum delay of the polling queues' rate
+// limiters. The effective maximum, which may be set per broker, is applied
+// when an item is added to the queue.
+const unlimitedPollingBackoff = time.Duration(1<<63 - 1)
+
+// operationTimeouts holds the timeouts that apply to an instance's or
+// binding's operations.
+type operationTimeouts struct {
+	// retryDuration is the maximum amount of time to retry an operation.
+	retryDuration time.Duration
+	// pollingDuration is the maximum amount of time to poll an asynchronous
+	// operation.
+	pollingDuration time.Duration
+	// maximumPollingBackoff is the maximum amount of time between polls.
+	maximumPollingBackoff time.Duration
+	// expectedDuration is how long an asynchronous operation is expected to
+	// take, or zero if unknown.
+	expectedDuration time.Duration
+}
+
+// durationExceeded returns whether more than the given duration has passed
+// since the given operation start time.
+func durationExceeded(operationStartTime *metav1.Time, duration time.Duration) bool {
+	if operationStartTime == nil || time.Now().Before(operationStartTime.Time.Add(duration)) {
+		return false
+	}
+	return true
+}
+
+// reconciliationRetryDurationForBroker returns the broker's reconciliation
+// retry duration, which defaults to the controller's.
+func (c *controller) reconciliationRetryDurationForBroker(spec *v1beta1.CommonServiceBrokerSpec) time.Duration {
+	if spec != nil && spec.ReconciliationRetryDuration != nil {
+		return spec.ReconciliationRetryDuration.Duration
+	}
+	return c.reconciliationRetryDuration
+}
+
+// operationTimeoutsFor returns the timeouts for operations against the given
+// broker on the given plan. Either may be nil, in which case the controller's
+// defaults apply.
+func (c *controller) operationTimeoutsFor(brokerSpec *v1beta1.CommonServiceBrokerSpec, planSpec *v1beta1.CommonServicePlanSpec) operationTimeouts {
+	timeouts := operationTimeouts{
+		retryDuration:         c.reconciliationRetryDurationForBroker(brokerSpec),
+		maximumPollingBackoff: c.operationPollingMaximumBackoffDuration,
+	}
+	if brokerSpec != nil && brokerSpec.OperationPollingMaximumBackoffDuration != nil {
+		timeouts.maximumPollingBackoff = brokerSpec.OperationPollingMaximumBackoffDuration.Duration
+	}
+	timeouts.pollingDuration = timeouts.retryDuration
+	if d, ok := planMaximumPollingDuration(planSpec); ok {
+		timeouts.pollingDuration = d
+	}
+	if d, ok := planExpectedOperationDuration(planSpec); ok {
+		timeouts.expectedDuration = d
+	}
+	return timeouts
+}
+
+// operationTimeoutsForServiceInstance returns the timeouts for the
+// instance's operations, based on its broker and plan. The controller's
+// defaults apply if the broker or plan cannot be found.
+func (c *controller) operationTimeoutsForServiceInstance(instance *v1beta1.ServiceInstance) operationTimeouts {
+	return c.operationTimeoutsFor(c.getBrokerAndPlanSpecsForServiceInstance(instance))
+}
+
+// getBrokerAndPlanSpecsForServiceInstance looks up the spec of the
+// instance's plan, and of the broker offering it, in the controller's
+// caches. Either is nil if it cannot be found.
+func (c *controller) getBrokerAndPlanSpecsForServiceInstance(instance *v1beta1.ServiceInstance) (*v1beta1.CommonServiceBrokerSpec, *v1beta1.CommonServicePlanSpec) {
+	var brokerSpec *v1beta1.CommonServiceBrokerSpec
+	var planSpec *v1beta1.CommonServicePlanSpec
+
+	switch {
+	case instance.Spec.ClusterServicePlanRef != nil:
+		plan, err := c.clusterServicePlanLister.Get(instance.Spec.ClusterServicePlanRef.Name)
+		if err != nil {
+			break
+		}
+		planSpec = &plan.Spec.CommonServicePlanSpec
+		if broker, err := c.clusterServiceBrokerLister.Get(plan.Spec.ClusterServiceBrokerName); err == nil {
+			brokerSpec = &broker.Spec.CommonServiceBrokerSpec
+		}
+	case instance.Spec.ServicePlanRef != nil && c.servicePlanLister != nil:
+		plan, err := c.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
+		if err != nil {
+			break
+		}
+		planSpec = &plan.Spec.CommonServicePlanSpec
+		if broker, err := c.serviceBrokerLister.ServiceBrokers(instance.Namespace).Get(plan.Spec.ServiceBrokerName); err == nil {
+			brokerSpec = &broker.Spec.CommonServiceBrokerSpec
+		}
+	}
+
+	return brokerSpec, planSpec
+}
+
+// operationTimeoutsForServiceBinding returns the timeouts for the binding's
+// operations, which are those of its instance. The expected duration of the
+// instance's operations does not apply to bindings.
+func (c *controller) operationTimeoutsForServiceBinding(binding *v1beta1.ServiceBinding) operationTimeouts {
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.ServiceInstanceRef.Name)
+	if err != nil {
+		return c.operationTimeoutsFor(nil, nil)
+	}
+	timeouts := c.operationTimeoutsForServiceInstance(instance)
+	timeouts.expectedDuration = 0
+	return timeouts
+}
+
+// instanceRetryDurationExceeded returns whether the instance's current
+// operation has been retried for longer than its reconciliation retry
+// duration.
+func (c *controller) instanceRetryDurationExceeded(instance *v1beta1.ServiceInstance) bool {
+	return durationExceeded(instance.Status.OperationStartTime, c.operationTimeoutsForServiceInstance(instance).retryDuration)
+}
+
+// instancePollingDurationExceeded returns whether the instance's current
+// asynchronous operation has been polled for longer than its maximum polling
+// duration.
+func (c *controller) instancePollingDurationExceeded(instance *v1beta1.ServiceInstance) bool {
+	return durationExceeded(instance.Status.OperationStartTime, c.operationTimeoutsForServiceInstance(instance).pollingDuration)
+}
+
+// bindingRetryDurationExceeded returns whether the binding's current
+// operation has been retried for longer than its reconciliation retry
+// duration.
+func (c *controller) bindingRetryDurationExceeded(binding *v1beta1.ServiceBinding) bool {
+	return durationExceeded(binding.Status.OperationStartTime, c.operationTimeoutsForServiceBinding(binding).retryDuration)
+}
+
+// bindingPollingDurationExceeded returns whether the binding's current
+// asynchronous operation has been polled for longer than its maximum polling
+// duration.
+func (c *controller) bindingPollingDurationExceeded(binding *v1beta1.ServiceBinding) bool {
+	return durationExceeded(binding.Status.OperationStartTime, c.operationTimeoutsForServiceBinding(binding).pollingDuration)
+}
+
+// planMaximumPollingDuration returns the plan's maximum polling duration, if
+// any. The duration from the broker's catalog takes precedence over one set
+// in the plan's external metadata.
+func planMaximumPollingDuration(planSpec *v1beta1.CommonServicePlanSpec) (time.Duration, bool) {
+	if planSpec != nil && planSpec.MaximumPollingDuration != nil && planSpec.MaximumPollingDuration.Duration > 0 {
+		return planSpec.MaximumPollingDuration.Duration, true
+	}
+	return planMetadataDuration(planSpec, planMaximumPollingDurationKey)
+}
+
+// planExpectedOperationDuration returns the expected duration of operations
+// set in the plan's external metadata, if any.
+func planExpectedOperationDuration(planSpec *v1beta1.CommonServicePlanSpec) (time.Duration, bool) {
+	return planMetadataDuration(planSpec, planExpectedOperationDurationKey)
+}
+
+// planMetadataDuration returns the positive number of seconds held by the
+// given key of the plan's external metadata, if any, as a duration.
+func planMetadataDuration(planSpec *v1beta1.CommonServicePlanSpec, key string) (time.Duration, bool) {
+	if planSpec == nil || planSpec.ExternalMetadata == nil || len(planSpec.ExternalMetadata.Raw) == 0 {
+		return 0, false
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(planSpec.ExternalMetadata.Raw, &metadata); err != nil {
+		glog.V(4).Infof("Ignoring unparseable external metadata of plan %q: %v", planSpec.ExternalName, err)
+		return 0, false
+	}
+	seconds, ok := metadata[key].(float64)
+	if !ok || seconds <= 0 {
+		return 0, false
+	}
+	return time.Duration(seconds * float64(time.Second)), true
+}
+
+// pollDelay returns how long to wait before next polling the operation with
+// the given key, which started at operationStartTime. The rate limiter's
+// exponential backoff, capped at the maximum polling backoff, is lengthened
+// to the time left until the operation is expected to finish, and to the
+// Retry-After the broker sent, if any, and then randomly lengthened by up to
+// pollingJitter. The lengthening is capped at maximumBrokerPollingDelay and at
+// the polling deadline, so that a broker cannot keep an operation from being
+// polled until long after it should have timed out.
+func pollDelay(rateLimiter workqueue.RateLimiter, key string, timeouts operationTimeouts, operationStartTime *metav1.Time, retryAfter *time.Duration) time.Duration {
+	delay := nextPollDelay(rateLimiter.When(key), timeouts, operationStartTime, retryAfter, time.Now())
+	return wait.Jitter(delay, pollingJitter)
+}
+
+// nextPollDelay returns the delay before the next poll, before jitter, given
+// the exponential backoff and the current time.
+func nextPollDelay(backoff time.Duration, timeouts operationTimeouts, operationStartTime *metav1.Time, retryAfter *time.Duration, now time.Time) time.Duration {
+	delay := backoff
+	if delay > timeouts.maximumPollingBackoff {
+		delay = timeouts.maximumPollingBackoff
+	}
+
+	var brokerDelay time.Duration
+	if timeouts.expectedDuration > 0 && operationStartTime != nil {
+		brokerDelay = operationStartTime.Add(timeouts.expectedDuration).Sub(now)
+	}
+	if retryAfter != nil && *retryAfter > brokerDelay {
+		brokerDelay = *retryAfter
+	}
+	if brokerDelay > maximumBrokerPollingDelay {
+		brokerDelay = maximumBrokerPollingDelay
+	}
+	if timeouts.pollingDuration > 0 && operationStartTime != nil {
+		if untilDeadline := operationStartTime.Add(timeouts.pollingDuration).Sub(now); brokerDelay > untilDeadline {
+			brokerDelay = untilDeadline
+		}
+	}
+
+	if brokerDelay > delay {
+		delay = brokerDelay
+	}
+	return delay
+}
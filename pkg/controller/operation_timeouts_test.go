@@ -0,0 +1,275 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestPlanMaximumPollingDuration(t *testing.T) {
+	cases := []struct {
+		name     string
+		field    *metav1.Duration
+		metadata string
+		duration time.Duration
+		ok       bool
+	}{
+		{
+			name: "no metadata",
+		},
+		{
+			name:     "not set",
+			metadata: `{"displayName":"Small"}`,
+		},
+		{
+			name:     "set",
+			metadata: `{"maximum_polling_duration":5400}`,
+			duration: 90 * time.Minute,
+			ok:       true,
+		},
+		{
+			name:     "zero",
+			metadata: `{"maximum_polling_duration":0}`,
+		},
+		{
+			name:     "not a number",
+			metadata: `{"maximum_polling_duration":"90m"}`,
+		},
+		{
+			name:     "invalid metadata",
+			metadata: `{`,
+		},
+		{
+			name:     "catalog field",
+			field:    &metav1.Duration{Duration: 45 * time.Minute},
+			duration: 45 * time.Minute,
+			ok:       true,
+		},
+		{
+			name:     "catalog field takes precedence over metadata",
+			field:    &metav1.Duration{Duration: 45 * time.Minute},
+			metadata: `{"maximum_polling_duration":5400}`,
+			duration: 45 * time.Minute,
+			ok:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &v1beta1.CommonServicePlanSpec{MaximumPollingDuration: tc.field}
+			if tc.metadata != "" {
+				spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(tc.metadata)}
+			}
+			duration, ok := planMaximumPollingDuration(spec)
+			if duration != tc.duration || ok != tc.ok {
+				t.Fatalf("expected (%v, %v), got (%v, %v)", tc.duration, tc.ok, duration, ok)
+			}
+		})
+	}
+}
+
+func TestOperationTimeoutsFor(t *testing.T) {
+	c := &controller{
+		reconciliationRetryDuration:            time.Hour,
+		operationPollingMaximumBackoffDuration: 20 * time.Minute,
+	}
+	defaults := operationTimeouts{
+		retryDuration:         time.Hour,
+		pollingDuration:       time.Hour,
+		maximumPollingBackoff: 20 * time.Minute,
+	}
+
+	if timeouts := c.operationTimeoutsFor(nil, nil); timeouts != defaults {
+		t.Fatalf("expected the controller's defaults %+v, got %+v", defaults, timeouts)
+	}
+
+	brokerSpec := &v1beta1.CommonServiceBrokerSpec{
+		ReconciliationRetryDuration:            &metav1.Duration{Duration: 2 * time.Minute},
+		OperationPollingMaximumBackoffDuration: &metav1.Duration{Duration: 30 * time.Second},
+	}
+	expected := operationTimeouts{
+		retryDuration:         2 * time.Minute,
+		pollingDuration:       2 * time.Minute,
+		maximumPollingBackoff: 30 * time.Second,
+	}
+	if timeouts := c.operationTimeoutsFor(brokerSpec, nil); timeouts != expected {
+		t.Fatalf("expected the broker's timeouts %+v, got %+v", expected, timeouts)
+	}
+
+	planSpec := &v1beta1.CommonServicePlanSpec{
+		ExternalMetadata: &runtime.RawExtension{Raw: []byte(`{"maximum_polling_duration":5400,"expected_operation_duration":600}`)},
+	}
+	expected.pollingDuration = 90 * time.Minute
+	expected.expectedDuration = 10 * time.Minute
+	if timeouts := c.operationTimeoutsFor(brokerSpec, planSpec); timeouts != expected {
+		t.Fatalf("expected the plan's polling and expected durations to take precedence, %+v, got %+v", expected, timeouts)
+	}
+}
+
+// TestOperationTimeoutsForServiceBinding ensures that the timeouts of a
+// binding are looked up through its instance's plan and broker.
+func TestOperationTimeoutsForServiceBinding(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	broker := getTestClusterServiceBroker()
+	broker.Spec.ReconciliationRetryDuration = &metav1.Duration{Duration: 2 * time.Minute}
+	plan := getTestClusterServicePlan()
+	plan.Spec.ExternalMetadata = &runtime.RawExtension{Raw: []byte(`{"maximum_polling_duration":5400,"expected_operation_duration":600}`)}
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithClusterRefs())
+
+	timeouts := testController.operationTimeoutsForServiceBinding(getTestServiceBinding())
+	if timeouts.retryDuration != 2*time.Minute {
+		t.Fatalf("expected the broker's retry duration, got %v", timeouts.retryDuration)
+	}
+	if timeouts.pollingDuration != 90*time.Minute {
+		t.Fatalf("expected the plan's polling duration, got %v", timeouts.pollingDuration)
+	}
+	if timeouts.expectedDuration != 0 {
+		t.Fatalf("expected the plan's expected operation duration not to apply to bindings, got %v", timeouts.expectedDuration)
+	}
+
+	binding := getTestServiceBinding()
+	binding.Spec.ServiceInstanceRef.Name = "missing"
+	timeouts = testController.operationTimeoutsForServiceBinding(binding)
+	if timeouts.retryDuration != testController.reconciliationRetryDuration {
+		t.Fatalf("expected the controller's retry duration for a missing instance, got %v", timeouts.retryDuration)
+	}
+}
+
+func TestNextPollDelay(t *testing.T) {
+	now := time.Now()
+	started := metav1.NewTime(now.Add(-10 * time.Minute))
+	retryAfter := func(d time.Duration) *time.Duration { return &d }
+
+	cases := []struct {
+		name            string
+		backoff         time.Duration
+		expected        time.Duration
+		retryAfter      *time.Duration
+		pollingDuration time.Duration
+		delay           time.Duration
+	}{
+		{
+			name:    "backoff",
+			backoff: time.Minute,
+			delay:   time.Minute,
+		},
+		{
+			name:    "backoff capped at the maximum",
+			backoff: time.Hour,
+			delay:   20 * time.Minute,
+		},
+		{
+			name:     "waits until the operation is expected to finish",
+			backoff:  time.Minute,
+			expected: 30 * time.Minute,
+			delay:    20 * time.Minute,
+		},
+		{
+			name:     "expected duration beyond the maximum backoff",
+			backoff:  time.Minute,
+			expected: 40 * time.Minute,
+			delay:    30 * time.Minute,
+		},
+		{
+			name:     "backs off once the expected duration has passed",
+			backoff:  time.Minute,
+			expected: 5 * time.Minute,
+			delay:    time.Minute,
+		},
+		{
+			name:       "retry after",
+			backoff:    time.Minute,
+			retryAfter: retryAfter(5 * time.Minute),
+			delay:      5 * time.Minute,
+		},
+		{
+			name:       "retry after shorter than the backoff",
+			backoff:    time.Minute,
+			retryAfter: retryAfter(time.Second),
+			delay:      time.Minute,
+		},
+		{
+			name:       "retry after capped at the maximum",
+			backoff:    time.Minute,
+			retryAfter: retryAfter(99999999 * time.Second),
+			delay:      maximumBrokerPollingDelay,
+		},
+		{
+			name:     "expected duration capped at the maximum",
+			backoff:  time.Minute,
+			expected: 24 * time.Hour,
+			delay:    maximumBrokerPollingDelay,
+		},
+		{
+			name:            "retry after capped at the polling deadline",
+			backoff:         time.Minute,
+			retryAfter:      retryAfter(30 * time.Minute),
+			pollingDuration: 25 * time.Minute,
+			delay:           15 * time.Minute,
+		},
+		{
+			name:            "backoff past the polling deadline",
+			backoff:         time.Minute,
+			retryAfter:      retryAfter(30 * time.Minute),
+			pollingDuration: 5 * time.Minute,
+			delay:           time.Minute,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			timeouts := operationTimeouts{
+				pollingDuration:       tc.pollingDuration,
+				maximumPollingBackoff: 20 * time.Minute,
+				expectedDuration:      tc.expected,
+			}
+			if e, a := tc.delay, nextPollDelay(tc.backoff, timeouts, &started, tc.retryAfter, now); e != a {
+				t.Fatalf("expected a delay of %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestPollDelay(t *testing.T) {
+	rateLimiter := workqueue.NewItemExponentialFailureRateLimiter(time.Minute, unlimitedPollingBackoff)
+	timeouts := operationTimeouts{maximumPollingBackoff: 20 * time.Minute}
+
+	for i := 0; i < 2; i++ {
+		base := time.Minute << uint(i)
+		delay := pollDelay(rateLimiter, "key", timeouts, nil, nil)
+		if delay < base || delay > time.Duration(float64(base)*(1+pollingJitter)) {
+			t.Fatalf("expected poll %d to be delayed by %v plus up to %v jitter, got %v", i, base, pollingJitter, delay)
+		}
+	}
+	if e, a := 2, rateLimiter.NumRequeues("key"); e != a {
+		t.Fatalf("expected the rate limiter to count %d polls, got %d", e, a)
+	}
+
+	timeouts.maximumPollingBackoff = 0
+	if delay := pollDelay(rateLimiter, "key", timeouts, nil, nil); delay != 0 {
+		t.Fatalf("expected no delay with a maximum backoff of 0, got %v", delay)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/golang/glog"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	osbAPIVersionNegotiatedReason string = "OSBAPIVersionNegotiated"
+)
+
+// supportedOSBAPIVersions are the versions of the Open Service Broker API the
+// controller can negotiate with brokers, from the newest to the oldest.
+var supportedOSBAPIVersions = []osb.APIVersion{
+	osb.Version2_15(),
+	osb.Version2_14(),
+	osb.Version2_13(),
+	osb.Version2_12(),
+	osb.Version2_11(),
+}
+
+// parseOSBAPIVersion returns the supported OSB API version whose header value
+// is the given string.
+func parseOSBAPIVersion(version string) (osb.APIVersion, error) {
+	for _, v := range supportedOSBAPIVersions {
+		if v.HeaderValue() == version {
+			return v, nil
+		}
+	}
+	return osb.APIVersion{}, fmt.Errorf("unsupported OSB API version %q", version)
+}
+
+// supportsAsyncBindingOperations returns whether asynchronous bindings, and
+// the GET endpoints used to fetch their results, may be used with a broker
+// that negotiated the given OSB API version. They were released in 2.14, but
+// the client also allows them as alpha features with 2.13.
+func supportsAsyncBindingOperations(version osb.APIVersion) bool {
+	return version.AtLeast(osb.Version2_13())
+}
+
+// supportsGetInstance returns whether instances may be fetched from a broker
+// that negotiated the given OSB API version.
+func supportsGetInstance(version osb.APIVersion) bool {
+	return version.AtLeast(osb.Version2_14())
+}
+
+// supportsMaintenanceInfo returns whether maintenance info may be sent to a
+// broker that negotiated the given OSB API version.
+func supportsMaintenanceInfo(version osb.APIVersion) bool {
+	return version.AtLeast(osb.Version2_15())
+}
+
+// osbAPIVersionForBroker returns the OSB API version to use for requests to a
+// broker: the version negotiated with the broker, unless it is newer than the
+// preferred version, or the preferred version if none was negotiated yet.
+func (c *controller) osbAPIVersionForBroker(status *v1beta1.CommonServiceBrokerStatus) osb.APIVersion {
+	if status.OSBAPIVersion == "" {
+		return c.preferredOSBAPIVersion
+	}
+	version, err := parseOSBAPIVersion(status.OSBAPIVersion)
+	if err != nil || !c.preferredOSBAPIVersion.AtLeast(version) {
+		return c.preferredOSBAPIVersion
+	}
+	return version
+}
+
+// osbAPIVersionForClusterServiceBroker returns the OSB API version to use for
+// requests to the named ClusterServiceBroker.
+func (c *controller) osbAPIVersionForClusterServiceBroker(name string) osb.APIVersion {
+	broker, err := c.clusterServiceBrokerLister.Get(name)
+	if err != nil {
+		return c.preferredOSBAPIVersion
+	}
+	return c.osbAPIVersionForBroker(&broker.Status.CommonServiceBrokerStatus)
+}
+
+// osbAPIVersionForServiceBroker returns the OSB API version to use for
+// requests to the named ServiceBroker.
+func (c *controller) osbAPIVersionForServiceBroker(namespace, name string) osb.APIVersion {
+	broker, err := c.serviceBrokerLister.ServiceBrokers(namespace).Get(name)
+	if err != nil {
+		return c.preferredOSBAPIVersion
+	}
+	return c.osbAPIVersionForBroker(&broker.Status.CommonServiceBrokerStatus)
+}
+
+// getCatalogNegotiatingOSBAPIVersion fetches a broker's catalog with the given
+// client, which sends the preferred OSB API version. Brokers reject versions
+// they do not implement with 412 Precondition Failed, in which case the
+// catalog is requested again with each older version in turn. It returns the
+// catalog and the version the broker accepted.
+//
+// Requests with the version recorded in the broker's status send the entity
+// tag of the catalog that was last reconciled. If the broker responds with
+// 304 Not Modified, no catalog is returned.
+func (c *controller) getCatalogNegotiatingOSBAPIVersion(brokerClient osb.Client, meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, credentials *brokerCredentials, status *v1beta1.CommonServiceBrokerStatus) (*osb.CatalogResponse, osb.APIVersion, error) {
+	etag := catalogETagFor(meta.Generation, status)
+	getCatalog := func(brokerClient osb.Client, version osb.APIVersion) (*osb.CatalogResponse, error) {
+		if etag == "" || version.HeaderValue() != status.OSBAPIVersion {
+			return brokerClient.GetCatalog()
+		}
+		catalog, err := brokerClient.GetCatalogIfNoneMatch(etag)
+		if isNotModifiedError(err) {
+			return nil, nil
+		}
+		return catalog, err
+	}
+
+	catalog, err := getCatalog(brokerClient, c.preferredOSBAPIVersion)
+	if err == nil || !isPreconditionFailedError(err) {
+		return catalog, c.preferredOSBAPIVersion, err
+	}
+
+	for _, version := range supportedOSBAPIVersions {
+		if version.AtLeast(c.preferredOSBAPIVersion) {
+			continue
+		}
+		glog.V(4).Infof("Broker %q rejected OSB API version: %v; retrying with version %s", meta.Name, err, version.HeaderValue())
+
+		brokerClient, err = c.getBrokerClient(meta, commonSpec, version, credentials)
+		if err != nil {
+			return nil, version, err
+		}
+		catalog, err = getCatalog(brokerClient, version)
+		if err == nil || !isPreconditionFailedError(err) {
+			return catalog, version, err
+		}
+	}
+	return nil, osb.APIVersion{}, fmt.Errorf("broker does not support any OSB API version up to %s: %v", c.preferredOSBAPIVersion.HeaderValue(), err)
+}
+
+// recordOSBAPIVersionChange emits an event on the broker when the OSB API
+// version negotiated with it differs from the one previously recorded in its
+// status, such as after the broker was upgraded or downgraded.
+func (c *controller) recordOSBAPIVersionChange(broker runtime.Object, status *v1beta1.CommonServiceBrokerStatus, version osb.APIVersion) {
+	if status.OSBAPIVersion == "" || status.OSBAPIVersion == version.HeaderValue() {
+		return
+	}
+	c.recorder.Eventf(broker, corev1.EventTypeNormal, osbAPIVersionNegotiatedReason, "Negotiated OSB API version %s with the broker, which previously used %s", version.HeaderValue(), status.OSBAPIVersion)
+}
+
+// isNotModifiedError returns whether the error is a 304 Not Modified
+// response, which brokers return for a catalog that still has the entity tag
+// sent in If-None-Match.
+func isNotModifiedError(err error) bool {
+	statusCodeError, ok := osb.IsHTTPError(err)
+	return ok && statusCodeError.StatusCode == http.StatusNotModified
+}
+
+// isPreconditionFailedError returns whether the error is a 412 Precondition
+// Failed response, which brokers return for unsupported API versions.
+func isPreconditionFailedError(err error) bool {
+	statusCodeError, ok := osb.IsHTTPError(err)
+	return ok && statusCodeError.StatusCode == http.StatusPreconditionFailed
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+)
+
+func TestParseOSBAPIVersion(t *testing.T) {
+	for _, version := range []string{"2.11", "2.12", "2.13", "2.14", "2.15"} {
+		v, err := parseOSBAPIVersion(version)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %v", version, err)
+			continue
+		}
+		if e, a := version, v.HeaderValue(); e != a {
+			t.Errorf("unexpected version: %v", expectedGot(e, a))
+		}
+	}
+
+	for _, version := range []string{"", "2.10", "2.16", "latest"} {
+		if _, err := parseOSBAPIVersion(version); err == nil {
+			t.Errorf("expected an error parsing %q", version)
+		}
+	}
+}
+
+func TestOSBAPIVersionForBroker(t *testing.T) {
+	cases := []struct {
+		name       string
+		preferred  osb.APIVersion
+		negotiated string
+		expected   string
+	}{
+		{
+			name:      "not negotiated",
+			preferred: osb.Version2_14(),
+			expected:  "2.14",
+		},
+		{
+			name:       "negotiated older version",
+			preferred:  osb.Version2_14(),
+			negotiated: "2.12",
+			expected:   "2.12",
+		},
+		{
+			name:       "negotiated version newer than preferred",
+			preferred:  osb.Version2_12(),
+			negotiated: "2.13",
+			expected:   "2.12",
+		},
+		{
+			name:       "unknown negotiated version",
+			preferred:  osb.Version2_13(),
+			negotiated: "1.0",
+			expected:   "2.13",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, testController, _ := newTestController(t, noFakeActions())
+			testController.preferredOSBAPIVersion = tc.preferred
+
+			status := &v1beta1.CommonServiceBrokerStatus{OSBAPIVersion: tc.negotiated}
+			if e, a := tc.expected, testController.osbAPIVersionForBroker(status).HeaderValue(); e != a {
+				t.Fatalf("unexpected OSB API version: %v", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconcileClusterServiceBrokerNegotiatesOSBAPIVersion ensures that the
+// catalog is requested with older OSB API versions until the broker accepts
+// one, and that the accepted version is recorded in the broker's status.
+func TestReconcileClusterServiceBrokerNegotiatesOSBAPIVersion(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+	testController.preferredOSBAPIVersion = osb.Version2_14()
+
+	var requestedVersions []string
+	testController.brokerClientCreateFunc = func(config *osb.ClientConfiguration) (osb.Client, error) {
+		requestedVersions = append(requestedVersions, config.APIVersion.HeaderValue())
+		clientConfig := getTestCatalogConfig()
+		if !osb.Version2_12().AtLeast(config.APIVersion) {
+			clientConfig.CatalogReaction = &fakeosb.CatalogReaction{
+				Error: osb.HTTPStatusCodeError{StatusCode: http.StatusPreconditionFailed},
+			}
+		}
+		return fakeosb.NewFakeClient(clientConfig), nil
+	}
+
+	broker := getTestClusterServiceBroker()
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, a := []string{"2.14", "2.13", "2.12"}, requestedVersions; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected OSB API versions: %v", expectedGot(e, a))
+	}
+
+	actions := fakeCatalogClient.Actions()
+	updatedBroker := assertUpdateStatus(t, actions[len(actions)-1], broker).(*v1beta1.ClusterServiceBroker)
+	assertClusterServiceBrokerReadyTrue(t, updatedBroker)
+	if e, a := "2.12", updatedBroker.Status.OSBAPIVersion; e != a {
+		t.Fatalf("unexpected negotiated OSB API version: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileClusterServiceBrokerNoSupportedOSBAPIVersion ensures that a
+// broker rejecting every OSB API version is not marked ready.
+func TestReconcileClusterServiceBrokerNoSupportedOSBAPIVersion(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+
+	testController.brokerClientCreateFunc = fakeosb.ReturnFakeClientFunc(fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Error: osb.HTTPStatusCodeError{StatusCode: http.StatusPreconditionFailed},
+		},
+	}))
+
+	broker := getTestClusterServiceBroker()
+	if err := reconcileClusterServiceBroker(t, testController, broker); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	actions := fakeCatalogClient.Actions()
+	updatedBroker := assertUpdateStatus(t, actions[0], broker)
+	assertClusterServiceBrokerReadyFalse(t, updatedBroker)
+}
+
+// TestPrepareBindRequestAsyncBindingOSBAPIVersion ensures that asynchronous
+// bindings are only requested from brokers that negotiated OSB API 2.13.
+func TestPrepareBindRequestAsyncBindingOSBAPIVersion(t *testing.T) {
+	utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.AsyncBindingOperations))
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.AsyncBindingOperations))
+
+	cases := []struct {
+		negotiated        string
+		acceptsIncomplete bool
+	}{
+		{negotiated: "", acceptsIncomplete: true},
+		{negotiated: "2.14", acceptsIncomplete: true},
+		{negotiated: "2.13", acceptsIncomplete: true},
+		{negotiated: "2.12", acceptsIncomplete: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("negotiated %q", tc.negotiated), func(t *testing.T) {
+			_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+			broker := getTestClusterServiceBroker()
+			broker.Status.OSBAPIVersion = tc.negotiated
+			instance := getTestServiceInstanceWithStatus(v1beta1.ConditionTrue)
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestBindingRetrievableClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			request, _, err := testController.prepareBindRequest(getTestServiceBinding(), instance)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := tc.acceptsIncomplete, request.AcceptsIncomplete; e != a {
+				t.Fatalf("unexpected AcceptsIncomplete: %v", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestPrepareProvisionRequestMaintenanceInfoOSBAPIVersion ensures that
+// maintenance info is only sent to brokers that negotiated OSB API 2.15.
+func TestPrepareProvisionRequestMaintenanceInfoOSBAPIVersion(t *testing.T) {
+	cases := []struct {
+		negotiated string
+		sent       bool
+	}{
+		{negotiated: "", sent: true},
+		{negotiated: "2.15", sent: true},
+		{negotiated: "2.14", sent: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("negotiated %q", tc.negotiated), func(t *testing.T) {
+			_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			testController.preferredOSBAPIVersion = osb.Version2_15()
+
+			broker := getTestClusterServiceBroker()
+			broker.Status.OSBAPIVersion = tc.negotiated
+			plan := getTestClusterServicePlan()
+			plan.Spec.MaintenanceInfo = &v1beta1.MaintenanceInfo{Version: "1.0.0"}
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+
+			request, inProgressProperties, err := testController.prepareProvisionRequest(getTestServiceInstanceWithClusterRefs())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := tc.sent, request.MaintenanceInfo != nil; e != a {
+				t.Fatalf("unexpected maintenance info in the request: %v", expectedGot(e, a))
+			}
+			if e, a := tc.sent, inProgressProperties.MaintenanceInfo != nil; e != a {
+				t.Fatalf("unexpected in-progress maintenance info: %v", expectedGot(e, a))
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+)
+
+// queuePriority is the priority of a key in a priorityQueue. Keys of a higher
+// priority are handed out before any key of a lower priority.
+type queuePriority int
+
+const (
+	// priorityLow is for keys that are only being resynced, where no change
+	// is expected.
+	priorityLow queuePriority = iota
+	// priorityNormal is for keys whose status changed, and for retries.
+	priorityNormal
+	// priorityHigh is for keys that users are waiting on: spec changes,
+	// deletions and completed polls of asynchronous operations.
+	priorityHigh
+
+	numQueuePriorities = int(priorityHigh) + 1
+)
+
+func (p queuePriority) String() string {
+	switch p {
+	case priorityLow:
+		return "low"
+	case priorityNormal:
+		return "normal"
+	case priorityHigh:
+		return "high"
+	}
+	return "unknown"
+}
+
+// updatePriority returns the priority to reconcile an updated resource at:
+// high for spec changes and deletions, low for resyncs, in which nothing
+// changed, and normal otherwise.
+func updatePriority(oldMeta, newMeta metav1.ObjectMeta) queuePriority {
+	switch {
+	case newMeta.Generation != oldMeta.Generation:
+		return priorityHigh
+	case newMeta.DeletionTimestamp != nil && oldMeta.DeletionTimestamp == nil:
+		return priorityHigh
+	case newMeta.ResourceVersion == oldMeta.ResourceVersion:
+		return priorityLow
+	}
+	return priorityNormal
+}
+
+// priorityRateLimitingInterface is a rate-limited workqueue whose keys can be
+// added with a priority. Add, AddAfter and AddRateLimited add keys at
+// priorityNormal.
+type priorityRateLimitingInterface interface {
+	workqueue.RateLimitingInterface
+	// AddWithPriority adds a key at a priority.
+	AddWithPriority(item interface{}, priority queuePriority)
+}
+
+// pickSchedule is the priority whose keys each successive Get prefers. When
+// keys of every priority are waiting, high, normal and low priority keys are
+// handed out in the ratio 4:2:1, so that a steady stream of higher priority
+// keys delays lower priority ones without starving them. A Get whose
+// preferred priority has no waiting keys takes one of the highest priority
+// that has.
+var pickSchedule = []queuePriority{priorityHigh, priorityNormal, priorityHigh, priorityLow, priorityHigh, priorityNormal, priorityHigh}
+
+// priorityQueue is a priorityRateLimitingInterface built from one workqueue
+// per priority, which each keep their keys unique and in order and report
+// the usual workqueue metrics under the queue's name and the priority. Keys
+// added with a delay or rate limited wait in a rate-limiting workqueue named
+// after the queue, and move to the normal priority workqueue once ready.
+//
+// Like a workqueue, a key is handed out to a single worker at a time. A key
+// waiting at two priorities, such as a resync that is followed by a spec
+// change, is handed out at the higher one first. A key taken from one
+// priority while it is being processed from another is handed out again at
+// that priority once Done is called for it.
+type priorityQueue struct {
+	name    string
+	delayed workqueue.RateLimitingInterface
+	bands   [numQueuePriorities]workqueue.Interface
+
+	// cond is signalled whenever a key becomes ready in one of the bands.
+	cond *sync.Cond
+	// picks counts the Gets, to index pickSchedule.
+	picks int
+	// processing holds the priority each key handed out and not yet done
+	// was taken from.
+	processing map[interface{}]queuePriority
+	// requeue holds the highest priority at which each key being processed
+	// was taken again, and must be handed out again once done.
+	requeue      map[interface{}]queuePriority
+	shuttingDown bool
+}
+
+var _ priorityRateLimitingInterface = &priorityQueue{}
+
+// newNamedPriorityQueue returns a priority queue whose rate-limited adds are
+// delayed by rateLimiter.
+func newNamedPriorityQueue(rateLimiter workqueue.RateLimiter, name string) *priorityQueue {
+	q := &priorityQueue{
+		name:       name,
+		delayed:    workqueue.NewNamedRateLimitingQueue(rateLimiter, name),
+		cond:       sync.NewCond(&sync.Mutex{}),
+		processing: map[interface{}]queuePriority{},
+		requeue:    map[interface{}]queuePriority{},
+	}
+	for priority := range q.bands {
+		q.bands[priority] = workqueue.NewNamed(name + "-" + queuePriority(priority).String())
+	}
+	go q.forwardDelayed()
+	return q
+}
+
+// forwardDelayed moves the keys of the delayed workqueue to the normal
+// priority one as they become ready, until the queue is shut down.
+func (q *priorityQueue) forwardDelayed() {
+	for {
+		item, shutdown := q.delayed.Get()
+		if shutdown {
+			return
+		}
+		q.delayed.Done(item)
+		q.AddWithPriority(item, priorityNormal)
+	}
+}
+
+// Add adds a key at priorityNormal.
+func (q *priorityQueue) Add(item interface{}) {
+	q.AddWithPriority(item, priorityNormal)
+}
+
+// AddWithPriority adds a key at a priority.
+func (q *priorityQueue) AddWithPriority(item interface{}, priority queuePriority) {
+	q.bands[priority].Add(item)
+	q.updateDepth(priority)
+
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.cond.Signal()
+}
+
+func (q *priorityQueue) updateDepth(priority queuePriority) {
+	metrics.WorkQueueDepth.WithLabelValues(q.name, priority.String()).Set(float64(q.bands[priority].Len()))
+}
+
+// Len returns the number of keys waiting, of every priority. Keys waiting
+// for a delay or their rate limit are not counted.
+func (q *priorityQueue) Len() int {
+	n := 0
+	for _, band := range q.bands {
+		n += band.Len()
+	}
+	return n
+}
+
+// LenWithPriority returns the number of keys waiting at a priority.
+func (q *priorityQueue) LenWithPriority(priority queuePriority) int {
+	return q.bands[priority].Len()
+}
+
+// Get blocks until a key is waiting that is not being processed, and returns
+// it. shutdown is true once the queue is shut down and empty.
+func (q *priorityQueue) Get() (item interface{}, shutdown bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for {
+		priority, ok := q.pick()
+		if !ok {
+			if q.shuttingDown {
+				return nil, true
+			}
+			q.cond.Wait()
+			continue
+		}
+
+		// The band has a waiting key, and only Get takes keys from the
+		// bands, so this does not block.
+		item, _ := q.bands[priority].Get()
+		q.updateDepth(priority)
+		if _, processing := q.processing[item]; processing {
+			if requeue, ok := q.requeue[item]; !ok || priority > requeue {
+				q.requeue[item] = priority
+			}
+			q.bands[priority].Done(item)
+			continue
+		}
+		q.processing[item] = priority
+		return item, false
+	}
+}
+
+// pick returns the priority to take the next key from, following
+// pickSchedule, or false if no key is waiting. It must be called with the
+// lock held.
+func (q *priorityQueue) pick() (queuePriority, bool) {
+	preferred := pickSchedule[q.picks%len(pickSchedule)]
+	q.picks++
+	if q.bands[preferred].Len() > 0 {
+		return preferred, true
+	}
+	for priority := numQueuePriorities - 1; priority >= 0; priority-- {
+		if q.bands[priority].Len() > 0 {
+			return queuePriority(priority), true
+		}
+	}
+	return 0, false
+}
+
+// Done marks a key as processed. If it was added again while processed, it
+// is handed out again.
+func (q *priorityQueue) Done(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	priority, ok := q.processing[item]
+	if !ok {
+		return
+	}
+	delete(q.processing, item)
+	// The band hands the key out again if it was added to it meanwhile.
+	q.bands[priority].Done(item)
+	q.updateDepth(priority)
+	if requeue, ok := q.requeue[item]; ok {
+		delete(q.requeue, item)
+		q.bands[requeue].Add(item)
+		q.updateDepth(requeue)
+	}
+	q.cond.Signal()
+}
+
+// ShutDown makes workers waiting on Get return once the queue is empty, and
+// ignores any later adds.
+func (q *priorityQueue) ShutDown() {
+	q.delayed.ShutDown()
+	for _, band := range q.bands {
+		band.ShutDown()
+	}
+
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShuttingDown returns whether ShutDown was called.
+func (q *priorityQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.shuttingDown
+}
+
+// AddAfter adds a key at priorityNormal once a duration elapses. A key that
+// is already waiting for a delay is added once the earlier of the two
+// elapses.
+func (q *priorityQueue) AddAfter(item interface{}, duration time.Duration) {
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+	q.delayed.AddAfter(item, duration)
+}
+
+// AddRateLimited adds a key at priorityNormal once the rate limiter allows.
+func (q *priorityQueue) AddRateLimited(item interface{}) {
+	q.delayed.AddRateLimited(item)
+}
+
+// Forget resets the rate limiting of a key.
+func (q *priorityQueue) Forget(item interface{}) {
+	q.delayed.Forget(item)
+}
+
+// NumRequeues returns how many times a key was added rate-limited.
+func (q *priorityQueue) NumRequeues(item interface{}) int {
+	return q.delayed.NumRequeues(item)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func newTestPriorityQueue() *priorityQueue {
+	return newNamedPriorityQueue(workqueue.DefaultControllerRateLimiter(), "test")
+}
+
+// drain gets and marks as done every waiting key, in order.
+func drain(q *priorityQueue) []interface{} {
+	var items []interface{}
+	for q.Len() > 0 {
+		item, _ := q.Get()
+		items = append(items, item)
+		q.Done(item)
+	}
+	return items
+}
+
+func TestPriorityQueueOrder(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddWithPriority("resync-1", priorityLow)
+	q.Add("status-1")
+	q.AddWithPriority("resync-2", priorityLow)
+	q.AddWithPriority("spec-1", priorityHigh)
+	q.Add("status-2")
+	q.AddWithPriority("spec-2", priorityHigh)
+
+	if e, a := 2, q.LenWithPriority(priorityLow); e != a {
+		t.Fatalf("expected %d low priority keys, got %d", e, a)
+	}
+
+	// Keys are picked following pickSchedule, from the highest priority
+	// with waiting keys when the preferred one has none.
+	expected := []interface{}{"spec-1", "status-1", "spec-2", "resync-1", "status-2", "resync-2"}
+	if a := drain(q); !reflect.DeepEqual(expected, a) {
+		t.Fatalf("expected keys in order %v, got %v", expected, a)
+	}
+}
+
+// TestPriorityQueueNoStarvation ensures that low priority keys are handed
+// out while higher priority keys keep waiting.
+func TestPriorityQueueNoStarvation(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddWithPriority("resync", priorityLow)
+	for i := 0; i < 10; i++ {
+		q.AddWithPriority(fmt.Sprintf("spec-%d", i), priorityHigh)
+	}
+
+	items := drain(q)
+	for i, item := range items {
+		if item == "resync" {
+			if i >= len(pickSchedule) {
+				t.Fatalf("expected the low priority key within the first %d keys, got it at %d", len(pickSchedule), i)
+			}
+			return
+		}
+	}
+	t.Fatalf("expected the low priority key to be handed out, got %v", items)
+}
+
+func TestPriorityQueueAddExistingKey(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.Add("a")
+	q.Add("a")
+	q.AddWithPriority("b", priorityLow)
+	q.AddWithPriority("b", priorityLow)
+
+	if e, a := 2, q.Len(); e != a {
+		t.Fatalf("expected keys to be unique within a priority: expected %d keys, got %d", e, a)
+	}
+}
+
+func TestPriorityQueueAddWhileProcessing(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.Add("a")
+	item, _ := q.Get()
+
+	q.AddWithPriority("a", priorityHigh)
+	q.AddWithPriority("b", priorityHigh)
+	if next, _ := q.Get(); next != "b" {
+		t.Fatalf("expected a key being processed not to be handed out again, got %v", next)
+	}
+	if e, a := 0, q.Len(); e != a {
+		t.Fatalf("expected %d keys waiting, got %d", e, a)
+	}
+
+	q.Done(item)
+	q.Done("b")
+	expected := []interface{}{"a"}
+	if a := drain(q); !reflect.DeepEqual(expected, a) {
+		t.Fatalf("expected the key to be handed out again once done: expected %v, got %v", expected, a)
+	}
+}
+
+// TestPriorityQueueConcurrentWorkers ensures that a key added at several
+// priorities is never processed by two workers at once.
+func TestPriorityQueueConcurrentWorkers(t *testing.T) {
+	q := newTestPriorityQueue()
+
+	var (
+		mutex    sync.Mutex
+		inFlight = map[interface{}]bool{}
+		wg       sync.WaitGroup
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, shutdown := q.Get()
+				if shutdown {
+					return
+				}
+				mutex.Lock()
+				if inFlight[item] {
+					t.Errorf("key %v handed out while being processed", item)
+				}
+				inFlight[item] = true
+				mutex.Unlock()
+
+				time.Sleep(time.Millisecond)
+
+				mutex.Lock()
+				inFlight[item] = false
+				mutex.Unlock()
+				q.Done(item)
+			}
+		}()
+	}
+
+	for i := 0; i < 200; i++ {
+		q.AddWithPriority(fmt.Sprintf("key-%d", i%5), queuePriority(i%numQueuePriorities))
+	}
+	for q.Len() > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	q.ShutDown()
+	wg.Wait()
+}
+
+func TestPriorityQueueShutDown(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.Add("a")
+	q.ShutDown()
+	q.Add("b")
+
+	if item, shutdown := q.Get(); item != "a" || shutdown {
+		t.Fatalf("expected the waiting key to be handed out after shutting down, got %v, %v", item, shutdown)
+	}
+	if _, shutdown := q.Get(); !shutdown {
+		t.Fatal("expected Get to return shutdown once the queue is empty")
+	}
+}
+
+func TestPriorityQueueAddAfter(t *testing.T) {
+	q := newTestPriorityQueue()
+	q.AddAfter("a", 10*time.Millisecond)
+	q.AddAfter("a", 20*time.Millisecond)
+	if e, a := 0, q.Len(); e != a {
+		t.Fatalf("expected %d keys before the delay, got %d", e, a)
+	}
+	item, _ := q.Get()
+	if item != "a" {
+		t.Fatalf("expected %q, got %v", "a", item)
+	}
+	q.Done(item)
+
+	time.Sleep(50 * time.Millisecond)
+	if e, a := 0, q.Len(); e != a {
+		t.Fatalf("expected a key added twice with a delay to be added once: expected %d keys, got %d", e, a)
+	}
+}
+
+func TestUpdatePriority(t *testing.T) {
+	now := metav1.Now()
+	old := metav1.ObjectMeta{Generation: 1, ResourceVersion: "1"}
+	cases := []struct {
+		name     string
+		updated  metav1.ObjectMeta
+		expected queuePriority
+	}{
+		{
+			name:     "resync",
+			updated:  metav1.ObjectMeta{Generation: 1, ResourceVersion: "1"},
+			expected: priorityLow,
+		},
+		{
+			name:     "status change",
+			updated:  metav1.ObjectMeta{Generation: 1, ResourceVersion: "2"},
+			expected: priorityNormal,
+		},
+		{
+			name:     "spec change",
+			updated:  metav1.ObjectMeta{Generation: 2, ResourceVersion: "2"},
+			expected: priorityHigh,
+		},
+		{
+			name:     "deletion",
+			updated:  metav1.ObjectMeta{Generation: 1, ResourceVersion: "2", DeletionTimestamp: &now},
+			expected: priorityHigh,
+		},
+	}
+	for _, tc := range cases {
+		if e, a := tc.expected, updatePriority(old, tc.updated); e != a {
+			t.Errorf("%v: expected priority %v, got %v", tc.name, e, a)
+		}
+	}
+}
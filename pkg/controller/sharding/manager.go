@@ -0,0 +1,577 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharding splits the keys reconciled by the service catalog
+// controller into shards, so that several controller-manager replicas can
+// reconcile at the same time. Each shard is owned by at most one replica at a
+// time through a lease, and the replicas share the shards out between them as
+// they come and go.
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+)
+
+const (
+	// KeyNamespace assigns all the keys of a namespace to the same shard.
+	KeyNamespace = "namespace"
+	// KeyName assigns keys to shards by hashing the whole key, spreading
+	// the resources of a namespace over all the shards.
+	KeyName = "name"
+)
+
+// ShardForKey returns which of the given number of shards a workqueue key
+// belongs to. Keys of cluster-scoped resources have no namespace and are
+// always hashed whole.
+func ShardForKey(key string, shards int, shardKey string) int {
+	hashed := key
+	if shardKey == KeyNamespace {
+		if i := strings.Index(key, "/"); i >= 0 {
+			hashed = key[:i]
+		}
+	}
+	h := fnv.New32a()
+	h.Write([]byte(hashed))
+	return int(h.Sum32() % uint32(shards))
+}
+
+// Config configures a Manager.
+type Config struct {
+	// Client is used to read and write the shard leases and the member
+	// list.
+	Client corev1client.CoreV1Interface
+	// LockType is the type of resource holding the shard leases, one of
+	// the resourcelock types.
+	LockType string
+	// Namespace is where the shard leases and the member list are kept.
+	Namespace string
+	// Name prefixes the names of the shard leases, which are
+	// <name>-shard-<n>, and of the member list, <name>-members.
+	Name string
+	// Identity distinguishes this replica from the others. It must be a
+	// valid ConfigMap key.
+	Identity string
+	// Shards is the number of shards the keys are split into.
+	Shards int
+	// ShardKey is how keys are assigned to shards: KeyNamespace or KeyName.
+	ShardKey string
+
+	// LeaseDuration is how long a lease that is not renewed is kept by its
+	// holder before other replicas may take it.
+	LeaseDuration time.Duration
+	// RenewDeadline is how long a replica keeps reconciling the keys of a
+	// shard without renewing its lease.
+	RenewDeadline time.Duration
+	// RetryPeriod is how often leases are renewed and the shards are
+	// rebalanced.
+	RetryPeriod time.Duration
+}
+
+func (c *Config) validate() error {
+	if c.Shards < 1 {
+		return fmt.Errorf("the number of shards must be at least 1, got %d", c.Shards)
+	}
+	if c.ShardKey != KeyNamespace && c.ShardKey != KeyName {
+		return fmt.Errorf("the shard key must be %q or %q, got %q", KeyNamespace, KeyName, c.ShardKey)
+	}
+	if errs := validation.IsConfigMapKey(c.Identity); len(errs) > 0 {
+		return fmt.Errorf("invalid identity %q: %s", c.Identity, strings.Join(errs, ", "))
+	}
+	if c.RenewDeadline >= c.LeaseDuration {
+		return fmt.Errorf("the renew deadline (%v) must be shorter than the lease duration (%v)", c.RenewDeadline, c.LeaseDuration)
+	}
+	if c.RetryPeriod >= c.RenewDeadline {
+		return fmt.Errorf("the retry period (%v) must be shorter than the renew deadline (%v)", c.RetryPeriod, c.RenewDeadline)
+	}
+	return nil
+}
+
+// shard is the state of one shard as seen by this replica.
+type shard struct {
+	// mu guards owned, draining and inFlight, which are read by the
+	// controller workers.
+	mu sync.Mutex
+	// owned is whether this replica holds the lease of the shard.
+	owned bool
+	// draining is set on an owned shard that is being given up: no new key
+	// of it is reconciled, and its lease is kept until inFlight, the number
+	// of its keys being reconciled, drops to zero.
+	draining bool
+	inFlight int
+
+	// renewTime is when the lease of an owned shard was last renewed.
+	renewTime time.Time
+	// observedRecord and observedTime are the last lease record read and
+	// when this replica first read it. Leases of other replicas expire
+	// LeaseDuration after they were last seen to change, so that the
+	// clocks of the replicas need not agree.
+	observedRecord resourcelock.LeaderElectionRecord
+	observedTime   time.Time
+}
+
+// observedMember is the last heartbeat of another replica read from the
+// member list, and when this replica first read it.
+type observedMember struct {
+	heartbeat    string
+	observedTime time.Time
+}
+
+// Manager takes a fair share of the shards for this replica and keeps their
+// leases renewed. It implements the controller's ShardFilter.
+type Manager struct {
+	config   Config
+	locks    []resourcelock.Interface
+	shards   []*shard
+	members  map[string]observedMember
+	handlers []func(shard int)
+	clock    clock.Clock
+	// lost is called when the lease of an owned shard could not be renewed
+	// before the renew deadline. Another replica may be reconciling the
+	// shard's keys soon after, so by default the process exits, as it does
+	// when it loses the leader election.
+	lost func(shard int)
+}
+
+// NewManager creates a Manager for the given configuration.
+func NewManager(config Config) (*Manager, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	m := &Manager{
+		config:  config,
+		members: map[string]observedMember{},
+		clock:   clock.RealClock{},
+		lost: func(shard int) {
+			glog.Fatalf("lost the lease of shard %d", shard)
+		},
+	}
+	for i := 0; i < config.Shards; i++ {
+		lock, err := resourcelock.New(
+			config.LockType,
+			config.Namespace,
+			fmt.Sprintf("%s-shard-%d", config.Name, i),
+			config.Client,
+			resourcelock.ResourceLockConfig{Identity: config.Identity},
+		)
+		if err != nil {
+			return nil, err
+		}
+		m.locks = append(m.locks, lock)
+		m.shards = append(m.shards, &shard{})
+	}
+	return m, nil
+}
+
+// AddAcquiredHandler registers a function called with each shard this
+// replica takes, once it owns it. It must be called before Run.
+func (m *Manager) AddAcquiredHandler(handler func(shard int)) {
+	m.handlers = append(m.handlers, handler)
+}
+
+// ShardForKey returns the shard of a workqueue key.
+func (m *Manager) ShardForKey(key string) int {
+	return ShardForKey(key, m.config.Shards, m.config.ShardKey)
+}
+
+// TryLock returns whether this replica owns the shard and is not giving it
+// up. If so, the shard is kept until Unlock is called.
+func (m *Manager) TryLock(shard int) bool {
+	s := m.shards[shard]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.owned || s.draining {
+		return false
+	}
+	s.inFlight++
+	return true
+}
+
+// Unlock allows a shard locked with TryLock to be given up.
+func (m *Manager) Unlock(shard int) {
+	s := m.shards[shard]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+}
+
+// Run takes and renews the leases of shards until stopCh is closed, then
+// gives up the shards this replica owns.
+func (m *Manager) Run(stopCh <-chan struct{}) {
+	glog.Infof("Sharding the controller into %d shards by %s as %q", m.config.Shards, m.config.ShardKey, m.config.Identity)
+	wait.Until(m.sync, m.config.RetryPeriod, stopCh)
+	m.releaseAll()
+}
+
+// sync renews the leases of the owned shards, then gives up or takes shards
+// so that this replica owns its fair share of them.
+func (m *Manager) sync() {
+	now := m.clock.Now()
+	members, err := m.heartbeat(now)
+	if err != nil {
+		glog.Warningf("Error updating the controller member list: %v", err)
+	}
+
+	owned := []int{}
+	draining := []int{}
+	free := []int{}
+	records := make([]*resourcelock.LeaderElectionRecord, len(m.shards))
+	for i, s := range m.shards {
+		record, err := m.locks[i].Get()
+		if err != nil && !apierrors.IsNotFound(err) {
+			glog.Warningf("Error getting the lease of shard %d: %v", i, err)
+			if s.owned {
+				m.checkRenewDeadline(i, now)
+			}
+			continue
+		}
+		if err != nil {
+			record = nil
+		}
+		m.observe(i, record, now)
+
+		if !s.owned {
+			records[i] = record
+			if m.isFree(i, record, now) {
+				free = append(free, i)
+			}
+			continue
+		}
+		if record == nil || record.HolderIdentity != m.config.Identity {
+			// Someone else holds the lease; that can only happen once it
+			// expired, so the shard was already lost.
+			m.lost(i)
+			m.setOwned(i, false)
+			continue
+		}
+		records[i] = m.renew(i, record, now)
+		if m.isDraining(i) {
+			if !m.releaseIfIdle(i, records[i], now) {
+				draining = append(draining, i)
+			}
+			continue
+		}
+		owned = append(owned, i)
+	}
+
+	if members == 0 {
+		// Without the member list the fair share is unknown, so only keep
+		// the shards already owned.
+		return
+	}
+	fairShare := (len(m.shards) + members - 1) / members
+	for len(owned) > fairShare {
+		i := owned[len(owned)-1]
+		owned = owned[:len(owned)-1]
+		m.setDraining(i, true)
+		m.releaseIfIdle(i, records[i], now)
+	}
+	for _, i := range draining {
+		if len(owned) >= fairShare {
+			break
+		}
+		m.setDraining(i, false)
+		owned = append(owned, i)
+	}
+	for _, i := range m.rotate(free) {
+		if len(owned) >= fairShare {
+			break
+		}
+		if m.acquire(i, records[i], now) {
+			owned = append(owned, i)
+		}
+	}
+}
+
+// observe records when the lease record of a shard was first seen.
+func (m *Manager) observe(i int, record *resourcelock.LeaderElectionRecord, now time.Time) {
+	s := m.shards[i]
+	if record == nil {
+		record = &resourcelock.LeaderElectionRecord{}
+	}
+	if s.observedTime.IsZero() || !recordsEqual(*record, s.observedRecord) {
+		s.observedRecord = *record
+		s.observedTime = now
+	}
+}
+
+func recordsEqual(a, b resourcelock.LeaderElectionRecord) bool {
+	return a.HolderIdentity == b.HolderIdentity &&
+		a.LeaseDurationSeconds == b.LeaseDurationSeconds &&
+		a.AcquireTime.Equal(&b.AcquireTime) &&
+		a.RenewTime.Equal(&b.RenewTime) &&
+		a.LeaderTransitions == b.LeaderTransitions
+}
+
+// isFree returns whether the lease of a shard this replica does not own may
+// be taken: it does not exist, was given up, or expired.
+func (m *Manager) isFree(i int, record *resourcelock.LeaderElectionRecord, now time.Time) bool {
+	if record == nil || record.HolderIdentity == "" || record.HolderIdentity == m.config.Identity {
+		return true
+	}
+	s := m.shards[i]
+	leaseDuration := time.Duration(record.LeaseDurationSeconds) * time.Second
+	return !s.observedTime.Add(leaseDuration).After(now)
+}
+
+// rotate orders the free shards starting from one picked from this
+// replica's identity, so that replicas starting together try to take
+// different shards.
+func (m *Manager) rotate(free []int) []int {
+	if len(free) == 0 {
+		return free
+	}
+	h := fnv.New32a()
+	h.Write([]byte(m.config.Identity))
+	start := int(h.Sum32() % uint32(len(free)))
+	return append(append([]int{}, free[start:]...), free[:start]...)
+}
+
+func (m *Manager) leaseRecord(now time.Time) resourcelock.LeaderElectionRecord {
+	return resourcelock.LeaderElectionRecord{
+		HolderIdentity:       m.config.Identity,
+		LeaseDurationSeconds: int(m.config.LeaseDuration / time.Second),
+		AcquireTime:          metav1.NewTime(now),
+		RenewTime:            metav1.NewTime(now),
+	}
+}
+
+// acquire takes the lease of a free shard. The write fails if another
+// replica changed the lease since it was read.
+func (m *Manager) acquire(i int, record *resourcelock.LeaderElectionRecord, now time.Time) bool {
+	ler := m.leaseRecord(now)
+	var err error
+	if record == nil {
+		err = m.locks[i].Create(ler)
+	} else {
+		ler.LeaderTransitions = record.LeaderTransitions
+		if record.HolderIdentity != m.config.Identity {
+			ler.LeaderTransitions++
+		}
+		err = m.locks[i].Update(ler)
+	}
+	if err != nil {
+		glog.V(4).Infof("Could not take the lease of shard %d: %v", i, err)
+		return false
+	}
+
+	m.observe(i, &ler, now)
+	m.shards[i].renewTime = now
+	m.setOwned(i, true)
+	glog.Infof("Took the lease of shard %d (%s)", i, m.locks[i].Describe())
+	for _, handler := range m.handlers {
+		handler(i)
+	}
+	return true
+}
+
+// renew extends the lease of an owned shard and returns the lease record.
+func (m *Manager) renew(i int, record *resourcelock.LeaderElectionRecord, now time.Time) *resourcelock.LeaderElectionRecord {
+	ler := *record
+	ler.LeaseDurationSeconds = int(m.config.LeaseDuration / time.Second)
+	ler.RenewTime = metav1.NewTime(now)
+	if err := m.locks[i].Update(ler); err != nil {
+		glog.Warningf("Error renewing the lease of shard %d: %v", i, err)
+		m.checkRenewDeadline(i, now)
+		return record
+	}
+	m.observe(i, &ler, now)
+	m.shards[i].renewTime = now
+	return &ler
+}
+
+// checkRenewDeadline gives up a shard whose lease has not been renewed
+// within the renew deadline.
+func (m *Manager) checkRenewDeadline(i int, now time.Time) {
+	if now.Sub(m.shards[i].renewTime) <= m.config.RenewDeadline {
+		return
+	}
+	m.lost(i)
+	m.setOwned(i, false)
+}
+
+// releaseIfIdle gives up a draining shard once none of its keys is being
+// reconciled, clearing its lease so that another replica can take it at
+// once. It returns whether the shard was given up.
+func (m *Manager) releaseIfIdle(i int, record *resourcelock.LeaderElectionRecord, now time.Time) bool {
+	s := m.shards[i]
+	s.mu.Lock()
+	idle := s.inFlight == 0
+	s.mu.Unlock()
+	if !idle {
+		return false
+	}
+
+	m.setOwned(i, false)
+	if record == nil {
+		return true
+	}
+	ler := *record
+	ler.HolderIdentity = ""
+	ler.LeaseDurationSeconds = 1
+	ler.RenewTime = metav1.NewTime(now)
+	if err := m.locks[i].Update(ler); err != nil {
+		glog.Warningf("Error giving up the lease of shard %d: %v", i, err)
+		return true
+	}
+	m.observe(i, &ler, now)
+	glog.Infof("Gave up the lease of shard %d (%s)", i, m.locks[i].Describe())
+	return true
+}
+
+// releaseAll gives up every owned shard and leaves the member list. It is
+// called once the controller stopped, so no key is being reconciled.
+func (m *Manager) releaseAll() {
+	now := m.clock.Now()
+	for i, s := range m.shards {
+		if !s.owned {
+			continue
+		}
+		m.setDraining(i, true)
+		record, err := m.locks[i].Get()
+		if err != nil || record.HolderIdentity != m.config.Identity {
+			m.setOwned(i, false)
+			continue
+		}
+		m.releaseIfIdle(i, record, now)
+	}
+
+	members := m.config.Client.ConfigMaps(m.config.Namespace)
+	cm, err := members.Get(m.membersName(), metav1.GetOptions{})
+	if err == nil {
+		delete(cm.Data, m.config.Identity)
+		_, err = members.Update(cm)
+	}
+	if err != nil {
+		glog.Warningf("Error leaving the controller member list: %v", err)
+	}
+}
+
+func (m *Manager) isDraining(i int) bool {
+	s := m.shards[i]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.draining
+}
+
+func (m *Manager) setDraining(i int, draining bool) {
+	s := m.shards[i]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.draining = draining
+}
+
+func (m *Manager) setOwned(i int, owned bool) {
+	s := m.shards[i]
+	s.mu.Lock()
+	s.owned = owned
+	s.draining = false
+	s.mu.Unlock()
+
+	value := 0.0
+	if owned {
+		value = 1
+	}
+	metrics.ControllerShardOwned.WithLabelValues(strconv.Itoa(i)).Set(value)
+}
+
+func (m *Manager) membersName() string {
+	return m.config.Name + "-members"
+}
+
+// heartbeat records that this replica is alive in the member list and
+// returns the number of live replicas, or 0 if the list could not be read.
+// Replicas whose heartbeat has not changed for a lease duration are not
+// counted, and are removed from the list after twice that.
+func (m *Manager) heartbeat(now time.Time) (int, error) {
+	client := m.config.Client.ConfigMaps(m.config.Namespace)
+	heartbeat := now.UTC().Format(time.RFC3339Nano)
+	cm, err := client.Get(m.membersName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = client.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      m.membersName(),
+				Namespace: m.config.Namespace,
+			},
+			Data: map[string]string{m.config.Identity: heartbeat},
+		})
+		if err != nil {
+			return 0, err
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	live := 1
+	var names []string
+	for name := range cm.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == m.config.Identity {
+			continue
+		}
+		observed, ok := m.members[name]
+		if !ok || observed.heartbeat != cm.Data[name] {
+			observed = observedMember{heartbeat: cm.Data[name], observedTime: now}
+			m.members[name] = observed
+		}
+		age := now.Sub(observed.observedTime)
+		if age < m.config.LeaseDuration {
+			live++
+		} else if age >= 2*m.config.LeaseDuration {
+			glog.V(4).Infof("Removing controller %q from the member list", name)
+			delete(cm.Data, name)
+			delete(m.members, name)
+		}
+	}
+	for name := range m.members {
+		if _, ok := cm.Data[name]; !ok {
+			delete(m.members, name)
+		}
+	}
+
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[m.config.Identity] = heartbeat
+	if _, err := client.Update(cm); err != nil {
+		// The list is updated by every replica; a conflict only delays this
+		// replica's heartbeat to the next sync.
+		glog.V(4).Infof("Could not update the controller member list: %v", err)
+	}
+	return live, nil
+}
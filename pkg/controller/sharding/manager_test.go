@@ -0,0 +1,251 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const testShards = 4
+
+func newTestManager(t *testing.T, client *fake.Clientset, identity string, clock clock.Clock) (*Manager, *[]int) {
+	m, err := NewManager(Config{
+		Client:        client.CoreV1(),
+		LockType:      resourcelock.ConfigMapsResourceLock,
+		Namespace:     "kube-system",
+		Name:          "service-catalog-controller-manager",
+		Identity:      identity,
+		Shards:        testShards,
+		ShardKey:      KeyNamespace,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating manager: %v", err)
+	}
+	m.clock = clock
+	lost := []int{}
+	m.lost = func(shard int) {
+		lost = append(lost, shard)
+	}
+	return m, &lost
+}
+
+func ownedShards(m *Manager) []int {
+	owned := []int{}
+	for i := range m.shards {
+		if m.TryLock(i) {
+			m.Unlock(i)
+			owned = append(owned, i)
+		}
+	}
+	return owned
+}
+
+func TestShardForKey(t *testing.T) {
+	for _, key := range []string{"ns/instance", "ns/binding", "other/instance", "cluster-broker"} {
+		shard := ShardForKey(key, testShards, KeyName)
+		if shard < 0 || shard >= testShards {
+			t.Fatalf("%q: shard %d out of range", key, shard)
+		}
+		if e, a := shard, ShardForKey(key, testShards, KeyName); e != a {
+			t.Fatalf("%q: shard not stable: %d != %d", key, e, a)
+		}
+	}
+
+	if e, a := ShardForKey("ns/instance", 1000, KeyNamespace), ShardForKey("ns/binding", 1000, KeyNamespace); e != a {
+		t.Fatalf("keys of a namespace should share a shard: %d != %d", e, a)
+	}
+	if e, a := ShardForKey("cluster-broker", 1000, KeyName), ShardForKey("cluster-broker", 1000, KeyNamespace); e != a {
+		t.Fatalf("cluster-scoped keys should be hashed whole: %d != %d", e, a)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	valid := Config{
+		Identity:      "host-external-service-catalog-controller",
+		Shards:        2,
+		ShardKey:      KeyName,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	}
+	if err := valid.validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := map[string]func(*Config){
+		"no shards":            func(c *Config) { c.Shards = 0 },
+		"unknown shard key":    func(c *Config) { c.ShardKey = "uid" },
+		"invalid identity":     func(c *Config) { c.Identity = "host:1" },
+		"renew after lease":    func(c *Config) { c.RenewDeadline = 20 * time.Second },
+		"retry after deadline": func(c *Config) { c.RetryPeriod = 10 * time.Second },
+	}
+	for name, modify := range cases {
+		config := valid
+		modify(&config)
+		if err := config.validate(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestManagerSharesShards(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	fakeClock := clock.NewFakeClock(time.Now())
+	a, _ := newTestManager(t, client, "a", fakeClock)
+	b, _ := newTestManager(t, client, "b", fakeClock)
+
+	acquired := []int{}
+	b.AddAcquiredHandler(func(shard int) {
+		acquired = append(acquired, shard)
+	})
+
+	a.sync()
+	if e, a := []int{0, 1, 2, 3}, ownedShards(a); !reflect.DeepEqual(e, a) {
+		t.Fatalf("the only replica should own every shard: expected %v, got %v", e, a)
+	}
+
+	// b joins; the shards are all held, so it takes none yet.
+	b.sync()
+	if owned := ownedShards(b); len(owned) != 0 {
+		t.Fatalf("expected no shards, got %v", owned)
+	}
+
+	// a sees b and gives up half of the shards, which b then takes.
+	fakeClock.Step(2 * time.Second)
+	a.sync()
+	b.sync()
+	ownedA, ownedB := ownedShards(a), ownedShards(b)
+	if len(ownedA) != 2 || len(ownedB) != 2 {
+		t.Fatalf("expected the shards to be split evenly, got %v and %v", ownedA, ownedB)
+	}
+	all := append(append([]int{}, ownedA...), ownedB...)
+	sort.Ints(all)
+	if e, a := []int{0, 1, 2, 3}, all; !reflect.DeepEqual(e, a) {
+		t.Fatalf("every shard should be owned once: got %v and %v", ownedA, ownedB)
+	}
+	sort.Ints(acquired)
+	if e, a := ownedB, acquired; !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected the acquired handler to be called with %v, got %v", e, a)
+	}
+
+	// Renewing keeps the split.
+	fakeClock.Step(2 * time.Second)
+	a.sync()
+	b.sync()
+	if e, a := ownedA, ownedShards(a); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected %v, got %v", e, a)
+	}
+	if e, a := ownedB, ownedShards(b); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected %v, got %v", e, a)
+	}
+}
+
+func TestManagerDrainsShardBeforeGivingItUp(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	fakeClock := clock.NewFakeClock(time.Now())
+	a, _ := newTestManager(t, client, "a", fakeClock)
+	b, _ := newTestManager(t, client, "b", fakeClock)
+
+	a.sync()
+	b.sync()
+
+	// A key of shard 3, the first a gives up, is being reconciled.
+	if !a.TryLock(3) {
+		t.Fatal("expected a to own shard 3")
+	}
+	fakeClock.Step(2 * time.Second)
+	a.sync()
+	if a.TryLock(3) {
+		t.Fatal("no new key of a draining shard should be reconciled")
+	}
+	b.sync()
+	if owned := ownedShards(b); len(owned) != 1 || owned[0] != 2 {
+		t.Fatalf("b should only take the shard that was given up, got %v", owned)
+	}
+
+	a.Unlock(3)
+	fakeClock.Step(2 * time.Second)
+	a.sync()
+	b.sync()
+	if e, a := []int{2, 3}, ownedShards(b); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected b to own %v once shard 3 was drained, got %v", e, a)
+	}
+	if e, a := []int{0, 1}, ownedShards(a); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected a to own %v, got %v", e, a)
+	}
+}
+
+func TestManagerTakesExpiredShards(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	fakeClock := clock.NewFakeClock(time.Now())
+	a, lostA := newTestManager(t, client, "a", fakeClock)
+	b, _ := newTestManager(t, client, "b", fakeClock)
+
+	a.sync()
+	b.sync()
+	fakeClock.Step(2 * time.Second)
+	a.sync()
+	b.sync()
+
+	// a stops renewing; once its leases and heartbeat expire b takes over.
+	fakeClock.Step(10 * time.Second)
+	b.sync()
+	if owned := ownedShards(b); len(owned) != 2 {
+		t.Fatalf("b should not take unexpired shards, got %v", owned)
+	}
+	fakeClock.Step(6 * time.Second)
+	b.sync()
+	if e, a := []int{0, 1, 2, 3}, ownedShards(b); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected b to take every shard, got %v", a)
+	}
+
+	a.sync()
+	if owned := ownedShards(a); len(owned) != 0 {
+		t.Fatalf("a should have lost its shards, got %v", owned)
+	}
+	if len(*lostA) != 2 {
+		t.Fatalf("expected a to lose 2 shards, got %v", *lostA)
+	}
+}
+
+func TestManagerReleasesShardsOnStop(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	fakeClock := clock.NewFakeClock(time.Now())
+	a, _ := newTestManager(t, client, "a", fakeClock)
+	b, _ := newTestManager(t, client, "b", fakeClock)
+
+	a.sync()
+	a.releaseAll()
+	if owned := ownedShards(a); len(owned) != 0 {
+		t.Fatalf("expected no shards after stopping, got %v", owned)
+	}
+
+	b.sync()
+	if e, a := []int{0, 1, 2, 3}, ownedShards(b); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected b to take the released shards at once, got %v", a)
+	}
+}
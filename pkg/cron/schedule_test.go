@@ -0,0 +1,77 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseErrors(t *testing.T) {
+	cases := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@sometimes",
+	}
+	for _, spec := range cases {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("%q: expected an error", spec)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2018, time.August, 15, 10, 30, 0, 0, time.UTC)
+	cases := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2018, time.August, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2018, time.August, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2018, time.August, 16, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2018, time.August, 16, 0, 0, 0, 0, time.UTC)},
+		{"30 2 * * 6", time.Date(2018, time.August, 18, 2, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2018, time.August, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 22 * * 1-5", time.Date(2018, time.August, 15, 22, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2018, time.September, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,20 * *", time.Date(2018, time.August, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// Either the day of month or the day of week matches.
+		{"0 0 1 * 5", time.Date(2018, time.August, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tc := range cases {
+		s, err := Parse(tc.spec)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tc.spec, err)
+			continue
+		}
+		if e, a := tc.expected, s.Next(from); !e.Equal(a) {
+			t.Errorf("%q: expected %v, got %v", tc.spec, e, a)
+		}
+	}
+}
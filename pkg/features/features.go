@@ -71,6 +71,23 @@ const (
 	// owner: @nilebox
 	// alpha: v0.1.14
 	OriginatingIdentityLocking utilfeature.Feature = "OriginatingIdentityLocking"
+
+	// PlanTransitionPolicy enables ClusterServicePlanTransitionPolicies,
+	// which restrict the plan changes allowed for ServiceInstances.
+	// alpha: v0.1.14
+	PlanTransitionPolicy utilfeature.Feature = "PlanTransitionPolicy"
+
+	// NotificationSinks enables ClusterNotificationSinks and
+	// NotificationSinks, and the delivery of lifecycle events to them by the
+	// controller.
+	// alpha: v0.1.14
+	NotificationSinks utilfeature.Feature = "NotificationSinks"
+
+	// BrokerCallbacks enables the controller-manager endpoint brokers call
+	// to report the completion of asynchronous operations, instead of
+	// waiting to be polled.
+	// alpha: v0.1.14
+	BrokerCallbacks utilfeature.Feature = "BrokerCallbacks"
 )
 
 func init() {
@@ -88,4 +105,7 @@ var defaultServiceCatalogFeatureGates = map[utilfeature.Feature]utilfeature.Feat
 	ResponseSchema:             {Default: false, PreRelease: utilfeature.Alpha},
 	UpdateDashboardURL:         {Default: false, PreRelease: utilfeature.Alpha},
 	OriginatingIdentityLocking: {Default: true, PreRelease: utilfeature.Alpha},
+	PlanTransitionPolicy:       {Default: false, PreRelease: utilfeature.Alpha},
+	NotificationSinks:          {Default: false, PreRelease: utilfeature.Alpha},
+	BrokerCallbacks:            {Default: false, PreRelease: utilfeature.Alpha},
 }
@@ -72,6 +72,113 @@ var (
 		},
 		[]string{"broker", "method", "status"},
 	)
+
+	// OSBRequestsLimited exposes the number of requests to a broker that
+	// were not sent because they were over the broker's concurrency or rate
+	// limit, and whose resources were requeued. The namespace is empty and
+	// the scope is "cluster" for ClusterServiceBrokers.
+	OSBRequestsLimited = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "osb_requests_limited_total",
+			Help:      "Number of requests to the specified Service Broker deferred by the broker's concurrency or rate limit.",
+		},
+		[]string{"broker", "namespace", "scope", "limit"},
+	)
+
+	// OSBRequestsInFlight exposes the number of requests to a broker with
+	// request limits that have been sent and not yet completed.
+	OSBRequestsInFlight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "osb_requests_in_flight",
+			Help:      "Number of in-flight requests to the specified Service Broker, for brokers with request limits.",
+		},
+		[]string{"broker", "namespace", "scope"},
+	)
+
+	// WorkQueueProcessedCount exposes the number of keys taken from each
+	// controller workqueue and reconciled, per shard of the keyspace. The
+	// keys of a controller that is not sharded are all in shard 0.
+	WorkQueueProcessedCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "workqueue_processed_count",
+			Help:      "Cumulative number of keys reconciled from the specified workqueue grouped by shard and result.",
+		},
+		[]string{"queue", "shard", "result"},
+	)
+
+	// WorkQueueSkippedCount exposes the number of keys taken from each
+	// controller workqueue and dropped because this controller does not own
+	// their shard.
+	WorkQueueSkippedCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "workqueue_skipped_count",
+			Help:      "Cumulative number of keys dropped from the specified workqueue because their shard is owned by another controller.",
+		},
+		[]string{"queue", "shard"},
+	)
+
+	// WorkQueueProcessingDuration exposes how long the keys of each
+	// controller workqueue take to reconcile, per shard.
+	WorkQueueProcessingDuration = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Namespace: catalogNamespace,
+			Name:      "workqueue_processing_duration_seconds",
+			Help:      "Time taken to reconcile a key from the specified workqueue grouped by shard.",
+		},
+		[]string{"queue", "shard"},
+	)
+
+	// WorkQueueDepth exposes the number of keys waiting in each priority
+	// controller workqueue, per priority.
+	WorkQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "workqueue_depth",
+			Help:      "Number of keys waiting in the specified workqueue grouped by priority.",
+		},
+		[]string{"queue", "priority"},
+	)
+
+	// ControllerShardOwned exposes which shards of the keyspace this
+	// controller owns: 1 if owned and 0 if not.
+	ControllerShardOwned = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "controller_shard_owned",
+			Help:      "Whether the specified shard of the keyspace is owned by this controller.",
+		},
+		[]string{"shard"},
+	)
+
+	// NotificationDeliveryCount exposes the number of lifecycle events
+	// posted to notification sinks, by event type and result: delivered,
+	// retried after a failed attempt, dropped after the last attempt, or
+	// rejected because the sink's host is not allowed.
+	NotificationDeliveryCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "notification_delivery_count",
+			Help:      "Cumulative number of attempts to post lifecycle events to notification sinks grouped by event type and result.",
+		},
+		[]string{"type", "result"},
+	)
+
+	// BrokerCallbackCount exposes the number of calls brokers made to report
+	// the completion of asynchronous operations, by the kind of resource
+	// and result: accepted, rejected for an invalid token, or failed when
+	// the callback could not be recorded on the resource.
+	BrokerCallbackCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Name:      "broker_callback_count",
+			Help:      "Cumulative number of calls brokers made to report the completion of asynchronous operations grouped by resource kind and result.",
+		},
+		[]string{"kind", "result"},
+	)
 )
 
 func register(registry *prometheus.Registry) {
@@ -79,6 +186,15 @@ func register(registry *prometheus.Registry) {
 		registry.MustRegister(BrokerServiceClassCount)
 		registry.MustRegister(BrokerServicePlanCount)
 		registry.MustRegister(OSBRequestCount)
+		registry.MustRegister(OSBRequestsLimited)
+		registry.MustRegister(OSBRequestsInFlight)
+		registry.MustRegister(WorkQueueProcessedCount)
+		registry.MustRegister(WorkQueueSkippedCount)
+		registry.MustRegister(WorkQueueProcessingDuration)
+		registry.MustRegister(WorkQueueDepth)
+		registry.MustRegister(ControllerShardOwned)
+		registry.MustRegister(NotificationDeliveryCount)
+		registry.MustRegister(BrokerCallbackCount)
 	})
 }
 
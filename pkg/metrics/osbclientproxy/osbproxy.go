@@ -47,6 +47,16 @@ func NewClient(config *osb.ClientConfiguration) (osb.Client, error) {
 
 var _ osb.CreateFunc = NewClient
 
+// CloseIdleConnections closes the idle connections of the real client, if it
+// keeps any.
+func (pc proxyclient) CloseIdleConnections() {
+	if closer, ok := pc.realOSBClient.(interface {
+		CloseIdleConnections()
+	}); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
 const (
 	getCatalog               = "GetCatalog"
 	provisionInstance        = "ProvisionInstance"
@@ -57,6 +67,7 @@ const (
 	bind                     = "Bind"
 	unbind                   = "Unbind"
 	getBinding               = "GetBinding"
+	getInstance              = "GetInstance"
 )
 
 // GetCatalog implements go-open-service-broker-client/v2/Client.GetCatalog by
@@ -69,6 +80,16 @@ func (pc proxyclient) GetCatalog() (*osb.CatalogResponse, error) {
 	return response, err
 }
 
+// GetCatalogIfNoneMatch implements
+// go-open-service-broker-client/v2/Client.GetCatalogIfNoneMatch by proxying
+// the method to the underlying implementation and capturing request metrics.
+func (pc proxyclient) GetCatalogIfNoneMatch(etag string) (*osb.CatalogResponse, error) {
+	glog.V(9).Info("OSBClientProxy GetCatalogIfNoneMatch()")
+	response, err := pc.realOSBClient.GetCatalogIfNoneMatch(etag)
+	pc.updateMetrics(getCatalog, err)
+	return response, err
+}
+
 // ProvisionInstance implements
 // go-open-service-broker-client/v2/Client.ProvisionInstance by proxying the
 // method to the underlying implementation and capturing request metrics.
@@ -148,6 +169,16 @@ func (pc proxyclient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingRespo
 	return response, err
 }
 
+// GetInstance implements go-open-service-broker-client/v2/Client.GetInstance by
+// proxying the method to the underlying implementation and capturing request
+// metrics.
+func (pc proxyclient) GetInstance(r *osb.GetInstanceRequest) (*osb.GetInstanceResponse, error) {
+	glog.V(9).Info("OSBClientProxy GetInstance()")
+	response, err := pc.realOSBClient.GetInstance(r)
+	pc.updateMetrics(getInstance, err)
+	return response, err
+}
+
 const clientErr = "client-error"
 
 // updateMetrics bumps the request count metric for the specific broker, method
@@ -0,0 +1,458 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package multinamespace provides informers over the resources of a set of
+// namespaces, for controllers that must not list or watch resources across
+// the whole cluster. Each namespace is watched by its own filtered informer,
+// and the namespaces can be added and removed while the informers run.
+package multinamespace
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NewInformerFunc creates an informer over the resources of one namespace.
+type NewInformerFunc func(namespace string) cache.SharedIndexInformer
+
+type eventHandler struct {
+	handler      cache.ResourceEventHandler
+	resyncPeriod time.Duration
+}
+
+type namespaceInformer struct {
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+}
+
+// Informer is a cache.SharedIndexInformer over the resources of a set of
+// namespaces. Event handlers and indexers are added to the informer of every
+// namespace, and its indexer merges theirs.
+type Informer struct {
+	newInformer NewInformerFunc
+
+	mu         sync.Mutex
+	namespaces map[string]*namespaceInformer
+	handlers   []eventHandler
+	indexers   cache.Indexers
+	// stopCh is set once the informer runs; the informers of namespaces
+	// added later are started right away.
+	stopCh <-chan struct{}
+}
+
+var _ cache.SharedIndexInformer = &Informer{}
+
+// NewInformer creates an informer over the resources of the given
+// namespaces, using newInformer to create the informer of each namespace.
+func NewInformer(newInformer NewInformerFunc, namespaces ...string) *Informer {
+	i := &Informer{
+		newInformer: newInformer,
+		namespaces:  map[string]*namespaceInformer{},
+		indexers:    cache.Indexers{},
+	}
+	for _, namespace := range namespaces {
+		i.AddNamespace(namespace)
+	}
+	return i
+}
+
+// AddNamespace starts watching the resources of a namespace.
+func (i *Informer) AddNamespace(namespace string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if _, ok := i.namespaces[namespace]; ok {
+		return
+	}
+
+	informer := i.newInformer(namespace)
+	if len(i.indexers) > 0 {
+		if err := informer.AddIndexers(i.indexers); err != nil {
+			glog.Errorf("Error adding indexers to the informer of namespace %q: %v", namespace, err)
+		}
+	}
+	for _, h := range i.handlers {
+		informer.AddEventHandlerWithResyncPeriod(h.handler, h.resyncPeriod)
+	}
+	ni := &namespaceInformer{informer: informer, stopCh: make(chan struct{})}
+	i.namespaces[namespace] = ni
+	if i.stopCh != nil {
+		i.run(ni)
+	}
+}
+
+// RemoveNamespace stops watching the resources of a namespace. They are
+// dropped from the indexer without delete events.
+func (i *Informer) RemoveNamespace(namespace string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	ni, ok := i.namespaces[namespace]
+	if !ok {
+		return
+	}
+	close(ni.stopCh)
+	delete(i.namespaces, namespace)
+}
+
+// Namespaces returns the namespaces watched, sorted.
+func (i *Informer) Namespaces() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	namespaces := make([]string, 0, len(i.namespaces))
+	for namespace := range i.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// run starts the informer of a namespace until either it is removed or the
+// Informer stops. It must be called with mu held.
+func (i *Informer) run(ni *namespaceInformer) {
+	stopCh := i.stopCh
+	merged := make(chan struct{})
+	go func() {
+		defer close(merged)
+		select {
+		case <-stopCh:
+		case <-ni.stopCh:
+		}
+	}()
+	go ni.informer.Run(merged)
+}
+
+// namespaceInformer returns the informer of a namespace, or nil if the
+// namespace is not watched.
+func (i *Informer) namespaceInformer(namespace string) cache.SharedIndexInformer {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if ni, ok := i.namespaces[namespace]; ok {
+		return ni.informer
+	}
+	return nil
+}
+
+// informers returns the informers of every watched namespace.
+func (i *Informer) informers() []cache.SharedIndexInformer {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	informers := make([]cache.SharedIndexInformer, 0, len(i.namespaces))
+	for _, ni := range i.namespaces {
+		informers = append(informers, ni.informer)
+	}
+	return informers
+}
+
+// AddEventHandler adds an event handler to the informer of every namespace,
+// including those added later.
+func (i *Informer) AddEventHandler(handler cache.ResourceEventHandler) {
+	i.AddEventHandlerWithResyncPeriod(handler, 0)
+}
+
+// AddEventHandlerWithResyncPeriod adds an event handler to the informer of
+// every namespace, including those added later.
+func (i *Informer) AddEventHandlerWithResyncPeriod(handler cache.ResourceEventHandler, resyncPeriod time.Duration) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.handlers = append(i.handlers, eventHandler{handler: handler, resyncPeriod: resyncPeriod})
+	for _, ni := range i.namespaces {
+		if resyncPeriod == 0 {
+			ni.informer.AddEventHandler(handler)
+		} else {
+			ni.informer.AddEventHandlerWithResyncPeriod(handler, resyncPeriod)
+		}
+	}
+}
+
+// GetStore returns the merged store of every namespace.
+func (i *Informer) GetStore() cache.Store {
+	return i.GetIndexer()
+}
+
+// GetIndexer returns the merged indexer of every namespace.
+func (i *Informer) GetIndexer() cache.Indexer {
+	return &indexer{informer: i}
+}
+
+// GetController returns a controller running the informer.
+func (i *Informer) GetController() cache.Controller {
+	return controller{informer: i}
+}
+
+// Run starts the informers of the namespaces and blocks until stopCh is
+// closed.
+func (i *Informer) Run(stopCh <-chan struct{}) {
+	i.mu.Lock()
+	if i.stopCh != nil {
+		i.mu.Unlock()
+		return
+	}
+	i.stopCh = stopCh
+	for _, ni := range i.namespaces {
+		i.run(ni)
+	}
+	i.mu.Unlock()
+	<-stopCh
+}
+
+// HasSynced returns whether the informer runs and the informers of all the
+// namespaces have synced.
+func (i *Informer) HasSynced() bool {
+	i.mu.Lock()
+	running := i.stopCh != nil
+	i.mu.Unlock()
+	if !running {
+		return false
+	}
+	for _, informer := range i.informers() {
+		if !informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// LastSyncResourceVersion is not meaningful across namespaces and is always
+// empty.
+func (i *Informer) LastSyncResourceVersion() string {
+	return ""
+}
+
+// AddIndexers adds indexers to the informer of every namespace, including
+// those added later.
+func (i *Informer) AddIndexers(indexers cache.Indexers) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for name, indexFunc := range indexers {
+		if _, ok := i.indexers[name]; ok {
+			return fmt.Errorf("indexer conflict: %v", name)
+		}
+		i.indexers[name] = indexFunc
+	}
+	for namespace, ni := range i.namespaces {
+		if err := ni.informer.AddIndexers(indexers); err != nil {
+			return fmt.Errorf("error adding indexers to the informer of namespace %q: %v", namespace, err)
+		}
+	}
+	return nil
+}
+
+// controller is the cache.Controller of an Informer.
+type controller struct {
+	informer *Informer
+}
+
+func (c controller) Run(stopCh <-chan struct{}) {
+	c.informer.Run(stopCh)
+}
+
+func (c controller) HasSynced() bool {
+	return c.informer.HasSynced()
+}
+
+func (c controller) LastSyncResourceVersion() string {
+	return c.informer.LastSyncResourceVersion()
+}
+
+// indexer merges the indexers of the informers of every namespace. Objects
+// are looked up in the indexer of their namespace; objects of namespaces
+// that are not watched are never found.
+type indexer struct {
+	informer *Informer
+}
+
+var _ cache.Indexer = &indexer{}
+
+func (x *indexer) indexerFor(obj interface{}) (cache.Indexer, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	informer := x.informer.namespaceInformer(accessor.GetNamespace())
+	if informer == nil {
+		return nil, nil
+	}
+	return informer.GetIndexer(), nil
+}
+
+func (x *indexer) indexerForKey(key string) (cache.Indexer, error) {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	informer := x.informer.namespaceInformer(namespace)
+	if informer == nil {
+		return nil, nil
+	}
+	return informer.GetIndexer(), nil
+}
+
+func (x *indexer) indexers() []cache.Indexer {
+	informers := x.informer.informers()
+	indexers := make([]cache.Indexer, 0, len(informers))
+	for _, informer := range informers {
+		indexers = append(indexers, informer.GetIndexer())
+	}
+	return indexers
+}
+
+func (x *indexer) Add(obj interface{}) error {
+	ix, err := x.indexerFor(obj)
+	if err != nil || ix == nil {
+		return err
+	}
+	return ix.Add(obj)
+}
+
+func (x *indexer) Update(obj interface{}) error {
+	ix, err := x.indexerFor(obj)
+	if err != nil || ix == nil {
+		return err
+	}
+	return ix.Update(obj)
+}
+
+func (x *indexer) Delete(obj interface{}) error {
+	ix, err := x.indexerFor(obj)
+	if err != nil || ix == nil {
+		return err
+	}
+	return ix.Delete(obj)
+}
+
+func (x *indexer) List() []interface{} {
+	var list []interface{}
+	for _, ix := range x.indexers() {
+		list = append(list, ix.List()...)
+	}
+	return list
+}
+
+func (x *indexer) ListKeys() []string {
+	var keys []string
+	for _, ix := range x.indexers() {
+		keys = append(keys, ix.ListKeys()...)
+	}
+	return keys
+}
+
+func (x *indexer) Get(obj interface{}) (interface{}, bool, error) {
+	ix, err := x.indexerFor(obj)
+	if err != nil || ix == nil {
+		return nil, false, err
+	}
+	return ix.Get(obj)
+}
+
+func (x *indexer) GetByKey(key string) (interface{}, bool, error) {
+	ix, err := x.indexerForKey(key)
+	if err != nil || ix == nil {
+		return nil, false, err
+	}
+	return ix.GetByKey(key)
+}
+
+// Replace is not supported: the contents of each namespace are replaced by
+// its own informer.
+func (x *indexer) Replace([]interface{}, string) error {
+	return fmt.Errorf("replacing the objects of every namespace is not supported")
+}
+
+func (x *indexer) Resync() error {
+	for _, ix := range x.indexers() {
+		if err := ix.Resync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *indexer) Index(indexName string, obj interface{}) ([]interface{}, error) {
+	if indexName == cache.NamespaceIndex {
+		ix, err := x.indexerFor(obj)
+		if err != nil || ix == nil {
+			return nil, err
+		}
+		return ix.Index(indexName, obj)
+	}
+	var list []interface{}
+	for _, ix := range x.indexers() {
+		objs, err := ix.Index(indexName, obj)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, objs...)
+	}
+	return list, nil
+}
+
+func (x *indexer) IndexKeys(indexName, indexKey string) ([]string, error) {
+	var keys []string
+	for _, ix := range x.indexers() {
+		k, err := ix.IndexKeys(indexName, indexKey)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k...)
+	}
+	return keys, nil
+}
+
+func (x *indexer) ListIndexFuncValues(indexName string) []string {
+	var values []string
+	for _, ix := range x.indexers() {
+		values = append(values, ix.ListIndexFuncValues(indexName)...)
+	}
+	return values
+}
+
+func (x *indexer) ByIndex(indexName, indexKey string) ([]interface{}, error) {
+	if indexName == cache.NamespaceIndex {
+		informer := x.informer.namespaceInformer(indexKey)
+		if informer == nil {
+			return nil, nil
+		}
+		return informer.GetIndexer().ByIndex(indexName, indexKey)
+	}
+	var list []interface{}
+	for _, ix := range x.indexers() {
+		objs, err := ix.ByIndex(indexName, indexKey)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, objs...)
+	}
+	return list, nil
+}
+
+func (x *indexer) GetIndexers() cache.Indexers {
+	x.informer.mu.Lock()
+	defer x.informer.mu.Unlock()
+	indexers := cache.Indexers{}
+	for name, indexFunc := range x.informer.indexers {
+		indexers[name] = indexFunc
+	}
+	return indexers
+}
+
+func (x *indexer) AddIndexers(newIndexers cache.Indexers) error {
+	return x.informer.AddIndexers(newIndexers)
+}
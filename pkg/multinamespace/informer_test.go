@@ -0,0 +1,200 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinamespace
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newSecret(namespace, name string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
+func newSecretInformer(client *fake.Clientset) NewInformerFunc {
+	return func(namespace string) cache.SharedIndexInformer {
+		return coreinformers.NewFilteredSecretInformer(client, namespace, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, nil)
+	}
+}
+
+func secretNames(t *testing.T, lister corelisters.SecretLister) []string {
+	secrets, err := lister.List(labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error listing secrets: %v", err)
+	}
+	var names []string
+	for _, secret := range secrets {
+		names = append(names, secret.Namespace+"/"+secret.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func waitForSecrets(t *testing.T, lister corelisters.SecretLister, expected []string) {
+	var names []string
+	err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		names = secretNames(t, lister)
+		return reflect.DeepEqual(expected, names), nil
+	})
+	if err != nil {
+		t.Fatalf("expected secrets %v, got %v", expected, names)
+	}
+}
+
+func TestInformerWatchesOnlyItsNamespaces(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newSecret("a", "one"),
+		newSecret("b", "two"),
+		newSecret("c", "three"),
+	)
+	informer := NewInformer(newSecretInformer(client), "a", "b")
+	lister := corelisters.NewSecretLister(informer.GetIndexer())
+
+	if informer.HasSynced() {
+		t.Fatal("expected the informer not to be synced before it runs")
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		t.Fatal("informer did not sync")
+	}
+
+	if e, a := []string{"a/one", "b/two"}, secretNames(t, lister); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected secrets %v, got %v", e, a)
+	}
+	if _, err := lister.Secrets("b").Get("two"); err != nil {
+		t.Fatalf("expected to get a secret of a watched namespace: %v", err)
+	}
+	if _, err := lister.Secrets("c").Get("three"); err == nil {
+		t.Fatal("expected not to get a secret of a namespace that is not watched")
+	}
+	secrets, err := lister.Secrets("a").List(labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error listing the secrets of a namespace: %v", err)
+	}
+	if e, a := 1, len(secrets); e != a {
+		t.Fatalf("expected %d secrets in namespace a, got %d", e, a)
+	}
+}
+
+func TestInformerAddRemoveNamespace(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newSecret("a", "one"),
+		newSecret("b", "two"),
+	)
+	informer := NewInformer(newSecretInformer(client), "a")
+	lister := corelisters.NewSecretLister(informer.GetIndexer())
+
+	var mu sync.Mutex
+	var added []string
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			mu.Lock()
+			defer mu.Unlock()
+			secret := obj.(*corev1.Secret)
+			added = append(added, secret.Namespace+"/"+secret.Name)
+		},
+	})
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		t.Fatal("informer did not sync")
+	}
+
+	informer.AddNamespace("b")
+	if e, a := []string{"a", "b"}, informer.Namespaces(); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected namespaces %v, got %v", e, a)
+	}
+	waitForSecrets(t, lister, []string{"a/one", "b/two"})
+
+	mu.Lock()
+	sort.Strings(added)
+	if e, a := []string{"a/one", "b/two"}, added; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected the event handler to be called for the secrets of the added namespace: expected %v, got %v", e, a)
+	}
+	mu.Unlock()
+
+	informer.RemoveNamespace("a")
+	if e, a := []string{"b"}, informer.Namespaces(); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected namespaces %v, got %v", e, a)
+	}
+	waitForSecrets(t, lister, []string{"b/two"})
+}
+
+func TestInformerIndexerRejectsReplace(t *testing.T) {
+	informer := NewInformer(newSecretInformer(fake.NewSimpleClientset()), "a")
+	if err := informer.GetIndexer().Replace(nil, ""); err == nil {
+		t.Fatal("expected replacing the objects of every namespace to fail")
+	}
+}
+
+func TestWatchNamespaceSelector(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "a", Labels: map[string]string{"tenant": "blue"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "b", Labels: map[string]string{"tenant": "red"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "c"}},
+	)
+	informer := NewInformer(newSecretInformer(client))
+	factory := kubeinformers.NewSharedInformerFactory(client, 0)
+	selector, err := labels.Parse("tenant=blue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	WatchNamespaceSelector(factory.Core().V1().Namespaces(), selector, informer)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	if e, a := []string{"a"}, informer.Namespaces(); !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected namespaces %v, got %v", e, a)
+	}
+
+	updated := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "b", Labels: map[string]string{"tenant": "blue"}}}
+	if _, err := client.CoreV1().Namespaces().Update(updated); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CoreV1().Namespaces().Delete("a", &metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	var namespaces []string
+	err = wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		namespaces = informer.Namespaces()
+		return reflect.DeepEqual([]string{"b"}, namespaces), nil
+	})
+	if err != nil {
+		t.Fatalf("expected namespaces [b], got %v", namespaces)
+	}
+}
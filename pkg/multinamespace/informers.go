@@ -0,0 +1,261 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinamespace
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/golang/glog"
+	clientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
+	listers "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+)
+
+// Informers are the informers over the namespaced resources the service
+// catalog controller reconciles or reads, restricted to a set of namespaces.
+// Like a shared informer factory, only the informers that were asked for are
+// started.
+type Informers struct {
+	mu   sync.Mutex
+	used []*Informer
+
+	serviceInstances  *Informer
+	serviceBindings   *Informer
+	serviceBrokers    *Informer
+	serviceClasses    *Informer
+	servicePlans      *Informer
+	notificationSinks *Informer
+	secrets           *Informer
+}
+
+// NewInformers creates informers over the namespaced resources of the given
+// namespaces.
+func NewInformers(serviceCatalogClient clientset.Interface, kubeClient kubernetes.Interface, resyncPeriod time.Duration, namespaces ...string) *Informers {
+	indexers := func() cache.Indexers {
+		return cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+	}
+	return &Informers{
+		serviceInstances: NewInformer(func(namespace string) cache.SharedIndexInformer {
+			return informers.NewFilteredServiceInstanceInformer(serviceCatalogClient, namespace, resyncPeriod, indexers(), nil)
+		}, namespaces...),
+		serviceBindings: NewInformer(func(namespace string) cache.SharedIndexInformer {
+			return informers.NewFilteredServiceBindingInformer(serviceCatalogClient, namespace, resyncPeriod, indexers(), nil)
+		}, namespaces...),
+		serviceBrokers: NewInformer(func(namespace string) cache.SharedIndexInformer {
+			return informers.NewFilteredServiceBrokerInformer(serviceCatalogClient, namespace, resyncPeriod, indexers(), nil)
+		}, namespaces...),
+		serviceClasses: NewInformer(func(namespace string) cache.SharedIndexInformer {
+			return informers.NewFilteredServiceClassInformer(serviceCatalogClient, namespace, resyncPeriod, indexers(), nil)
+		}, namespaces...),
+		servicePlans: NewInformer(func(namespace string) cache.SharedIndexInformer {
+			return informers.NewFilteredServicePlanInformer(serviceCatalogClient, namespace, resyncPeriod, indexers(), nil)
+		}, namespaces...),
+		notificationSinks: NewInformer(func(namespace string) cache.SharedIndexInformer {
+			return informers.NewFilteredNotificationSinkInformer(serviceCatalogClient, namespace, resyncPeriod, indexers(), nil)
+		}, namespaces...),
+		secrets: NewInformer(func(namespace string) cache.SharedIndexInformer {
+			return coreinformers.NewFilteredSecretInformer(kubeClient, namespace, resyncPeriod, indexers(), nil)
+		}, namespaces...),
+	}
+}
+
+// use marks an informer as asked for, and returns it.
+func (s *Informers) use(informer *Informer) *Informer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, used := range s.used {
+		if used == informer {
+			return informer
+		}
+	}
+	s.used = append(s.used, informer)
+	return informer
+}
+
+func (s *Informers) all() []*Informer {
+	return []*Informer{s.serviceInstances, s.serviceBindings, s.serviceBrokers, s.serviceClasses, s.servicePlans, s.notificationSinks, s.secrets}
+}
+
+// usedInformers returns the informers that were asked for.
+func (s *Informers) usedInformers() []*Informer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Informer(nil), s.used...)
+}
+
+// AddNamespace starts watching the resources of a namespace.
+func (s *Informers) AddNamespace(namespace string) {
+	glog.V(4).Infof("Watching namespace %q", namespace)
+	for _, informer := range s.all() {
+		informer.AddNamespace(namespace)
+	}
+}
+
+// RemoveNamespace stops watching the resources of a namespace.
+func (s *Informers) RemoveNamespace(namespace string) {
+	glog.V(4).Infof("No longer watching namespace %q", namespace)
+	for _, informer := range s.all() {
+		informer.RemoveNamespace(namespace)
+	}
+}
+
+// Start runs the informers that were asked for until stopCh is closed.
+func (s *Informers) Start(stopCh <-chan struct{}) {
+	for _, informer := range s.usedInformers() {
+		go informer.Run(stopCh)
+	}
+}
+
+// WaitForCacheSync waits for the informers of every namespace to sync, and
+// returns false if stopCh was closed first.
+func (s *Informers) WaitForCacheSync(stopCh <-chan struct{}) bool {
+	var synced []cache.InformerSynced
+	for _, informer := range s.usedInformers() {
+		synced = append(synced, informer.HasSynced)
+	}
+	return cache.WaitForCacheSync(stopCh, synced...)
+}
+
+// ServiceInstances returns the informer over ServiceInstances.
+func (s *Informers) ServiceInstances() informers.ServiceInstanceInformer {
+	return serviceInstanceInformer{s.use(s.serviceInstances)}
+}
+
+// ServiceBindings returns the informer over ServiceBindings.
+func (s *Informers) ServiceBindings() informers.ServiceBindingInformer {
+	return serviceBindingInformer{s.use(s.serviceBindings)}
+}
+
+// ServiceBrokers returns the informer over ServiceBrokers.
+func (s *Informers) ServiceBrokers() informers.ServiceBrokerInformer {
+	return serviceBrokerInformer{s.use(s.serviceBrokers)}
+}
+
+// ServiceClasses returns the informer over ServiceClasses.
+func (s *Informers) ServiceClasses() informers.ServiceClassInformer {
+	return serviceClassInformer{s.use(s.serviceClasses)}
+}
+
+// ServicePlans returns the informer over ServicePlans.
+func (s *Informers) ServicePlans() informers.ServicePlanInformer {
+	return servicePlanInformer{s.use(s.servicePlans)}
+}
+
+// NotificationSinks returns the informer over NotificationSinks.
+func (s *Informers) NotificationSinks() informers.NotificationSinkInformer {
+	return notificationSinkInformer{s.use(s.notificationSinks)}
+}
+
+// Secrets returns the informer over Secrets.
+func (s *Informers) Secrets() coreinformers.SecretInformer {
+	return secretInformer{s.use(s.secrets)}
+}
+
+type serviceInstanceInformer struct{ informer *Informer }
+
+func (i serviceInstanceInformer) Informer() cache.SharedIndexInformer { return i.informer }
+func (i serviceInstanceInformer) Lister() listers.ServiceInstanceLister {
+	return listers.NewServiceInstanceLister(i.informer.GetIndexer())
+}
+
+type serviceBindingInformer struct{ informer *Informer }
+
+func (i serviceBindingInformer) Informer() cache.SharedIndexInformer { return i.informer }
+func (i serviceBindingInformer) Lister() listers.ServiceBindingLister {
+	return listers.NewServiceBindingLister(i.informer.GetIndexer())
+}
+
+type serviceBrokerInformer struct{ informer *Informer }
+
+func (i serviceBrokerInformer) Informer() cache.SharedIndexInformer { return i.informer }
+func (i serviceBrokerInformer) Lister() listers.ServiceBrokerLister {
+	return listers.NewServiceBrokerLister(i.informer.GetIndexer())
+}
+
+type serviceClassInformer struct{ informer *Informer }
+
+func (i serviceClassInformer) Informer() cache.SharedIndexInformer { return i.informer }
+func (i serviceClassInformer) Lister() listers.ServiceClassLister {
+	return listers.NewServiceClassLister(i.informer.GetIndexer())
+}
+
+type servicePlanInformer struct{ informer *Informer }
+
+func (i servicePlanInformer) Informer() cache.SharedIndexInformer { return i.informer }
+func (i servicePlanInformer) Lister() listers.ServicePlanLister {
+	return listers.NewServicePlanLister(i.informer.GetIndexer())
+}
+
+type notificationSinkInformer struct{ informer *Informer }
+
+func (i notificationSinkInformer) Informer() cache.SharedIndexInformer { return i.informer }
+func (i notificationSinkInformer) Lister() listers.NotificationSinkLister {
+	return listers.NewNotificationSinkLister(i.informer.GetIndexer())
+}
+
+type secretInformer struct{ informer *Informer }
+
+func (i secretInformer) Informer() cache.SharedIndexInformer { return i.informer }
+func (i secretInformer) Lister() corelisters.SecretLister {
+	return corelisters.NewSecretLister(i.informer.GetIndexer())
+}
+
+// NamespaceSet is a set of namespaces that can change, such as the
+// namespaces watched by Informers.
+type NamespaceSet interface {
+	AddNamespace(namespace string)
+	RemoveNamespace(namespace string)
+}
+
+// WatchNamespaceSelector adds the namespaces matching a label selector to a
+// NamespaceSet as they are seen by a namespace informer, and removes them
+// once they no longer match or are deleted.
+func WatchNamespaceSelector(namespaceInformer coreinformers.NamespaceInformer, selector labels.Selector, set NamespaceSet) {
+	update := func(obj interface{}) {
+		namespace, ok := obj.(*corev1.Namespace)
+		if !ok {
+			return
+		}
+		if selector.Matches(labels.Set(namespace.Labels)) {
+			set.AddNamespace(namespace.Name)
+		} else {
+			set.RemoveNamespace(namespace.Name)
+		}
+	}
+	namespaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: update,
+		UpdateFunc: func(_, newObj interface{}) {
+			update(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if namespace, ok := obj.(*corev1.Namespace); ok {
+				set.RemoveNamespace(namespace.Name)
+			}
+		},
+	})
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions/servicecatalog/v1beta1"
+	listers "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+	"github.com/kubernetes-incubator/service-catalog/pkg/util"
+)
+
+const (
+	// SignatureHeader is the header an event is signed in when its sink has
+	// a signing secret. Its value is "sha256=" followed by the hex encoded
+	// HMAC-SHA256 of the request body.
+	SignatureHeader = "X-Service-Catalog-Signature"
+
+	// contentType is the media type of events posted in the structured
+	// content mode of the CloudEvents HTTP binding.
+	contentType = "application/cloudevents+json; charset=utf-8"
+
+	// retryBaseDelay and retryMaxDelay bound the exponential backoff between
+	// the attempts to deliver an event.
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 5 * time.Minute
+
+	// maxResponseSize bounds how much of a sink's response is read.
+	maxResponseSize = 1 << 16
+)
+
+// Dispatcher posts lifecycle events to the notification sinks that subscribe
+// to them. Events are delivered asynchronously; a delivery that fails is
+// retried with exponential backoff and dropped after the last retry.
+//
+// NotificationSinks are created by namespace users, so the controller only
+// posts to the hosts of their URLs that the cluster administrator allows;
+// otherwise any user could have the controller send requests to endpoints
+// only reachable from inside the cluster. ClusterNotificationSinks are
+// trusted. Redirects are not followed for either.
+type Dispatcher struct {
+	kubeClient   kubernetes.Interface
+	secretLister corelisters.SecretLister
+	httpClient   *http.Client
+	retries      int
+	// allowedHosts are the hosts NotificationSinks may be posted to.
+	allowedHosts []string
+
+	// missingSecrets remembers the signing secrets that were recently not
+	// found.
+	missingSecrets *util.SecretNotFoundCache
+
+	// clusterSinkLister is nil when cluster-scoped resources are ignored.
+	clusterSinkLister listers.ClusterNotificationSinkLister
+	sinkLister        listers.NotificationSinkLister
+	cacheSynced       []cache.InformerSynced
+
+	queue workqueue.RateLimitingInterface
+}
+
+// delivery is the posting of an event to one sink.
+type delivery struct {
+	event *Event
+	body  []byte
+	// sink names the sink in logs.
+	sink string
+	url  string
+	// secretNamespace and secretName locate the signing secret of the
+	// sink. secretName is empty if events are not signed.
+	secretNamespace string
+	secretName      string
+}
+
+// NewDispatcher creates a Dispatcher delivering events to the sinks of the
+// given informers. clusterSinkInformer may be nil, in which case events are
+// only delivered to NotificationSinks. Signing keys are read from the secrets
+// of secretInformer. NotificationSinks are only posted to if the host of
+// their URL matches one of allowedHosts, either exactly or, for an entry of
+// the form "*.example.com", as a subdomain. A delivery is attempted once and
+// then retried up to retries times, each attempt waiting up to timeout for
+// the sink to respond.
+func NewDispatcher(
+	kubeClient kubernetes.Interface,
+	secretInformer coreinformers.SecretInformer,
+	clusterSinkInformer informers.ClusterNotificationSinkInformer,
+	sinkInformer informers.NotificationSinkInformer,
+	allowedHosts []string,
+	retries int,
+	timeout time.Duration,
+) *Dispatcher {
+	d := &Dispatcher{
+		kubeClient:   kubeClient,
+		secretLister: secretInformer.Lister(),
+		httpClient: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		retries:        retries,
+		allowedHosts:   allowedHosts,
+		missingSecrets: util.NewSecretNotFoundCache(),
+		sinkLister:     sinkInformer.Lister(),
+		queue: workqueue.NewNamedRateLimitingQueue(
+			workqueue.NewItemExponentialFailureRateLimiter(retryBaseDelay, retryMaxDelay),
+			"notifications"),
+	}
+	d.cacheSynced = append(d.cacheSynced, secretInformer.Informer().HasSynced, sinkInformer.Informer().HasSynced)
+	if clusterSinkInformer != nil {
+		d.clusterSinkLister = clusterSinkInformer.Lister()
+		d.cacheSynced = append(d.cacheSynced, clusterSinkInformer.Informer().HasSynced)
+	}
+	return d
+}
+
+// Notify queues an event for delivery to the sinks that subscribe to it:
+// every ClusterNotificationSink, and the NotificationSinks of the namespace
+// of the resource the event happened to whose host is allowed.
+func (d *Dispatcher) Notify(event *Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("error encoding %v event for %q: %v", event.NotificationType, event.Source+"/"+event.Subject, err))
+		return
+	}
+
+	if d.clusterSinkLister != nil {
+		sinks, err := d.clusterSinkLister.List(labels.Everything())
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("error listing ClusterNotificationSinks: %v", err))
+		}
+		for _, sink := range sinks {
+			if !subscribes(&sink.Spec.CommonNotificationSinkSpec, event.NotificationType) {
+				continue
+			}
+			dl := &delivery{
+				event: event,
+				body:  body,
+				sink:  fmt.Sprintf("ClusterNotificationSink %q", sink.Name),
+				url:   sink.Spec.URL,
+			}
+			if ref := sink.Spec.SigningSecretRef; ref != nil {
+				dl.secretNamespace, dl.secretName = ref.Namespace, ref.Name
+			}
+			d.queue.Add(dl)
+		}
+	}
+
+	if event.Data.Namespace == "" {
+		return
+	}
+	sinks, err := d.sinkLister.NotificationSinks(event.Data.Namespace).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("error listing NotificationSinks in namespace %q: %v", event.Data.Namespace, err))
+	}
+	for _, sink := range sinks {
+		if !subscribes(&sink.Spec.CommonNotificationSinkSpec, event.NotificationType) {
+			continue
+		}
+		dl := &delivery{
+			event: event,
+			body:  body,
+			sink:  fmt.Sprintf("NotificationSink %q", sink.Namespace+"/"+sink.Name),
+			url:   sink.Spec.URL,
+		}
+		if !d.allowsHost(sink.Spec.URL) {
+			glog.Warningf("Not delivering %v event %v to %v: the host of %q is not allowed", event.NotificationType, event.ID, dl.sink, sink.Spec.URL)
+			metrics.NotificationDeliveryCount.WithLabelValues(event.Type, "rejected").Inc()
+			continue
+		}
+		if ref := sink.Spec.SigningSecretRef; ref != nil {
+			dl.secretNamespace, dl.secretName = sink.Namespace, ref.Name
+		}
+		d.queue.Add(dl)
+	}
+}
+
+// subscribes returns whether a sink subscribes to events of the given type.
+func subscribes(spec *v1beta1.CommonNotificationSinkSpec, notificationType v1beta1.NotificationEventType) bool {
+	if len(spec.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range spec.EventTypes {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsHost returns whether the host of a NotificationSink's URL is allowed.
+func (d *Dispatcher) allowsHost(sinkURL string) bool {
+	u, err := url.Parse(sinkURL)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range d.allowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed {
+			return true
+		}
+		if strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Run delivers events with the given number of workers until stopCh is
+// closed.
+func (d *Dispatcher) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer d.queue.ShutDown()
+
+	glog.Info("Starting notification dispatcher")
+	defer glog.Info("Shutting down notification dispatcher")
+
+	if !cache.WaitForCacheSync(stopCh, d.cacheSynced...) {
+		utilruntime.HandleError(fmt.Errorf("timed out waiting for notification sink caches to sync"))
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(d.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (d *Dispatcher) worker() {
+	for d.processNextDelivery() {
+	}
+}
+
+func (d *Dispatcher) processNextDelivery() bool {
+	item, quit := d.queue.Get()
+	if quit {
+		return false
+	}
+	defer d.queue.Done(item)
+
+	dl := item.(*delivery)
+	err := d.deliver(dl)
+	if err == nil {
+		glog.V(4).Infof("Delivered %v event %v to %v", dl.event.NotificationType, dl.event.ID, dl.sink)
+		metrics.NotificationDeliveryCount.WithLabelValues(dl.event.Type, "delivered").Inc()
+		d.queue.Forget(dl)
+		return true
+	}
+
+	if d.queue.NumRequeues(dl) < d.retries {
+		glog.V(4).Infof("Error delivering %v event %v to %v, will retry: %v", dl.event.NotificationType, dl.event.ID, dl.sink, err)
+		metrics.NotificationDeliveryCount.WithLabelValues(dl.event.Type, "retried").Inc()
+		d.queue.AddRateLimited(dl)
+		return true
+	}
+
+	glog.Warningf("Dropping %v event %v for %v after %v attempts: %v", dl.event.NotificationType, dl.event.ID, dl.sink, d.queue.NumRequeues(dl)+1, err)
+	metrics.NotificationDeliveryCount.WithLabelValues(dl.event.Type, "dropped").Inc()
+	d.queue.Forget(dl)
+	return true
+}
+
+// deliver makes one attempt to post an event to a sink.
+func (d *Dispatcher) deliver(dl *delivery) error {
+	req, err := http.NewRequest(http.MethodPost, dl.url, bytes.NewReader(dl.body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	if dl.secretName != "" {
+		key, err := d.signingKey(dl.secretNamespace, dl.secretName)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(SignatureHeader, Sign(key, dl.body))
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sink responded with status %v", resp.Status)
+	}
+	return nil
+}
+
+// signingKey returns the key held by a signing secret.
+func (d *Dispatcher) signingKey(namespace, name string) ([]byte, error) {
+	secret, err := d.getSecret(namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting signing secret %q: %v", namespace+"/"+name, err)
+	}
+	key := secret.Data[v1beta1.NotificationSigningKeySecretKey]
+	if len(key) == 0 {
+		return nil, fmt.Errorf("signing secret %q has no %q entry", namespace+"/"+name, v1beta1.NotificationSigningKeySecretKey)
+	}
+	return key, nil
+}
+
+// getSecret returns a secret from the shared secret informer. The secret is
+// read from the API server if the informer has not seen it, which happens
+// when it was created right before the event or, for a
+// ClusterNotificationSink, is in a namespace the controller does not watch.
+// A secret the API server does not have either is briefly remembered as
+// missing.
+func (d *Dispatcher) getSecret(namespace, name string) (*corev1.Secret, error) {
+	secret, err := d.secretLister.Secrets(namespace).Get(name)
+	if err == nil {
+		return secret, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return d.missingSecrets.Get(d.kubeClient, namespace, name)
+}
+
+// Sign returns the value of the SignatureHeader of a request body signed with
+// the given key.
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	servicecataloginformers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/externalversions"
+)
+
+const testNamespace = "test-ns"
+
+// recordingSink is a notification sink that records the requests it
+// receives, and fails the first failures of them.
+type recordingSink struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newRecordingSink(failures int) *recordingSink {
+	s := &recordingSink{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, r)
+		s.bodies = append(s.bodies, body)
+		if len(s.requests) <= s.failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	return s
+}
+
+func (s *recordingSink) received() ([]*http.Request, [][]byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...), append([][]byte(nil), s.bodies...)
+}
+
+// waitForRequests waits for a sink to have received n requests.
+func waitForRequests(t *testing.T, s *recordingSink, n int) {
+	deadline := time.Now().Add(wait.ForeverTestTimeout)
+	for time.Now().Before(deadline) {
+		if requests, _ := s.received(); len(requests) >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	requests, _ := s.received()
+	t.Fatalf("expected %v requests to the sink, got %v", n, len(requests))
+}
+
+// testAllowedHosts allows NotificationSinks to be posted to the httptest
+// servers of the tests.
+var testAllowedHosts = []string{"127.0.0.1"}
+
+func newTestDispatcher(t *testing.T, retries int, kubeObjects []runtime.Object, clusterSinks []*v1beta1.ClusterNotificationSink, sinks []*v1beta1.NotificationSink) *Dispatcher {
+	fakeKubeClient := clientgofake.NewSimpleClientset(kubeObjects...)
+	secretInformer := kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Secrets()
+	for _, obj := range kubeObjects {
+		if secret, ok := obj.(*corev1.Secret); ok {
+			secretInformer.Informer().GetStore().Add(secret)
+		}
+	}
+	informerFactory := servicecataloginformers.NewSharedInformerFactory(&servicecatalogclientset.Clientset{}, 0)
+	clusterSinkInformer := informerFactory.Servicecatalog().V1beta1().ClusterNotificationSinks()
+	sinkInformer := informerFactory.Servicecatalog().V1beta1().NotificationSinks()
+	for _, sink := range clusterSinks {
+		clusterSinkInformer.Informer().GetStore().Add(sink)
+	}
+	for _, sink := range sinks {
+		sinkInformer.Informer().GetStore().Add(sink)
+	}
+
+	d := NewDispatcher(fakeKubeClient, secretInformer, clusterSinkInformer, sinkInformer, testAllowedHosts, retries, wait.ForeverTestTimeout)
+	// retry without waiting
+	d.queue = workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond))
+	d.cacheSynced = nil
+	return d
+}
+
+func runDispatcher(t *testing.T, d *Dispatcher) func() {
+	stopCh := make(chan struct{})
+	go d.Run(1, stopCh)
+	return func() { close(stopCh) }
+}
+
+func testInstance() *v1beta1.ServiceInstance {
+	return &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-instance",
+			Namespace: testNamespace,
+			UID:       "test-uid",
+		},
+	}
+}
+
+func clusterSink(name, url string, eventTypes ...v1beta1.NotificationEventType) *v1beta1.ClusterNotificationSink {
+	return &v1beta1.ClusterNotificationSink{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.ClusterNotificationSinkSpec{
+			CommonNotificationSinkSpec: v1beta1.CommonNotificationSinkSpec{URL: url, EventTypes: eventTypes},
+		},
+	}
+}
+
+func namespacedSink(namespace, name, url string, eventTypes ...v1beta1.NotificationEventType) *v1beta1.NotificationSink {
+	return &v1beta1.NotificationSink{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: v1beta1.NotificationSinkSpec{
+			CommonNotificationSinkSpec: v1beta1.CommonNotificationSinkSpec{URL: url, EventTypes: eventTypes},
+		},
+	}
+}
+
+func TestNotifyDeliversToSubscribedSinks(t *testing.T) {
+	all := newRecordingSink(0)
+	defer all.Close()
+	bindingsOnly := newRecordingSink(0)
+	defer bindingsOnly.Close()
+	local := newRecordingSink(0)
+	defer local.Close()
+	otherNamespace := newRecordingSink(0)
+	defer otherNamespace.Close()
+
+	d := newTestDispatcher(t, 0, nil,
+		[]*v1beta1.ClusterNotificationSink{
+			clusterSink("all", all.URL),
+			clusterSink("bindings-only", bindingsOnly.URL, v1beta1.NotificationEventBindingBound),
+		},
+		[]*v1beta1.NotificationSink{
+			namespacedSink(testNamespace, "local", local.URL, v1beta1.NotificationEventInstanceProvisioned),
+			namespacedSink("other-ns", "other", otherNamespace.URL),
+		})
+	defer runDispatcher(t, d)()
+
+	event := NewEvent(v1beta1.NotificationEventInstanceProvisioned, "ServiceInstance", "serviceinstances", testInstance(), "", "")
+	d.Notify(event)
+
+	waitForRequests(t, all, 1)
+	waitForRequests(t, local, 1)
+
+	requests, bodies := all.received()
+	if e, a := contentType, requests[0].Header.Get("Content-Type"); e != a {
+		t.Errorf("unexpected content type: expected %q, got %q", e, a)
+	}
+	if a := requests[0].Header.Get(SignatureHeader); a != "" {
+		t.Errorf("expected an unsigned event, got signature %q", a)
+	}
+	var received map[string]interface{}
+	if err := json.Unmarshal(bodies[0], &received); err != nil {
+		t.Fatalf("unexpected error decoding event: %v", err)
+	}
+	if e, a := "1.0", received["specversion"]; e != a {
+		t.Errorf("unexpected specversion: expected %q, got %q", e, a)
+	}
+	if e, a := event.ID, received["id"]; e != a {
+		t.Errorf("unexpected id: expected %q, got %q", e, a)
+	}
+	if e, a := "test-uid", received["data"].(map[string]interface{})["uid"]; e != a {
+		t.Errorf("unexpected data uid: expected %q, got %q", e, a)
+	}
+
+	// Give the queue a chance to deliver anything it should not have.
+	time.Sleep(100 * time.Millisecond)
+	if requests, _ := bindingsOnly.received(); len(requests) != 0 {
+		t.Errorf("expected no events for a sink not subscribed to the event type, got %v", len(requests))
+	}
+	if requests, _ := otherNamespace.received(); len(requests) != 0 {
+		t.Errorf("expected no events for a sink in another namespace, got %v", len(requests))
+	}
+}
+
+func TestNotifySignsEvents(t *testing.T) {
+	sink := newRecordingSink(0)
+	defer sink.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "signing"},
+		Data:       map[string][]byte{v1beta1.NotificationSigningKeySecretKey: []byte("s3cr3t")},
+	}
+	signed := namespacedSink(testNamespace, "signed", sink.URL)
+	signed.Spec.SigningSecretRef = &v1beta1.LocalObjectReference{Name: "signing"}
+
+	d := newTestDispatcher(t, 0, []runtime.Object{secret}, nil, []*v1beta1.NotificationSink{signed})
+	defer runDispatcher(t, d)()
+
+	d.Notify(NewEvent(v1beta1.NotificationEventInstanceFailed, "ServiceInstance", "serviceinstances", testInstance(), "", ""))
+	waitForRequests(t, sink, 1)
+
+	requests, bodies := sink.received()
+	if e, a := Sign([]byte("s3cr3t"), bodies[0]), requests[0].Header.Get(SignatureHeader); e != a {
+		t.Errorf("unexpected signature: expected %q, got %q", e, a)
+	}
+	// The key is read from the informer, not the API server.
+	if actions := d.kubeClient.(*clientgofake.Clientset).Actions(); len(actions) != 0 {
+		t.Errorf("expected no API requests for the signing secret, got %v", actions)
+	}
+}
+
+func TestNotifyOnlyDeliversToAllowedHosts(t *testing.T) {
+	sink := newRecordingSink(0)
+	defer sink.Close()
+	// localhost resolves to the same server, but is not an allowed host.
+	disallowedURL := strings.Replace(sink.URL, "127.0.0.1", "localhost", 1)
+
+	d := newTestDispatcher(t, 0, nil,
+		[]*v1beta1.ClusterNotificationSink{clusterSink("cluster", disallowedURL)},
+		[]*v1beta1.NotificationSink{
+			namespacedSink(testNamespace, "allowed", sink.URL),
+			namespacedSink(testNamespace, "disallowed", disallowedURL),
+		})
+	defer runDispatcher(t, d)()
+
+	d.Notify(NewEvent(v1beta1.NotificationEventInstanceProvisioned, "ServiceInstance", "serviceinstances", testInstance(), "", ""))
+	waitForRequests(t, sink, 2)
+
+	time.Sleep(100 * time.Millisecond)
+	requests, _ := sink.received()
+	if len(requests) != 2 {
+		t.Fatalf("expected events for the allowed and the cluster sinks only, got %v requests", len(requests))
+	}
+	hosts := []string{requests[0].Host, requests[1].Host}
+	sort.Strings(hosts)
+	if e, a := []string{sink.Listener.Addr().String(), strings.TrimPrefix(disallowedURL, "http://")}, hosts; !reflect.DeepEqual(e, a) {
+		t.Errorf("unexpected hosts: expected %v, got %v", e, a)
+	}
+}
+
+func TestAllowsHost(t *testing.T) {
+	d := &Dispatcher{allowedHosts: []string{"hooks.example.com", "*.events.example.com"}}
+	cases := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "https://hooks.example.com/events", allowed: true},
+		{url: "https://HOOKS.example.com:8443/events", allowed: true},
+		{url: "https://a.events.example.com/events", allowed: true},
+		{url: "https://events.example.com/events", allowed: false},
+		{url: "https://hooks.example.com.evil.test/events", allowed: false},
+		{url: "http://169.254.169.254/latest/meta-data", allowed: false},
+	}
+	for _, tc := range cases {
+		if e, a := tc.allowed, d.allowsHost(tc.url); e != a {
+			t.Errorf("%v: expected allowed %v, got %v", tc.url, e, a)
+		}
+	}
+}
+
+func TestNotifyDoesNotFollowRedirects(t *testing.T) {
+	target := newRecordingSink(0)
+	defer target.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer redirect.Close()
+
+	d := newTestDispatcher(t, 0, nil, []*v1beta1.ClusterNotificationSink{clusterSink("redirect", redirect.URL)}, nil)
+	dl := &delivery{event: &Event{}, url: redirect.URL}
+	if err := d.deliver(dl); err == nil {
+		t.Errorf("expected a redirect to fail the delivery")
+	}
+	if requests, _ := target.received(); len(requests) != 0 {
+		t.Errorf("expected the redirect not to be followed, got %v requests", len(requests))
+	}
+}
+
+func TestNotifyRetriesFailedDeliveries(t *testing.T) {
+	cases := []struct {
+		name             string
+		failures         int
+		retries          int
+		expectedRequests int
+	}{
+		{
+			name:             "delivered after retries",
+			failures:         2,
+			retries:          3,
+			expectedRequests: 3,
+		},
+		{
+			name:             "dropped after last retry",
+			failures:         5,
+			retries:          1,
+			expectedRequests: 2,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := newRecordingSink(tc.failures)
+			defer sink.Close()
+
+			d := newTestDispatcher(t, tc.retries, nil, []*v1beta1.ClusterNotificationSink{clusterSink("all", sink.URL)}, nil)
+			defer runDispatcher(t, d)()
+
+			d.Notify(NewEvent(v1beta1.NotificationEventBindingFailed, "ServiceBinding", "servicebindings", testInstance(), "", ""))
+			waitForRequests(t, sink, tc.expectedRequests)
+
+			time.Sleep(100 * time.Millisecond)
+			if requests, _ := sink.received(); len(requests) != tc.expectedRequests {
+				t.Errorf("expected %v requests, got %v", tc.expectedRequests, len(requests))
+			}
+		})
+	}
+}
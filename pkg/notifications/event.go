@@ -0,0 +1,112 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notifications delivers the lifecycle events of service catalog
+// resources to ClusterNotificationSinks and NotificationSinks, as CloudEvents
+// posted over HTTP.
+package notifications
+
+import (
+	"path"
+	"time"
+
+	"github.com/pborman/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+const (
+	// cloudEventsSpecVersion is the version of the CloudEvents specification
+	// the events conform to.
+	cloudEventsSpecVersion = "1.0"
+
+	// eventTypePrefix prefixes the CloudEvents type of every event, in
+	// reverse-DNS form.
+	eventTypePrefix = "io.k8s.servicecatalog."
+)
+
+// cloudEventTypes maps the event types sinks subscribe to to the CloudEvents
+// type of the events sent.
+var cloudEventTypes = map[v1beta1.NotificationEventType]string{
+	v1beta1.NotificationEventInstanceProvisioned:   eventTypePrefix + "instance.provisioned",
+	v1beta1.NotificationEventInstanceFailed:        eventTypePrefix + "instance.failed",
+	v1beta1.NotificationEventInstanceDeprovisioned: eventTypePrefix + "instance.deprovisioned",
+	v1beta1.NotificationEventBindingBound:          eventTypePrefix + "binding.bound",
+	v1beta1.NotificationEventBindingFailed:         eventTypePrefix + "binding.failed",
+	v1beta1.NotificationEventBindingUnbound:        eventTypePrefix + "binding.unbound",
+	v1beta1.NotificationEventCredentialsRotated:    eventTypePrefix + "binding.credentialsrotated",
+	v1beta1.NotificationEventClassRemoved:          eventTypePrefix + "class.removed",
+}
+
+// Event is a lifecycle event of a service catalog resource, in the structured
+// JSON format of CloudEvents.
+type Event struct {
+	// NotificationType is the type of the event sinks subscribe to. It is
+	// not part of the CloudEvent.
+	NotificationType v1beta1.NotificationEventType `json:"-"`
+
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            EventData `json:"data"`
+}
+
+// EventData is the payload of an Event, identifying the resource the event
+// happened to.
+type EventData struct {
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace,omitempty"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	// Reason is a brief machine readable explanation of the event.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the event.
+	Message string `json:"message,omitempty"`
+}
+
+// NewEvent returns the event of the given type for an object. The source of
+// the event is the API path of the collection the object belongs to, given
+// by its resource name, and its subject is the name of the object.
+func NewEvent(notificationType v1beta1.NotificationEventType, kind, resource string, obj metav1.Object, reason, message string) *Event {
+	source := path.Join("/apis", v1beta1.SchemeGroupVersion.String(), resource)
+	if obj.GetNamespace() != "" {
+		source = path.Join("/apis", v1beta1.SchemeGroupVersion.String(), "namespaces", obj.GetNamespace(), resource)
+	}
+	return &Event{
+		NotificationType: notificationType,
+		SpecVersion:      cloudEventsSpecVersion,
+		ID:               uuid.New(),
+		Source:           source,
+		Type:             cloudEventTypes[notificationType],
+		Subject:          obj.GetName(),
+		Time:             time.Now().UTC(),
+		DataContentType:  "application/json",
+		Data: EventData{
+			Kind:      kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			UID:       obj.GetUID(),
+			Reason:    reason,
+			Message:   message,
+		},
+	}
+}
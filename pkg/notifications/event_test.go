@@ -0,0 +1,48 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestNewEvent(t *testing.T) {
+	event := NewEvent(v1beta1.NotificationEventInstanceProvisioned, "ServiceInstance", "serviceinstances", testInstance(), "ProvisionedSuccessfully", "The instance was provisioned successfully")
+
+	if e, a := "/apis/servicecatalog.k8s.io/v1beta1/namespaces/test-ns/serviceinstances", event.Source; e != a {
+		t.Errorf("unexpected source: expected %q, got %q", e, a)
+	}
+	if e, a := "io.k8s.servicecatalog.instance.provisioned", event.Type; e != a {
+		t.Errorf("unexpected type: expected %q, got %q", e, a)
+	}
+	if e, a := "test-instance", event.Subject; e != a {
+		t.Errorf("unexpected subject: expected %q, got %q", e, a)
+	}
+	if event.ID == "" {
+		t.Error("expected the event to have an id")
+	}
+
+	class := &v1beta1.ClusterServiceClass{ObjectMeta: metav1.ObjectMeta{Name: "test-class"}}
+	event = NewEvent(v1beta1.NotificationEventClassRemoved, "ClusterServiceClass", "clusterserviceclasses", class, "", "")
+	if e, a := "/apis/servicecatalog.k8s.io/v1beta1/clusterserviceclasses", event.Source; e != a {
+		t.Errorf("unexpected source: expected %q, got %q", e, a)
+	}
+}
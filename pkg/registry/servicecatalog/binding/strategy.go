@@ -22,7 +22,6 @@ import (
 	"context"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
-	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -145,14 +144,24 @@ func (bindingRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtim
 	// proper validation of allowed changes needs to be implemented in
 	// ValidateUpdate. Also, the check for whether the generation needs
 	// to be updated needs to be un-commented.
-	newServiceBinding.Spec = oldServiceBinding.Spec
+	//
+	// The DeletionPolicy is the exception, as it is only consulted by the
+	// controller when the binding is deleted. So is RetryRequests, which
+	// re-drives a failed bind without changing it; it is ignored when it is
+	// the default value.
+	spec := oldServiceBinding.Spec
+	spec.DeletionPolicy = newServiceBinding.Spec.DeletionPolicy
+	if newServiceBinding.Spec.RetryRequests != 0 {
+		spec.RetryRequests = newServiceBinding.Spec.RetryRequests
+	}
+	newServiceBinding.Spec = spec
 
 	// Spec updates bump the generation so that we can distinguish between
 	// spec changes and other changes to the object.
 	//
-	// Note that since we do not currently handle any changes to the spec,
-	// the generation will never be incremented
-	if !apiequality.Semantic.DeepEqual(oldServiceBinding.Spec, newServiceBinding.Spec) {
+	// Note that since we do not currently handle any other changes to the
+	// spec, the generation is only incremented by a retry request.
+	if newServiceBinding.Spec.RetryRequests != oldServiceBinding.Spec.RetryRequests {
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
 			setServiceBindingUserInfo(ctx, newServiceBinding)
 		}
@@ -102,6 +102,83 @@ func TestInstanceCredentialUpdate(t *testing.T) {
 	}
 }
 
+// TestInstanceCredentialDeletionPolicyUpdate tests that the DeletionPolicy of
+// a ServiceBinding can be changed without incrementing the generation.
+func TestInstanceCredentialDeletionPolicyUpdate(t *testing.T) {
+	older := getTestInstanceCredential()
+	newer := getTestInstanceCredential()
+	newer.Spec.DeletionPolicy = servicecatalog.DeletionPolicyRetain
+	newer.Spec.SecretName = "new-secret"
+
+	bindingRESTStrategies.PrepareForUpdate(nil, newer, older)
+
+	if e, a := servicecatalog.DeletionPolicyRetain, newer.Spec.DeletionPolicy; e != a {
+		t.Errorf("expected deletion policy %v, got %v", e, a)
+	}
+	if e, a := older.Spec.SecretName, newer.Spec.SecretName; e != a {
+		t.Errorf("expected secret name %v, got %v", e, a)
+	}
+	if e, a := older.Generation, newer.Generation; e != a {
+		t.Errorf("expected %v, got %v for generation", e, a)
+	}
+}
+
+// TestInstanceCredentialRetryRequestsUpdate tests that incrementing the
+// RetryRequests of a ServiceBinding bumps the generation, and that it is
+// ignored when it is the default value.
+func TestInstanceCredentialRetryRequestsUpdate(t *testing.T) {
+	older := getTestInstanceCredential()
+	older.Spec.RetryRequests = 1
+	newer := getTestInstanceCredential()
+	newer.Spec.RetryRequests = 2
+
+	bindingRESTStrategies.PrepareForUpdate(nil, newer, older)
+
+	if e, a := int64(2), newer.Spec.RetryRequests; e != a {
+		t.Errorf("expected retry requests %v, got %v", e, a)
+	}
+	if e, a := older.Generation+1, newer.Generation; e != a {
+		t.Errorf("expected %v, got %v for generation", e, a)
+	}
+
+	newer = getTestInstanceCredential()
+	bindingRESTStrategies.PrepareForUpdate(nil, newer, older)
+
+	if e, a := int64(1), newer.Spec.RetryRequests; e != a {
+		t.Errorf("expected retry requests %v, got %v", e, a)
+	}
+	if e, a := older.Generation, newer.Generation; e != a {
+		t.Errorf("expected %v, got %v for generation", e, a)
+	}
+}
+
+// TestInstanceCredentialRetryRequestsAndDeletionPolicyUpdate tests that a
+// retry request and a DeletionPolicy change made in the same update are both
+// kept, that other spec changes are still dropped, and that the generation is
+// bumped once.
+func TestInstanceCredentialRetryRequestsAndDeletionPolicyUpdate(t *testing.T) {
+	older := getTestInstanceCredential()
+	newer := getTestInstanceCredential()
+	newer.Spec.RetryRequests = 1
+	newer.Spec.DeletionPolicy = servicecatalog.DeletionPolicyRetain
+	newer.Spec.SecretName = "new-secret"
+
+	bindingRESTStrategies.PrepareForUpdate(nil, newer, older)
+
+	if e, a := int64(1), newer.Spec.RetryRequests; e != a {
+		t.Errorf("expected retry requests %v, got %v", e, a)
+	}
+	if e, a := servicecatalog.DeletionPolicyRetain, newer.Spec.DeletionPolicy; e != a {
+		t.Errorf("expected deletion policy %v, got %v", e, a)
+	}
+	if e, a := older.Spec.SecretName, newer.Spec.SecretName; e != a {
+		t.Errorf("expected secret name %v, got %v", e, a)
+	}
+	if e, a := older.Generation+1, newer.Generation; e != a {
+		t.Errorf("expected %v, got %v for generation", e, a)
+	}
+}
+
 // TestInstanceCredentialUserInfo tests that the user info is set properly
 // as the user changes for different modifications of the instance credential.
 func TestInstanceCredentialUserInfo(t *testing.T) {
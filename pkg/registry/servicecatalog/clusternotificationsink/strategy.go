@@ -0,0 +1,117 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusternotificationsink
+
+import (
+	"context"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/golang/glog"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+)
+
+// NewScopeStrategy returns a new NamespaceScopedStrategy for notification
+// sinks
+func NewScopeStrategy() rest.NamespaceScopedStrategy {
+	return clusterNotificationSinkRESTStrategies
+}
+
+// implements interfaces RESTCreateStrategy, RESTUpdateStrategy, RESTDeleteStrategy,
+// NamespaceScopedStrategy
+type clusterNotificationSinkRESTStrategy struct {
+	runtime.ObjectTyper // inherit ObjectKinds method
+	names.NameGenerator // GenerateName method for CreateStrategy
+}
+
+var (
+	clusterNotificationSinkRESTStrategies = clusterNotificationSinkRESTStrategy{
+		// embeds to pull in existing code behavior from upstream
+
+		ObjectTyper: api.Scheme,
+		// use the generator from upstream k8s, or implement method
+		// `GenerateName(base string) string`
+		NameGenerator: names.SimpleNameGenerator,
+	}
+	_ rest.RESTCreateStrategy = clusterNotificationSinkRESTStrategies
+	_ rest.RESTUpdateStrategy = clusterNotificationSinkRESTStrategies
+	_ rest.RESTDeleteStrategy = clusterNotificationSinkRESTStrategies
+)
+
+// Canonicalize does not transform a ClusterNotificationSink.
+func (clusterNotificationSinkRESTStrategy) Canonicalize(obj runtime.Object) {
+	_, ok := obj.(*sc.ClusterNotificationSink)
+	if !ok {
+		glog.Fatal("received a non-ClusterNotificationSink object to create")
+	}
+}
+
+// NamespaceScoped returns false as ClusterNotificationSinks are
+// not scoped to a namespace.
+func (clusterNotificationSinkRESTStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate receives the incoming ClusterNotificationSink.
+func (clusterNotificationSinkRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, ok := obj.(*sc.ClusterNotificationSink)
+	if !ok {
+		glog.Fatal("received a non-ClusterNotificationSink object to create")
+	}
+	// a sink is a data record and has no status to track
+}
+
+func (clusterNotificationSinkRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return scv.ValidateClusterNotificationSink(obj.(*sc.ClusterNotificationSink))
+}
+
+func (clusterNotificationSinkRESTStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (clusterNotificationSinkRESTStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (clusterNotificationSinkRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
+	_, ok := new.(*sc.ClusterNotificationSink)
+	if !ok {
+		glog.Fatal("received a non-ClusterNotificationSink object to update to")
+	}
+	_, ok = old.(*sc.ClusterNotificationSink)
+	if !ok {
+		glog.Fatal("received a non-ClusterNotificationSink object to update from")
+	}
+}
+
+func (clusterNotificationSinkRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+	newSink, ok := new.(*sc.ClusterNotificationSink)
+	if !ok {
+		glog.Fatal("received a non-ClusterNotificationSink object to validate to")
+	}
+	oldSink, ok := old.(*sc.ClusterNotificationSink)
+	if !ok {
+		glog.Fatal("received a non-ClusterNotificationSink object to validate from")
+	}
+
+	return scv.ValidateClusterNotificationSinkUpdate(newSink, oldSink)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterserviceplantransitionpolicy
+
+import (
+	"context"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/golang/glog"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+)
+
+// NewScopeStrategy returns a new NamespaceScopedStrategy for plan transition
+// policies
+func NewScopeStrategy() rest.NamespaceScopedStrategy {
+	return clusterServicePlanTransitionPolicyRESTStrategies
+}
+
+// implements interfaces RESTCreateStrategy, RESTUpdateStrategy, RESTDeleteStrategy,
+// NamespaceScopedStrategy
+type clusterServicePlanTransitionPolicyRESTStrategy struct {
+	runtime.ObjectTyper // inherit ObjectKinds method
+	names.NameGenerator // GenerateName method for CreateStrategy
+}
+
+var (
+	clusterServicePlanTransitionPolicyRESTStrategies = clusterServicePlanTransitionPolicyRESTStrategy{
+		// embeds to pull in existing code behavior from upstream
+
+		ObjectTyper: api.Scheme,
+		// use the generator from upstream k8s, or implement method
+		// `GenerateName(base string) string`
+		NameGenerator: names.SimpleNameGenerator,
+	}
+	_ rest.RESTCreateStrategy = clusterServicePlanTransitionPolicyRESTStrategies
+	_ rest.RESTUpdateStrategy = clusterServicePlanTransitionPolicyRESTStrategies
+	_ rest.RESTDeleteStrategy = clusterServicePlanTransitionPolicyRESTStrategies
+)
+
+// Canonicalize does not transform a ClusterServicePlanTransitionPolicy.
+func (clusterServicePlanTransitionPolicyRESTStrategy) Canonicalize(obj runtime.Object) {
+	_, ok := obj.(*sc.ClusterServicePlanTransitionPolicy)
+	if !ok {
+		glog.Fatal("received a non-ClusterServicePlanTransitionPolicy object to create")
+	}
+}
+
+// NamespaceScoped returns false as ClusterServicePlanTransitionPolicies are
+// not scoped to a namespace.
+func (clusterServicePlanTransitionPolicyRESTStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate receives the incoming ClusterServicePlanTransitionPolicy.
+func (clusterServicePlanTransitionPolicyRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, ok := obj.(*sc.ClusterServicePlanTransitionPolicy)
+	if !ok {
+		glog.Fatal("received a non-ClusterServicePlanTransitionPolicy object to create")
+	}
+	// a policy is a data record and has no status to track
+}
+
+func (clusterServicePlanTransitionPolicyRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return scv.ValidateClusterServicePlanTransitionPolicy(obj.(*sc.ClusterServicePlanTransitionPolicy))
+}
+
+func (clusterServicePlanTransitionPolicyRESTStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (clusterServicePlanTransitionPolicyRESTStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (clusterServicePlanTransitionPolicyRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
+	_, ok := new.(*sc.ClusterServicePlanTransitionPolicy)
+	if !ok {
+		glog.Fatal("received a non-ClusterServicePlanTransitionPolicy object to update to")
+	}
+	_, ok = old.(*sc.ClusterServicePlanTransitionPolicy)
+	if !ok {
+		glog.Fatal("received a non-ClusterServicePlanTransitionPolicy object to update from")
+	}
+}
+
+func (clusterServicePlanTransitionPolicyRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+	newPolicy, ok := new.(*sc.ClusterServicePlanTransitionPolicy)
+	if !ok {
+		glog.Fatal("received a non-ClusterServicePlanTransitionPolicy object to validate to")
+	}
+	oldPolicy, ok := old.(*sc.ClusterServicePlanTransitionPolicy)
+	if !ok {
+		glog.Fatal("received a non-ClusterServicePlanTransitionPolicy object to validate from")
+	}
+
+	return scv.ValidateClusterServicePlanTransitionPolicyUpdate(newPolicy, oldPolicy)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"fmt"
+
+	scmeta "github.com/kubernetes-incubator/service-catalog/pkg/api/meta"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// REST wraps the store of a resource that can be created as a dry run.
+// A creation requested with the dryRun=All query parameter, which WithDryRun
+// records in the request's context, runs the same preparation, defaulting
+// and validation as a regular creation, including the validating admission
+// plugins, and returns the resulting object without persisting it. As the
+// object is never stored, the controller never acts on it and no request is
+// sent to a broker.
+type REST struct {
+	*registry.Store
+}
+
+var _ rest.Creater = &REST{}
+
+// NewREST wraps store so that creations requested as dry runs are not
+// persisted.
+func NewREST(store *registry.Store) *REST {
+	return &REST{store}
+}
+
+// Create creates obj, or, if the request is a dry run, validates it and
+// returns it as it would have been persisted.
+//
+// The object the validating admission plugins see during a dry run carries
+// servicecatalog.DryRunAnnotation, so that plugins can run the checks that
+// only apply to dry runs. Clients may not set the annotation themselves.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, includeUninitialized bool) (runtime.Object, error) {
+	if hasDryRunAnnotation(obj) {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("the %s annotation is reserved; use the dryRun=All query parameter to request a dry run", servicecatalog.DryRunAnnotation))
+	}
+	if !IsDryRun(ctx) {
+		return r.Store.Create(ctx, obj, createValidation, includeUninitialized)
+	}
+
+	if err := rest.BeforeCreate(r.CreateStrategy, ctx, obj); err != nil {
+		return nil, err
+	}
+	if createValidation != nil {
+		admitted := obj.DeepCopyObject()
+		if err := setDryRunAnnotation(admitted); err != nil {
+			return nil, err
+		}
+		if err := createValidation(admitted); err != nil {
+			return nil, err
+		}
+	}
+
+	// Report a name that is already taken, as the creation would fail.
+	name, err := r.ObjectNameFunc(obj)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.Store.Get(ctx, name, &metav1.GetOptions{}); err == nil {
+		return nil, apierrors.NewAlreadyExists(r.DefaultQualifiedResource, name)
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func hasDryRunAnnotation(obj runtime.Object) bool {
+	annotations, err := scmeta.GetAccessor().Annotations(obj)
+	if err != nil {
+		return false
+	}
+	_, ok := annotations[servicecatalog.DryRunAnnotation]
+	return ok
+}
+
+func setDryRunAnnotation(obj runtime.Object) error {
+	accessor := scmeta.GetAccessor()
+	annotations, err := accessor.Annotations(obj)
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[servicecatalog.DryRunAnnotation] = "true"
+	return accessor.SetAnnotations(obj, annotations)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const (
+	// dryRunParameter is the query parameter that requests a dry run. Its
+	// name and value are those of the dry-run parameter of later Kubernetes
+	// API servers.
+	dryRunParameter = "dryRun"
+	dryRunAll       = "All"
+)
+
+type dryRunKeyType int
+
+const dryRunKey dryRunKeyType = iota
+
+// WithDryRun records the requests to create a ServiceInstance or a
+// ServiceBinding that carry the dryRun=All query parameter as dry runs in the
+// request's context, where REST picks them up, and removes the parameter,
+// which the API handlers of this Kubernetes version reject. The response to a
+// dry run has status 200 OK instead of 201 Created, as nothing was created.
+//
+// Any other use of the parameter, with another value or on a request other
+// than such a create, is rejected with 400 Bad Request, encoded with s. Not
+// every API handler of this Kubernetes version rejects the parameter, and
+// those that do not would persist a request meant as a dry run.
+func WithDryRun(handler http.Handler, s runtime.NegotiatedSerializer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		values, ok := query[dryRunParameter]
+		if !ok {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		info, ok := request.RequestInfoFrom(req.Context())
+		if !ok || !supportsDryRun(info) || len(values) != 1 || values[0] != dryRunAll {
+			var gv schema.GroupVersion
+			if info != nil {
+				gv = schema.GroupVersion{Group: info.APIGroup, Version: info.APIVersion}
+			}
+			err := errors.NewBadRequest(fmt.Sprintf("%s is only supported as %s=%s on the creation of serviceinstances and servicebindings", dryRunParameter, dryRunParameter, dryRunAll))
+			responsewriters.ErrorNegotiated(err, s, gv, w, req)
+			return
+		}
+
+		query.Del(dryRunParameter)
+		req = req.WithContext(context.WithValue(req.Context(), dryRunKey, true))
+		req.URL.RawQuery = query.Encode()
+		handler.ServeHTTP(&dryRunResponseWriter{ResponseWriter: w}, req)
+	})
+}
+
+// IsDryRun returns whether the request of ctx was recorded as a dry run by
+// WithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey).(bool)
+	return dryRun
+}
+
+func supportsDryRun(info *request.RequestInfo) bool {
+	if !info.IsResourceRequest || info.Verb != "create" || info.APIGroup != servicecatalog.GroupName || info.Subresource != "" {
+		return false
+	}
+	return info.Resource == "serviceinstances" || info.Resource == "servicebindings"
+}
+
+// dryRunResponseWriter replaces the 201 Created status of a successful dry
+// run with 200 OK.
+type dryRunResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w *dryRunResponseWriter) WriteHeader(code int) {
+	if code == http.StatusCreated {
+		code = http.StatusOK
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
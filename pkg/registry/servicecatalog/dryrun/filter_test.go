@@ -0,0 +1,121 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package dryrun
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+)
+
+func TestWithDryRun(t *testing.T) {
+	cases := []struct {
+		name       string
+		url        string
+		info       *request.RequestInfo
+		dryRun     bool
+		statusCode int
+	}{
+		{
+			name:       "no parameter",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/serviceinstances",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "create", APIGroup: "servicecatalog.k8s.io", Resource: "serviceinstances"},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "instance",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/serviceinstances?dryRun=All",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "create", APIGroup: "servicecatalog.k8s.io", Resource: "serviceinstances"},
+			dryRun:     true,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "binding",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/servicebindings?dryRun=All",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "create", APIGroup: "servicecatalog.k8s.io", Resource: "servicebindings"},
+			dryRun:     true,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "unsupported value",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/serviceinstances?dryRun=true",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "create", APIGroup: "servicecatalog.k8s.io", Resource: "serviceinstances"},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "update",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/serviceinstances/name?dryRun=All",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "update", APIGroup: "servicecatalog.k8s.io", Resource: "serviceinstances", Name: "name"},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "patch",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/serviceinstances/name?dryRun=All",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "patch", APIGroup: "servicecatalog.k8s.io", Resource: "serviceinstances", Name: "name"},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "repeated parameter",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/serviceinstances?dryRun=All&dryRun=All",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "create", APIGroup: "servicecatalog.k8s.io", Resource: "serviceinstances"},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "other resource",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/clusterservicebrokers?dryRun=All",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "create", APIGroup: "servicecatalog.k8s.io", Resource: "clusterservicebrokers"},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "subresource",
+			url:        "/apis/servicecatalog.k8s.io/v1beta1/namespaces/ns/serviceinstances/name/status?dryRun=All",
+			info:       &request.RequestInfo{IsResourceRequest: true, Verb: "create", APIGroup: "servicecatalog.k8s.io", Resource: "serviceinstances", Subresource: "status"},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := WithDryRun(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if tc.statusCode == http.StatusBadRequest {
+					t.Errorf("expected the request to be rejected before reaching the handler")
+				}
+				if e, a := tc.dryRun, IsDryRun(req.Context()); e != a {
+					t.Errorf("expected IsDryRun %v, got %v", e, a)
+				}
+				if _, ok := req.URL.Query()["dryRun"]; ok {
+					t.Errorf("expected the dryRun parameter to be removed")
+				}
+				w.WriteHeader(http.StatusCreated)
+			}), api.Codecs)
+
+			req := httptest.NewRequest(http.MethodPost, tc.url, nil)
+			req = req.WithContext(request.WithRequestInfo(req.Context(), tc.info))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			if e, a := tc.statusCode, recorder.Code; e != a {
+				t.Fatalf("unexpected status code: expected %d, got %d", e, a)
+			}
+		})
+	}
+}
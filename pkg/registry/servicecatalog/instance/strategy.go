@@ -171,14 +171,28 @@ func (instanceRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runti
 		newServiceInstance.Spec.ClusterServicePlanRef = nil
 	}
 
-	// Ignore the UpdateRequests field when it is the default value
+	// Ignore the UpdateRequests, CancelRequests and RetryRequests fields
+	// when they are the default value
 	if newServiceInstance.Spec.UpdateRequests == 0 {
 		newServiceInstance.Spec.UpdateRequests = oldServiceInstance.Spec.UpdateRequests
 	}
+	if newServiceInstance.Spec.CancelRequests == 0 {
+		newServiceInstance.Spec.CancelRequests = oldServiceInstance.Spec.CancelRequests
+	}
+	if newServiceInstance.Spec.RetryRequests == 0 {
+		newServiceInstance.Spec.RetryRequests = oldServiceInstance.Spec.RetryRequests
+	}
 
 	// Spec updates bump the generation so that we can distinguish between
-	// spec changes and other changes to the object.
-	if !apiequality.Semantic.DeepEqual(oldServiceInstance.Spec, newServiceInstance.Spec) {
+	// spec changes and other changes to the object. The DeletionPolicy and
+	// the MaintenanceWindow are not sent to the broker, so changing them
+	// alone does not require an update request. Neither does a
+	// cancellation, which must not start a new operation.
+	oldSpec := oldServiceInstance.Spec
+	oldSpec.DeletionPolicy = newServiceInstance.Spec.DeletionPolicy
+	oldSpec.MaintenanceWindow = newServiceInstance.Spec.MaintenanceWindow
+	oldSpec.CancelRequests = newServiceInstance.Spec.CancelRequests
+	if !apiequality.Semantic.DeepEqual(oldSpec, newServiceInstance.Spec) {
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
 			setServiceInstanceUserInfo(ctx, newServiceInstance)
 		}
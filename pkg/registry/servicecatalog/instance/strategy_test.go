@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
@@ -146,6 +147,15 @@ func TestInstanceUpdate(t *testing.T) {
 			shouldGenerationIncrement: true,
 			shouldPlanRefClear:        true,
 		},
+		{
+			name:  "deletion policy change",
+			older: getTestInstance(),
+			newer: func() *servicecatalog.ServiceInstance {
+				i := getTestInstance()
+				i.Spec.DeletionPolicy = servicecatalog.DeletionPolicyRetain
+				return i
+			}(),
+		},
 	}
 
 	for _, tc := range cases {
@@ -256,6 +266,70 @@ func TestInstanceUpdateForUpdateRequests(t *testing.T) {
 	}
 }
 
+// TestInstanceUpdateForCancelRequests tests that incrementing the
+// CancelRequests field does not bump the generation, and that it is ignored
+// when it is the default value.
+func TestInstanceUpdateForCancelRequests(t *testing.T) {
+	oldInstance := getTestInstance()
+	oldInstance.Generation = 1
+	oldInstance.Spec.CancelRequests = 1
+
+	newInstance := getTestInstance()
+	newInstance.Spec.CancelRequests = 2
+	instanceRESTStrategies.PrepareForUpdate(nil, newInstance, oldInstance)
+	if e, a := int64(1), newInstance.Generation; e != a {
+		t.Errorf("expected a cancellation not to bump the generation: expected %v, got %v", e, a)
+	}
+
+	newInstance = getTestInstance()
+	instanceRESTStrategies.PrepareForUpdate(nil, newInstance, oldInstance)
+	if e, a := int64(1), newInstance.Spec.CancelRequests; e != a {
+		t.Errorf("got unexpected CancelRequests: expected %v, got %v", e, a)
+	}
+}
+
+// TestInstanceUpdateForRetryRequests tests that incrementing the
+// RetryRequests field bumps the generation, and that it is ignored when it is
+// the default value.
+func TestInstanceUpdateForRetryRequests(t *testing.T) {
+	oldInstance := getTestInstance()
+	oldInstance.Generation = 1
+	oldInstance.Spec.RetryRequests = 1
+
+	newInstance := getTestInstance()
+	newInstance.Spec.RetryRequests = 2
+	instanceRESTStrategies.PrepareForUpdate(nil, newInstance, oldInstance)
+	if e, a := int64(2), newInstance.Generation; e != a {
+		t.Errorf("expected a retry to bump the generation: expected %v, got %v", e, a)
+	}
+
+	newInstance = getTestInstance()
+	instanceRESTStrategies.PrepareForUpdate(nil, newInstance, oldInstance)
+	if e, a := int64(1), newInstance.Spec.RetryRequests; e != a {
+		t.Errorf("got unexpected RetryRequests: expected %v, got %v", e, a)
+	}
+}
+
+// TestInstanceUpdateForMaintenanceWindow tests that changing the
+// MaintenanceWindow does not bump the generation.
+func TestInstanceUpdateForMaintenanceWindow(t *testing.T) {
+	oldInstance := getTestInstance()
+	oldInstance.Generation = 1
+
+	newInstance := getTestInstance()
+	newInstance.Spec.MaintenanceWindow = &servicecatalog.MaintenanceWindow{
+		Schedule: "0 2 * * 6",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+	instanceRESTStrategies.PrepareForUpdate(nil, newInstance, oldInstance)
+	if e, a := int64(1), newInstance.Generation; e != a {
+		t.Errorf("expected a maintenance window change not to bump the generation: expected %v, got %v", e, a)
+	}
+	if newInstance.Spec.MaintenanceWindow == nil {
+		t.Error("expected the maintenance window to be kept")
+	}
+}
+
 // TestExternalIDSet checks that we set the ExternalID if the user doesn't provide it.
 func TestExternalIDSet(t *testing.T) {
 	createdInstanceCredential := getTestInstance()
@@ -0,0 +1,159 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationsink
+
+import (
+	"errors"
+	"fmt"
+
+	scmeta "github.com/kubernetes-incubator/service-catalog/pkg/api/meta"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/tableconvertor"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+var (
+	errNotANotificationSink = errors.New("not a NotificationSink")
+)
+
+// NewSingular returns a new shell of a NotificationSink,
+// according to the given namespace and name
+func NewSingular(ns, name string) runtime.Object {
+	return &servicecatalog.NotificationSink{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "NotificationSink",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      name,
+		},
+	}
+}
+
+// EmptyObject returns an empty NotificationSink
+func EmptyObject() runtime.Object {
+	return &servicecatalog.NotificationSink{}
+}
+
+// NewList returns a new shell of a NotificationSink list
+func NewList() runtime.Object {
+	return &servicecatalog.NotificationSinkList{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "NotificationSinkList",
+		},
+		Items: []servicecatalog.NotificationSink{},
+	}
+}
+
+// CheckObject returns a non-nil error if obj is not a
+// NotificationSink object
+func CheckObject(obj runtime.Object) error {
+	_, ok := obj.(*servicecatalog.NotificationSink)
+	if !ok {
+		return errNotANotificationSink
+	}
+	return nil
+}
+
+// Match determines whether a NotificationSink matches a
+// field and label selector.
+func Match(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// toSelectableFields returns a field set that represents the object for matching purposes.
+func toSelectableFields(sink *servicecatalog.NotificationSink) fields.Set {
+	return generic.ObjectMetaFieldsSet(&sink.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
+	sink, ok := obj.(*servicecatalog.NotificationSink)
+	if !ok {
+		return nil, nil, false, fmt.Errorf("given object is not a NotificationSink")
+	}
+	return labels.Set(sink.ObjectMeta.Labels), toSelectableFields(sink), sink.Initializers != nil, nil
+}
+
+// NewStorage creates a new rest.Storage responsible for accessing
+// NotificationSink resources
+func NewStorage(opts server.Options) rest.Storage {
+	prefix := "/" + opts.ResourcePrefix()
+
+	storageInterface, dFunc := opts.GetStorage(
+		&servicecatalog.NotificationSink{},
+		prefix,
+		notificationSinkRESTStrategies,
+		NewList,
+		nil,
+		storage.NoTriggerPublisher,
+	)
+
+	store := registry.Store{
+		NewFunc:     EmptyObject,
+		NewListFunc: NewList,
+		KeyRootFunc: opts.KeyRootFunc(),
+		KeyFunc:     opts.KeyFunc(true),
+		// Retrieve the name field of the resource.
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return scmeta.GetAccessor().Name(obj)
+		},
+		// Used to match objects based on labels/fields for list.
+		PredicateFunc: Match,
+		// DefaultQualifiedResource should always be plural
+		DefaultQualifiedResource: servicecatalog.Resource("notificationsinks"),
+
+		CreateStrategy: notificationSinkRESTStrategies,
+		UpdateStrategy: notificationSinkRESTStrategies,
+		DeleteStrategy: notificationSinkRESTStrategies,
+
+		TableConvertor: tableconvertor.NewTableConvertor(
+			[]metav1beta1.TableColumnDefinition{
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "URL", Type: "string"},
+				{Name: "Age", Type: "string"},
+			},
+			func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+				sink := obj.(*servicecatalog.NotificationSink)
+				cells := []interface{}{
+					name,
+					sink.Spec.URL,
+					age,
+				}
+				return cells, nil
+			},
+		),
+
+		Storage:     storageInterface,
+		DestroyFunc: dFunc,
+	}
+
+	return &store
+}
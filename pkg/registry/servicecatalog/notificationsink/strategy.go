@@ -0,0 +1,117 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationsink
+
+import (
+	"context"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/golang/glog"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+)
+
+// NewScopeStrategy returns a new NamespaceScopedStrategy for notification
+// sinks
+func NewScopeStrategy() rest.NamespaceScopedStrategy {
+	return notificationSinkRESTStrategies
+}
+
+// implements interfaces RESTCreateStrategy, RESTUpdateStrategy, RESTDeleteStrategy,
+// NamespaceScopedStrategy
+type notificationSinkRESTStrategy struct {
+	runtime.ObjectTyper // inherit ObjectKinds method
+	names.NameGenerator // GenerateName method for CreateStrategy
+}
+
+var (
+	notificationSinkRESTStrategies = notificationSinkRESTStrategy{
+		// embeds to pull in existing code behavior from upstream
+
+		ObjectTyper: api.Scheme,
+		// use the generator from upstream k8s, or implement method
+		// `GenerateName(base string) string`
+		NameGenerator: names.SimpleNameGenerator,
+	}
+	_ rest.RESTCreateStrategy = notificationSinkRESTStrategies
+	_ rest.RESTUpdateStrategy = notificationSinkRESTStrategies
+	_ rest.RESTDeleteStrategy = notificationSinkRESTStrategies
+)
+
+// Canonicalize does not transform a NotificationSink.
+func (notificationSinkRESTStrategy) Canonicalize(obj runtime.Object) {
+	_, ok := obj.(*sc.NotificationSink)
+	if !ok {
+		glog.Fatal("received a non-NotificationSink object to create")
+	}
+}
+
+// NamespaceScoped returns true as NotificationSinks are scoped to a
+// namespace.
+func (notificationSinkRESTStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate receives the incoming NotificationSink.
+func (notificationSinkRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, ok := obj.(*sc.NotificationSink)
+	if !ok {
+		glog.Fatal("received a non-NotificationSink object to create")
+	}
+	// a sink is a data record and has no status to track
+}
+
+func (notificationSinkRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return scv.ValidateNotificationSink(obj.(*sc.NotificationSink))
+}
+
+func (notificationSinkRESTStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (notificationSinkRESTStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (notificationSinkRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtime.Object) {
+	_, ok := new.(*sc.NotificationSink)
+	if !ok {
+		glog.Fatal("received a non-NotificationSink object to update to")
+	}
+	_, ok = old.(*sc.NotificationSink)
+	if !ok {
+		glog.Fatal("received a non-NotificationSink object to update from")
+	}
+}
+
+func (notificationSinkRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+	newSink, ok := new.(*sc.NotificationSink)
+	if !ok {
+		glog.Fatal("received a non-NotificationSink object to validate to")
+	}
+	oldSink, ok := old.(*sc.NotificationSink)
+	if !ok {
+		glog.Fatal("received a non-NotificationSink object to validate from")
+	}
+
+	return scv.ValidateNotificationSinkUpdate(newSink, oldSink)
+}
@@ -21,10 +21,13 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	servicecatalogv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/binding"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusternotificationsink"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterservicebroker"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterserviceclass"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterserviceplan"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterserviceplantransitionpolicy"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/instance"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/notificationsink"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/servicebroker"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/serviceclass"
@@ -249,6 +252,69 @@ func (p StorageProvider) v1beta1Storage(
 		storageMap["servicebrokers/status"] = serviceBrokerStatusStorage
 	}
 
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.PlanTransitionPolicy) {
+		planTransitionPolicyRESTOptions, err := restOptionsGetter.GetRESTOptions(servicecatalog.Resource("clusterserviceplantransitionpolicies"))
+		if err != nil {
+			return nil, err
+		}
+
+		planTransitionPolicyOpts := server.NewOptions(
+			etcd.Options{
+				RESTOptions:   planTransitionPolicyRESTOptions,
+				Capacity:      1000,
+				ObjectType:    clusterserviceplantransitionpolicy.EmptyObject(),
+				ScopeStrategy: clusterserviceplantransitionpolicy.NewScopeStrategy(),
+				NewListFunc:   clusterserviceplantransitionpolicy.NewList,
+				GetAttrsFunc:  clusterserviceplantransitionpolicy.GetAttrs,
+				Trigger:       storage.NoTriggerPublisher,
+			},
+			p.StorageType,
+		)
+
+		storageMap["clusterserviceplantransitionpolicies"] = clusterserviceplantransitionpolicy.NewStorage(*planTransitionPolicyOpts)
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NotificationSinks) {
+		clusterNotificationSinkRESTOptions, err := restOptionsGetter.GetRESTOptions(servicecatalog.Resource("clusternotificationsinks"))
+		if err != nil {
+			return nil, err
+		}
+
+		clusterNotificationSinkOpts := server.NewOptions(
+			etcd.Options{
+				RESTOptions:   clusterNotificationSinkRESTOptions,
+				Capacity:      1000,
+				ObjectType:    clusternotificationsink.EmptyObject(),
+				ScopeStrategy: clusternotificationsink.NewScopeStrategy(),
+				NewListFunc:   clusternotificationsink.NewList,
+				GetAttrsFunc:  clusternotificationsink.GetAttrs,
+				Trigger:       storage.NoTriggerPublisher,
+			},
+			p.StorageType,
+		)
+
+		notificationSinkRESTOptions, err := restOptionsGetter.GetRESTOptions(servicecatalog.Resource("notificationsinks"))
+		if err != nil {
+			return nil, err
+		}
+
+		notificationSinkOpts := server.NewOptions(
+			etcd.Options{
+				RESTOptions:   notificationSinkRESTOptions,
+				Capacity:      1000,
+				ObjectType:    notificationsink.EmptyObject(),
+				ScopeStrategy: notificationsink.NewScopeStrategy(),
+				NewListFunc:   notificationsink.NewList,
+				GetAttrsFunc:  notificationsink.GetAttrs,
+				Trigger:       storage.NoTriggerPublisher,
+			},
+			p.StorageType,
+		)
+
+		storageMap["clusternotificationsinks"] = clusternotificationsink.NewStorage(*clusterNotificationSinkOpts)
+		storageMap["notificationsinks"] = notificationsink.NewStorage(*notificationSinkOpts)
+	}
+
 	return storageMap, nil
 }
 
@@ -49,6 +49,10 @@ var _ = Describe("ensure that our storage types implement the appropriate interf
 
 		defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
 		Expect(utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.NamespacedServiceBroker))).Should(Succeed())
+		defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.PlanTransitionPolicy))
+		Expect(utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.PlanTransitionPolicy))).Should(Succeed())
+		defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NotificationSinks))
+		Expect(utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.NotificationSinks))).Should(Succeed())
 
 		checkStorageType := func(t GinkgoTInterface, s rest.Storage) {
 			// Our normal stores are all of these things
@@ -100,6 +104,9 @@ var _ = Describe("ensure that our storage types implement the appropriate interf
 			"serviceclasses",
 			"serviceplans",
 			"servicebrokers",
+			"clusterserviceplantransitionpolicies",
+			"clusternotificationsinks",
+			"notificationsinks",
 		}
 
 		for _, storage := range storages {
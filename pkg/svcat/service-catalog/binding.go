@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -239,6 +240,36 @@ func (sdk *SDK) WaitForBinding(ns, name string, interval time.Duration, timeout
 	return binding, err
 }
 
+// RetryBinding increments the retryRequests field on a binding that failed
+// terminally, so that the controller clears the Failed condition and binds
+// again.
+func (sdk *SDK) RetryBinding(ns, name string, retries int) (*v1beta1.ServiceBinding, error) {
+	for j := 0; j < retries; j++ {
+		binding, err := sdk.RetrieveBinding(ns, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !sdk.IsBindingFailed(binding) {
+			return nil, fmt.Errorf("binding %s/%s has not failed", ns, name)
+		}
+
+		binding.Spec.RetryRequests = binding.Spec.RetryRequests + 1
+
+		updated, err := sdk.ServiceCatalog().ServiceBindings(ns).Update(binding)
+		if err == nil {
+			return updated, nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("could not retry binding (%s)", err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return nil, fmt.Errorf("could not retry binding after %d tries", retries)
+}
+
 // IsBindingReady returns true if the instance is in the Ready status.
 func (sdk *SDK) IsBindingReady(binding *v1beta1.ServiceBinding) bool {
 	return sdk.bindingHasStatus(binding, v1beta1.ServiceBindingConditionReady)
@@ -280,6 +280,28 @@ var _ = Describe("Binding", func() {
 		})
 	})
 
+	Describe("RetryBinding", func() {
+		It("Increments the binding's retry requests", func() {
+			sb.Status.Conditions = []v1beta1.ServiceBindingCondition{
+				{Type: v1beta1.ServiceBindingConditionFailed, Status: v1beta1.ConditionTrue},
+			}
+			svcCatClient = fake.NewSimpleClientset(sb)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			retried, err := sdk.RetryBinding(sb.Namespace, sb.Name, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retried.Spec.RetryRequests).To(Equal(int64(1)))
+
+			actions := svcCatClient.Actions()
+			Expect(actions[len(actions)-1].Matches("update", "servicebindings")).To(BeTrue())
+		})
+
+		It("Bubbles up an error when the binding has not failed", func() {
+			_, err := sdk.RetryBinding(sb.Namespace, sb.Name, 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has not failed"))
+		})
+	})
 	Describe("DeleteBindings", func() {
 		It("Calls the generated v1beta1 delete method for every binding", func() {
 			si := &v1beta1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "myinstance", Namespace: sb.Namespace}}
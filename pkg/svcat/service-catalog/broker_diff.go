@@ -0,0 +1,190 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/brokerauth"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogconversion"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
+)
+
+// DiffBrokerCatalog fetches the live catalog of a ClusterServiceBroker and
+// compares it, after applying the broker's catalog restrictions, with the
+// classes and plans currently in the cluster. The result is what the next
+// relist of the broker would change.
+//
+// The catalog is requested from where this runs rather than by the
+// controller, so the broker's URL must be reachable from there and the caller
+// must be able to read the secret referenced by the broker's auth info.
+func (sdk *SDK) DiffBrokerCatalog(name string) (*catalogdiff.Diff, error) {
+	broker, err := sdk.RetrieveBroker(name)
+	if err != nil {
+		return nil, err
+	}
+
+	authConfig, clientCertificate, err := sdk.brokerAuthConfig(broker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the credentials of broker '%s' (%s)", name, err)
+	}
+	config := osb.DefaultClientConfiguration()
+	config.Name = broker.Name
+	config.URL = broker.Spec.URL
+	config.AuthConfig = authConfig
+	if clientCertificate != nil {
+		config.TLSConfig = &tls.Config{Certificates: []tls.Certificate{*clientCertificate}}
+	}
+	config.APIVersion = brokerAPIVersion(&broker.Status.CommonServiceBrokerStatus)
+	config.EnableAlphaFeatures = true
+	config.Insecure = broker.Spec.InsecureSkipTLSVerify
+	config.CAData = broker.Spec.CABundle
+
+	createFunc := sdk.BrokerClientCreateFunc
+	if createFunc == nil {
+		createFunc = osb.NewClient
+	}
+	client, err := createFunc(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a client for broker '%s' (%s)", name, err)
+	}
+	catalog, err := client.GetCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the catalog of broker '%s' (%s)", name, err)
+	}
+
+	payloadClasses, payloadPlans, err := catalogconversion.ConvertAndFilterCatalog(catalog, broker.Spec.CatalogRestrictions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the catalog of broker '%s' (%s)", name, err)
+	}
+
+	listOpts := v1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterServiceBrokerName", broker.Name).String(),
+	}
+	classes, err := sdk.ServiceCatalog().ClusterServiceClasses().List(listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list classes (%s)", err)
+	}
+	plans, err := sdk.ServiceCatalog().ClusterServicePlans().List(listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list plans (%s)", err)
+	}
+
+	return catalogdiff.ForClusterServiceBroker(payloadClasses, payloadPlans, classes.Items, plans.Items), nil
+}
+
+// knownAPIVersions are the versions of the Open Service Broker API that a
+// broker's status may record.
+var knownAPIVersions = []osb.APIVersion{
+	osb.Version2_15(),
+	osb.Version2_14(),
+	osb.Version2_13(),
+	osb.Version2_12(),
+	osb.Version2_11(),
+}
+
+// brokerAPIVersion returns the OSB API version the controller negotiated with
+// the broker, so that the catalog is fetched as the controller would fetch
+// it. The client's default is used for brokers that have not negotiated a
+// known version yet.
+func brokerAPIVersion(status *v1beta1.CommonServiceBrokerStatus) osb.APIVersion {
+	for _, version := range knownAPIVersions {
+		if version.HeaderValue() == status.OSBAPIVersion {
+			return version
+		}
+	}
+	return osb.LatestAPIVersion()
+}
+
+// brokerAuthConfig reads the credentials referenced by a broker's auth info.
+// For brokers using OAuth2 client credentials, a token is requested from the
+// broker's token endpoint.
+func (sdk *SDK) brokerAuthConfig(broker *v1beta1.ClusterServiceBroker) (*osb.AuthConfig, *tls.Certificate, error) {
+	authInfo := broker.Spec.AuthInfo
+	if authInfo == nil {
+		return nil, nil, nil
+	}
+
+	if authInfo.Basic != nil && authInfo.Basic.SecretRef != nil {
+		ref := authInfo.Basic.SecretRef
+		secret, err := sdk.Core().Secrets(ref.Namespace).Get(ref.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return &osb.AuthConfig{
+			BasicAuthConfig: &osb.BasicAuthConfig{
+				Username: string(secret.Data["username"]),
+				Password: string(secret.Data["password"]),
+			},
+		}, nil, nil
+	}
+	if authInfo.Bearer != nil && authInfo.Bearer.SecretRef != nil {
+		ref := authInfo.Bearer.SecretRef
+		secret, err := sdk.Core().Secrets(ref.Namespace).Get(ref.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return &osb.AuthConfig{
+			BearerConfig: &osb.BearerConfig{
+				Token: string(secret.Data["token"]),
+			},
+		}, nil, nil
+	}
+	if authInfo.ClientCertificate != nil && authInfo.ClientCertificate.SecretRef != nil {
+		ref := authInfo.ClientCertificate.SecretRef
+		secret, err := sdk.Core().Secrets(ref.Namespace).Get(ref.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		clientCertificate, err := brokerauth.ClientCertificateFromSecret(secret)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, clientCertificate, nil
+	}
+	if authInfo.OAuth2ClientCredentials != nil && authInfo.OAuth2ClientCredentials.SecretRef != nil {
+		oauth2Config := authInfo.OAuth2ClientCredentials
+		secret, err := sdk.Core().Secrets(oauth2Config.SecretRef.Namespace).Get(oauth2Config.SecretRef.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		clientID, clientSecret, err := brokerauth.ClientCredentialsFromSecret(secret)
+		if err != nil {
+			return nil, nil, err
+		}
+		httpClient, err := brokerauth.NewTokenHTTPClient(broker.Spec.CABundle, broker.Spec.InsecureSkipTLSVerify)
+		if err != nil {
+			return nil, nil, err
+		}
+		token, err := brokerauth.FetchClientCredentialsToken(httpClient, oauth2Config.TokenURL, clientID, clientSecret, oauth2Config.Scopes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &osb.AuthConfig{
+			BearerConfig: &osb.BearerConfig{
+				Token: token.AccessToken,
+			},
+		}, nil, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported auth mode")
+}
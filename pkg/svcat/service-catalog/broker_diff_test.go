@@ -0,0 +1,250 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/cert"
+
+	. "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiffBrokerCatalog", func() {
+	var (
+		sdk          *SDK
+		broker       *v1beta1.ClusterServiceBroker
+		class        *v1beta1.ClusterServiceClass
+		smallPlan    *v1beta1.ClusterServicePlan
+		largePlan    *v1beta1.ClusterServicePlan
+		catalog      *osb.CatalogResponse
+		catalogErr   error
+		clientConfig *osb.ClientConfiguration
+	)
+
+	managedBy := func(obj metav1.Object) {
+		isController := true
+		obj.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: v1beta1.SchemeGroupVersion.String(),
+			Kind:       "ClusterServiceBroker",
+			Name:       broker.Name,
+			Controller: &isController,
+		}})
+	}
+
+	BeforeEach(func() {
+		broker = &v1beta1.ClusterServiceBroker{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-broker"},
+			Spec: v1beta1.ClusterServiceBrokerSpec{
+				CommonServiceBrokerSpec: v1beta1.CommonServiceBrokerSpec{URL: "https://broker.example.com"},
+				AuthInfo: &v1beta1.ClusterServiceBrokerAuthInfo{
+					Bearer: &v1beta1.ClusterBearerTokenAuthConfig{
+						SecretRef: &v1beta1.ObjectReference{Namespace: "brokers", Name: "mysql-token"},
+					},
+				},
+			},
+		}
+		class = &v1beta1.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-id"},
+			Spec: v1beta1.ClusterServiceClassSpec{
+				ClusterServiceBrokerName: broker.Name,
+				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+					ExternalID:   "mysql-id",
+					ExternalName: "mysql",
+					Description:  "MySQL databases",
+				},
+			},
+		}
+		managedBy(class)
+		smallPlan = &v1beta1.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "small-id"},
+			Spec: v1beta1.ClusterServicePlanSpec{
+				ClusterServiceBrokerName: broker.Name,
+				ClusterServiceClassRef:   v1beta1.ClusterObjectReference{Name: class.Name},
+				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+					ExternalID:   "small-id",
+					ExternalName: "small",
+					Description:  "A small database",
+					Free:         true,
+				},
+			},
+		}
+		managedBy(smallPlan)
+		largePlan = smallPlan.DeepCopy()
+		largePlan.Name = "large-id"
+		largePlan.Spec.ExternalID = "large-id"
+		largePlan.Spec.ExternalName = "large"
+		largePlan.Spec.Description = "A large database"
+		largePlan.Spec.Free = false
+
+		free := true
+		catalog = &osb.CatalogResponse{
+			Services: []osb.Service{
+				{
+					ID:          "mysql-id",
+					Name:        "mysql",
+					Description: "MySQL databases",
+					Plans: []osb.Plan{
+						{ID: "small-id", Name: "small", Description: "A smaller database", Free: &free},
+						{ID: "medium-id", Name: "medium", Description: "A medium database"},
+					},
+				},
+			},
+		}
+		catalogErr = nil
+
+		sdk = &SDK{
+			K8sClient: k8sfake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "brokers", Name: "mysql-token"},
+				Data:       map[string][]byte{"token": []byte("s3cr3t")},
+			}),
+			ServiceCatalogClient: fake.NewSimpleClientset(broker, class, smallPlan, largePlan),
+			BrokerClientCreateFunc: func(config *osb.ClientConfiguration) (osb.Client, error) {
+				clientConfig = config
+				return fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+					CatalogReaction: &fakeosb.CatalogReaction{Response: catalog, Error: catalogErr},
+				}), nil
+			},
+		}
+	})
+
+	It("reports what the next relist would change", func() {
+		diff, err := sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.AddedClasses).To(BeEmpty())
+		Expect(diff.ChangedClasses).To(BeEmpty())
+		Expect(diff.RemovedClasses).To(BeEmpty())
+		Expect(diff.AddedPlans).To(ConsistOf("mysql/medium"))
+		Expect(diff.ChangedPlans).To(ConsistOf("mysql/small"))
+		Expect(diff.RemovedPlans).To(ConsistOf("mysql/large"))
+	})
+
+	It("connects to the broker with its credentials", func() {
+		_, err := sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clientConfig.URL).To(Equal(broker.Spec.URL))
+		Expect(clientConfig.AuthConfig.BearerConfig.Token).To(Equal("s3cr3t"))
+	})
+
+	It("uses the OSB API version negotiated with the broker", func() {
+		broker.Status.OSBAPIVersion = osb.Version2_14().HeaderValue()
+		sdk.ServiceCatalogClient = fake.NewSimpleClientset(broker, class, smallPlan, largePlan)
+
+		_, err := sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clientConfig.APIVersion).To(Equal(osb.Version2_14()))
+	})
+
+	It("uses the latest OSB API version before one is negotiated", func() {
+		_, err := sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clientConfig.APIVersion).To(Equal(osb.LatestAPIVersion()))
+	})
+
+	It("presents the broker's client certificate", func() {
+		certPEM, keyPEM, err := cert.GenerateSelfSignedCertKey("client.example.com", nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		broker.Spec.AuthInfo = &v1beta1.ClusterServiceBrokerAuthInfo{
+			ClientCertificate: &v1beta1.ClusterClientCertificateAuthConfig{
+				SecretRef: &v1beta1.ObjectReference{Namespace: "brokers", Name: "mysql-cert"},
+			},
+		}
+		sdk.ServiceCatalogClient = fake.NewSimpleClientset(broker, class, smallPlan, largePlan)
+		sdk.K8sClient = k8sfake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "brokers", Name: "mysql-cert"},
+			Data: map[string][]byte{
+				v1beta1.ClientCertificateCertKey: certPEM,
+				v1beta1.ClientCertificateKeyKey:  keyPEM,
+			},
+		})
+
+		_, err = sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clientConfig.AuthConfig).To(BeNil())
+		Expect(clientConfig.TLSConfig.Certificates).To(HaveLen(1))
+	})
+
+	It("requests a token for brokers using oauth2 client credentials", func() {
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if id, secret, ok := r.BasicAuth(); !ok || id != "mysql" || secret != "s3cr3t" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"access_token":"t0k3n","token_type":"bearer","expires_in":3600}`))
+		}))
+		defer tokenServer.Close()
+		broker.Spec.AuthInfo = &v1beta1.ClusterServiceBrokerAuthInfo{
+			OAuth2ClientCredentials: &v1beta1.ClusterOAuth2ClientCredentialsAuthConfig{
+				TokenURL:  tokenServer.URL,
+				SecretRef: &v1beta1.ObjectReference{Namespace: "brokers", Name: "mysql-client"},
+			},
+		}
+		sdk.ServiceCatalogClient = fake.NewSimpleClientset(broker, class, smallPlan, largePlan)
+		sdk.K8sClient = k8sfake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "brokers", Name: "mysql-client"},
+			Data: map[string][]byte{
+				v1beta1.OAuth2ClientIDKey:     []byte("mysql"),
+				v1beta1.OAuth2ClientSecretKey: []byte("s3cr3t"),
+			},
+		})
+
+		_, err := sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clientConfig.AuthConfig.BearerConfig.Token).To(Equal("t0k3n"))
+	})
+
+	It("applies the broker's catalog restrictions", func() {
+		broker.Spec.CatalogRestrictions = &v1beta1.CatalogRestrictions{
+			ServicePlan: []string{"spec.externalName!=medium"},
+		}
+		sdk.ServiceCatalogClient = fake.NewSimpleClientset(broker, class, smallPlan, largePlan)
+
+		diff, err := sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.AddedPlans).To(BeEmpty())
+		Expect(diff.ChangedPlans).To(ConsistOf("mysql/small"))
+		Expect(diff.RemovedPlans).To(ConsistOf("mysql/large"))
+	})
+
+	It("bubbles up errors fetching the catalog", func() {
+		catalogErr = errors.New("connection refused")
+
+		_, err := sdk.DiffBrokerCatalog(broker.Name)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("connection refused"))
+	})
+})
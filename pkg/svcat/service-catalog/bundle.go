@@ -0,0 +1,449 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// BundleAPIVersion is the version of the bundle format written by Export.
+	BundleAPIVersion = "svcat.servicecatalog.k8s.io/v1alpha1"
+
+	// BundleKind identifies a bundle written by Export.
+	BundleKind = "Bundle"
+)
+
+// Bundle is a portable snapshot of the service catalog resources in a
+// namespace. It is written by Export and recreated by Import, usually in
+// another cluster, without provisioning or binding again at the broker.
+type Bundle struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// Namespace is the namespace the resources were exported from.
+	Namespace string `json:"namespace"`
+	// Instances are the exported instances and the external IDs of their
+	// class and plan.
+	Instances []BundleInstance `json:"instances,omitempty"`
+	// Bindings are the exported bindings.
+	Bindings []v1beta1.ServiceBinding `json:"bindings,omitempty"`
+	// Secrets are the secrets referenced by the instances and bindings,
+	// both parameter secrets and binding credentials.
+	Secrets []corev1.Secret `json:"secrets,omitempty"`
+}
+
+// BundleInstance is an exported instance along with the external IDs of the
+// class and plan that it was provisioned from. A broker in the target cluster
+// must offer the same class and plan.
+type BundleInstance struct {
+	ClassExternalID string                  `json:"classExternalID"`
+	PlanExternalID  string                  `json:"planExternalID"`
+	Instance        v1beta1.ServiceInstance `json:"instance"`
+}
+
+// Export builds a bundle from the instances, bindings and referenced secrets
+// in a namespace. Every instance must be provisioned and every binding must be
+// bound, otherwise there is no broker state to carry over.
+func (sdk *SDK) Export(namespace string) (*Bundle, error) {
+	instances, err := sdk.ServiceCatalog().ServiceInstances(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list instances in %s (%s)", namespace, err)
+	}
+	bindings, err := sdk.ServiceCatalog().ServiceBindings(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list bindings in %s (%s)", namespace, err)
+	}
+
+	bundle := &Bundle{
+		APIVersion: BundleAPIVersion,
+		Kind:       BundleKind,
+		Namespace:  namespace,
+	}
+	secretNames := sets.NewString()
+
+	for _, instance := range instances.Items {
+		if instance.Status.ProvisionStatus != v1beta1.ServiceInstanceProvisionStatusProvisioned ||
+			instance.Status.ExternalProperties == nil {
+			return nil, fmt.Errorf("instance %s/%s is not provisioned and cannot be exported", namespace, instance.Name)
+		}
+		classID, planID, err := sdk.instanceExternalIDs(&instance)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Instances = append(bundle.Instances, BundleInstance{
+			ClassExternalID: classID,
+			PlanExternalID:  planID,
+			Instance:        exportInstance(&instance),
+		})
+		secretNames.Insert(parametersFromSecretNames(instance.Spec.ParametersFrom)...)
+	}
+
+	for _, binding := range bindings.Items {
+		if binding.Status.ExternalProperties == nil {
+			return nil, fmt.Errorf("binding %s/%s is not bound and cannot be exported", namespace, binding.Name)
+		}
+		bundle.Bindings = append(bundle.Bindings, exportBinding(&binding))
+		secretNames.Insert(parametersFromSecretNames(binding.Spec.ParametersFrom)...)
+		secretNames.Insert(binding.Spec.SecretName)
+	}
+
+	for _, name := range secretNames.List() {
+		secret, err := sdk.Core().Secrets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get secret %s/%s (%s)", namespace, name, err)
+		}
+		bundle.Secrets = append(bundle.Secrets, corev1.Secret{
+			ObjectMeta: exportObjectMeta(secret.ObjectMeta),
+			Type:       secret.Type,
+			Data:       secret.Data,
+		})
+	}
+
+	return bundle, nil
+}
+
+// Import recreates the resources in a bundle as adopted resources, so that
+// the controller records them as provisioned and bound without sending
+// requests to the broker. When namespace is empty, the namespace the bundle
+// was exported from is used. Instances and bindings that already exist with
+// the same external ID, and secrets that already exist with the same data,
+// are left as they are, so an import that failed part way may be run again.
+func (sdk *SDK) Import(bundle *Bundle, namespace string) error {
+	if bundle.Kind != BundleKind {
+		return fmt.Errorf("unsupported bundle kind %q, expected %q", bundle.Kind, BundleKind)
+	}
+	if namespace == "" {
+		namespace = bundle.Namespace
+	}
+
+	if err := sdk.checkBundleOfferings(bundle, namespace); err != nil {
+		return err
+	}
+
+	secrets := make(map[string]corev1.Secret, len(bundle.Secrets))
+	for _, secret := range bundle.Secrets {
+		secrets[secret.Name] = secret
+	}
+	credentialSecrets := sets.NewString()
+	for _, binding := range bundle.Bindings {
+		credentialSecrets.Insert(binding.Spec.SecretName)
+	}
+
+	// Parameter secrets must exist before the instances and bindings that
+	// reference them. Credential secrets are created once their binding
+	// exists, so that they are owned by it.
+	for _, secret := range bundle.Secrets {
+		if credentialSecrets.Has(secret.Name) {
+			continue
+		}
+		if err := sdk.importSecret(secret, namespace, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, bi := range bundle.Instances {
+		if err := sdk.importInstance(bi.Instance.DeepCopy(), namespace); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range bundle.Bindings {
+		created, err := sdk.importBinding(b.DeepCopy(), namespace)
+		if err != nil {
+			return err
+		}
+
+		secret, ok := secrets[b.Spec.SecretName]
+		if !ok {
+			continue
+		}
+		owner := metav1.NewControllerRef(created, v1beta1.SchemeGroupVersion.WithKind("ServiceBinding"))
+		if err := sdk.importSecret(secret, namespace, owner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkBundleOfferings verifies that every class and plan used by the
+// instances in a bundle is offered by a broker in the target cluster, and
+// reports all of the missing ones at once.
+func (sdk *SDK) checkBundleOfferings(bundle *Bundle, namespace string) error {
+	var clusterOfferings, nsOfferings sets.String
+	var missing []string
+
+	for _, bi := range bundle.Instances {
+		var offerings sets.String
+		if bi.Instance.Spec.ClusterServiceClassSpecified() {
+			if clusterOfferings == nil {
+				o, err := sdk.clusterOfferings()
+				if err != nil {
+					return err
+				}
+				clusterOfferings = o
+			}
+			offerings = clusterOfferings
+		} else {
+			if nsOfferings == nil {
+				o, err := sdk.namespacedOfferings(namespace)
+				if err != nil {
+					return err
+				}
+				nsOfferings = o
+			}
+			offerings = nsOfferings
+		}
+
+		if !offerings.Has(offeringKey(bi.ClassExternalID, bi.PlanExternalID)) {
+			missing = append(missing, fmt.Sprintf("class %q plan %q (instance %s)",
+				bi.ClassExternalID, bi.PlanExternalID, bi.Instance.Name))
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("no broker in the target cluster offers:\n  %s", strings.Join(missing, "\n  "))
+	}
+	return nil
+}
+
+// clusterOfferings returns the class and plan external ID pairs offered by
+// the cluster-scoped brokers.
+func (sdk *SDK) clusterOfferings() (sets.String, error) {
+	classes, err := sdk.ServiceCatalog().ClusterServiceClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list classes (%s)", err)
+	}
+	plans, err := sdk.ServiceCatalog().ClusterServicePlans().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list plans (%s)", err)
+	}
+
+	classIDs := make(map[string]string, len(classes.Items))
+	for _, class := range classes.Items {
+		if !class.Status.RemovedFromBrokerCatalog {
+			classIDs[class.Name] = class.Spec.ExternalID
+		}
+	}
+	offerings := sets.NewString()
+	for _, plan := range plans.Items {
+		classID, ok := classIDs[plan.Spec.ClusterServiceClassRef.Name]
+		if ok && !plan.Status.RemovedFromBrokerCatalog {
+			offerings.Insert(offeringKey(classID, plan.Spec.ExternalID))
+		}
+	}
+	return offerings, nil
+}
+
+// namespacedOfferings returns the class and plan external ID pairs offered by
+// the brokers in a namespace.
+func (sdk *SDK) namespacedOfferings(namespace string) (sets.String, error) {
+	classes, err := sdk.ServiceCatalog().ServiceClasses(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list classes in %s (%s)", namespace, err)
+	}
+	plans, err := sdk.ServiceCatalog().ServicePlans(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list plans in %s (%s)", namespace, err)
+	}
+
+	classIDs := make(map[string]string, len(classes.Items))
+	for _, class := range classes.Items {
+		if !class.Status.RemovedFromBrokerCatalog {
+			classIDs[class.Name] = class.Spec.ExternalID
+		}
+	}
+	offerings := sets.NewString()
+	for _, plan := range plans.Items {
+		classID, ok := classIDs[plan.Spec.ServiceClassRef.Name]
+		if ok && !plan.Status.RemovedFromBrokerCatalog {
+			offerings.Insert(offeringKey(classID, plan.Spec.ExternalID))
+		}
+	}
+	return offerings, nil
+}
+
+// instanceExternalIDs resolves the external IDs of the class and plan that an
+// instance was provisioned from.
+func (sdk *SDK) instanceExternalIDs(instance *v1beta1.ServiceInstance) (string, string, error) {
+	if instance.Spec.ClusterServiceClassRef != nil && instance.Spec.ClusterServicePlanRef != nil {
+		class, err := sdk.ServiceCatalog().ClusterServiceClasses().Get(instance.Spec.ClusterServiceClassRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("unable to get class for instance %s/%s (%s)", instance.Namespace, instance.Name, err)
+		}
+		plan, err := sdk.ServiceCatalog().ClusterServicePlans().Get(instance.Spec.ClusterServicePlanRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("unable to get plan for instance %s/%s (%s)", instance.Namespace, instance.Name, err)
+		}
+		return class.Spec.ExternalID, plan.Spec.ExternalID, nil
+	}
+
+	if instance.Spec.ServiceClassRef != nil && instance.Spec.ServicePlanRef != nil {
+		class, err := sdk.ServiceCatalog().ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("unable to get class for instance %s/%s (%s)", instance.Namespace, instance.Name, err)
+		}
+		plan, err := sdk.ServiceCatalog().ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("unable to get plan for instance %s/%s (%s)", instance.Namespace, instance.Name, err)
+		}
+		return class.Spec.ExternalID, plan.Spec.ExternalID, nil
+	}
+
+	return "", "", fmt.Errorf("instance %s/%s has no resolved class and plan", instance.Namespace, instance.Name)
+}
+
+func (sdk *SDK) importSecret(secret corev1.Secret, namespace string, owner *metav1.OwnerReference) error {
+	secret.Namespace = namespace
+	if owner != nil {
+		secret.OwnerReferences = []metav1.OwnerReference{*owner}
+	}
+	_, err := sdk.Core().Secrets(namespace).Create(&secret)
+	if errors.IsAlreadyExists(err) {
+		existing, getErr := sdk.Core().Secrets(namespace).Get(secret.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return fmt.Errorf("unable to get secret %s/%s (%s)", namespace, secret.Name, getErr)
+		}
+		if !reflect.DeepEqual(existing.Data, secret.Data) {
+			return fmt.Errorf("secret %s/%s already exists with different data", namespace, secret.Name)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to create secret %s/%s (%s)", namespace, secret.Name, err)
+	}
+	return nil
+}
+
+// importInstance creates an adopted instance, unless an instance with the
+// same name and external ID already exists.
+func (sdk *SDK) importInstance(instance *v1beta1.ServiceInstance, namespace string) error {
+	instance.Namespace = namespace
+	instance.Annotations = adoptionAnnotations(instance.Annotations)
+	_, err := sdk.ServiceCatalog().ServiceInstances(namespace).Create(instance)
+	if errors.IsAlreadyExists(err) {
+		existing, getErr := sdk.ServiceCatalog().ServiceInstances(namespace).Get(instance.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return fmt.Errorf("unable to get instance %s/%s (%s)", namespace, instance.Name, getErr)
+		}
+		if existing.Spec.ExternalID != instance.Spec.ExternalID {
+			return fmt.Errorf("instance %s/%s already exists with external ID %q", namespace, instance.Name, existing.Spec.ExternalID)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to create instance %s/%s (%s)", namespace, instance.Name, err)
+	}
+	return nil
+}
+
+// importBinding creates an adopted binding, unless a binding with the same
+// name and external ID already exists, and returns the binding in the
+// cluster.
+func (sdk *SDK) importBinding(binding *v1beta1.ServiceBinding, namespace string) (*v1beta1.ServiceBinding, error) {
+	binding.Namespace = namespace
+	binding.Annotations = adoptionAnnotations(binding.Annotations)
+	created, err := sdk.ServiceCatalog().ServiceBindings(namespace).Create(binding)
+	if errors.IsAlreadyExists(err) {
+		existing, getErr := sdk.ServiceCatalog().ServiceBindings(namespace).Get(binding.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return nil, fmt.Errorf("unable to get binding %s/%s (%s)", namespace, binding.Name, getErr)
+		}
+		if existing.Spec.ExternalID != binding.Spec.ExternalID {
+			return nil, fmt.Errorf("binding %s/%s already exists with external ID %q", namespace, binding.Name, existing.Spec.ExternalID)
+		}
+		return existing, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create binding %s/%s (%s)", namespace, binding.Name, err)
+	}
+	return created, nil
+}
+
+// exportInstance strips an instance down to what is needed to recreate it in
+// another cluster. References and user info are resolved again by the target
+// cluster, and only the external properties are kept from the status.
+func exportInstance(instance *v1beta1.ServiceInstance) v1beta1.ServiceInstance {
+	exported := v1beta1.ServiceInstance{
+		ObjectMeta: exportObjectMeta(instance.ObjectMeta),
+		Spec:       *instance.Spec.DeepCopy(),
+		Status: v1beta1.ServiceInstanceStatus{
+			ExternalProperties: instance.Status.ExternalProperties.DeepCopy(),
+		},
+	}
+	exported.Spec.ClusterServiceClassRef = nil
+	exported.Spec.ClusterServicePlanRef = nil
+	exported.Spec.ServiceClassRef = nil
+	exported.Spec.ServicePlanRef = nil
+	exported.Spec.UserInfo = nil
+	return exported
+}
+
+// exportBinding strips a binding down to what is needed to recreate it in
+// another cluster.
+func exportBinding(binding *v1beta1.ServiceBinding) v1beta1.ServiceBinding {
+	exported := v1beta1.ServiceBinding{
+		ObjectMeta: exportObjectMeta(binding.ObjectMeta),
+		Spec:       *binding.Spec.DeepCopy(),
+		Status: v1beta1.ServiceBindingStatus{
+			ExternalProperties: binding.Status.ExternalProperties.DeepCopy(),
+		},
+	}
+	exported.Spec.UserInfo = nil
+	return exported
+}
+
+// exportObjectMeta keeps only the metadata that is meaningful in another
+// cluster.
+func exportObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
+
+func adoptionAnnotations(annotations map[string]string) map[string]string {
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[v1beta1.AdoptionAnnotation] = "true"
+	return annotations
+}
+
+func parametersFromSecretNames(parametersFrom []v1beta1.ParametersFromSource) []string {
+	var names []string
+	for _, p := range parametersFrom {
+		if p.SecretKeyRef != nil {
+			names = append(names, p.SecretKeyRef.Name)
+		}
+	}
+	return names
+}
+
+func offeringKey(classID, planID string) string {
+	return classID + "/" + planID
+}
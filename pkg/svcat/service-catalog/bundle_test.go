@@ -0,0 +1,254 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bundle", func() {
+	var (
+		sdk          *SDK
+		k8sClient    *k8sfake.Clientset
+		svcCatClient *fake.Clientset
+		class        *v1beta1.ClusterServiceClass
+		plan         *v1beta1.ClusterServicePlan
+		instance     *v1beta1.ServiceInstance
+		binding      *v1beta1.ServiceBinding
+		paramsSecret *corev1.Secret
+		credsSecret  *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		class = &v1beta1.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-class"},
+			Spec: v1beta1.ClusterServiceClassSpec{
+				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{ExternalID: "mysql-class-id"},
+			},
+		}
+		plan = &v1beta1.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-plan"},
+			Spec: v1beta1.ClusterServicePlanSpec{
+				CommonServicePlanSpec:  v1beta1.CommonServicePlanSpec{ExternalID: "mysql-plan-id"},
+				ClusterServiceClassRef: v1beta1.ClusterObjectReference{Name: "mysql-class"},
+			},
+		}
+		instance = &v1beta1.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "mysql",
+				Namespace:       "dev",
+				UID:             "instance-uid",
+				ResourceVersion: "42",
+			},
+			Spec: v1beta1.ServiceInstanceSpec{
+				PlanReference: v1beta1.PlanReference{
+					ClusterServiceClassExternalName: "mysql",
+					ClusterServicePlanExternalName:  "small",
+				},
+				ClusterServiceClassRef: &v1beta1.ClusterObjectReference{Name: "mysql-class"},
+				ClusterServicePlanRef:  &v1beta1.ClusterObjectReference{Name: "mysql-plan"},
+				ExternalID:             "instance-id",
+				ParametersFrom: []v1beta1.ParametersFromSource{
+					{SecretKeyRef: &v1beta1.SecretKeyReference{Name: "mysql-params", Key: "params"}},
+				},
+				UserInfo: &v1beta1.UserInfo{Username: "alice"},
+			},
+			Status: v1beta1.ServiceInstanceStatus{
+				ProvisionStatus: v1beta1.ServiceInstanceProvisionStatusProvisioned,
+				ExternalProperties: &v1beta1.ServiceInstancePropertiesState{
+					ClusterServicePlanExternalName: "small",
+					ClusterServicePlanExternalID:   "mysql-plan-id",
+				},
+			},
+		}
+		binding = &v1beta1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mysql-binding",
+				Namespace: "dev",
+				UID:       "binding-uid",
+			},
+			Spec: v1beta1.ServiceBindingSpec{
+				ServiceInstanceRef: v1beta1.LocalObjectReference{Name: "mysql"},
+				SecretName:         "mysql-creds",
+				ExternalID:         "binding-id",
+			},
+			Status: v1beta1.ServiceBindingStatus{
+				ExternalProperties: &v1beta1.ServiceBindingPropertiesState{},
+			},
+		}
+		paramsSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-params", Namespace: "dev", UID: "params-uid"},
+			Data:       map[string][]byte{"params": []byte(`{"size":"small"}`)},
+		}
+		credsSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-creds", Namespace: "dev", UID: "creds-uid"},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		}
+		svcCatClient = fake.NewSimpleClientset(class, plan, instance, binding)
+		k8sClient = k8sfake.NewSimpleClientset(paramsSecret, credsSecret)
+		sdk = &SDK{
+			K8sClient:            k8sClient,
+			ServiceCatalogClient: svcCatClient,
+		}
+	})
+
+	Describe("Export", func() {
+		It("Exports the instances, bindings and their secrets", func() {
+			bundle, err := sdk.Export("dev")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bundle.Kind).To(Equal(BundleKind))
+			Expect(bundle.Namespace).To(Equal("dev"))
+
+			Expect(bundle.Instances).To(HaveLen(1))
+			bi := bundle.Instances[0]
+			Expect(bi.ClassExternalID).To(Equal("mysql-class-id"))
+			Expect(bi.PlanExternalID).To(Equal("mysql-plan-id"))
+			Expect(bi.Instance.Name).To(Equal("mysql"))
+			Expect(bi.Instance.UID).To(BeEmpty())
+			Expect(bi.Instance.ResourceVersion).To(BeEmpty())
+			Expect(bi.Instance.Spec.ExternalID).To(Equal("instance-id"))
+			Expect(bi.Instance.Spec.ClusterServiceClassRef).To(BeNil())
+			Expect(bi.Instance.Spec.UserInfo).To(BeNil())
+			Expect(bi.Instance.Status.ExternalProperties).To(Equal(instance.Status.ExternalProperties))
+
+			Expect(bundle.Bindings).To(HaveLen(1))
+			Expect(bundle.Bindings[0].Name).To(Equal("mysql-binding"))
+			Expect(bundle.Bindings[0].UID).To(BeEmpty())
+			Expect(bundle.Bindings[0].Spec.ExternalID).To(Equal("binding-id"))
+
+			Expect(bundle.Secrets).To(HaveLen(2))
+			Expect(bundle.Secrets[0].Name).To(Equal("mysql-creds"))
+			Expect(bundle.Secrets[0].Data).To(Equal(credsSecret.Data))
+			Expect(bundle.Secrets[1].Name).To(Equal("mysql-params"))
+			Expect(bundle.Secrets[1].UID).To(BeEmpty())
+		})
+		It("Refuses to export an instance that is not provisioned", func() {
+			instance.Status = v1beta1.ServiceInstanceStatus{}
+			svcCatClient = fake.NewSimpleClientset(class, plan, instance)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.Export("dev")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("dev/mysql is not provisioned"))
+		})
+	})
+
+	Describe("Import", func() {
+		var bundle *Bundle
+
+		BeforeEach(func() {
+			var err error
+			bundle, err = sdk.Export("dev")
+			Expect(err).NotTo(HaveOccurred())
+
+			svcCatClient = fake.NewSimpleClientset(class, plan)
+			k8sClient = k8sfake.NewSimpleClientset()
+			sdk.ServiceCatalogClient = svcCatClient
+			sdk.K8sClient = k8sClient
+		})
+
+		It("Recreates the bundle as adopted resources", func() {
+			err := sdk.Import(bundle, "staging")
+
+			Expect(err).NotTo(HaveOccurred())
+
+			gotInstance, err := svcCatClient.ServicecatalogV1beta1().ServiceInstances("staging").Get("mysql", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotInstance.Annotations).To(HaveKeyWithValue(v1beta1.AdoptionAnnotation, "true"))
+			Expect(gotInstance.Spec.ExternalID).To(Equal("instance-id"))
+
+			gotBinding, err := svcCatClient.ServicecatalogV1beta1().ServiceBindings("staging").Get("mysql-binding", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotBinding.Annotations).To(HaveKeyWithValue(v1beta1.AdoptionAnnotation, "true"))
+
+			gotParams, err := k8sClient.CoreV1().Secrets("staging").Get("mysql-params", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotParams.OwnerReferences).To(BeEmpty())
+
+			gotCreds, err := k8sClient.CoreV1().Secrets("staging").Get("mysql-creds", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotCreds.Data).To(Equal(credsSecret.Data))
+			Expect(gotCreds.OwnerReferences).To(HaveLen(1))
+			Expect(gotCreds.OwnerReferences[0].Kind).To(Equal("ServiceBinding"))
+			Expect(gotCreds.OwnerReferences[0].Name).To(Equal("mysql-binding"))
+		})
+		It("Defaults to the namespace the bundle was exported from", func() {
+			err := sdk.Import(bundle, "")
+
+			Expect(err).NotTo(HaveOccurred())
+			_, err = svcCatClient.ServicecatalogV1beta1().ServiceInstances("dev").Get("mysql", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("Skips the resources that an earlier import created", func() {
+			Expect(sdk.Import(bundle, "staging")).To(Succeed())
+
+			err := sdk.Import(bundle, "staging")
+
+			Expect(err).NotTo(HaveOccurred())
+			instances, err := svcCatClient.ServicecatalogV1beta1().ServiceInstances("staging").List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances.Items).To(HaveLen(1))
+		})
+		It("Refuses to import over an instance with another external ID", func() {
+			existing := &v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "mysql", Namespace: "staging"},
+				Spec:       v1beta1.ServiceInstanceSpec{ExternalID: "other-instance-id"},
+			}
+			svcCatClient = fake.NewSimpleClientset(class, plan, existing)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			err := sdk.Import(bundle, "staging")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`instance staging/mysql already exists with external ID "other-instance-id"`))
+		})
+		It("Refuses to import over a secret with other data", func() {
+			existing := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "mysql-params", Namespace: "staging"},
+				Data:       map[string][]byte{"other": []byte("data")},
+			}
+			k8sClient = k8sfake.NewSimpleClientset(existing)
+			sdk.K8sClient = k8sClient
+
+			err := sdk.Import(bundle, "staging")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("secret staging/mysql-params already exists with different data"))
+		})
+		It("Refuses to import when no broker offers the class and plan", func() {
+			svcCatClient = fake.NewSimpleClientset(class)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			err := sdk.Import(bundle, "staging")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`class "mysql-class-id" plan "mysql-plan-id" (instance mysql)`))
+			Expect(svcCatClient.Actions()).To(HaveLen(2))
+		})
+	})
+})
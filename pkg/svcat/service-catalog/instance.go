@@ -164,9 +164,10 @@ func (sdk *SDK) InstanceToServiceClassAndPlan(instance *v1beta1.ServiceInstance,
 	}
 }
 
-// Provision creates an instance of a service class and plan.
+// Provision creates an instance of a service class and plan. As a dry run,
+// the instance is validated by the server and returned without being created.
 func (sdk *SDK) Provision(namespace, instanceName, externalID, className, planName string,
-	params interface{}, secrets map[string]string) (*v1beta1.ServiceInstance, error) {
+	params interface{}, secrets map[string]string, opts *ProvisionOptions) (*v1beta1.ServiceInstance, error) {
 
 	request := &v1beta1.ServiceInstance{
 		ObjectMeta: v1.ObjectMeta{
@@ -184,6 +185,22 @@ func (sdk *SDK) Provision(namespace, instanceName, externalID, className, planNa
 		},
 	}
 
+	if opts != nil && opts.DryRun {
+		// The generated client cannot send query parameters with a create.
+		result := &v1beta1.ServiceInstance{}
+		err := sdk.ServiceCatalog().RESTClient().Post().
+			Namespace(namespace).
+			Resource("serviceinstances").
+			Param("dryRun", "All").
+			Body(request).
+			Do().
+			Into(result)
+		if err != nil {
+			return nil, fmt.Errorf("provision request failed (%s)", err)
+		}
+		return result, nil
+	}
+
 	result, err := sdk.ServiceCatalog().ServiceInstances(namespace).Create(request)
 	if err != nil {
 		return nil, fmt.Errorf("provision request failed (%s)", err)
@@ -225,6 +242,126 @@ func (sdk *SDK) TouchInstance(ns, name string, retries int) error {
 	return fmt.Errorf("could not sync service broker after %d tries", retries)
 }
 
+// UpgradeInstance requests an upgrade of an instance to the current
+// maintenance version of its plan by setting the instance's
+// maintenanceInfoVersion.
+func (sdk *SDK) UpgradeInstance(ns, name string, retries int) (*v1beta1.ServiceInstance, error) {
+	for j := 0; j < retries; j++ {
+		inst, err := sdk.RetrieveInstance(ns, name)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := sdk.instancePlanMaintenanceInfo(inst)
+		if err != nil {
+			return nil, err
+		}
+		if info == nil {
+			return nil, fmt.Errorf("the plan of instance %s/%s has no maintenance version", ns, name)
+		}
+		if props := inst.Status.ExternalProperties; props != nil && props.MaintenanceInfo != nil &&
+			props.MaintenanceInfo.Version == info.Version {
+			return nil, fmt.Errorf("instance %s/%s is already on maintenance version %s", ns, name, info.Version)
+		}
+
+		inst.Spec.MaintenanceInfoVersion = info.Version
+
+		updated, err := sdk.ServiceCatalog().ServiceInstances(ns).Update(inst)
+		if err == nil {
+			return updated, nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("could not upgrade instance (%s)", err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return nil, fmt.Errorf("could not upgrade instance after %d tries", retries)
+}
+
+// CancelInstance cancels the operation in progress on an instance by
+// incrementing the instance's cancelRequests.
+func (sdk *SDK) CancelInstance(ns, name string, retries int) (*v1beta1.ServiceInstance, error) {
+	for j := 0; j < retries; j++ {
+		inst, err := sdk.RetrieveInstance(ns, name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch inst.Status.CurrentOperation {
+		case "":
+			return nil, fmt.Errorf("instance %s/%s has no operation in progress", ns, name)
+		case v1beta1.ServiceInstanceOperationDeprovision:
+			return nil, fmt.Errorf("the deprovision of instance %s/%s cannot be cancelled", ns, name)
+		}
+
+		inst.Spec.CancelRequests = inst.Spec.CancelRequests + 1
+
+		updated, err := sdk.ServiceCatalog().ServiceInstances(ns).Update(inst)
+		if err == nil {
+			return updated, nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("could not cancel instance operation (%s)", err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return nil, fmt.Errorf("could not cancel instance operation after %d tries", retries)
+}
+
+// RetryInstance increments the retryRequests field on an instance whose
+// operation failed terminally, so that the controller clears the Failed
+// condition and drives the operation again.
+func (sdk *SDK) RetryInstance(ns, name string, retries int) (*v1beta1.ServiceInstance, error) {
+	for j := 0; j < retries; j++ {
+		inst, err := sdk.RetrieveInstance(ns, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !sdk.IsInstanceFailed(inst) {
+			return nil, fmt.Errorf("instance %s/%s has not failed", ns, name)
+		}
+
+		inst.Spec.RetryRequests = inst.Spec.RetryRequests + 1
+
+		updated, err := sdk.ServiceCatalog().ServiceInstances(ns).Update(inst)
+		if err == nil {
+			return updated, nil
+		}
+		// if we didn't get a conflict, no idea what happened
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("could not retry instance (%s)", err)
+		}
+	}
+
+	// conflict after `retries` tries
+	return nil, fmt.Errorf("could not retry instance after %d tries", retries)
+}
+
+// instancePlanMaintenanceInfo returns the current maintenance info of the
+// instance's plan.
+func (sdk *SDK) instancePlanMaintenanceInfo(instance *v1beta1.ServiceInstance) (*v1beta1.MaintenanceInfo, error) {
+	switch {
+	case instance.Spec.ClusterServicePlanRef != nil:
+		plan, err := sdk.ServiceCatalog().ClusterServicePlans().Get(instance.Spec.ClusterServicePlanRef.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return plan.Spec.MaintenanceInfo, nil
+	case instance.Spec.ServicePlanRef != nil:
+		plan, err := sdk.ServiceCatalog().ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return plan.Spec.MaintenanceInfo, nil
+	}
+	return nil, fmt.Errorf("instance %s/%s does not reference a resolved plan", instance.Namespace, instance.Name)
+}
+
 // WaitForInstance waits for the instance to complete the current operation (or fail).
 func (sdk *SDK) WaitForInstance(ns, name string, interval time.Duration, timeout *time.Duration) (instance *v1beta1.ServiceInstance, err error) {
 	if timeout == nil {
@@ -17,14 +17,21 @@ limitations under the License.
 package servicecatalog_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/testing"
 
 	. "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
@@ -207,7 +214,7 @@ var _ = Describe("Instances", func() {
 			secrets["password"] = "abc123"
 			retries := 3
 
-			provisionedInstance, err := sdk.Provision(namespace, instanceName, "", className, planName, params, secrets)
+			provisionedInstance, err := sdk.Provision(namespace, instanceName, "", className, planName, params, secrets, nil)
 			Expect(err).To(BeNil())
 			// once for the provision request
 			actions := svcCatClient.Actions()
@@ -246,6 +253,114 @@ var _ = Describe("Instances", func() {
 			Expect(obj.Spec.UpdateRequests).To(Equal(int64(1)))
 		})
 	})
+	Describe("UpgradeInstance", func() {
+		var plan *v1beta1.ClusterServicePlan
+
+		BeforeEach(func() {
+			plan = &v1beta1.ClusterServicePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "small"},
+				Spec: v1beta1.ClusterServicePlanSpec{
+					CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+						MaintenanceInfo: &v1beta1.MaintenanceInfo{Version: "1.1.0"},
+					},
+				},
+			}
+			si.Spec.ClusterServicePlanRef = &v1beta1.ClusterObjectReference{Name: plan.Name}
+			si.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+				MaintenanceInfo: &v1beta1.MaintenanceInfo{Version: "1.0.0"},
+			}
+		})
+
+		It("Sets the instance's maintenance info version to the plan's", func() {
+			svcCatClient = fake.NewSimpleClientset(si, plan)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			upgraded, err := sdk.UpgradeInstance(si.Namespace, si.Name, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upgraded.Spec.MaintenanceInfoVersion).To(Equal("1.1.0"))
+
+			actions := svcCatClient.Actions()
+			Expect(actions[len(actions)-1].Matches("update", "serviceinstances")).To(BeTrue())
+		})
+
+		It("Bubbles up an error when the instance is already on the plan's version", func() {
+			si.Status.ExternalProperties.MaintenanceInfo.Version = "1.1.0"
+			svcCatClient = fake.NewSimpleClientset(si, plan)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.UpgradeInstance(si.Namespace, si.Name, 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("already on maintenance version 1.1.0"))
+		})
+
+		It("Bubbles up an error when the plan has no maintenance version", func() {
+			plan.Spec.MaintenanceInfo = nil
+			svcCatClient = fake.NewSimpleClientset(si, plan)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.UpgradeInstance(si.Namespace, si.Name, 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has no maintenance version"))
+		})
+	})
+	Describe("CancelInstance", func() {
+		It("Increments the instance's cancel requests", func() {
+			si.Status.CurrentOperation = v1beta1.ServiceInstanceOperationProvision
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			cancelled, err := sdk.CancelInstance(si.Namespace, si.Name, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cancelled.Spec.CancelRequests).To(Equal(int64(1)))
+
+			actions := svcCatClient.Actions()
+			Expect(actions[len(actions)-1].Matches("update", "serviceinstances")).To(BeTrue())
+		})
+
+		It("Bubbles up an error when there is no operation in progress", func() {
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.CancelInstance(si.Namespace, si.Name, 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has no operation in progress"))
+		})
+
+		It("Bubbles up an error when the instance is being deprovisioned", func() {
+			si.Status.CurrentOperation = v1beta1.ServiceInstanceOperationDeprovision
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.CancelInstance(si.Namespace, si.Name, 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cannot be cancelled"))
+		})
+	})
+	Describe("RetryInstance", func() {
+		It("Increments the instance's retry requests", func() {
+			si.Status.Conditions = []v1beta1.ServiceInstanceCondition{
+				{Type: v1beta1.ServiceInstanceConditionFailed, Status: v1beta1.ConditionTrue},
+			}
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			retried, err := sdk.RetryInstance(si.Namespace, si.Name, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retried.Spec.RetryRequests).To(Equal(int64(1)))
+
+			actions := svcCatClient.Actions()
+			Expect(actions[len(actions)-1].Matches("update", "serviceinstances")).To(BeTrue())
+		})
+
+		It("Bubbles up an error when the instance has not failed", func() {
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.RetryInstance(si.Namespace, si.Name, 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has not failed"))
+		})
+	})
 	Describe("InstanceParentHierarchy", func() {
 		It("calls the v1beta1 generated Get function repeatedly to build the heirarchy of the passed in service isntance", func() {
 			broker := &v1beta1.ClusterServiceBroker{ObjectMeta: metav1.ObjectMeta{Name: "foobar_broker"}}
@@ -460,7 +575,7 @@ var _ = Describe("Instances", func() {
 			secrets["username"] = "admin"
 			secrets["password"] = "abc123"
 
-			service, err := sdk.Provision(namespace, instanceName, externalID, className, planName, params, secrets)
+			service, err := sdk.Provision(namespace, instanceName, externalID, className, planName, params, secrets, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(service.Namespace).To(Equal(namespace))
@@ -492,6 +607,35 @@ var _ = Describe("Instances", func() {
 			Expect(objectFromRequest.Spec.ParametersFrom).Should(ConsistOf(param, param2))
 			Expect(objectFromRequest.Spec.ExternalID).To(Equal(externalID))
 		})
+		It("Requests a dry run with the dryRun query parameter", func() {
+			namespace := "cherry_namespace"
+			instanceName := "cherry"
+			className := "cherry_class"
+			planName := "cherry_plan"
+
+			var requestURL *url.URL
+			var objectFromRequest v1beta1.ServiceInstance
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestURL = r.URL
+				body, _ := ioutil.ReadAll(r.Body)
+				json.Unmarshal(body, &objectFromRequest)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write(body)
+			}))
+			defer server.Close()
+			client, err := clientset.NewForConfig(&rest.Config{Host: server.URL})
+			Expect(err).NotTo(HaveOccurred())
+			sdk.ServiceCatalogClient = client
+
+			result, err := sdk.Provision(namespace, instanceName, "", className, planName, nil, nil, &ProvisionOptions{DryRun: true})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Name).To(Equal(instanceName))
+			Expect(requestURL.Path).To(Equal("/apis/servicecatalog.k8s.io/v1beta1/namespaces/cherry_namespace/serviceinstances"))
+			Expect(requestURL.Query().Get("dryRun")).To(Equal("All"))
+			Expect(objectFromRequest.Spec.PlanReference.ClusterServicePlanExternalName).To(Equal(planName))
+			Expect(objectFromRequest.Annotations).To(BeEmpty())
+		})
 		It("Bubbles up errors", func() {
 			errorMessage := "error retrieving list"
 			namespace := "cherry_namespace"
@@ -509,7 +653,7 @@ var _ = Describe("Instances", func() {
 			})
 			sdk.ServiceCatalogClient = badClient
 
-			service, err := sdk.Provision(namespace, instanceName, "", className, planName, params, secrets)
+			service, err := sdk.Provision(namespace, instanceName, "", className, planName, params, secrets, nil)
 			Expect(service).To(BeNil())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(errorMessage))
@@ -26,6 +26,14 @@ type FilterOptions struct {
 	ClassID string
 }
 
+// ProvisionOptions allows for passing of optional fields to the instance
+// Provision method.
+type ProvisionOptions struct {
+	// DryRun asks the server to validate the instance, and return it as it
+	// would be created, without creating it.
+	DryRun bool
+}
+
 // RegisterOptions allows for passing of optional fields to the broker Register method.
 type RegisterOptions struct {
 	BasicSecret       string
@@ -20,11 +20,14 @@ import (
 	"time"
 
 	apiv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
 	apicorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
@@ -41,8 +44,10 @@ type SvcatClient interface {
 	RetrieveBinding(string, string) (*apiv1beta1.ServiceBinding, error)
 	RetrieveBindings(string) (*apiv1beta1.ServiceBindingList, error)
 	RetrieveBindingsByInstance(*apiv1beta1.ServiceInstance) ([]apiv1beta1.ServiceBinding, error)
+	RetryBinding(string, string, int) (*apiv1beta1.ServiceBinding, error)
 	Unbind(string, string) ([]types.NamespacedName, error)
 	WaitForBinding(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceBinding, error)
+	WatchBindings(string, string) (watch.Interface, error)
 
 	Deregister(string) error
 	RetrieveBrokers(opts ScopeOptions) ([]Broker, error)
@@ -50,6 +55,8 @@ type SvcatClient interface {
 	RetrieveBrokerByClass(*apiv1beta1.ClusterServiceClass) (*apiv1beta1.ClusterServiceBroker, error)
 	Register(string, string, *RegisterOptions) (*apiv1beta1.ClusterServiceBroker, error)
 	Sync(string, int) error
+	DiffBrokerCatalog(string) (*catalogdiff.Diff, error)
+	WatchBrokers(ScopeOptions, string) (watch.Interface, error)
 
 	RetrieveClasses(ScopeOptions) ([]Class, error)
 	RetrieveClassByName(string) (*apiv1beta1.ClusterServiceClass, error)
@@ -62,13 +69,17 @@ type SvcatClient interface {
 	InstanceToServiceClassAndPlan(*apiv1beta1.ServiceInstance) (*apiv1beta1.ClusterServiceClass, *apiv1beta1.ClusterServicePlan, error)
 	IsInstanceFailed(*apiv1beta1.ServiceInstance) bool
 	IsInstanceReady(*apiv1beta1.ServiceInstance) bool
-	Provision(string, string, string, string, string, interface{}, map[string]string) (*apiv1beta1.ServiceInstance, error)
+	Provision(string, string, string, string, string, interface{}, map[string]string, *ProvisionOptions) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstance(string, string) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstanceByBinding(*apiv1beta1.ServiceBinding) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstances(string, string, string) (*apiv1beta1.ServiceInstanceList, error)
 	RetrieveInstancesByPlan(*apiv1beta1.ClusterServicePlan) ([]apiv1beta1.ServiceInstance, error)
 	TouchInstance(string, string, int) error
+	UpgradeInstance(string, string, int) (*apiv1beta1.ServiceInstance, error)
+	CancelInstance(string, string, int) (*apiv1beta1.ServiceInstance, error)
+	RetryInstance(string, string, int) (*apiv1beta1.ServiceInstance, error)
 	WaitForInstance(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceInstance, error)
+	WatchInstances(string, string) (watch.Interface, error)
 
 	RetrievePlans(*FilterOptions) ([]apiv1beta1.ClusterServicePlan, error)
 	RetrievePlanByName(string) (*apiv1beta1.ClusterServicePlan, error)
@@ -78,6 +89,12 @@ type SvcatClient interface {
 
 	RetrieveSecretByBinding(*apiv1beta1.ServiceBinding) (*apicorev1.Secret, error)
 
+	RetrieveEvents(string, string, string) ([]apicorev1.Event, error)
+	WatchEvents(string, string, string) (watch.Interface, error)
+
+	Export(string) (*Bundle, error)
+	Import(*Bundle, string) error
+
 	ServerVersion() (*version.Info, error)
 }
 
@@ -85,6 +102,10 @@ type SvcatClient interface {
 type SDK struct {
 	K8sClient            kubernetes.Interface
 	ServiceCatalogClient clientset.Interface
+
+	// BrokerClientCreateFunc creates clients for talking to brokers directly.
+	// It defaults to osb.NewClient when unset.
+	BrokerClientCreateFunc osb.CreateFunc
 }
 
 // ServiceCatalog is the underlying generated Service Catalog versioned interface
@@ -22,10 +22,12 @@ import (
 	"time"
 
 	apiv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/catalogdiff"
 	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	apicorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 type FakeSvcatClient struct {
@@ -154,6 +156,21 @@ type FakeSvcatClient struct {
 		result1 []apiv1beta1.ServiceBinding
 		result2 error
 	}
+	RetryBindingStub        func(string, string, int) (*apiv1beta1.ServiceBinding, error)
+	retryBindingMutex       sync.RWMutex
+	retryBindingArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	retryBindingReturns struct {
+		result1 *apiv1beta1.ServiceBinding
+		result2 error
+	}
+	retryBindingReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceBinding
+		result2 error
+	}
 	UnbindStub        func(string, string) ([]types.NamespacedName, error)
 	unbindMutex       sync.RWMutex
 	unbindArgsForCall []struct {
@@ -184,6 +201,20 @@ type FakeSvcatClient struct {
 		result1 *apiv1beta1.ServiceBinding
 		result2 error
 	}
+	WatchBindingsStub        func(string, string) (watch.Interface, error)
+	watchBindingsMutex       sync.RWMutex
+	watchBindingsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	watchBindingsReturns struct {
+		result1 watch.Interface
+		result2 error
+	}
+	watchBindingsReturnsOnCall map[int]struct {
+		result1 watch.Interface
+		result2 error
+	}
 	DeregisterStub        func(string) error
 	deregisterMutex       sync.RWMutex
 	deregisterArgsForCall []struct {
@@ -261,6 +292,33 @@ type FakeSvcatClient struct {
 	syncReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DiffBrokerCatalogStub        func(string) (*catalogdiff.Diff, error)
+	diffBrokerCatalogMutex       sync.RWMutex
+	diffBrokerCatalogArgsForCall []struct {
+		arg1 string
+	}
+	diffBrokerCatalogReturns struct {
+		result1 *catalogdiff.Diff
+		result2 error
+	}
+	diffBrokerCatalogReturnsOnCall map[int]struct {
+		result1 *catalogdiff.Diff
+		result2 error
+	}
+	WatchBrokersStub        func(servicecatalog.ScopeOptions, string) (watch.Interface, error)
+	watchBrokersMutex       sync.RWMutex
+	watchBrokersArgsForCall []struct {
+		arg1 servicecatalog.ScopeOptions
+		arg2 string
+	}
+	watchBrokersReturns struct {
+		result1 watch.Interface
+		result2 error
+	}
+	watchBrokersReturnsOnCall map[int]struct {
+		result1 watch.Interface
+		result2 error
+	}
 	RetrieveClassesStub        func(servicecatalog.ScopeOptions) ([]servicecatalog.Class, error)
 	retrieveClassesMutex       sync.RWMutex
 	retrieveClassesArgsForCall []struct {
@@ -392,7 +450,7 @@ type FakeSvcatClient struct {
 	isInstanceReadyReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	ProvisionStub        func(string, string, string, string, string, interface{}, map[string]string) (*apiv1beta1.ServiceInstance, error)
+	ProvisionStub        func(string, string, string, string, string, interface{}, map[string]string, *servicecatalog.ProvisionOptions) (*apiv1beta1.ServiceInstance, error)
 	provisionMutex       sync.RWMutex
 	provisionArgsForCall []struct {
 		arg1 string
@@ -402,6 +460,7 @@ type FakeSvcatClient struct {
 		arg5 string
 		arg6 interface{}
 		arg7 map[string]string
+		arg8 *servicecatalog.ProvisionOptions
 	}
 	provisionReturns struct {
 		result1 *apiv1beta1.ServiceInstance
@@ -479,6 +538,51 @@ type FakeSvcatClient struct {
 	touchInstanceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpgradeInstanceStub        func(string, string, int) (*apiv1beta1.ServiceInstance, error)
+	upgradeInstanceMutex       sync.RWMutex
+	upgradeInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	upgradeInstanceReturns struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	upgradeInstanceReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	CancelInstanceStub        func(string, string, int) (*apiv1beta1.ServiceInstance, error)
+	cancelInstanceMutex       sync.RWMutex
+	cancelInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	cancelInstanceReturns struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	cancelInstanceReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	RetryInstanceStub        func(string, string, int) (*apiv1beta1.ServiceInstance, error)
+	retryInstanceMutex       sync.RWMutex
+	retryInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	retryInstanceReturns struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	retryInstanceReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
 	WaitForInstanceStub        func(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceInstance, error)
 	waitForInstanceMutex       sync.RWMutex
 	waitForInstanceArgsForCall []struct {
@@ -495,6 +599,20 @@ type FakeSvcatClient struct {
 		result1 *apiv1beta1.ServiceInstance
 		result2 error
 	}
+	WatchInstancesStub        func(string, string) (watch.Interface, error)
+	watchInstancesMutex       sync.RWMutex
+	watchInstancesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	watchInstancesReturns struct {
+		result1 watch.Interface
+		result2 error
+	}
+	watchInstancesReturnsOnCall map[int]struct {
+		result1 watch.Interface
+		result2 error
+	}
 	RetrievePlansStub        func(*servicecatalog.FilterOptions) ([]apiv1beta1.ClusterServicePlan, error)
 	retrievePlansMutex       sync.RWMutex
 	retrievePlansArgsForCall []struct {
@@ -574,6 +692,61 @@ type FakeSvcatClient struct {
 		result1 *apicorev1.Secret
 		result2 error
 	}
+	RetrieveEventsStub        func(string, string, string) ([]apicorev1.Event, error)
+	retrieveEventsMutex       sync.RWMutex
+	retrieveEventsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	retrieveEventsReturns struct {
+		result1 []apicorev1.Event
+		result2 error
+	}
+	retrieveEventsReturnsOnCall map[int]struct {
+		result1 []apicorev1.Event
+		result2 error
+	}
+	WatchEventsStub        func(string, string, string) (watch.Interface, error)
+	watchEventsMutex       sync.RWMutex
+	watchEventsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	watchEventsReturns struct {
+		result1 watch.Interface
+		result2 error
+	}
+	watchEventsReturnsOnCall map[int]struct {
+		result1 watch.Interface
+		result2 error
+	}
+	ExportStub        func(string) (*servicecatalog.Bundle, error)
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		arg1 string
+	}
+	exportReturns struct {
+		result1 *servicecatalog.Bundle
+		result2 error
+	}
+	exportReturnsOnCall map[int]struct {
+		result1 *servicecatalog.Bundle
+		result2 error
+	}
+	ImportStub        func(*servicecatalog.Bundle, string) error
+	importMutex       sync.RWMutex
+	importArgsForCall []struct {
+		arg1 *servicecatalog.Bundle
+		arg2 string
+	}
+	importReturns struct {
+		result1 error
+	}
+	importReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ServerVersionStub        func() (*version.Info, error)
 	serverVersionMutex       sync.RWMutex
 	serverVersionArgsForCall []struct{}
@@ -1061,6 +1234,59 @@ func (fake *FakeSvcatClient) RetrieveBindingsByInstanceReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetryBinding(arg1 string, arg2 string, arg3 int) (*apiv1beta1.ServiceBinding, error) {
+	fake.retryBindingMutex.Lock()
+	ret, specificReturn := fake.retryBindingReturnsOnCall[len(fake.retryBindingArgsForCall)]
+	fake.retryBindingArgsForCall = append(fake.retryBindingArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RetryBinding", []interface{}{arg1, arg2, arg3})
+	fake.retryBindingMutex.Unlock()
+	if fake.RetryBindingStub != nil {
+		return fake.RetryBindingStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retryBindingReturns.result1, fake.retryBindingReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetryBindingCallCount() int {
+	fake.retryBindingMutex.RLock()
+	defer fake.retryBindingMutex.RUnlock()
+	return len(fake.retryBindingArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetryBindingArgsForCall(i int) (string, string, int) {
+	fake.retryBindingMutex.RLock()
+	defer fake.retryBindingMutex.RUnlock()
+	return fake.retryBindingArgsForCall[i].arg1, fake.retryBindingArgsForCall[i].arg2, fake.retryBindingArgsForCall[i].arg3
+}
+
+func (fake *FakeSvcatClient) RetryBindingReturns(result1 *apiv1beta1.ServiceBinding, result2 error) {
+	fake.RetryBindingStub = nil
+	fake.retryBindingReturns = struct {
+		result1 *apiv1beta1.ServiceBinding
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetryBindingReturnsOnCall(i int, result1 *apiv1beta1.ServiceBinding, result2 error) {
+	fake.RetryBindingStub = nil
+	if fake.retryBindingReturnsOnCall == nil {
+		fake.retryBindingReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceBinding
+			result2 error
+		})
+	}
+	fake.retryBindingReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceBinding
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) Unbind(arg1 string, arg2 string) ([]types.NamespacedName, error) {
 	fake.unbindMutex.Lock()
 	ret, specificReturn := fake.unbindReturnsOnCall[len(fake.unbindArgsForCall)]
@@ -1167,6 +1393,58 @@ func (fake *FakeSvcatClient) WaitForBindingReturnsOnCall(i int, result1 *apiv1be
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) WatchBindings(arg1 string, arg2 string) (watch.Interface, error) {
+	fake.watchBindingsMutex.Lock()
+	ret, specificReturn := fake.watchBindingsReturnsOnCall[len(fake.watchBindingsArgsForCall)]
+	fake.watchBindingsArgsForCall = append(fake.watchBindingsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("WatchBindings", []interface{}{arg1, arg2})
+	fake.watchBindingsMutex.Unlock()
+	if fake.WatchBindingsStub != nil {
+		return fake.WatchBindingsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.watchBindingsReturns.result1, fake.watchBindingsReturns.result2
+}
+
+func (fake *FakeSvcatClient) WatchBindingsCallCount() int {
+	fake.watchBindingsMutex.RLock()
+	defer fake.watchBindingsMutex.RUnlock()
+	return len(fake.watchBindingsArgsForCall)
+}
+
+func (fake *FakeSvcatClient) WatchBindingsArgsForCall(i int) (string, string) {
+	fake.watchBindingsMutex.RLock()
+	defer fake.watchBindingsMutex.RUnlock()
+	return fake.watchBindingsArgsForCall[i].arg1, fake.watchBindingsArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) WatchBindingsReturns(result1 watch.Interface, result2 error) {
+	fake.WatchBindingsStub = nil
+	fake.watchBindingsReturns = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WatchBindingsReturnsOnCall(i int, result1 watch.Interface, result2 error) {
+	fake.WatchBindingsStub = nil
+	if fake.watchBindingsReturnsOnCall == nil {
+		fake.watchBindingsReturnsOnCall = make(map[int]struct {
+			result1 watch.Interface
+			result2 error
+		})
+	}
+	fake.watchBindingsReturnsOnCall[i] = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) Deregister(arg1 string) error {
 	fake.deregisterMutex.Lock()
 	ret, specificReturn := fake.deregisterReturnsOnCall[len(fake.deregisterArgsForCall)]
@@ -1470,6 +1748,109 @@ func (fake *FakeSvcatClient) SyncReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) DiffBrokerCatalog(arg1 string) (*catalogdiff.Diff, error) {
+	fake.diffBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.diffBrokerCatalogReturnsOnCall[len(fake.diffBrokerCatalogArgsForCall)]
+	fake.diffBrokerCatalogArgsForCall = append(fake.diffBrokerCatalogArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DiffBrokerCatalog", []interface{}{arg1})
+	fake.diffBrokerCatalogMutex.Unlock()
+	if fake.DiffBrokerCatalogStub != nil {
+		return fake.DiffBrokerCatalogStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.diffBrokerCatalogReturns.result1, fake.diffBrokerCatalogReturns.result2
+}
+
+func (fake *FakeSvcatClient) DiffBrokerCatalogCallCount() int {
+	fake.diffBrokerCatalogMutex.RLock()
+	defer fake.diffBrokerCatalogMutex.RUnlock()
+	return len(fake.diffBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeSvcatClient) DiffBrokerCatalogArgsForCall(i int) string {
+	fake.diffBrokerCatalogMutex.RLock()
+	defer fake.diffBrokerCatalogMutex.RUnlock()
+	return fake.diffBrokerCatalogArgsForCall[i].arg1
+}
+
+func (fake *FakeSvcatClient) DiffBrokerCatalogReturns(result1 *catalogdiff.Diff, result2 error) {
+	fake.DiffBrokerCatalogStub = nil
+	fake.diffBrokerCatalogReturns = struct {
+		result1 *catalogdiff.Diff
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) DiffBrokerCatalogReturnsOnCall(i int, result1 *catalogdiff.Diff, result2 error) {
+	fake.DiffBrokerCatalogStub = nil
+	if fake.diffBrokerCatalogReturnsOnCall == nil {
+		fake.diffBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 *catalogdiff.Diff
+			result2 error
+		})
+	}
+	fake.diffBrokerCatalogReturnsOnCall[i] = struct {
+		result1 *catalogdiff.Diff
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WatchBrokers(arg1 servicecatalog.ScopeOptions, arg2 string) (watch.Interface, error) {
+	fake.watchBrokersMutex.Lock()
+	ret, specificReturn := fake.watchBrokersReturnsOnCall[len(fake.watchBrokersArgsForCall)]
+	fake.watchBrokersArgsForCall = append(fake.watchBrokersArgsForCall, struct {
+		arg1 servicecatalog.ScopeOptions
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("WatchBrokers", []interface{}{arg1, arg2})
+	fake.watchBrokersMutex.Unlock()
+	if fake.WatchBrokersStub != nil {
+		return fake.WatchBrokersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.watchBrokersReturns.result1, fake.watchBrokersReturns.result2
+}
+
+func (fake *FakeSvcatClient) WatchBrokersCallCount() int {
+	fake.watchBrokersMutex.RLock()
+	defer fake.watchBrokersMutex.RUnlock()
+	return len(fake.watchBrokersArgsForCall)
+}
+
+func (fake *FakeSvcatClient) WatchBrokersArgsForCall(i int) (servicecatalog.ScopeOptions, string) {
+	fake.watchBrokersMutex.RLock()
+	defer fake.watchBrokersMutex.RUnlock()
+	return fake.watchBrokersArgsForCall[i].arg1, fake.watchBrokersArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) WatchBrokersReturns(result1 watch.Interface, result2 error) {
+	fake.WatchBrokersStub = nil
+	fake.watchBrokersReturns = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WatchBrokersReturnsOnCall(i int, result1 watch.Interface, result2 error) {
+	fake.WatchBrokersStub = nil
+	if fake.watchBrokersReturnsOnCall == nil {
+		fake.watchBrokersReturnsOnCall = make(map[int]struct {
+			result1 watch.Interface
+			result2 error
+		})
+	}
+	fake.watchBrokersReturnsOnCall[i] = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrieveClasses(arg1 servicecatalog.ScopeOptions) ([]servicecatalog.Class, error) {
 	fake.retrieveClassesMutex.Lock()
 	ret, specificReturn := fake.retrieveClassesReturnsOnCall[len(fake.retrieveClassesArgsForCall)]
@@ -1981,7 +2362,7 @@ func (fake *FakeSvcatClient) IsInstanceReadyReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *FakeSvcatClient) Provision(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 interface{}, arg7 map[string]string) (*apiv1beta1.ServiceInstance, error) {
+func (fake *FakeSvcatClient) Provision(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 interface{}, arg7 map[string]string, arg8 *servicecatalog.ProvisionOptions) (*apiv1beta1.ServiceInstance, error) {
 	fake.provisionMutex.Lock()
 	ret, specificReturn := fake.provisionReturnsOnCall[len(fake.provisionArgsForCall)]
 	fake.provisionArgsForCall = append(fake.provisionArgsForCall, struct {
@@ -1992,11 +2373,12 @@ func (fake *FakeSvcatClient) Provision(arg1 string, arg2 string, arg3 string, ar
 		arg5 string
 		arg6 interface{}
 		arg7 map[string]string
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
-	fake.recordInvocation("Provision", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+		arg8 *servicecatalog.ProvisionOptions
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
+	fake.recordInvocation("Provision", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	fake.provisionMutex.Unlock()
 	if fake.ProvisionStub != nil {
-		return fake.ProvisionStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return fake.ProvisionStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2010,10 +2392,10 @@ func (fake *FakeSvcatClient) ProvisionCallCount() int {
 	return len(fake.provisionArgsForCall)
 }
 
-func (fake *FakeSvcatClient) ProvisionArgsForCall(i int) (string, string, string, string, string, interface{}, map[string]string) {
+func (fake *FakeSvcatClient) ProvisionArgsForCall(i int) (string, string, string, string, string, interface{}, map[string]string, *servicecatalog.ProvisionOptions) {
 	fake.provisionMutex.RLock()
 	defer fake.provisionMutex.RUnlock()
-	return fake.provisionArgsForCall[i].arg1, fake.provisionArgsForCall[i].arg2, fake.provisionArgsForCall[i].arg3, fake.provisionArgsForCall[i].arg4, fake.provisionArgsForCall[i].arg5, fake.provisionArgsForCall[i].arg6, fake.provisionArgsForCall[i].arg7
+	return fake.provisionArgsForCall[i].arg1, fake.provisionArgsForCall[i].arg2, fake.provisionArgsForCall[i].arg3, fake.provisionArgsForCall[i].arg4, fake.provisionArgsForCall[i].arg5, fake.provisionArgsForCall[i].arg6, fake.provisionArgsForCall[i].arg7, fake.provisionArgsForCall[i].arg8
 }
 
 func (fake *FakeSvcatClient) ProvisionReturns(result1 *apiv1beta1.ServiceInstance, result2 error) {
@@ -2295,6 +2677,165 @@ func (fake *FakeSvcatClient) TouchInstanceReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) UpgradeInstance(arg1 string, arg2 string, arg3 int) (*apiv1beta1.ServiceInstance, error) {
+	fake.upgradeInstanceMutex.Lock()
+	ret, specificReturn := fake.upgradeInstanceReturnsOnCall[len(fake.upgradeInstanceArgsForCall)]
+	fake.upgradeInstanceArgsForCall = append(fake.upgradeInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpgradeInstance", []interface{}{arg1, arg2, arg3})
+	fake.upgradeInstanceMutex.Unlock()
+	if fake.UpgradeInstanceStub != nil {
+		return fake.UpgradeInstanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.upgradeInstanceReturns.result1, fake.upgradeInstanceReturns.result2
+}
+
+func (fake *FakeSvcatClient) UpgradeInstanceCallCount() int {
+	fake.upgradeInstanceMutex.RLock()
+	defer fake.upgradeInstanceMutex.RUnlock()
+	return len(fake.upgradeInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) UpgradeInstanceArgsForCall(i int) (string, string, int) {
+	fake.upgradeInstanceMutex.RLock()
+	defer fake.upgradeInstanceMutex.RUnlock()
+	return fake.upgradeInstanceArgsForCall[i].arg1, fake.upgradeInstanceArgsForCall[i].arg2, fake.upgradeInstanceArgsForCall[i].arg3
+}
+
+func (fake *FakeSvcatClient) UpgradeInstanceReturns(result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.UpgradeInstanceStub = nil
+	fake.upgradeInstanceReturns = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) UpgradeInstanceReturnsOnCall(i int, result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.UpgradeInstanceStub = nil
+	if fake.upgradeInstanceReturnsOnCall == nil {
+		fake.upgradeInstanceReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceInstance
+			result2 error
+		})
+	}
+	fake.upgradeInstanceReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) CancelInstance(arg1 string, arg2 string, arg3 int) (*apiv1beta1.ServiceInstance, error) {
+	fake.cancelInstanceMutex.Lock()
+	ret, specificReturn := fake.cancelInstanceReturnsOnCall[len(fake.cancelInstanceArgsForCall)]
+	fake.cancelInstanceArgsForCall = append(fake.cancelInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CancelInstance", []interface{}{arg1, arg2, arg3})
+	fake.cancelInstanceMutex.Unlock()
+	if fake.CancelInstanceStub != nil {
+		return fake.CancelInstanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelInstanceReturns.result1, fake.cancelInstanceReturns.result2
+}
+
+func (fake *FakeSvcatClient) CancelInstanceCallCount() int {
+	fake.cancelInstanceMutex.RLock()
+	defer fake.cancelInstanceMutex.RUnlock()
+	return len(fake.cancelInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) CancelInstanceArgsForCall(i int) (string, string, int) {
+	fake.cancelInstanceMutex.RLock()
+	defer fake.cancelInstanceMutex.RUnlock()
+	return fake.cancelInstanceArgsForCall[i].arg1, fake.cancelInstanceArgsForCall[i].arg2, fake.cancelInstanceArgsForCall[i].arg3
+}
+
+func (fake *FakeSvcatClient) CancelInstanceReturns(result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.CancelInstanceStub = nil
+	fake.cancelInstanceReturns = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) CancelInstanceReturnsOnCall(i int, result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.CancelInstanceStub = nil
+	if fake.cancelInstanceReturnsOnCall == nil {
+		fake.cancelInstanceReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceInstance
+			result2 error
+		})
+	}
+	fake.cancelInstanceReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetryInstance(arg1 string, arg2 string, arg3 int) (*apiv1beta1.ServiceInstance, error) {
+	fake.retryInstanceMutex.Lock()
+	ret, specificReturn := fake.retryInstanceReturnsOnCall[len(fake.retryInstanceArgsForCall)]
+	fake.retryInstanceArgsForCall = append(fake.retryInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RetryInstance", []interface{}{arg1, arg2, arg3})
+	fake.retryInstanceMutex.Unlock()
+	if fake.RetryInstanceStub != nil {
+		return fake.RetryInstanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retryInstanceReturns.result1, fake.retryInstanceReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetryInstanceCallCount() int {
+	fake.retryInstanceMutex.RLock()
+	defer fake.retryInstanceMutex.RUnlock()
+	return len(fake.retryInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetryInstanceArgsForCall(i int) (string, string, int) {
+	fake.retryInstanceMutex.RLock()
+	defer fake.retryInstanceMutex.RUnlock()
+	return fake.retryInstanceArgsForCall[i].arg1, fake.retryInstanceArgsForCall[i].arg2, fake.retryInstanceArgsForCall[i].arg3
+}
+
+func (fake *FakeSvcatClient) RetryInstanceReturns(result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.RetryInstanceStub = nil
+	fake.retryInstanceReturns = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetryInstanceReturnsOnCall(i int, result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.RetryInstanceStub = nil
+	if fake.retryInstanceReturnsOnCall == nil {
+		fake.retryInstanceReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceInstance
+			result2 error
+		})
+	}
+	fake.retryInstanceReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) WaitForInstance(arg1 string, arg2 string, arg3 time.Duration, arg4 *time.Duration) (*apiv1beta1.ServiceInstance, error) {
 	fake.waitForInstanceMutex.Lock()
 	ret, specificReturn := fake.waitForInstanceReturnsOnCall[len(fake.waitForInstanceArgsForCall)]
@@ -2349,6 +2890,58 @@ func (fake *FakeSvcatClient) WaitForInstanceReturnsOnCall(i int, result1 *apiv1b
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) WatchInstances(arg1 string, arg2 string) (watch.Interface, error) {
+	fake.watchInstancesMutex.Lock()
+	ret, specificReturn := fake.watchInstancesReturnsOnCall[len(fake.watchInstancesArgsForCall)]
+	fake.watchInstancesArgsForCall = append(fake.watchInstancesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("WatchInstances", []interface{}{arg1, arg2})
+	fake.watchInstancesMutex.Unlock()
+	if fake.WatchInstancesStub != nil {
+		return fake.WatchInstancesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.watchInstancesReturns.result1, fake.watchInstancesReturns.result2
+}
+
+func (fake *FakeSvcatClient) WatchInstancesCallCount() int {
+	fake.watchInstancesMutex.RLock()
+	defer fake.watchInstancesMutex.RUnlock()
+	return len(fake.watchInstancesArgsForCall)
+}
+
+func (fake *FakeSvcatClient) WatchInstancesArgsForCall(i int) (string, string) {
+	fake.watchInstancesMutex.RLock()
+	defer fake.watchInstancesMutex.RUnlock()
+	return fake.watchInstancesArgsForCall[i].arg1, fake.watchInstancesArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) WatchInstancesReturns(result1 watch.Interface, result2 error) {
+	fake.WatchInstancesStub = nil
+	fake.watchInstancesReturns = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WatchInstancesReturnsOnCall(i int, result1 watch.Interface, result2 error) {
+	fake.WatchInstancesStub = nil
+	if fake.watchInstancesReturnsOnCall == nil {
+		fake.watchInstancesReturnsOnCall = make(map[int]struct {
+			result1 watch.Interface
+			result2 error
+		})
+	}
+	fake.watchInstancesReturnsOnCall[i] = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) RetrievePlans(arg1 *servicecatalog.FilterOptions) ([]apiv1beta1.ClusterServicePlan, error) {
 	fake.retrievePlansMutex.Lock()
 	ret, specificReturn := fake.retrievePlansReturnsOnCall[len(fake.retrievePlansArgsForCall)]
@@ -2656,6 +3249,212 @@ func (fake *FakeSvcatClient) RetrieveSecretByBindingReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrieveEvents(arg1 string, arg2 string, arg3 string) ([]apicorev1.Event, error) {
+	fake.retrieveEventsMutex.Lock()
+	ret, specificReturn := fake.retrieveEventsReturnsOnCall[len(fake.retrieveEventsArgsForCall)]
+	fake.retrieveEventsArgsForCall = append(fake.retrieveEventsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RetrieveEvents", []interface{}{arg1, arg2, arg3})
+	fake.retrieveEventsMutex.Unlock()
+	if fake.RetrieveEventsStub != nil {
+		return fake.RetrieveEventsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retrieveEventsReturns.result1, fake.retrieveEventsReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsCallCount() int {
+	fake.retrieveEventsMutex.RLock()
+	defer fake.retrieveEventsMutex.RUnlock()
+	return len(fake.retrieveEventsArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsArgsForCall(i int) (string, string, string) {
+	fake.retrieveEventsMutex.RLock()
+	defer fake.retrieveEventsMutex.RUnlock()
+	return fake.retrieveEventsArgsForCall[i].arg1, fake.retrieveEventsArgsForCall[i].arg2, fake.retrieveEventsArgsForCall[i].arg3
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsReturns(result1 []apicorev1.Event, result2 error) {
+	fake.RetrieveEventsStub = nil
+	fake.retrieveEventsReturns = struct {
+		result1 []apicorev1.Event
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveEventsReturnsOnCall(i int, result1 []apicorev1.Event, result2 error) {
+	fake.RetrieveEventsStub = nil
+	if fake.retrieveEventsReturnsOnCall == nil {
+		fake.retrieveEventsReturnsOnCall = make(map[int]struct {
+			result1 []apicorev1.Event
+			result2 error
+		})
+	}
+	fake.retrieveEventsReturnsOnCall[i] = struct {
+		result1 []apicorev1.Event
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WatchEvents(arg1 string, arg2 string, arg3 string) (watch.Interface, error) {
+	fake.watchEventsMutex.Lock()
+	ret, specificReturn := fake.watchEventsReturnsOnCall[len(fake.watchEventsArgsForCall)]
+	fake.watchEventsArgsForCall = append(fake.watchEventsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("WatchEvents", []interface{}{arg1, arg2, arg3})
+	fake.watchEventsMutex.Unlock()
+	if fake.WatchEventsStub != nil {
+		return fake.WatchEventsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.watchEventsReturns.result1, fake.watchEventsReturns.result2
+}
+
+func (fake *FakeSvcatClient) WatchEventsCallCount() int {
+	fake.watchEventsMutex.RLock()
+	defer fake.watchEventsMutex.RUnlock()
+	return len(fake.watchEventsArgsForCall)
+}
+
+func (fake *FakeSvcatClient) WatchEventsArgsForCall(i int) (string, string, string) {
+	fake.watchEventsMutex.RLock()
+	defer fake.watchEventsMutex.RUnlock()
+	return fake.watchEventsArgsForCall[i].arg1, fake.watchEventsArgsForCall[i].arg2, fake.watchEventsArgsForCall[i].arg3
+}
+
+func (fake *FakeSvcatClient) WatchEventsReturns(result1 watch.Interface, result2 error) {
+	fake.WatchEventsStub = nil
+	fake.watchEventsReturns = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) WatchEventsReturnsOnCall(i int, result1 watch.Interface, result2 error) {
+	fake.WatchEventsStub = nil
+	if fake.watchEventsReturnsOnCall == nil {
+		fake.watchEventsReturnsOnCall = make(map[int]struct {
+			result1 watch.Interface
+			result2 error
+		})
+	}
+	fake.watchEventsReturnsOnCall[i] = struct {
+		result1 watch.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) Export(arg1 string) (*servicecatalog.Bundle, error) {
+	fake.exportMutex.Lock()
+	ret, specificReturn := fake.exportReturnsOnCall[len(fake.exportArgsForCall)]
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Export", []interface{}{arg1})
+	fake.exportMutex.Unlock()
+	if fake.ExportStub != nil {
+		return fake.ExportStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.exportReturns.result1, fake.exportReturns.result2
+}
+
+func (fake *FakeSvcatClient) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeSvcatClient) ExportArgsForCall(i int) string {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return fake.exportArgsForCall[i].arg1
+}
+
+func (fake *FakeSvcatClient) ExportReturns(result1 *servicecatalog.Bundle, result2 error) {
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 *servicecatalog.Bundle
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) ExportReturnsOnCall(i int, result1 *servicecatalog.Bundle, result2 error) {
+	fake.ExportStub = nil
+	if fake.exportReturnsOnCall == nil {
+		fake.exportReturnsOnCall = make(map[int]struct {
+			result1 *servicecatalog.Bundle
+			result2 error
+		})
+	}
+	fake.exportReturnsOnCall[i] = struct {
+		result1 *servicecatalog.Bundle
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) Import(arg1 *servicecatalog.Bundle, arg2 string) error {
+	fake.importMutex.Lock()
+	ret, specificReturn := fake.importReturnsOnCall[len(fake.importArgsForCall)]
+	fake.importArgsForCall = append(fake.importArgsForCall, struct {
+		arg1 *servicecatalog.Bundle
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Import", []interface{}{arg1, arg2})
+	fake.importMutex.Unlock()
+	if fake.ImportStub != nil {
+		return fake.ImportStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.importReturns.result1
+}
+
+func (fake *FakeSvcatClient) ImportCallCount() int {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	return len(fake.importArgsForCall)
+}
+
+func (fake *FakeSvcatClient) ImportArgsForCall(i int) (*servicecatalog.Bundle, string) {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	return fake.importArgsForCall[i].arg1, fake.importArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) ImportReturns(result1 error) {
+	fake.ImportStub = nil
+	fake.importReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSvcatClient) ImportReturnsOnCall(i int, result1 error) {
+	fake.ImportStub = nil
+	if fake.importReturnsOnCall == nil {
+		fake.importReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.importReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSvcatClient) ServerVersion() (*version.Info, error) {
 	fake.serverVersionMutex.Lock()
 	ret, specificReturn := fake.serverVersionReturnsOnCall[len(fake.serverVersionArgsForCall)]
@@ -2720,10 +3519,14 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveBindingsMutex.RUnlock()
 	fake.retrieveBindingsByInstanceMutex.RLock()
 	defer fake.retrieveBindingsByInstanceMutex.RUnlock()
+	fake.retryBindingMutex.RLock()
+	defer fake.retryBindingMutex.RUnlock()
 	fake.unbindMutex.RLock()
 	defer fake.unbindMutex.RUnlock()
 	fake.waitForBindingMutex.RLock()
 	defer fake.waitForBindingMutex.RUnlock()
+	fake.watchBindingsMutex.RLock()
+	defer fake.watchBindingsMutex.RUnlock()
 	fake.deregisterMutex.RLock()
 	defer fake.deregisterMutex.RUnlock()
 	fake.retrieveBrokersMutex.RLock()
@@ -2736,6 +3539,10 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.registerMutex.RUnlock()
 	fake.syncMutex.RLock()
 	defer fake.syncMutex.RUnlock()
+	fake.diffBrokerCatalogMutex.RLock()
+	defer fake.diffBrokerCatalogMutex.RUnlock()
+	fake.watchBrokersMutex.RLock()
+	defer fake.watchBrokersMutex.RUnlock()
 	fake.retrieveClassesMutex.RLock()
 	defer fake.retrieveClassesMutex.RUnlock()
 	fake.retrieveClassByNameMutex.RLock()
@@ -2744,6 +3551,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveClassByIDMutex.RUnlock()
 	fake.retrieveClassByPlanMutex.RLock()
 	defer fake.retrieveClassByPlanMutex.RUnlock()
+	fake.createClassMutex.RLock()
+	defer fake.createClassMutex.RUnlock()
 	fake.deprovisionMutex.RLock()
 	defer fake.deprovisionMutex.RUnlock()
 	fake.instanceParentHierarchyMutex.RLock()
@@ -2766,8 +3575,16 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveInstancesByPlanMutex.RUnlock()
 	fake.touchInstanceMutex.RLock()
 	defer fake.touchInstanceMutex.RUnlock()
+	fake.upgradeInstanceMutex.RLock()
+	defer fake.upgradeInstanceMutex.RUnlock()
+	fake.cancelInstanceMutex.RLock()
+	defer fake.cancelInstanceMutex.RUnlock()
+	fake.retryInstanceMutex.RLock()
+	defer fake.retryInstanceMutex.RUnlock()
 	fake.waitForInstanceMutex.RLock()
 	defer fake.waitForInstanceMutex.RUnlock()
+	fake.watchInstancesMutex.RLock()
+	defer fake.watchInstancesMutex.RUnlock()
 	fake.retrievePlansMutex.RLock()
 	defer fake.retrievePlansMutex.RUnlock()
 	fake.retrievePlanByNameMutex.RLock()
@@ -2780,6 +3597,14 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrievePlanByClassAndPlanNamesMutex.RUnlock()
 	fake.retrieveSecretByBindingMutex.RLock()
 	defer fake.retrieveSecretByBindingMutex.RUnlock()
+	fake.retrieveEventsMutex.RLock()
+	defer fake.retrieveEventsMutex.RUnlock()
+	fake.watchEventsMutex.RLock()
+	defer fake.watchEventsMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
 	fake.serverVersionMutex.RLock()
 	defer fake.serverVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,310 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	// InstanceKind is the kind of the objects that instance events involve.
+	InstanceKind = "ServiceInstance"
+
+	// BindingKind is the kind of the objects that binding events involve.
+	BindingKind = "ServiceBinding"
+
+	// ClusterBrokerKind is the kind of the objects that cluster-scoped broker
+	// events involve.
+	ClusterBrokerKind = "ClusterServiceBroker"
+
+	// BrokerKind is the kind of the objects that namespaced broker events
+	// involve.
+	BrokerKind = "ServiceBroker"
+)
+
+// WatchInstances watches the instances in a namespace, or a single instance
+// when name is set.
+func (sdk *SDK) WatchInstances(ns, name string) (watch.Interface, error) {
+	w, err := resumeWatch(func(resourceVersion string) (watch.Interface, error) {
+		return sdk.ServiceCatalog().ServiceInstances(ns).Watch(nameListOptions(name, resourceVersion))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch instances (%s)", err)
+	}
+	return w, nil
+}
+
+// WatchBindings watches the bindings in a namespace, or a single binding when
+// name is set.
+func (sdk *SDK) WatchBindings(ns, name string) (watch.Interface, error) {
+	w, err := resumeWatch(func(resourceVersion string) (watch.Interface, error) {
+		return sdk.ServiceCatalog().ServiceBindings(ns).Watch(nameListOptions(name, resourceVersion))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch bindings (%s)", err)
+	}
+	return w, nil
+}
+
+// WatchBrokers watches the brokers in the given scope, or a single broker
+// when name is set.
+func (sdk *SDK) WatchBrokers(opts ScopeOptions, name string) (watch.Interface, error) {
+	var watches []watch.Interface
+
+	if opts.Scope.Matches(ClusterScope) {
+		w, err := resumeWatch(func(resourceVersion string) (watch.Interface, error) {
+			return sdk.ServiceCatalog().ClusterServiceBrokers().Watch(nameListOptions(name, resourceVersion))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to watch cluster-scoped brokers (%s)", err)
+		}
+		watches = append(watches, w)
+	}
+
+	if opts.Scope.Matches(NamespaceScope) {
+		w, err := resumeWatch(func(resourceVersion string) (watch.Interface, error) {
+			return sdk.ServiceCatalog().ServiceBrokers(opts.Namespace).Watch(nameListOptions(name, resourceVersion))
+		})
+		if err != nil {
+			stopWatches(watches)
+			return nil, fmt.Errorf("unable to watch brokers in %q (%s)", opts.Namespace, err)
+		}
+		watches = append(watches, w)
+	}
+
+	return mergeWatches(watches...), nil
+}
+
+// RetrieveEvents lists the Kubernetes events recorded for the named object of
+// the given kind, ordered from the oldest to the most recent.
+func (sdk *SDK) RetrieveEvents(ns, kind, name string) ([]apicorev1.Event, error) {
+	events, err := sdk.Core().Events(ns).List(eventListOptions(kind, name))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list events for %s %q (%s)", kind, name, err)
+	}
+
+	items := events.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].LastTimestamp.Before(&items[j].LastTimestamp)
+	})
+	return items, nil
+}
+
+// WatchEvents watches the Kubernetes events recorded for the objects of the
+// given kind, or for a single object when name is set.
+func (sdk *SDK) WatchEvents(ns, kind, name string) (watch.Interface, error) {
+	w, err := resumeWatch(func(resourceVersion string) (watch.Interface, error) {
+		opts := eventListOptions(kind, name)
+		opts.ResourceVersion = resourceVersion
+		return sdk.Core().Events(ns).Watch(opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch events for %s (%s)", kind, err)
+	}
+	return w, nil
+}
+
+func nameListOptions(name, resourceVersion string) v1.ListOptions {
+	if name == "" {
+		return v1.ListOptions{ResourceVersion: resourceVersion}
+	}
+	return v1.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+		ResourceVersion: resourceVersion,
+	}
+}
+
+func eventListOptions(kind, name string) v1.ListOptions {
+	selector := fields.Set{"involvedObject.kind": kind}
+	if name != "" {
+		selector["involvedObject.name"] = name
+	}
+	return v1.ListOptions{
+		FieldSelector: selector.AsSelector().String(),
+	}
+}
+
+// mergedWatch combines the results of several watches into one.
+type mergedWatch struct {
+	watches  []watch.Interface
+	result   chan watch.Event
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+// mergeWatches returns a watch that delivers the events of all the given
+// watches. Its result channel is closed once all of them are closed, or when
+// it is stopped.
+func mergeWatches(watches ...watch.Interface) watch.Interface {
+	if len(watches) == 1 {
+		return watches[0]
+	}
+
+	m := &mergedWatch{
+		watches: watches,
+		result:  make(chan watch.Event),
+		stopped: make(chan struct{}),
+	}
+
+	var wg sync.WaitGroup
+	for _, w := range watches {
+		wg.Add(1)
+		go func(w watch.Interface) {
+			defer wg.Done()
+			for event := range w.ResultChan() {
+				select {
+				case m.result <- event:
+				case <-m.stopped:
+					return
+				}
+			}
+		}(w)
+	}
+	go func() {
+		wg.Wait()
+		close(m.result)
+	}()
+
+	return m
+}
+
+// Stop stops all the merged watches.
+func (m *mergedWatch) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopped)
+		stopWatches(m.watches)
+	})
+}
+
+// ResultChan returns the channel delivering the events of all the merged
+// watches.
+func (m *mergedWatch) ResultChan() <-chan watch.Event {
+	return m.result
+}
+
+func stopWatches(watches []watch.Interface) {
+	for _, w := range watches {
+		w.Stop()
+	}
+}
+
+// resumingWatch re-establishes a watch that the API server closed, which it
+// does periodically, from the resource version of the last object it
+// delivered, so that watching does not end after a few minutes.
+type resumingWatch struct {
+	start    func(resourceVersion string) (watch.Interface, error)
+	result   chan watch.Event
+	stopOnce sync.Once
+	stopped  chan struct{}
+
+	mutex   sync.Mutex
+	current watch.Interface
+}
+
+// resumeWatch starts a watch with the given function, and starts it again
+// from the last resource version seen whenever it is closed. If it cannot be
+// started again, an error event is delivered and the result channel closed.
+func resumeWatch(start func(resourceVersion string) (watch.Interface, error)) (watch.Interface, error) {
+	w, err := start("")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resumingWatch{
+		start:   start,
+		result:  make(chan watch.Event),
+		stopped: make(chan struct{}),
+		current: w,
+	}
+	go r.run(w)
+	return r, nil
+}
+
+func (r *resumingWatch) run(w watch.Interface) {
+	defer close(r.result)
+
+	resourceVersion := ""
+	for {
+		for event := range w.ResultChan() {
+			if event.Type != watch.Error {
+				if accessor, err := meta.Accessor(event.Object); err == nil {
+					resourceVersion = accessor.GetResourceVersion()
+				}
+			}
+			select {
+			case r.result <- event:
+			case <-r.stopped:
+				return
+			}
+		}
+
+		select {
+		case <-r.stopped:
+			return
+		default:
+		}
+
+		next, err := r.start(resourceVersion)
+		if err != nil {
+			status := &v1.Status{
+				Status:  v1.StatusFailure,
+				Message: fmt.Sprintf("unable to resume the watch (%s)", err),
+			}
+			select {
+			case r.result <- watch.Event{Type: watch.Error, Object: status}:
+			case <-r.stopped:
+			}
+			return
+		}
+
+		r.mutex.Lock()
+		r.current = next
+		r.mutex.Unlock()
+		// Stop may have stopped the previous watch instead of this one.
+		select {
+		case <-r.stopped:
+			next.Stop()
+			return
+		default:
+		}
+		w = next
+	}
+}
+
+// Stop stops the current watch.
+func (r *resumingWatch) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stopped)
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.current.Stop()
+	})
+}
+
+// ResultChan returns the channel delivering the events of the successive
+// watches.
+func (r *resumingWatch) ResultChan() <-chan watch.Event {
+	return r.result
+}
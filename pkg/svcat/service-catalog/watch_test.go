@@ -0,0 +1,258 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+
+	. "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Watch", func() {
+	var (
+		sdk          *SDK
+		k8sClient    *k8sfake.Clientset
+		svcCatClient *fake.Clientset
+		older        *corev1.Event
+		newer        *corev1.Event
+	)
+
+	BeforeEach(func() {
+		now := time.Now()
+		newer = &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "newer", Namespace: "foobar_namespace"},
+			InvolvedObject: corev1.ObjectReference{Kind: InstanceKind, Name: "foobar", Namespace: "foobar_namespace"},
+			LastTimestamp:  metav1.NewTime(now),
+		}
+		older = &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "older", Namespace: "foobar_namespace"},
+			InvolvedObject: corev1.ObjectReference{Kind: InstanceKind, Name: "foobar", Namespace: "foobar_namespace"},
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		}
+		k8sClient = k8sfake.NewSimpleClientset(newer, older)
+		svcCatClient = fake.NewSimpleClientset()
+		sdk = &SDK{
+			K8sClient:            k8sClient,
+			ServiceCatalogClient: svcCatClient,
+		}
+	})
+
+	Describe("WatchInstances", func() {
+		It("Watches the instances in the namespace", func() {
+			w, err := sdk.WatchInstances("foobar_namespace", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			actions := svcCatClient.Actions()
+			Expect(actions[0].Matches("watch", "serviceinstances")).To(BeTrue())
+			Expect(actions[0].GetNamespace()).To(Equal("foobar_namespace"))
+			Expect(actions[0].(testing.WatchActionImpl).GetWatchRestrictions().Fields.Empty()).To(BeTrue())
+		})
+		It("Restricts the watch to the named instance", func() {
+			w, err := sdk.WatchInstances("foobar_namespace", "foobar")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			restrictions := svcCatClient.Actions()[0].(testing.WatchActionImpl).GetWatchRestrictions()
+			Expect(restrictions.Fields.String()).To(Equal("metadata.name=foobar"))
+		})
+		It("Bubbles up errors", func() {
+			badClient := &fake.Clientset{}
+			errorMessage := "error watching instances"
+			badClient.AddWatchReactor("serviceinstances", func(action testing.Action) (bool, watch.Interface, error) {
+				return true, nil, errors.New(errorMessage)
+			})
+			sdk.ServiceCatalogClient = badClient
+
+			_, err := sdk.WatchInstances("foobar_namespace", "")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(errorMessage))
+		})
+	})
+
+	Describe("Resuming watches", func() {
+		var (
+			// started receives each watch started by the client, and the
+			// resource version it was started from.
+			started  chan *watch.FakeWatcher
+			versions chan string
+		)
+
+		BeforeEach(func() {
+			started = make(chan *watch.FakeWatcher, 2)
+			versions = make(chan string, 3)
+			client := &fake.Clientset{}
+			client.AddWatchReactor("serviceinstances", func(action testing.Action) (bool, watch.Interface, error) {
+				versions <- action.(testing.WatchActionImpl).GetWatchRestrictions().ResourceVersion
+				if len(versions) == 3 {
+					return true, nil, errors.New("watch refused")
+				}
+				w := watch.NewFakeWithChanSize(1, false)
+				started <- w
+				return true, w, nil
+			})
+			sdk.ServiceCatalogClient = client
+		})
+
+		It("Resumes a closed watch from the last resource version", func() {
+			w, err := sdk.WatchInstances("foobar_namespace", "foobar")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			first := <-started
+			first.Modify(&v1beta1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "foobar", ResourceVersion: "42"}})
+			Expect((<-w.ResultChan()).Type).To(Equal(watch.Modified))
+			first.Stop()
+
+			second := <-started
+			Expect(<-versions).To(Equal(""))
+			Expect(<-versions).To(Equal("42"))
+			second.Delete(&v1beta1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "foobar", ResourceVersion: "43"}})
+			Expect((<-w.ResultChan()).Type).To(Equal(watch.Deleted))
+		})
+		It("Ends with an error when the watch cannot be resumed", func() {
+			w, err := sdk.WatchInstances("foobar_namespace", "foobar")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			(<-started).Stop()
+			(<-started).Stop()
+
+			event := <-w.ResultChan()
+			Expect(event.Type).To(Equal(watch.Error))
+			Expect(event.Object.(*metav1.Status).Message).To(ContainSubstring("watch refused"))
+			_, open := <-w.ResultChan()
+			Expect(open).To(BeFalse())
+		})
+	})
+
+	Describe("WatchBindings", func() {
+		It("Watches the named binding", func() {
+			w, err := sdk.WatchBindings("foobar_namespace", "foobar")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			actions := svcCatClient.Actions()
+			Expect(actions[0].Matches("watch", "servicebindings")).To(BeTrue())
+			Expect(actions[0].(testing.WatchActionImpl).GetWatchRestrictions().Fields.String()).To(Equal("metadata.name=foobar"))
+		})
+	})
+
+	Describe("WatchBrokers", func() {
+		It("Watches both cluster-scoped and namespaced brokers", func() {
+			w, err := sdk.WatchBrokers(ScopeOptions{Scope: AllScope, Namespace: "foobar_namespace"}, "")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			actions := svcCatClient.Actions()
+			Expect(actions).To(HaveLen(2))
+			Expect(actions[0].Matches("watch", "clusterservicebrokers")).To(BeTrue())
+			Expect(actions[1].Matches("watch", "servicebrokers")).To(BeTrue())
+			Expect(actions[1].GetNamespace()).To(Equal("foobar_namespace"))
+		})
+		It("Delivers the events of all the watched brokers", func() {
+			clusterWatch := watch.NewFakeWithChanSize(1, false)
+			namespacedWatch := watch.NewFakeWithChanSize(1, false)
+			client := &fake.Clientset{}
+			client.AddWatchReactor("clusterservicebrokers", func(action testing.Action) (bool, watch.Interface, error) {
+				return true, clusterWatch, nil
+			})
+			client.AddWatchReactor("servicebrokers", func(action testing.Action) (bool, watch.Interface, error) {
+				return true, namespacedWatch, nil
+			})
+			sdk.ServiceCatalogClient = client
+
+			w, err := sdk.WatchBrokers(ScopeOptions{Scope: AllScope, Namespace: "foobar_namespace"}, "")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			clusterWatch.Add(&v1beta1.ClusterServiceBroker{ObjectMeta: metav1.ObjectMeta{Name: "global"}})
+			namespacedWatch.Add(&v1beta1.ServiceBroker{ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "foobar_namespace"}})
+
+			var names []string
+			for i := 0; i < 2; i++ {
+				event := <-w.ResultChan()
+				names = append(names, event.Object.(metav1.Object).GetName())
+			}
+			Expect(names).To(ConsistOf("global", "local"))
+		})
+		It("Only watches cluster-scoped brokers in the cluster scope", func() {
+			w, err := sdk.WatchBrokers(ScopeOptions{Scope: ClusterScope}, "")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			actions := svcCatClient.Actions()
+			Expect(actions).To(HaveLen(1))
+			Expect(actions[0].Matches("watch", "clusterservicebrokers")).To(BeTrue())
+		})
+	})
+
+	Describe("RetrieveEvents", func() {
+		It("Lists the events of the object from the oldest to the newest", func() {
+			events, err := sdk.RetrieveEvents("foobar_namespace", InstanceKind, "foobar")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].Name).To(Equal(older.Name))
+			Expect(events[1].Name).To(Equal(newer.Name))
+			actions := k8sClient.Actions()
+			Expect(actions[0].Matches("list", "events")).To(BeTrue())
+			restrictions := actions[0].(testing.ListActionImpl).GetListRestrictions()
+			Expect(restrictions.Fields.String()).To(Equal("involvedObject.kind=ServiceInstance,involvedObject.name=foobar"))
+		})
+		It("Bubbles up errors", func() {
+			badClient := &k8sfake.Clientset{}
+			errorMessage := "error listing events"
+			badClient.AddReactor("list", "events", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New(errorMessage)
+			})
+			sdk.K8sClient = badClient
+
+			_, err := sdk.RetrieveEvents("foobar_namespace", InstanceKind, "foobar")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(errorMessage))
+		})
+	})
+
+	Describe("WatchEvents", func() {
+		It("Watches the events of every object of the kind", func() {
+			w, err := sdk.WatchEvents("foobar_namespace", BindingKind, "")
+			Expect(err).NotTo(HaveOccurred())
+			defer w.Stop()
+
+			actions := k8sClient.Actions()
+			Expect(actions[0].Matches("watch", "events")).To(BeTrue())
+			restrictions := actions[0].(testing.WatchActionImpl).GetWatchRestrictions()
+			Expect(restrictions.Fields.String()).To(Equal("involvedObject.kind=ServiceBinding"))
+		})
+	})
+})
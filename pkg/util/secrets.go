@@ -0,0 +1,79 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// secretNotFoundTTL is how long a secret the API server did not find is
+// reported as not found without asking the API server again.
+const secretNotFoundTTL = 10 * time.Second
+
+// SecretNotFoundCache reads secrets that an informer has not seen from the
+// API server, and remembers for a short time those the API server did not
+// find either. Objects that reference a missing secret are then not a GET
+// against the API server on every sync. It is safe for concurrent use.
+type SecretNotFoundCache struct {
+	mutex    sync.Mutex
+	notFound map[string]time.Time
+	now      func() time.Time
+}
+
+// NewSecretNotFoundCache returns an empty SecretNotFoundCache.
+func NewSecretNotFoundCache() *SecretNotFoundCache {
+	return &SecretNotFoundCache{
+		notFound: make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+// Get reads the secret from the API server, unless the API server recently
+// did not find it, in which case a NotFound error is returned right away.
+func (c *SecretNotFoundCache) Get(client kubernetes.Interface, namespace, name string) (*corev1.Secret, error) {
+	key := namespace + "/" + name
+
+	c.mutex.Lock()
+	expiry, ok := c.notFound[key]
+	c.mutex.Unlock()
+	if ok && c.now().Before(expiry) {
+		return nil, errors.NewNotFound(corev1.Resource("secrets"), name)
+	}
+
+	secret, err := client.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !errors.IsNotFound(err) {
+		delete(c.notFound, key)
+		return secret, err
+	}
+	now := c.now()
+	for k, expiry := range c.notFound {
+		if !now.Before(expiry) {
+			delete(c.notFound, k)
+		}
+	}
+	c.notFound[key] = now.Add(secretNotFoundTTL)
+	return nil, err
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// TestSecretNotFoundCache ensures that a secret the API server did not find
+// is not read again until the not found result expires.
+func TestSecretNotFoundCache(t *testing.T) {
+	now := time.Now()
+	cache := NewSecretNotFoundCache()
+	cache.now = func() time.Time { return now }
+	client := fake.NewSimpleClientset()
+
+	gets := func() int {
+		count := 0
+		for _, action := range client.Actions() {
+			if action.GetVerb() == "get" {
+				count++
+			}
+		}
+		return count
+	}
+
+	if _, err := cache.Get(client, "brokers", "auth"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if _, err := cache.Get(client, "brokers", "auth"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if e, a := 1, gets(); e != a {
+		t.Fatalf("expected %d GET while the secret is remembered as missing, got %d", e, a)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "brokers", Name: "auth"}}
+	if _, err := client.CoreV1().Secrets("brokers").Create(secret); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now = now.Add(secretNotFoundTTL)
+	got, err := cache.Get(client, "brokers", "auth")
+	if err != nil {
+		t.Fatalf("unexpected error once the not found result expired: %v", err)
+	}
+	if e, a := "auth", got.Name; e != a {
+		t.Fatalf("expected secret %q, got %q", e, a)
+	}
+	if e, a := 2, gets(); e != a {
+		t.Fatalf("expected %d GETs, got %d", e, a)
+	}
+}
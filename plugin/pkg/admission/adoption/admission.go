@@ -0,0 +1,158 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package adoption
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	authorizationapi "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apiserver/pkg/admission"
+	kubeclientset "k8s.io/client-go/kubernetes"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceAdoptionSarCheck"
+
+	// adoptVerb is the verb a user must be allowed on instances or bindings
+	// to create them with the adoption annotation.
+	adoptVerb = "adopt"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewAdoptionSARCheck()
+	})
+}
+
+// adoptionSARCheck is an implementation of admission.Interface.
+// It enforces that a user who sets the adoption annotation on an instance or
+// binding is allowed the adopt verb on that resource. The controller records
+// adopted resources as provisioned or bound with the external ID the user
+// chose, so without this check any user who can create an instance could
+// claim an instance that belongs to someone else at the broker.
+type adoptionSARCheck struct {
+	*admission.Handler
+	client kubeclientset.Interface
+}
+
+var _ = scadmission.WantsKubeClientSet(&adoptionSARCheck{})
+
+func convertToSARExtra(extra map[string][]string) map[string]authorizationapi.ExtraValue {
+	if extra == nil {
+		return nil
+	}
+
+	ret := map[string]authorizationapi.ExtraValue{}
+	for k, v := range extra {
+		ret[k] = authorizationapi.ExtraValue(v)
+	}
+
+	return ret
+}
+
+func (s *adoptionSARCheck) Admit(a admission.Attributes) error {
+	// need to wait for our caches to warm
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetSubresource() != "" {
+		return nil
+	}
+	resource := a.GetResource().GroupResource()
+	if resource != servicecatalog.Resource("serviceinstances") && resource != servicecatalog.Resource("servicebindings") {
+		return nil
+	}
+
+	adopted, err := isAdopted(a.GetObject())
+	if err != nil || !adopted {
+		return err
+	}
+	// the annotation only has an effect before the resource has been
+	// provisioned or bound, but an update that adds it is checked as well
+	if a.GetOperation() == admission.Update && a.GetOldObject() != nil {
+		wasAdopted, err := isAdopted(a.GetOldObject())
+		if err != nil || wasAdopted {
+			return err
+		}
+	}
+
+	glog.V(5).Infof("%s %s/%s: evaluating access to adopt", resource.Resource, a.GetNamespace(), a.GetName())
+
+	userInfo := a.GetUserInfo()
+	sar := &authorizationapi.SubjectAccessReview{
+		Spec: authorizationapi.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationapi.ResourceAttributes{
+				Namespace: a.GetNamespace(),
+				Verb:      adoptVerb,
+				Group:     servicecatalog.GroupName,
+				Resource:  resource.Resource,
+				Name:      a.GetName(),
+			},
+			User:   userInfo.GetName(),
+			Groups: userInfo.GetGroups(),
+			Extra:  convertToSARExtra(userInfo.GetExtra()),
+			UID:    userInfo.GetUID(),
+		},
+	}
+	sar, err = s.client.AuthorizationV1().SubjectAccessReviews().Create(sar)
+	if err != nil {
+		return err
+	}
+
+	if !sar.Status.Allowed {
+		return admission.NewForbidden(a, fmt.Errorf("the %q annotation requires the %q verb on %s: Reason: %s, EvaluationError: %s", servicecatalog.AdoptionAnnotation, adoptVerb, resource.Resource, sar.Status.Reason, sar.Status.EvaluationError))
+	}
+	return nil
+}
+
+// isAdopted returns whether the object carries the adoption annotation.
+func isAdopted(obj interface{}) (bool, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	return accessor.GetAnnotations()[servicecatalog.AdoptionAnnotation] == "true", nil
+}
+
+// NewAdoptionSARCheck creates a new admission control handler that checks
+// whether the user may adopt the instances and bindings they create.
+func NewAdoptionSARCheck() (admission.Interface, error) {
+	return &adoptionSARCheck{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+func (s *adoptionSARCheck) SetKubeClientSet(client kubeclientset.Interface) {
+	s.client = client
+}
+
+func (s *adoptionSARCheck) ValidateInitialization() error {
+	if s.client == nil {
+		return fmt.Errorf("missing client")
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+package adoption
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	authorizationapi "k8s.io/api/authorization/v1"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(kubeClient kubeclientset.Interface) (admission.Interface, error) {
+	handler, err := NewAdoptionSARCheck()
+	if err != nil {
+		return nil, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(nil, nil, kubeClient, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, err
+}
+
+// newMockKubeClientForTest creates a mock kubernetes client whose SAR
+// creations are allowed unless the user is named "forbidden".
+func newMockKubeClientForTest(userInfo *user.DefaultInfo) *kubefake.Clientset {
+	mockClient := &kubefake.Clientset{}
+	allowed := userInfo.GetName() != "forbidden"
+	mockClient.AddReactor("create", "subjectaccessreviews", func(action core.Action) (bool, runtime.Object, error) {
+		mysar := &authorizationapi.SubjectAccessReview{
+			Status: authorizationapi.SubjectAccessReviewStatus{
+				Allowed: allowed,
+				Reason:  "seemed friendly enough",
+			},
+		}
+		return true, mysar, nil
+	})
+	return mockClient
+}
+
+// assertAdoptSAR checks the SAR created for the request, if any.
+func assertAdoptSAR(t *testing.T, name string, mockClient *kubefake.Clientset, resource string, expected bool) {
+	var sars []*authorizationapi.SubjectAccessReview
+	for _, action := range mockClient.Actions() {
+		if create, ok := action.(core.CreateAction); ok {
+			sars = append(sars, create.GetObject().(*authorizationapi.SubjectAccessReview))
+		}
+	}
+	if !expected {
+		if len(sars) != 0 {
+			t.Errorf("%s: expected no subject access review, got %+v", name, sars)
+		}
+		return
+	}
+	if len(sars) != 1 {
+		t.Fatalf("%s: expected one subject access review, got %d", name, len(sars))
+	}
+	attributes := sars[0].Spec.ResourceAttributes
+	if attributes.Verb != adoptVerb || attributes.Group != servicecatalog.GroupName || attributes.Resource != resource || attributes.Namespace != "test-ns" || attributes.Name != "test-name" {
+		t.Errorf("%s: unexpected subject access review attributes %+v", name, attributes)
+	}
+}
+
+// TestAdmissionAdoption tests Admit to ensure that instances and bindings
+// carrying the adoption annotation are only admitted when the SAR check for
+// the adopt verb allows it.
+func TestAdmissionAdoption(t *testing.T) {
+	adoptAnnotations := map[string]string{servicecatalog.AdoptionAnnotation: "true"}
+
+	cases := []struct {
+		name           string
+		resource       string
+		operation      admission.Operation
+		annotations    map[string]string
+		oldAnnotations map[string]string
+		userName       string
+		expectedSAR    bool
+		allowed        bool
+	}{
+		{
+			name:      "instance without the annotation",
+			resource:  "serviceinstances",
+			operation: admission.Create,
+			userName:  "forbidden",
+			allowed:   true,
+		},
+		{
+			name:        "adopted instance, user allowed",
+			resource:    "serviceinstances",
+			operation:   admission.Create,
+			annotations: adoptAnnotations,
+			userName:    "allowed",
+			expectedSAR: true,
+			allowed:     true,
+		},
+		{
+			name:        "adopted instance, user forbidden",
+			resource:    "serviceinstances",
+			operation:   admission.Create,
+			annotations: adoptAnnotations,
+			userName:    "forbidden",
+			expectedSAR: true,
+		},
+		{
+			name:        "adopted binding, user forbidden",
+			resource:    "servicebindings",
+			operation:   admission.Create,
+			annotations: adoptAnnotations,
+			userName:    "forbidden",
+			expectedSAR: true,
+		},
+		{
+			name:        "update adding the annotation, user forbidden",
+			resource:    "serviceinstances",
+			operation:   admission.Update,
+			annotations: adoptAnnotations,
+			userName:    "forbidden",
+			expectedSAR: true,
+		},
+		{
+			name:           "update keeping the annotation",
+			resource:       "serviceinstances",
+			operation:      admission.Update,
+			annotations:    adoptAnnotations,
+			oldAnnotations: adoptAnnotations,
+			userName:       "forbidden",
+			allowed:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		userInfo := &user.DefaultInfo{Name: tc.userName}
+		mockKubeClient := newMockKubeClientForTest(userInfo)
+		handler, err := newHandlerForTest(mockKubeClient)
+		if err != nil {
+			t.Fatalf("%s: unexpected error initializing handler: %v", tc.name, err)
+		}
+
+		var obj, oldObj runtime.Object
+		var kind string
+		objectMeta := metav1.ObjectMeta{Namespace: "test-ns", Name: "test-name", Annotations: tc.annotations}
+		oldObjectMeta := metav1.ObjectMeta{Namespace: "test-ns", Name: "test-name", Annotations: tc.oldAnnotations}
+		if tc.resource == "serviceinstances" {
+			kind = "ServiceInstance"
+			obj = &servicecatalog.ServiceInstance{ObjectMeta: objectMeta}
+			oldObj = &servicecatalog.ServiceInstance{ObjectMeta: oldObjectMeta}
+		} else {
+			kind = "ServiceBinding"
+			obj = &servicecatalog.ServiceBinding{ObjectMeta: objectMeta}
+			oldObj = &servicecatalog.ServiceBinding{ObjectMeta: oldObjectMeta}
+		}
+		if tc.operation == admission.Create {
+			oldObj = nil
+		}
+
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(obj, oldObj, servicecatalog.Kind(kind).WithVersion("version"), "test-ns", "test-name", servicecatalog.Resource(tc.resource).WithVersion("version"), "", tc.operation, userInfo))
+		if err != nil && tc.allowed || err == nil && !tc.allowed {
+			t.Errorf("%s: unexpected result from admission handler: %v", tc.name, err)
+		}
+		assertAdoptSAR(t, tc.name, mockKubeClient, tc.resource, tc.expectedSAR)
+	}
+}
@@ -26,6 +26,8 @@ import (
 	authorizationapi "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/admission"
 	kubeclientset "k8s.io/client-go/kubernetes"
 
@@ -45,7 +47,12 @@ func Register(plugins *admission.Plugins) {
 }
 
 // sarcheck is an implementation of admission.Interface.
-// It enforces the creator of a broker has proper access to the auth credentials
+// It enforces that the creator of a broker, instance, binding or notification
+// sink has access to the secrets it references. Service catalog reads those
+// secrets on the creator's behalf, to authenticate to a broker, to send
+// parameters to a broker, to copy keys into a binding's secret or to sign
+// notifications, so without this check a user could use service catalog to
+// read or use secrets they cannot read themselves.
 type sarcheck struct {
 	*admission.Handler
 	client kubeclientset.Interface
@@ -53,6 +60,14 @@ type sarcheck struct {
 
 var _ = scadmission.WantsKubeClientSet(&sarcheck{})
 
+// secretReference is a secret referenced by a service catalog resource.
+type secretReference struct {
+	namespace string
+	name      string
+	// usage describes what the secret is used for, for error messages.
+	usage string
+}
+
 func convertToSARExtra(extra map[string][]string) map[string]authorizationapi.ExtraValue {
 	if extra == nil {
 		return nil
@@ -71,42 +86,53 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 	if !s.WaitForReady() {
 		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
 	}
-	// only care about brokers
-	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("clusterservicebrokers") {
+	if a.GetResource().Group != servicecatalog.GroupName {
 		return nil
 	}
-	clusterServiceBroker, ok := a.GetObject().(*servicecatalog.ClusterServiceBroker)
-	if !ok {
-		return errors.NewBadRequest("Resource was marked with kind ClusterServiceBroker, but was unable to be converted")
+	// updates of subresources such as status do not change the secrets a
+	// resource references
+	if a.GetSubresource() != "" {
+		return nil
 	}
 
-	if clusterServiceBroker.Spec.AuthInfo == nil {
-		// no auth secret to check
-		return nil
+	kind, secretRefs, err := secretReferencesOf(a.GetResource().GroupResource(), a.GetNamespace(), a.GetObject())
+	if err != nil || len(secretRefs) == 0 {
+		return err
 	}
 
-	var secretRef *servicecatalog.ObjectReference
-	if clusterServiceBroker.Spec.AuthInfo.Basic != nil {
-		secretRef = clusterServiceBroker.Spec.AuthInfo.Basic.SecretRef
-	} else if clusterServiceBroker.Spec.AuthInfo.Bearer != nil {
-		secretRef = clusterServiceBroker.Spec.AuthInfo.Bearer.SecretRef
+	// only the secrets an update adds need to be checked; the user does not
+	// gain access to the secrets that were already referenced
+	if a.GetOperation() == admission.Update && a.GetOldObject() != nil {
+		_, oldSecretRefs, err := secretReferencesOf(a.GetResource().GroupResource(), a.GetNamespace(), a.GetOldObject())
+		if err != nil {
+			return err
+		}
+		secretRefs = addedSecretReferences(secretRefs, oldSecretRefs)
 	}
 
-	if secretRef == nil {
-		return nil
+	for _, secretRef := range secretRefs {
+		glog.V(5).Infof("%s %s/%s: evaluating access to %s %s/%s", kind, a.GetNamespace(), a.GetName(), secretRef.usage, secretRef.namespace, secretRef.name)
+		if err := s.checkSecretAccess(a, kind, secretRef); err != nil {
+			return err
+		}
 	}
-	glog.V(5).Infof("ClusterServiceBroker %+v: evaluating auth secret ref, with authInfo %q", clusterServiceBroker, secretRef)
+	return nil
+}
+
+// checkSecretAccess creates a subject access review to check whether the user
+// making the request can get the referenced secret.
+func (s *sarcheck) checkSecretAccess(a admission.Attributes, kind string, secretRef secretReference) error {
 	userInfo := a.GetUserInfo()
 
 	sar := &authorizationapi.SubjectAccessReview{
 		Spec: authorizationapi.SubjectAccessReviewSpec{
 			ResourceAttributes: &authorizationapi.ResourceAttributes{
-				Namespace: secretRef.Namespace,
+				Namespace: secretRef.namespace,
 				Verb:      "get",
 				Group:     corev1.SchemeGroupVersion.Group,
 				Version:   corev1.SchemeGroupVersion.Version,
 				Resource:  corev1.ResourceSecrets.String(),
-				Name:      secretRef.Name,
+				Name:      secretRef.name,
 			},
 			User:   userInfo.GetName(),
 			Groups: userInfo.GetGroups(),
@@ -120,11 +146,152 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 	}
 
 	if !sar.Status.Allowed {
-		return admission.NewForbidden(a, fmt.Errorf("broker forbidden access to auth secret (%s): Reason: %s, EvaluationError: %s", secretRef.Name, sar.Status.Reason, sar.Status.EvaluationError))
+		return admission.NewForbidden(a, fmt.Errorf("%s forbidden access to %s (%s): Reason: %s, EvaluationError: %s", kind, secretRef.usage, secretRef.name, sar.Status.Reason, sar.Status.EvaluationError))
 	}
 	return nil
 }
 
+// secretReferencesOf returns a short description of the kind of a resource
+// and the secrets it references. Resources that cannot reference secrets
+// return no references.
+func secretReferencesOf(resource schema.GroupResource, namespace string, obj runtime.Object) (string, []secretReference, error) {
+	switch resource {
+	case servicecatalog.Resource("clusterservicebrokers"):
+		broker, ok := obj.(*servicecatalog.ClusterServiceBroker)
+		if !ok {
+			return "", nil, errors.NewBadRequest("Resource was marked with kind ClusterServiceBroker, but was unable to be converted")
+		}
+		return "broker", clusterServiceBrokerSecretReferences(broker), nil
+	case servicecatalog.Resource("servicebrokers"):
+		broker, ok := obj.(*servicecatalog.ServiceBroker)
+		if !ok {
+			return "", nil, errors.NewBadRequest("Resource was marked with kind ServiceBroker, but was unable to be converted")
+		}
+		return "broker", serviceBrokerSecretReferences(namespace, broker), nil
+	case servicecatalog.Resource("serviceinstances"):
+		instance, ok := obj.(*servicecatalog.ServiceInstance)
+		if !ok {
+			return "", nil, errors.NewBadRequest("Resource was marked with kind ServiceInstance, but was unable to be converted")
+		}
+		return "instance", parametersFromSecretReferences(namespace, instance.Spec.ParametersFrom), nil
+	case servicecatalog.Resource("servicebindings"):
+		binding, ok := obj.(*servicecatalog.ServiceBinding)
+		if !ok {
+			return "", nil, errors.NewBadRequest("Resource was marked with kind ServiceBinding, but was unable to be converted")
+		}
+		secretRefs := parametersFromSecretReferences(namespace, binding.Spec.ParametersFrom)
+		for _, transform := range binding.Spec.SecretTransforms {
+			if transform.AddKeysFrom != nil && transform.AddKeysFrom.SecretRef != nil {
+				secretRefs = append(secretRefs, secretReference{
+					namespace: transform.AddKeysFrom.SecretRef.Namespace,
+					name:      transform.AddKeysFrom.SecretRef.Name,
+					usage:     "secret transform secret",
+				})
+			}
+		}
+		return "binding", secretRefs, nil
+	case servicecatalog.Resource("clusternotificationsinks"):
+		sink, ok := obj.(*servicecatalog.ClusterNotificationSink)
+		if !ok {
+			return "", nil, errors.NewBadRequest("Resource was marked with kind ClusterNotificationSink, but was unable to be converted")
+		}
+		if ref := sink.Spec.SigningSecretRef; ref != nil {
+			return "notification sink", []secretReference{{namespace: ref.Namespace, name: ref.Name, usage: "signing secret"}}, nil
+		}
+		return "notification sink", nil, nil
+	case servicecatalog.Resource("notificationsinks"):
+		sink, ok := obj.(*servicecatalog.NotificationSink)
+		if !ok {
+			return "", nil, errors.NewBadRequest("Resource was marked with kind NotificationSink, but was unable to be converted")
+		}
+		if ref := sink.Spec.SigningSecretRef; ref != nil {
+			return "notification sink", []secretReference{{namespace: namespace, name: ref.Name, usage: "signing secret"}}, nil
+		}
+		return "notification sink", nil, nil
+	}
+	return "", nil, nil
+}
+
+func clusterServiceBrokerSecretReferences(broker *servicecatalog.ClusterServiceBroker) []secretReference {
+	authInfo := broker.Spec.AuthInfo
+	if authInfo == nil {
+		// no auth secret to check
+		return nil
+	}
+
+	var secretRef *servicecatalog.ObjectReference
+	if authInfo.Basic != nil {
+		secretRef = authInfo.Basic.SecretRef
+	} else if authInfo.Bearer != nil {
+		secretRef = authInfo.Bearer.SecretRef
+	} else if authInfo.ClientCertificate != nil {
+		secretRef = authInfo.ClientCertificate.SecretRef
+	} else if authInfo.OAuth2ClientCredentials != nil {
+		secretRef = authInfo.OAuth2ClientCredentials.SecretRef
+	}
+
+	if secretRef == nil {
+		return nil
+	}
+	return []secretReference{{namespace: secretRef.Namespace, name: secretRef.Name, usage: "auth secret"}}
+}
+
+func serviceBrokerSecretReferences(namespace string, broker *servicecatalog.ServiceBroker) []secretReference {
+	authInfo := broker.Spec.AuthInfo
+	if authInfo == nil {
+		// no auth secret to check
+		return nil
+	}
+
+	var secretRef *servicecatalog.LocalObjectReference
+	if authInfo.Basic != nil {
+		secretRef = authInfo.Basic.SecretRef
+	} else if authInfo.Bearer != nil {
+		secretRef = authInfo.Bearer.SecretRef
+	} else if authInfo.ClientCertificate != nil {
+		secretRef = authInfo.ClientCertificate.SecretRef
+	} else if authInfo.OAuth2ClientCredentials != nil {
+		secretRef = authInfo.OAuth2ClientCredentials.SecretRef
+	}
+
+	if secretRef == nil {
+		return nil
+	}
+	return []secretReference{{namespace: namespace, name: secretRef.Name, usage: "auth secret"}}
+}
+
+func parametersFromSecretReferences(namespace string, parametersFrom []servicecatalog.ParametersFromSource) []secretReference {
+	var secretRefs []secretReference
+	for _, source := range parametersFrom {
+		if source.SecretKeyRef != nil {
+			secretRefs = append(secretRefs, secretReference{
+				namespace: namespace,
+				name:      source.SecretKeyRef.Name,
+				usage:     "parameters secret",
+			})
+		}
+	}
+	return secretRefs
+}
+
+// addedSecretReferences returns the secret references that are not in old.
+func addedSecretReferences(secretRefs, old []secretReference) []secretReference {
+	var added []secretReference
+	for _, secretRef := range secretRefs {
+		found := false
+		for _, oldSecretRef := range old {
+			if secretRef.namespace == oldSecretRef.namespace && secretRef.name == oldSecretRef.name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			added = append(added, secretRef)
+		}
+	}
+	return added
+}
+
 // NewSARCheck creates a new subject access review check admission control handler
 func NewSARCheck() (admission.Interface, error) {
 	return &sarcheck{
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -182,6 +183,116 @@ func TestAdmissionBroker(t *testing.T) {
 			},
 			allowed: false,
 		},
+		{
+			name: "broker with client certificate, user authenticated",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						ClientCertificate: &servicecatalog.ClusterClientCertificateAuthConfig{
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "test-secret",
+							},
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: "Manual",
+					},
+				},
+			},
+			userInfo: &user.DefaultInfo{
+				Name:   "system:serviceaccount:test-ns:catalog",
+				Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+			},
+			allowed: true,
+		},
+		{
+			name: "broker with client certificate, unauthenticated user",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						ClientCertificate: &servicecatalog.ClusterClientCertificateAuthConfig{
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "test-secret",
+							},
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: "Manual",
+					},
+				},
+			},
+			userInfo: &user.DefaultInfo{
+				Name:   "system:serviceaccount:test-ns:forbidden",
+				Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+			},
+			allowed: false,
+		},
+		{
+			name: "broker with oauth2 client credentials, user authenticated",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						OAuth2ClientCredentials: &servicecatalog.ClusterOAuth2ClientCredentialsAuthConfig{
+							TokenURL: "https://auth.example.com/token",
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "test-secret",
+							},
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: "Manual",
+					},
+				},
+			},
+			userInfo: &user.DefaultInfo{
+				Name:   "system:serviceaccount:test-ns:catalog",
+				Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+			},
+			allowed: true,
+		},
+		{
+			name: "broker with oauth2 client credentials, unauthenticated user",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						OAuth2ClientCredentials: &servicecatalog.ClusterOAuth2ClientCredentialsAuthConfig{
+							TokenURL: "https://auth.example.com/token",
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "test-secret",
+							},
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: "Manual",
+					},
+				},
+			},
+			userInfo: &user.DefaultInfo{
+				Name:   "system:serviceaccount:test-ns:forbidden",
+				Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+			},
+			allowed: false,
+		},
 		{
 			name: "broker with empty authInfo",
 			broker: &servicecatalog.ClusterServiceBroker{
@@ -245,3 +356,394 @@ func TestAdmissionBroker(t *testing.T) {
 		}
 	}
 }
+
+// TestAdmissionServiceBroker tests that namespaced brokers are subject to the
+// same check as cluster scoped ones.
+func TestAdmissionServiceBroker(t *testing.T) {
+	cases := []struct {
+		name     string
+		authInfo *servicecatalog.ServiceBrokerAuthInfo
+		userInfo *user.DefaultInfo
+		allowed  bool
+	}{
+		{
+			name:     "broker with no auth",
+			authInfo: nil,
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:  true,
+		},
+		{
+			name: "broker with basic auth, user authenticated",
+			authInfo: &servicecatalog.ServiceBrokerAuthInfo{
+				Basic: &servicecatalog.BasicAuthConfig{
+					SecretRef: &servicecatalog.LocalObjectReference{Name: "test-secret"},
+				},
+			},
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:catalog"},
+			allowed:  true,
+		},
+		{
+			name: "broker with bearer token, unauthenticated user",
+			authInfo: &servicecatalog.ServiceBrokerAuthInfo{
+				Bearer: &servicecatalog.BearerTokenAuthConfig{
+					SecretRef: &servicecatalog.LocalObjectReference{Name: "test-secret"},
+				},
+			},
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:  false,
+		},
+		{
+			name: "broker with client certificate, unauthenticated user",
+			authInfo: &servicecatalog.ServiceBrokerAuthInfo{
+				ClientCertificate: &servicecatalog.ClientCertificateAuthConfig{
+					SecretRef: &servicecatalog.LocalObjectReference{Name: "test-secret"},
+				},
+			},
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		broker := &servicecatalog.ServiceBroker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-broker",
+				Namespace: "test-ns",
+			},
+			Spec: servicecatalog.ServiceBrokerSpec{
+				AuthInfo: tc.authInfo,
+				CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+					URL:            "http://example.com",
+					RelistBehavior: "Manual",
+				},
+			},
+		}
+		mockKubeClient := newMockKubeClientForTest(tc.userInfo)
+		handler, kubeInformerFactory, err := newHandlerForTest(mockKubeClient)
+		if err != nil {
+			t.Errorf("unexpected error initializing handler: %v", err)
+		}
+		kubeInformerFactory.Start(wait.NeverStop)
+
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(broker, nil, servicecatalog.Kind("ServiceBroker").WithVersion("version"), broker.Namespace, broker.Name, servicecatalog.Resource("servicebrokers").WithVersion("version"), "", admission.Create, tc.userInfo))
+		if err != nil && tc.allowed || err == nil && !tc.allowed {
+			t.Errorf("Create test '%s' reports: Unexpected error returned from admission handler: %v", tc.name, err)
+		}
+		if tc.authInfo != nil {
+			assertSARNamespaces(t, tc.name, mockKubeClient, "test-ns")
+		} else {
+			assertSARNamespaces(t, tc.name, mockKubeClient)
+		}
+	}
+}
+
+// TestAdmissionServiceInstance tests that the secrets an instance's
+// parameters are read from are checked.
+func TestAdmissionServiceInstance(t *testing.T) {
+	cases := []struct {
+		name           string
+		parametersFrom []servicecatalog.ParametersFromSource
+		userInfo       *user.DefaultInfo
+		allowed        bool
+	}{
+		{
+			name:     "instance without parametersFrom",
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:  true,
+		},
+		{
+			name: "instance with parametersFrom, user authenticated",
+			parametersFrom: []servicecatalog.ParametersFromSource{
+				{SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-secret", Key: "params"}},
+			},
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:catalog"},
+			allowed:  true,
+		},
+		{
+			name: "instance with parametersFrom, unauthenticated user",
+			parametersFrom: []servicecatalog.ParametersFromSource{
+				{SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-secret", Key: "params"}},
+			},
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		instance := &servicecatalog.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-instance",
+				Namespace: "test-ns",
+			},
+			Spec: servicecatalog.ServiceInstanceSpec{
+				ParametersFrom: tc.parametersFrom,
+			},
+		}
+		mockKubeClient := newMockKubeClientForTest(tc.userInfo)
+		handler, kubeInformerFactory, err := newHandlerForTest(mockKubeClient)
+		if err != nil {
+			t.Errorf("unexpected error initializing handler: %v", err)
+		}
+		kubeInformerFactory.Start(wait.NeverStop)
+
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, tc.userInfo))
+		if err != nil && tc.allowed || err == nil && !tc.allowed {
+			t.Errorf("Create test '%s' reports: Unexpected error returned from admission handler: %v", tc.name, err)
+		}
+		if len(tc.parametersFrom) > 0 {
+			assertSARNamespaces(t, tc.name, mockKubeClient, "test-ns")
+		} else {
+			assertSARNamespaces(t, tc.name, mockKubeClient)
+		}
+	}
+}
+
+// TestAdmissionServiceBinding tests that the secrets a binding's parameters
+// are read from, and the secrets merged into its credentials, are checked.
+func TestAdmissionServiceBinding(t *testing.T) {
+	cases := []struct {
+		name             string
+		parametersFrom   []servicecatalog.ParametersFromSource
+		secretTransforms []servicecatalog.SecretTransform
+		userInfo         *user.DefaultInfo
+		allowed          bool
+		sarNamespaces    []string
+	}{
+		{
+			name:     "binding without secret references",
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:  true,
+		},
+		{
+			name: "binding with parametersFrom, unauthenticated user",
+			parametersFrom: []servicecatalog.ParametersFromSource{
+				{SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-secret", Key: "params"}},
+			},
+			userInfo:      &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:       false,
+			sarNamespaces: []string{"test-ns"},
+		},
+		{
+			name: "binding with addKeysFrom, user authenticated",
+			secretTransforms: []servicecatalog.SecretTransform{
+				{RenameKey: &servicecatalog.RenameKeyTransform{From: "a", To: "b"}},
+				{AddKeysFrom: &servicecatalog.AddKeysFromTransform{
+					SecretRef: &servicecatalog.ObjectReference{Namespace: "other-ns", Name: "test-secret"},
+				}},
+			},
+			userInfo:      &user.DefaultInfo{Name: "system:serviceaccount:test-ns:catalog"},
+			allowed:       true,
+			sarNamespaces: []string{"other-ns"},
+		},
+		{
+			name: "binding with addKeysFrom, unauthenticated user",
+			secretTransforms: []servicecatalog.SecretTransform{
+				{AddKeysFrom: &servicecatalog.AddKeysFromTransform{
+					SecretRef: &servicecatalog.ObjectReference{Namespace: "other-ns", Name: "test-secret"},
+				}},
+			},
+			userInfo:      &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:       false,
+			sarNamespaces: []string{"other-ns"},
+		},
+	}
+
+	for _, tc := range cases {
+		binding := &servicecatalog.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-binding",
+				Namespace: "test-ns",
+			},
+			Spec: servicecatalog.ServiceBindingSpec{
+				ParametersFrom:   tc.parametersFrom,
+				SecretTransforms: tc.secretTransforms,
+			},
+		}
+		mockKubeClient := newMockKubeClientForTest(tc.userInfo)
+		handler, kubeInformerFactory, err := newHandlerForTest(mockKubeClient)
+		if err != nil {
+			t.Errorf("unexpected error initializing handler: %v", err)
+		}
+		kubeInformerFactory.Start(wait.NeverStop)
+
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(binding, nil, servicecatalog.Kind("ServiceBinding").WithVersion("version"), binding.Namespace, binding.Name, servicecatalog.Resource("servicebindings").WithVersion("version"), "", admission.Create, tc.userInfo))
+		if err != nil && tc.allowed || err == nil && !tc.allowed {
+			t.Errorf("Create test '%s' reports: Unexpected error returned from admission handler: %v", tc.name, err)
+		}
+		assertSARNamespaces(t, tc.name, mockKubeClient, tc.sarNamespaces...)
+	}
+}
+
+// TestAdmissionNotificationSink tests that the signing secrets of cluster and
+// namespaced notification sinks are checked.
+func TestAdmissionNotificationSink(t *testing.T) {
+	cases := []struct {
+		name          string
+		sink          runtime.Object
+		kind          string
+		resource      string
+		userInfo      *user.DefaultInfo
+		allowed       bool
+		sarNamespaces []string
+	}{
+		{
+			name: "cluster sink without signing secret",
+			sink: &servicecatalog.ClusterNotificationSink{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-sink"},
+			},
+			kind:     "ClusterNotificationSink",
+			resource: "clusternotificationsinks",
+			userInfo: &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:  true,
+		},
+		{
+			name: "cluster sink with signing secret, user authenticated",
+			sink: &servicecatalog.ClusterNotificationSink{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-sink"},
+				Spec: servicecatalog.ClusterNotificationSinkSpec{
+					SigningSecretRef: &servicecatalog.ObjectReference{Namespace: "other-ns", Name: "test-secret"},
+				},
+			},
+			kind:          "ClusterNotificationSink",
+			resource:      "clusternotificationsinks",
+			userInfo:      &user.DefaultInfo{Name: "system:serviceaccount:test-ns:catalog"},
+			allowed:       true,
+			sarNamespaces: []string{"other-ns"},
+		},
+		{
+			name: "cluster sink with signing secret, unauthenticated user",
+			sink: &servicecatalog.ClusterNotificationSink{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-sink"},
+				Spec: servicecatalog.ClusterNotificationSinkSpec{
+					SigningSecretRef: &servicecatalog.ObjectReference{Namespace: "other-ns", Name: "test-secret"},
+				},
+			},
+			kind:          "ClusterNotificationSink",
+			resource:      "clusternotificationsinks",
+			userInfo:      &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:       false,
+			sarNamespaces: []string{"other-ns"},
+		},
+		{
+			name: "sink with signing secret, unauthenticated user",
+			sink: &servicecatalog.NotificationSink{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-sink", Namespace: "test-ns"},
+				Spec: servicecatalog.NotificationSinkSpec{
+					SigningSecretRef: &servicecatalog.LocalObjectReference{Name: "test-secret"},
+				},
+			},
+			kind:          "NotificationSink",
+			resource:      "notificationsinks",
+			userInfo:      &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"},
+			allowed:       false,
+			sarNamespaces: []string{"test-ns"},
+		},
+	}
+
+	for _, tc := range cases {
+		mockKubeClient := newMockKubeClientForTest(tc.userInfo)
+		handler, kubeInformerFactory, err := newHandlerForTest(mockKubeClient)
+		if err != nil {
+			t.Errorf("unexpected error initializing handler: %v", err)
+		}
+		kubeInformerFactory.Start(wait.NeverStop)
+
+		accessor, err := meta.Accessor(tc.sink)
+		if err != nil {
+			t.Fatalf("Create test '%s' reports: unexpected error: %v", tc.name, err)
+		}
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(tc.sink, nil, servicecatalog.Kind(tc.kind).WithVersion("version"), accessor.GetNamespace(), accessor.GetName(), servicecatalog.Resource(tc.resource).WithVersion("version"), "", admission.Create, tc.userInfo))
+		if err != nil && tc.allowed || err == nil && !tc.allowed {
+			t.Errorf("Create test '%s' reports: Unexpected error returned from admission handler: %v", tc.name, err)
+		}
+		assertSARNamespaces(t, tc.name, mockKubeClient, tc.sarNamespaces...)
+	}
+}
+
+// TestAdmissionUpdate tests that updates are only checked for the secrets
+// they add, and that subresource updates are not checked.
+func TestAdmissionUpdate(t *testing.T) {
+	userInfo := &user.DefaultInfo{Name: "system:serviceaccount:test-ns:forbidden"}
+	oldInstance := &servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-instance",
+			Namespace: "test-ns",
+		},
+		Spec: servicecatalog.ServiceInstanceSpec{
+			ParametersFrom: []servicecatalog.ParametersFromSource{
+				{SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "existing-secret", Key: "params"}},
+			},
+		},
+	}
+	unchangedInstance := oldInstance.DeepCopy()
+	unchangedInstance.Spec.UpdateRequests = 1
+	changedInstance := oldInstance.DeepCopy()
+	changedInstance.Spec.ParametersFrom = append(changedInstance.Spec.ParametersFrom, servicecatalog.ParametersFromSource{
+		SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "new-secret", Key: "params"},
+	})
+
+	cases := []struct {
+		name        string
+		instance    *servicecatalog.ServiceInstance
+		subresource string
+		allowed     bool
+	}{
+		{
+			name:     "update keeping the referenced secrets",
+			instance: unchangedInstance,
+			allowed:  true,
+		},
+		{
+			name:     "update adding a secret reference",
+			instance: changedInstance,
+			allowed:  false,
+		},
+		{
+			name:        "status update",
+			instance:    changedInstance,
+			subresource: "status",
+			allowed:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		mockKubeClient := newMockKubeClientForTest(userInfo)
+		handler, kubeInformerFactory, err := newHandlerForTest(mockKubeClient)
+		if err != nil {
+			t.Errorf("unexpected error initializing handler: %v", err)
+		}
+		kubeInformerFactory.Start(wait.NeverStop)
+
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(tc.instance, oldInstance, servicecatalog.Kind("ServiceInstance").WithVersion("version"), tc.instance.Namespace, tc.instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), tc.subresource, admission.Update, userInfo))
+		if err != nil && tc.allowed || err == nil && !tc.allowed {
+			t.Errorf("Update test '%s' reports: Unexpected error returned from admission handler: %v", tc.name, err)
+		}
+	}
+}
+
+// assertSARNamespaces checks that a subject access review was created for
+// secrets in each of the given namespaces, in order.
+func assertSARNamespaces(t *testing.T, name string, mockKubeClient *kubefake.Clientset, namespaces ...string) {
+	var sarNamespaces []string
+	for _, action := range mockKubeClient.Actions() {
+		createAction, ok := action.(core.CreateAction)
+		if !ok {
+			continue
+		}
+		sar, ok := createAction.GetObject().(*authorizationapi.SubjectAccessReview)
+		if !ok {
+			continue
+		}
+		sarNamespaces = append(sarNamespaces, sar.Spec.ResourceAttributes.Namespace)
+	}
+	if len(sarNamespaces) != len(namespaces) {
+		t.Errorf("Test '%s' reports: expected subject access reviews for namespaces %v, got %v", name, namespaces, sarNamespaces)
+		return
+	}
+	for i := range namespaces {
+		if namespaces[i] != sarNamespaces[i] {
+			t.Errorf("Test '%s' reports: expected subject access reviews for namespaces %v, got %v", name, namespaces, sarNamespaces)
+			return
+		}
+	}
+}
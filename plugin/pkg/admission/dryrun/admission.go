@@ -0,0 +1,338 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "DryRunValidation"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewDryRunValidation()
+	})
+}
+
+// dryRunValidation is an implementation of admission.ValidationInterface.
+// For Service Instances and Service Bindings created as a dry run, which the
+// API server marks with the dry-run annotation, it resolves the class, plan
+// and instance they reference and checks their parameters against the plan's
+// schema, so that a dry run reports the problems the controller would
+// otherwise only find once the resource has been persisted. Validation runs after all mutating plugins,
+// so the plan chosen by DefaultServicePlan is the one that is checked.
+type dryRunValidation struct {
+	*admission.Handler
+	internalClientSet internalclientset.Interface
+}
+
+var _ = scadmission.WantsInternalServiceCatalogClientSet(&dryRunValidation{})
+var _ = admission.ValidationInterface(&dryRunValidation{})
+
+// resolvedPlan is the part of a resolved class and plan that a dry run
+// checks a resource against.
+type resolvedPlan struct {
+	description    string
+	removed        bool
+	bindable       bool
+	instanceSchema *runtime.RawExtension
+	bindingSchema  *runtime.RawExtension
+}
+
+func (d *dryRunValidation) Validate(a admission.Attributes) error {
+	if a.GetResource().Group != servicecatalog.GroupName {
+		return nil
+	}
+
+	switch a.GetResource().GroupResource() {
+	case servicecatalog.Resource("serviceinstances"):
+		instance, ok := a.GetObject().(*servicecatalog.ServiceInstance)
+		if !ok {
+			return apierrors.NewBadRequest("Resource was marked with kind ServiceInstance but was unable to be converted")
+		}
+		if !isDryRun(instance.ObjectMeta) {
+			return nil
+		}
+		return d.validateInstance(a, instance)
+	case servicecatalog.Resource("servicebindings"):
+		binding, ok := a.GetObject().(*servicecatalog.ServiceBinding)
+		if !ok {
+			return apierrors.NewBadRequest("Resource was marked with kind ServiceBinding but was unable to be converted")
+		}
+		if !isDryRun(binding.ObjectMeta) {
+			return nil
+		}
+		return d.validateBinding(a, binding)
+	}
+
+	return nil
+}
+
+func (d *dryRunValidation) validateInstance(a admission.Attributes, instance *servicecatalog.ServiceInstance) error {
+	plan, err := d.resolvePlan(instance.Namespace, instance.Spec.PlanReference)
+	if err != nil {
+		glog.V(4).Infof(`ServiceInstance "%s/%s": dry run failed: %v`, instance.Namespace, instance.Name, err)
+		return admission.NewForbidden(a, err)
+	}
+
+	if plan.removed {
+		return admission.NewForbidden(a, fmt.Errorf("%s has been removed from the broker's catalog; cannot provision", plan.description))
+	}
+
+	if len(instance.Spec.ParametersFrom) == 0 {
+		problems, err := validateParameters(plan.instanceSchema, instance.Spec.Parameters)
+		if err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("unable to check parameters against the schema of %s: %v", plan.description, err))
+		}
+		if len(problems) > 0 {
+			return admission.NewForbidden(a, fmt.Errorf("parameters do not match the schema of %s: %s", plan.description, strings.Join(problems, "; ")))
+		}
+	}
+
+	glog.V(4).Infof(`ServiceInstance "%s/%s": dry run resolved %v to %s`, instance.Namespace, instance.Name, instance.Spec.PlanReference, plan.description)
+	return nil
+}
+
+func (d *dryRunValidation) validateBinding(a admission.Attributes, binding *servicecatalog.ServiceBinding) error {
+	instanceName := binding.Spec.ServiceInstanceRef.Name
+	instance, err := d.internalClientSet.Servicecatalog().ServiceInstances(binding.Namespace).Get(instanceName, apimachineryv1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("references a non-existent ServiceInstance %s/%s", binding.Namespace, instanceName))
+		}
+		return admission.NewForbidden(a, err)
+	}
+
+	plan, err := d.resolvePlan(instance.Namespace, instance.Spec.PlanReference)
+	if err != nil {
+		glog.V(4).Infof(`ServiceBinding "%s/%s": dry run failed: %v`, binding.Namespace, binding.Name, err)
+		return admission.NewForbidden(a, fmt.Errorf("unable to resolve the plan of ServiceInstance %s/%s: %v", instance.Namespace, instance.Name, err))
+	}
+
+	if !plan.bindable {
+		return admission.NewForbidden(a, fmt.Errorf("ServiceInstance %s/%s uses non-bindable %s", instance.Namespace, instance.Name, plan.description))
+	}
+
+	if len(binding.Spec.ParametersFrom) == 0 {
+		problems, err := validateParameters(plan.bindingSchema, binding.Spec.Parameters)
+		if err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("unable to check parameters against the schema of %s: %v", plan.description, err))
+		}
+		if len(problems) > 0 {
+			return admission.NewForbidden(a, fmt.Errorf("parameters do not match the binding schema of %s: %s", plan.description, strings.Join(problems, "; ")))
+		}
+	}
+
+	return nil
+}
+
+// resolvePlan resolves the class and plan named by ref, the same way the
+// controller does before it provisions an instance.
+func (d *dryRunValidation) resolvePlan(namespace string, ref servicecatalog.PlanReference) (*resolvedPlan, error) {
+	if ref.ClusterServiceClassSpecified() {
+		return d.resolveClusterServicePlan(ref)
+	} else if ref.ServiceClassSpecified() {
+		return d.resolveServicePlan(namespace, ref)
+	}
+	return nil, errors.New("class not specified")
+}
+
+func (d *dryRunValidation) resolveClusterServicePlan(ref servicecatalog.PlanReference) (*resolvedPlan, error) {
+	client := d.internalClientSet.Servicecatalog()
+
+	var class *servicecatalog.ClusterServiceClass
+	if ref.ClusterServiceClassName != "" {
+		c, err := client.ClusterServiceClasses().Get(ref.ClusterServiceClassName, apimachineryv1.GetOptions{})
+		if err != nil {
+			return nil, notFound(err, "references a non-existent ClusterServiceClass %c", ref)
+		}
+		class = c
+	} else {
+		classes, err := client.ClusterServiceClasses().List(fieldSelector(fields.Set{
+			ref.GetClusterServiceClassFilterFieldName(): ref.GetSpecifiedClusterServiceClass(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if len(classes.Items) != 1 {
+			return nil, fmt.Errorf("references a non-existent ClusterServiceClass %c or there is more than one (found: %d)", ref, len(classes.Items))
+		}
+		class = &classes.Items[0]
+	}
+
+	if !ref.ClusterServicePlanSpecified() {
+		return nil, fmt.Errorf("ClusterServicePlan not specified for ClusterServiceClass %q", class.Spec.ExternalName)
+	}
+
+	var plan *servicecatalog.ClusterServicePlan
+	if ref.ClusterServicePlanName != "" {
+		p, err := client.ClusterServicePlans().Get(ref.ClusterServicePlanName, apimachineryv1.GetOptions{})
+		if err != nil {
+			return nil, notFound(err, "references a non-existent ClusterServicePlan %b", ref)
+		}
+		if p.Spec.ClusterServiceClassRef.Name != class.Name {
+			return nil, fmt.Errorf("ClusterServicePlan %q does not belong to ClusterServiceClass %q", p.Spec.ExternalName, class.Spec.ExternalName)
+		}
+		plan = p
+	} else {
+		plans, err := client.ClusterServicePlans().List(fieldSelector(fields.Set{
+			ref.GetClusterServicePlanFilterFieldName(): ref.GetSpecifiedClusterServicePlan(),
+			"spec.clusterServiceClassRef.name":         class.Name,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if len(plans.Items) != 1 {
+			return nil, fmt.Errorf("references a non-existent ClusterServicePlan %b on ClusterServiceClass %q or there is more than one (found: %d)", ref, class.Spec.ExternalName, len(plans.Items))
+		}
+		plan = &plans.Items[0]
+	}
+
+	return &resolvedPlan{
+		description:    fmt.Sprintf("ClusterServicePlan (K8S: %q ExternalName: %q) of ClusterServiceClass %q", plan.Name, plan.Spec.ExternalName, class.Spec.ExternalName),
+		removed:        class.Status.RemovedFromBrokerCatalog || plan.Status.RemovedFromBrokerCatalog,
+		bindable:       isBindable(class.Spec.CommonServiceClassSpec, plan.Spec.CommonServicePlanSpec),
+		instanceSchema: plan.Spec.ServiceInstanceCreateParameterSchema,
+		bindingSchema:  plan.Spec.ServiceBindingCreateParameterSchema,
+	}, nil
+}
+
+func (d *dryRunValidation) resolveServicePlan(namespace string, ref servicecatalog.PlanReference) (*resolvedPlan, error) {
+	client := d.internalClientSet.Servicecatalog()
+
+	var class *servicecatalog.ServiceClass
+	if ref.ServiceClassName != "" {
+		c, err := client.ServiceClasses(namespace).Get(ref.ServiceClassName, apimachineryv1.GetOptions{})
+		if err != nil {
+			return nil, notFound(err, "references a non-existent ServiceClass %c", ref)
+		}
+		class = c
+	} else {
+		classes, err := client.ServiceClasses(namespace).List(fieldSelector(fields.Set{
+			ref.GetServiceClassFilterFieldName(): ref.GetSpecifiedServiceClass(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if len(classes.Items) != 1 {
+			return nil, fmt.Errorf("references a non-existent ServiceClass %c or there is more than one (found: %d)", ref, len(classes.Items))
+		}
+		class = &classes.Items[0]
+	}
+
+	if !ref.ServicePlanSpecified() {
+		return nil, fmt.Errorf("ServicePlan not specified for ServiceClass %q", class.Spec.ExternalName)
+	}
+
+	var plan *servicecatalog.ServicePlan
+	if ref.ServicePlanName != "" {
+		p, err := client.ServicePlans(namespace).Get(ref.ServicePlanName, apimachineryv1.GetOptions{})
+		if err != nil {
+			return nil, notFound(err, "references a non-existent ServicePlan %b", ref)
+		}
+		if p.Spec.ServiceClassRef.Name != class.Name {
+			return nil, fmt.Errorf("ServicePlan %q does not belong to ServiceClass %q", p.Spec.ExternalName, class.Spec.ExternalName)
+		}
+		plan = p
+	} else {
+		plans, err := client.ServicePlans(namespace).List(fieldSelector(fields.Set{
+			ref.GetServicePlanFilterFieldName(): ref.GetSpecifiedServicePlan(),
+			"spec.serviceClassRef.name":         class.Name,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if len(plans.Items) != 1 {
+			return nil, fmt.Errorf("references a non-existent ServicePlan %b on ServiceClass %q or there is more than one (found: %d)", ref, class.Spec.ExternalName, len(plans.Items))
+		}
+		plan = &plans.Items[0]
+	}
+
+	return &resolvedPlan{
+		description:    fmt.Sprintf("ServicePlan (K8S: %q ExternalName: %q) of ServiceClass %q", plan.Name, plan.Spec.ExternalName, class.Spec.ExternalName),
+		removed:        class.Status.RemovedFromBrokerCatalog || plan.Status.RemovedFromBrokerCatalog,
+		bindable:       isBindable(class.Spec.CommonServiceClassSpec, plan.Spec.CommonServicePlanSpec),
+		instanceSchema: plan.Spec.ServiceInstanceCreateParameterSchema,
+		bindingSchema:  plan.Spec.ServiceBindingCreateParameterSchema,
+	}, nil
+}
+
+// isDryRun returns whether the resource carries the dry-run annotation, which
+// the API server sets on the resources it admits during a dry run.
+func isDryRun(meta apimachineryv1.ObjectMeta) bool {
+	return meta.Annotations[servicecatalog.DryRunAnnotation] == "true"
+}
+
+// isBindable returns whether instances of the plan can be bound to. The
+// plan's own setting overrides the class's.
+func isBindable(class servicecatalog.CommonServiceClassSpec, plan servicecatalog.CommonServicePlanSpec) bool {
+	if plan.Bindable != nil {
+		return *plan.Bindable
+	}
+	return class.Bindable
+}
+
+func fieldSelector(set fields.Set) apimachineryv1.ListOptions {
+	return apimachineryv1.ListOptions{FieldSelector: fields.SelectorFromSet(set).String()}
+}
+
+// notFound replaces a not found error with a message naming the reference
+// that could not be resolved.
+func notFound(err error, format string, ref servicecatalog.PlanReference) error {
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf(format, ref)
+	}
+	return err
+}
+
+// NewDryRunValidation creates a new admission control handler that resolves
+// and validates Service Instances and Service Bindings created as a dry run.
+func NewDryRunValidation() (admission.Interface, error) {
+	return &dryRunValidation{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+func (d *dryRunValidation) SetInternalServiceCatalogClientSet(i internalclientset.Interface) {
+	d.internalClientSet = i
+}
+
+func (d *dryRunValidation) ValidateInitialization() error {
+	if d.internalClientSet == nil {
+		return errors.New("missing service catalog clientset")
+	}
+	return nil
+}
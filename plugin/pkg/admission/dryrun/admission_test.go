@@ -0,0 +1,312 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+)
+
+const planSchema = `{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "type": "object",
+  "properties": {
+    "size": {"type": "string", "enum": ["small", "large"]}
+  },
+  "required": ["size"]
+}`
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(objects ...runtime.Object) (admission.ValidationInterface, error) {
+	handler, err := NewDryRunValidation()
+	if err != nil {
+		return nil, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(fake.NewSimpleClientset(objects...), nil, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler.(admission.ValidationInterface), err
+}
+
+func newClusterServiceClass(bindable bool) *servicecatalog.ClusterServiceClass {
+	return &servicecatalog.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "class-id"},
+		Spec: servicecatalog.ClusterServiceClassSpec{
+			CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+				ExternalName: "database",
+				ExternalID:   "class-id",
+				Bindable:     bindable,
+			},
+		},
+	}
+}
+
+func newClusterServicePlan(className string) *servicecatalog.ClusterServicePlan {
+	return &servicecatalog.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "plan-id"},
+		Spec: servicecatalog.ClusterServicePlanSpec{
+			CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+				ExternalName:                         "standard",
+				ExternalID:                           "plan-id",
+				ServiceInstanceCreateParameterSchema: &runtime.RawExtension{Raw: []byte(planSchema)},
+				ServiceBindingCreateParameterSchema:  &runtime.RawExtension{Raw: []byte(planSchema)},
+			},
+			ClusterServiceClassRef: servicecatalog.ClusterObjectReference{Name: className},
+		},
+	}
+}
+
+func newServiceClass() *servicecatalog.ServiceClass {
+	return &servicecatalog.ServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "class-id", Namespace: "dev"},
+		Spec: servicecatalog.ServiceClassSpec{
+			CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+				ExternalName: "database",
+				ExternalID:   "class-id",
+				Bindable:     true,
+			},
+		},
+	}
+}
+
+func newServicePlan() *servicecatalog.ServicePlan {
+	return &servicecatalog.ServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "plan-id", Namespace: "dev"},
+		Spec: servicecatalog.ServicePlanSpec{
+			CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+				ExternalName:                         "standard",
+				ExternalID:                           "plan-id",
+				ServiceInstanceCreateParameterSchema: &runtime.RawExtension{Raw: []byte(planSchema)},
+			},
+			ServiceClassRef: servicecatalog.LocalObjectReference{Name: "class-id"},
+		},
+	}
+}
+
+func newDryRunInstance(ref servicecatalog.PlanReference, parameters string) *servicecatalog.ServiceInstance {
+	instance := &servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "instance",
+			Namespace:   "dev",
+			Annotations: map[string]string{servicecatalog.DryRunAnnotation: "true"},
+		},
+		Spec: servicecatalog.ServiceInstanceSpec{
+			PlanReference: ref,
+		},
+	}
+	if parameters != "" {
+		instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(parameters)}
+	}
+	return instance
+}
+
+func newDryRunBinding(parameters string) *servicecatalog.ServiceBinding {
+	binding := &servicecatalog.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "binding",
+			Namespace:   "dev",
+			Annotations: map[string]string{servicecatalog.DryRunAnnotation: "true"},
+		},
+		Spec: servicecatalog.ServiceBindingSpec{
+			ServiceInstanceRef: servicecatalog.LocalObjectReference{Name: "instance"},
+		},
+	}
+	if parameters != "" {
+		binding.Spec.Parameters = &runtime.RawExtension{Raw: []byte(parameters)}
+	}
+	return binding
+}
+
+func instanceAttributes(instance *servicecatalog.ServiceInstance) admission.Attributes {
+	return admission.NewAttributesRecord(instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, nil)
+}
+
+func bindingAttributes(binding *servicecatalog.ServiceBinding) admission.Attributes {
+	return admission.NewAttributesRecord(binding, nil, servicecatalog.Kind("ServiceBinding").WithVersion("version"), binding.Namespace, binding.Name, servicecatalog.Resource("servicebindings").WithVersion("version"), "", admission.Create, nil)
+}
+
+var clusterPlanReference = servicecatalog.PlanReference{
+	ClusterServiceClassExternalName: "database",
+	ClusterServicePlanExternalName:  "standard",
+}
+
+func TestValidateInstance(t *testing.T) {
+	removedPlan := newClusterServicePlan("class-id")
+	removedPlan.Status.RemovedFromBrokerCatalog = true
+
+	cases := []struct {
+		name     string
+		objects  []runtime.Object
+		instance *servicecatalog.ServiceInstance
+		errMsg   string
+	}{
+		{
+			name:     "valid cluster plan",
+			objects:  []runtime.Object{newClusterServiceClass(true), newClusterServicePlan("class-id")},
+			instance: newDryRunInstance(clusterPlanReference, `{"size": "small"}`),
+		},
+		{
+			name:    "valid cluster plan by k8s name",
+			objects: []runtime.Object{newClusterServiceClass(true), newClusterServicePlan("class-id")},
+			instance: newDryRunInstance(servicecatalog.PlanReference{
+				ClusterServiceClassName: "class-id",
+				ClusterServicePlanName:  "plan-id",
+			}, `{"size": "large"}`),
+		},
+		{
+			name:    "valid namespaced plan",
+			objects: []runtime.Object{newServiceClass(), newServicePlan()},
+			instance: newDryRunInstance(servicecatalog.PlanReference{
+				ServiceClassExternalName: "database",
+				ServicePlanExternalName:  "standard",
+			}, `{"size": "small"}`),
+		},
+		{
+			name:     "not a dry run",
+			instance: &servicecatalog.ServiceInstance{Spec: servicecatalog.ServiceInstanceSpec{PlanReference: clusterPlanReference}},
+		},
+		{
+			name:     "missing class",
+			instance: newDryRunInstance(clusterPlanReference, `{"size": "small"}`),
+			errMsg:   "references a non-existent ClusterServiceClass",
+		},
+		{
+			name:     "missing plan",
+			objects:  []runtime.Object{newClusterServiceClass(true)},
+			instance: newDryRunInstance(clusterPlanReference, `{"size": "small"}`),
+			errMsg:   "references a non-existent ClusterServicePlan",
+		},
+		{
+			name:    "plan of another class",
+			objects: []runtime.Object{newClusterServiceClass(true), newClusterServicePlan("other-class-id")},
+			instance: newDryRunInstance(servicecatalog.PlanReference{
+				ClusterServiceClassName: "class-id",
+				ClusterServicePlanName:  "plan-id",
+			}, `{"size": "small"}`),
+			errMsg: `ClusterServicePlan "standard" does not belong to ClusterServiceClass "database"`,
+		},
+		{
+			name:     "plan not specified",
+			objects:  []runtime.Object{newClusterServiceClass(true)},
+			instance: newDryRunInstance(servicecatalog.PlanReference{ClusterServiceClassExternalName: "database"}, ""),
+			errMsg:   `ClusterServicePlan not specified for ClusterServiceClass "database"`,
+		},
+		{
+			name:     "removed plan",
+			objects:  []runtime.Object{newClusterServiceClass(true), removedPlan},
+			instance: newDryRunInstance(clusterPlanReference, `{"size": "small"}`),
+			errMsg:   "has been removed from the broker's catalog",
+		},
+		{
+			name:     "parameters not matching the schema",
+			objects:  []runtime.Object{newClusterServiceClass(true), newClusterServicePlan("class-id")},
+			instance: newDryRunInstance(clusterPlanReference, `{"size": "medium"}`),
+			errMsg:   `spec.parameters.size: must be one of "small", "large"`,
+		},
+		{
+			name:     "missing required parameters",
+			objects:  []runtime.Object{newClusterServiceClass(true), newClusterServicePlan("class-id")},
+			instance: newDryRunInstance(clusterPlanReference, ""),
+			errMsg:   "spec.parameters.size: is required",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := newHandlerForTest(tc.objects...)
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+			err = handler.Validate(instanceAttributes(tc.instance))
+			checkError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestValidateBinding(t *testing.T) {
+	instance := newDryRunInstance(clusterPlanReference, "")
+	instance.Annotations = nil
+
+	cases := []struct {
+		name    string
+		objects []runtime.Object
+		binding *servicecatalog.ServiceBinding
+		errMsg  string
+	}{
+		{
+			name:    "valid binding",
+			objects: []runtime.Object{instance, newClusterServiceClass(true), newClusterServicePlan("class-id")},
+			binding: newDryRunBinding(`{"size": "small"}`),
+		},
+		{
+			name:    "missing instance",
+			objects: []runtime.Object{newClusterServiceClass(true), newClusterServicePlan("class-id")},
+			binding: newDryRunBinding(`{"size": "small"}`),
+			errMsg:  "references a non-existent ServiceInstance dev/instance",
+		},
+		{
+			name:    "non-bindable plan",
+			objects: []runtime.Object{instance, newClusterServiceClass(false), newClusterServicePlan("class-id")},
+			binding: newDryRunBinding(`{"size": "small"}`),
+			errMsg:  "uses non-bindable ClusterServicePlan",
+		},
+		{
+			name:    "parameters not matching the schema",
+			objects: []runtime.Object{instance, newClusterServiceClass(true), newClusterServicePlan("class-id")},
+			binding: newDryRunBinding(`{"size": 3}`),
+			errMsg:  "spec.parameters.size: must be of type string",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := newHandlerForTest(tc.objects...)
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+			err = handler.Validate(bindingAttributes(tc.binding))
+			checkError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func checkError(t *testing.T, err error, errMsg string) {
+	if errMsg == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	if err == nil {
+		t.Fatalf("expected error containing %q, got none", errMsg)
+	}
+	if !apierrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), errMsg) {
+		t.Errorf("expected error containing %q, got %q", errMsg, err.Error())
+	}
+}
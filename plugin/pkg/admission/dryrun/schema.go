@@ -0,0 +1,248 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// parametersPath is the path reported for problems found at the top level of
+// a resource's parameters.
+const parametersPath = "spec.parameters"
+
+// validateParameters checks parameters against a plan's parameter schema and
+// returns the problems found. It understands the subset of JSON Schema that
+// broker catalogs use to describe parameters: type, enum, required,
+// properties, additionalProperties, items and the numeric, length and
+// pattern constraints. Other keywords, such as $ref and the allOf, anyOf and
+// oneOf combinators, are not checked. A missing schema accepts anything.
+func validateParameters(schema *runtime.RawExtension, parameters *runtime.RawExtension) ([]string, error) {
+	if schema == nil || len(schema.Raw) == 0 {
+		return nil, nil
+	}
+	var s map[string]interface{}
+	if err := json.Unmarshal(schema.Raw, &s); err != nil {
+		return nil, fmt.Errorf("invalid schema: %v", err)
+	}
+
+	var value interface{} = map[string]interface{}{}
+	if parameters != nil && len(parameters.Raw) > 0 {
+		if err := json.Unmarshal(parameters.Raw, &value); err != nil {
+			return nil, fmt.Errorf("invalid parameters: %v", err)
+		}
+	}
+
+	return validateValue(s, value, parametersPath), nil
+}
+
+func validateValue(schema map[string]interface{}, value interface{}, path string) []string {
+	if types := schemaTypes(schema["type"]); len(types) > 0 && !matchesAnyType(value, types) {
+		return []string{fmt.Sprintf("%s: must be of type %s", path, strings.Join(types, " or "))}
+	}
+
+	var problems []string
+	if enum, ok := schema["enum"].([]interface{}); ok && !inEnum(value, enum) {
+		allowed := make([]string, 0, len(enum))
+		for _, e := range enum {
+			b, _ := json.Marshal(e)
+			allowed = append(allowed, string(b))
+		}
+		problems = append(problems, fmt.Sprintf("%s: must be one of %s", path, strings.Join(allowed, ", ")))
+	}
+
+	switch v := value.(type) {
+	case string:
+		problems = append(problems, validateString(schema, v, path)...)
+	case float64:
+		problems = append(problems, validateNumber(schema, v, path)...)
+	case map[string]interface{}:
+		problems = append(problems, validateObject(schema, v, path)...)
+	case []interface{}:
+		problems = append(problems, validateArray(schema, v, path)...)
+	}
+	return problems
+}
+
+func validateString(schema map[string]interface{}, value, path string) []string {
+	var problems []string
+	length := len([]rune(value))
+	if min, ok := schema["minLength"].(float64); ok && float64(length) < min {
+		problems = append(problems, fmt.Sprintf("%s: must be at least %v characters long", path, min))
+	}
+	if max, ok := schema["maxLength"].(float64); ok && float64(length) > max {
+		problems = append(problems, fmt.Sprintf("%s: must be at most %v characters long", path, max))
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		// A pattern that is not a valid Go regular expression cannot be
+		// checked, and is left for the broker to enforce.
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(value) {
+			problems = append(problems, fmt.Sprintf("%s: must match %q", path, pattern))
+		}
+	}
+	return problems
+}
+
+func validateNumber(schema map[string]interface{}, value float64, path string) []string {
+	var problems []string
+	if min, ok := schema["minimum"].(float64); ok {
+		if exclusive, _ := schema["exclusiveMinimum"].(bool); exclusive && value <= min {
+			problems = append(problems, fmt.Sprintf("%s: must be greater than %v", path, min))
+		} else if value < min {
+			problems = append(problems, fmt.Sprintf("%s: must be greater than or equal to %v", path, min))
+		}
+	}
+	if max, ok := schema["maximum"].(float64); ok {
+		if exclusive, _ := schema["exclusiveMaximum"].(bool); exclusive && value >= max {
+			problems = append(problems, fmt.Sprintf("%s: must be less than %v", path, max))
+		} else if value > max {
+			problems = append(problems, fmt.Sprintf("%s: must be less than or equal to %v", path, max))
+		}
+	}
+	// Later drafts of JSON Schema give the exclusive bounds as numbers.
+	if min, ok := schema["exclusiveMinimum"].(float64); ok && value <= min {
+		problems = append(problems, fmt.Sprintf("%s: must be greater than %v", path, min))
+	}
+	if max, ok := schema["exclusiveMaximum"].(float64); ok && value >= max {
+		problems = append(problems, fmt.Sprintf("%s: must be less than %v", path, max))
+	}
+	return problems
+}
+
+func validateObject(schema map[string]interface{}, value map[string]interface{}, path string) []string {
+	var problems []string
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, present := value[name]; !present {
+					problems = append(problems, fmt.Sprintf("%s: is required", path+"."+name))
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if property, ok := properties[key].(map[string]interface{}); ok {
+			problems = append(problems, validateValue(property, value[key], path+"."+key)...)
+			continue
+		}
+		if _, ok := properties[key]; ok {
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				problems = append(problems, fmt.Sprintf("%s: is not allowed", path+"."+key))
+			}
+		case map[string]interface{}:
+			problems = append(problems, validateValue(additional, value[key], path+"."+key)...)
+		}
+	}
+	return problems
+}
+
+func validateArray(schema map[string]interface{}, value []interface{}, path string) []string {
+	var problems []string
+	if min, ok := schema["minItems"].(float64); ok && float64(len(value)) < min {
+		problems = append(problems, fmt.Sprintf("%s: must have at least %v items", path, min))
+	}
+	if max, ok := schema["maxItems"].(float64); ok && float64(len(value)) > max {
+		problems = append(problems, fmt.Sprintf("%s: must have at most %v items", path, max))
+	}
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		for i, item := range value {
+			problems = append(problems, validateValue(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+	return problems
+}
+
+// schemaTypes returns the types allowed by a schema's type keyword, which
+// is either a single type or a list of them.
+func schemaTypes(t interface{}) []string {
+	switch t := t.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		var types []string
+		for _, e := range t {
+			if s, ok := e.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func matchesAnyType(value interface{}, types []string) bool {
+	for _, t := range types {
+		if matchesType(value, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(value interface{}, t string) bool {
+	switch t {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	}
+	// Unknown types are left for the broker to check.
+	return true
+}
+
+func inEnum(value interface{}, enum []interface{}) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(value, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestValidateParameters(t *testing.T) {
+	cases := []struct {
+		name       string
+		schema     string
+		parameters string
+		problems   []string
+	}{
+		{
+			name:       "no schema",
+			parameters: `{"anything": true}`,
+		},
+		{
+			name:   "no parameters",
+			schema: `{"type": "object", "properties": {"size": {"type": "string"}}}`,
+		},
+		{
+			name:       "wrong type",
+			schema:     `{"type": "object", "properties": {"count": {"type": "integer"}}}`,
+			parameters: `{"count": 1.5}`,
+			problems:   []string{"spec.parameters.count: must be of type integer"},
+		},
+		{
+			name:       "one of several types",
+			schema:     `{"type": "object", "properties": {"port": {"type": ["integer", "string"]}}}`,
+			parameters: `{"port": "http"}`,
+		},
+		{
+			name:       "enum",
+			schema:     `{"properties": {"tier": {"enum": ["free", "paid"]}}}`,
+			parameters: `{"tier": "gold"}`,
+			problems:   []string{`spec.parameters.tier: must be one of "free", "paid"`},
+		},
+		{
+			name:       "required and additional properties",
+			schema:     `{"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}}, "additionalProperties": false}`,
+			parameters: `{"nmae": "db"}`,
+			problems: []string{
+				"spec.parameters.name: is required",
+				"spec.parameters.nmae: is not allowed",
+			},
+		},
+		{
+			name:       "additional properties schema",
+			schema:     `{"type": "object", "additionalProperties": {"type": "string"}}`,
+			parameters: `{"a": "x", "b": 2}`,
+			problems:   []string{"spec.parameters.b: must be of type string"},
+		},
+		{
+			name:       "string constraints",
+			schema:     `{"properties": {"name": {"type": "string", "minLength": 3, "maxLength": 5, "pattern": "^[a-z]+$"}}}`,
+			parameters: `{"name": "DB"}`,
+			problems: []string{
+				"spec.parameters.name: must be at least 3 characters long",
+				`spec.parameters.name: must match "^[a-z]+$"`,
+			},
+		},
+		{
+			name:       "numeric bounds",
+			schema:     `{"properties": {"a": {"minimum": 1, "maximum": 10}, "b": {"minimum": 1, "exclusiveMinimum": true}, "c": {"exclusiveMaximum": 10}}}`,
+			parameters: `{"a": 11, "b": 1, "c": 10}`,
+			problems: []string{
+				"spec.parameters.a: must be less than or equal to 10",
+				"spec.parameters.b: must be greater than 1",
+				"spec.parameters.c: must be less than 10",
+			},
+		},
+		{
+			name:       "array items",
+			schema:     `{"properties": {"zones": {"type": "array", "maxItems": 2, "items": {"type": "string"}}}}`,
+			parameters: `{"zones": ["a", 2, "c"]}`,
+			problems: []string{
+				"spec.parameters.zones: must have at most 2 items",
+				"spec.parameters.zones[1]: must be of type string",
+			},
+		},
+		{
+			name:       "nested objects",
+			schema:     `{"properties": {"backup": {"type": "object", "required": ["schedule"]}}}`,
+			parameters: `{"backup": {}}`,
+			problems:   []string{"spec.parameters.backup.schedule: is required"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var schema, parameters *runtime.RawExtension
+			if tc.schema != "" {
+				schema = &runtime.RawExtension{Raw: []byte(tc.schema)}
+			}
+			if tc.parameters != "" {
+				parameters = &runtime.RawExtension{Raw: []byte(tc.parameters)}
+			}
+			problems, err := validateParameters(schema, parameters)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(problems, tc.problems) {
+				t.Errorf("unexpected problems\nexpected: %q\ngot:      %q", tc.problems, problems)
+			}
+		})
+	}
+}
+
+func TestValidateParametersInvalidSchema(t *testing.T) {
+	_, err := validateParameters(&runtime.RawExtension{Raw: []byte(`{"type":`)}, nil)
+	if err == nil {
+		t.Fatal("expected an error for an invalid schema")
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deletionprotection
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceInstanceDeletionProtection"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewDenyProtectedInstanceDeletion()
+	})
+}
+
+// denyProtectedInstanceDeletion is an implementation of admission.Interface.
+// It blocks the deletion of Service Instances that carry the deletion
+// protection annotation. Collection deletes, as issued when a namespace is
+// removed, are blocked if any instance in the namespace is protected.
+type denyProtectedInstanceDeletion struct {
+	*admission.Handler
+	instanceLister internalversion.ServiceInstanceLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&denyProtectedInstanceDeletion{})
+
+func (d *denyProtectedInstanceDeletion) Admit(a admission.Attributes) error {
+	// we need to wait for our caches to warm
+	if !d.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	// We only care about service Instances
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("serviceinstances") {
+		return nil
+	}
+
+	lister := d.instanceLister.ServiceInstances(a.GetNamespace())
+
+	if a.GetName() != "" {
+		instance, err := lister.Get(a.GetName())
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			glog.Error(err)
+			return admission.NewForbidden(a, err)
+		}
+		if isProtected(instance) {
+			msg := fmt.Sprintf("ServiceInstance %s/%s is protected from deletion by the %q annotation", instance.Namespace, instance.Name, servicecatalog.DeletionProtectionAnnotation)
+			glog.V(4).Info(msg)
+			return admission.NewForbidden(a, errors.New(msg))
+		}
+		return nil
+	}
+
+	// The attributes of a collection delete carry neither its label nor its
+	// field selector, so every instance in the namespace is considered
+	// rather than only those the delete would remove.
+	instances, err := lister.List(labels.Everything())
+	if err != nil {
+		glog.Error(err)
+		return admission.NewForbidden(a, err)
+	}
+	for _, instance := range instances {
+		if isProtected(instance) {
+			msg := fmt.Sprintf("ServiceInstance %s/%s is protected from deletion by the %q annotation", instance.Namespace, instance.Name, servicecatalog.DeletionProtectionAnnotation)
+			glog.V(4).Info(msg)
+			return admission.NewForbidden(a, errors.New(msg))
+		}
+	}
+
+	return nil
+}
+
+// isProtected returns whether the instance carries the deletion protection
+// annotation.
+func isProtected(instance *servicecatalog.ServiceInstance) bool {
+	return instance.Annotations[servicecatalog.DeletionProtectionAnnotation] == "true"
+}
+
+// NewDenyProtectedInstanceDeletion creates a new admission control handler
+// that blocks the deletion of protected Service Instances.
+func NewDenyProtectedInstanceDeletion() (admission.Interface, error) {
+	return &denyProtectedInstanceDeletion{
+		Handler: admission.NewHandler(admission.Delete),
+	}, nil
+}
+
+func (d *denyProtectedInstanceDeletion) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	instanceInformer := f.Servicecatalog().InternalVersion().ServiceInstances()
+	d.instanceLister = instanceInformer.Lister()
+	d.SetReadyFunc(instanceInformer.Informer().HasSynced)
+}
+
+func (d *denyProtectedInstanceDeletion) ValidateInitialization() error {
+	if d.instanceLister == nil {
+		return errors.New("missing instance lister")
+	}
+	return nil
+}
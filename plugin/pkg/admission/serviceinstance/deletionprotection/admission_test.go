@@ -0,0 +1,164 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deletionprotection
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	core "k8s.io/client-go/testing"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	handler, err := NewDenyProtectedInstanceDeletion()
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+// newFakeServiceCatalogClientForTest creates a fake clientset that returns a
+// ServiceInstanceList with the given ServiceInstances.
+func newFakeServiceCatalogClientForTest(instances ...servicecatalog.ServiceInstance) *fake.Clientset {
+	fakeClient := &fake.Clientset{}
+
+	instanceList := &servicecatalog.ServiceInstanceList{
+		ListMeta: metav1.ListMeta{
+			ResourceVersion: "1",
+		}}
+	instanceList.Items = append(instanceList.Items, instances...)
+
+	fakeClient.AddReactor("list", "serviceinstances", func(action core.Action) (bool, runtime.Object, error) {
+		return true, instanceList, nil
+	})
+	return fakeClient
+}
+
+// newServiceInstance returns a new instance for the specified namespace.
+func newServiceInstance(namespace, name string, protected bool) servicecatalog.ServiceInstance {
+	instance := servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	if protected {
+		instance.Annotations = map[string]string{
+			servicecatalog.DeletionProtectionAnnotation: "true",
+		}
+	}
+	return instance
+}
+
+func TestDeletionProtection(t *testing.T) {
+	cases := []struct {
+		name      string
+		instances []servicecatalog.ServiceInstance
+		namespace string
+		// instanceName is empty for collection deletes
+		instanceName string
+		expectedErr  string
+	}{
+		{
+			name:         "unprotected instance",
+			instances:    []servicecatalog.ServiceInstance{newServiceInstance("ns", "instance", false)},
+			namespace:    "ns",
+			instanceName: "instance",
+		},
+		{
+			name:         "protected instance",
+			instances:    []servicecatalog.ServiceInstance{newServiceInstance("ns", "instance", true)},
+			namespace:    "ns",
+			instanceName: "instance",
+			expectedErr:  "ServiceInstance ns/instance is protected from deletion",
+		},
+		{
+			name:         "unknown instance",
+			instances:    []servicecatalog.ServiceInstance{newServiceInstance("ns", "instance", true)},
+			namespace:    "ns",
+			instanceName: "other",
+		},
+		{
+			name: "collection with protected instance",
+			instances: []servicecatalog.ServiceInstance{
+				newServiceInstance("ns", "instance", false),
+				newServiceInstance("ns", "protected", true),
+			},
+			namespace:   "ns",
+			expectedErr: "ServiceInstance ns/protected is protected from deletion",
+		},
+		{
+			name: "collection with protected instance in other namespace",
+			instances: []servicecatalog.ServiceInstance{
+				newServiceInstance("ns", "instance", false),
+				newServiceInstance("other-ns", "protected", true),
+			},
+			namespace: "ns",
+		},
+	}
+
+	for _, tc := range cases {
+		fakeClient := newFakeServiceCatalogClientForTest(tc.instances...)
+		handler, informerFactory, err := newHandlerForTest(fakeClient)
+		if err != nil {
+			t.Errorf("%v: unexpected error initializing handler: %v", tc.name, err)
+			continue
+		}
+		informerFactory.Start(wait.NeverStop)
+
+		err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(nil, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), tc.namespace, tc.instanceName, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Delete, nil))
+		if tc.expectedErr == "" {
+			if err != nil {
+				t.Errorf("%v: unexpected error: %v", tc.name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%v: expected error containing %q", tc.name, tc.expectedErr)
+		} else if !strings.Contains(err.Error(), tc.expectedErr) {
+			t.Errorf("%v: unexpected error %q returned from admission handler", tc.name, err.Error())
+		}
+	}
+}
+
+func TestDeletionProtectionHandles(t *testing.T) {
+	handler, _, err := newHandlerForTest(newFakeServiceCatalogClientForTest())
+	if err != nil {
+		t.Fatalf("unexpected error initializing handler: %v", err)
+	}
+	for _, op := range []admission.Operation{admission.Create, admission.Update, admission.Connect} {
+		if handler.Handles(op) {
+			t.Errorf("expected handler to not handle %v", op)
+		}
+	}
+	if !handler.Handles(admission.Delete) {
+		t.Errorf("expected handler to handle %v", admission.Delete)
+	}
+}
@@ -24,13 +24,18 @@ import (
 	"github.com/golang/glog"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
 	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 )
 
 const (
@@ -47,12 +52,17 @@ func Register(plugins *admission.Plugins) {
 
 // denyPlanChangeIfNotUpdatable is an implementation of admission.Interface.
 // It checks if the Service Instance is being updated with a Service Plan and
-// blocks the operation if the Service Class is set to PlanUpdatable=false
+// blocks the operation if the Service Class is set to PlanUpdatable=false, or
+// if the plan change is not allowed by the ClusterServicePlanTransitionPolicies
+// of the Service Class. Policies apply to instances of both cluster-scoped and
+// namespaced Service Classes.
 type denyPlanChangeIfNotUpdatable struct {
 	*admission.Handler
-	scLister       internalversion.ClusterServiceClassLister
-	spLister       internalversion.ClusterServicePlanLister
-	instanceLister internalversion.ServiceInstanceLister
+	scLister           internalversion.ClusterServiceClassLister
+	spLister           internalversion.ClusterServicePlanLister
+	namespacedSpLister internalversion.ServicePlanLister
+	instanceLister     internalversion.ServiceInstanceLister
+	policyLister       internalversion.ClusterServicePlanTransitionPolicyLister
 }
 
 var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&denyPlanChangeIfNotUpdatable{})
@@ -72,6 +82,9 @@ func (d *denyPlanChangeIfNotUpdatable) Admit(a admission.Attributes) error {
 		return apierrors.NewBadRequest("Resource was marked with kind Instance but was unable to be converted")
 	}
 
+	if instance.Spec.ServiceClassRef != nil {
+		return d.checkNamespacedPlanTransitionPolicies(a, instance)
+	}
 	if instance.Spec.ClusterServiceClassRef == nil {
 		return nil // user chose a service class that doesn't exist
 	}
@@ -87,7 +100,7 @@ func (d *denyPlanChangeIfNotUpdatable) Admit(a admission.Attributes) error {
 	}
 
 	if sc.Spec.PlanUpdatable {
-		return nil
+		return d.checkPlanTransitionPolicies(a, instance, sc)
 	}
 
 	if instance.Spec.GetSpecifiedClusterServicePlan() != "" {
@@ -123,6 +136,250 @@ func (d *denyPlanChangeIfNotUpdatable) Admit(a admission.Attributes) error {
 	return nil
 }
 
+// checkPlanTransitionPolicies blocks a plan change of an instance of a
+// ClusterServiceClass that is not allowed by any rule of the
+// ClusterServicePlanTransitionPolicies of the class. Plan changes are not
+// restricted when the class has no policies.
+func (d *denyPlanChangeIfNotUpdatable) checkPlanTransitionPolicies(a admission.Attributes, instance *servicecatalog.ServiceInstance, sc *servicecatalog.ClusterServiceClass) error {
+	if d.policyLister == nil || instance.Spec.GetSpecifiedClusterServicePlan() == "" {
+		return nil
+	}
+
+	policies, err := d.planTransitionPoliciesFor(func(spec *servicecatalog.ClusterServicePlanTransitionPolicySpec) bool {
+		return spec.ClusterServiceClassName == sc.Name
+	})
+	if err != nil {
+		glog.Error(err)
+		return admission.NewForbidden(a, err)
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	origInstance, err := d.instanceLister.ServiceInstances(instance.Namespace).Get(instance.Name)
+	if err != nil {
+		glog.Errorf("Error locating instance %v/%v", instance.Namespace, instance.Name)
+		return err
+	}
+	if instance.Spec.ClusterServicePlanExternalName == origInstance.Spec.ClusterServicePlanExternalName &&
+		instance.Spec.ClusterServicePlanExternalID == origInstance.Spec.ClusterServicePlanExternalID &&
+		instance.Spec.ClusterServicePlanName == origInstance.Spec.ClusterServicePlanName {
+		return nil
+	}
+
+	oldPlan, err := d.findClusterServicePlan(sc, &origInstance.Spec.PlanReference)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	newPlan, err := d.findClusterServicePlan(sc, &instance.Spec.PlanReference)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	return checkPlanTransition(a, instance, policies, &oldPlan.Spec.CommonServicePlanSpec, &newPlan.Spec.CommonServicePlanSpec)
+}
+
+// checkNamespacedPlanTransitionPolicies blocks a plan change of an instance of
+// a namespaced ServiceClass that is not allowed by any rule of the
+// ClusterServicePlanTransitionPolicies that reference the class. Plan changes
+// are not restricted when the class has no policies.
+func (d *denyPlanChangeIfNotUpdatable) checkNamespacedPlanTransitionPolicies(a admission.Attributes, instance *servicecatalog.ServiceInstance) error {
+	if d.policyLister == nil || instance.Spec.GetSpecifiedServicePlan() == "" {
+		return nil
+	}
+
+	className := instance.Spec.ServiceClassRef.Name
+	policies, err := d.planTransitionPoliciesFor(func(spec *servicecatalog.ClusterServicePlanTransitionPolicySpec) bool {
+		return spec.ServiceClassRef != nil && spec.ServiceClassRef.Namespace == instance.Namespace && spec.ServiceClassRef.Name == className
+	})
+	if err != nil {
+		glog.Error(err)
+		return admission.NewForbidden(a, err)
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	origInstance, err := d.instanceLister.ServiceInstances(instance.Namespace).Get(instance.Name)
+	if err != nil {
+		glog.Errorf("Error locating instance %v/%v", instance.Namespace, instance.Name)
+		return err
+	}
+	if instance.Spec.ServicePlanExternalName == origInstance.Spec.ServicePlanExternalName &&
+		instance.Spec.ServicePlanExternalID == origInstance.Spec.ServicePlanExternalID &&
+		instance.Spec.ServicePlanName == origInstance.Spec.ServicePlanName {
+		return nil
+	}
+
+	oldPlan, err := d.findServicePlan(instance.Namespace, className, &origInstance.Spec.PlanReference)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	newPlan, err := d.findServicePlan(instance.Namespace, className, &instance.Spec.PlanReference)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	return checkPlanTransition(a, instance, policies, &oldPlan.Spec.CommonServicePlanSpec, &newPlan.Spec.CommonServicePlanSpec)
+}
+
+// planTransitionPoliciesFor returns the ClusterServicePlanTransitionPolicies
+// whose spec is selected by the given function.
+func (d *denyPlanChangeIfNotUpdatable) planTransitionPoliciesFor(selected func(*servicecatalog.ClusterServicePlanTransitionPolicySpec) bool) ([]*servicecatalog.ClusterServicePlanTransitionPolicy, error) {
+	allPolicies, err := d.policyLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var policies []*servicecatalog.ClusterServicePlanTransitionPolicy
+	for _, policy := range allPolicies {
+		if selected(&policy.Spec) {
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
+// checkPlanTransition blocks the change from the old plan to the new one
+// unless a rule of one of the policies allows it for the requesting user.
+func checkPlanTransition(a admission.Attributes, instance *servicecatalog.ServiceInstance, policies []*servicecatalog.ClusterServicePlanTransitionPolicy, oldPlan, newPlan *servicecatalog.CommonServicePlanSpec) error {
+	userInfo := a.GetUserInfo()
+	deniedForUser := false
+	var policyNames []string
+	for _, policy := range policies {
+		policyNames = append(policyNames, policy.Name)
+		for i := range policy.Spec.Rules {
+			rule := &policy.Spec.Rules[i]
+			if !planTransitionRuleMatches(policy, rule, oldPlan, newPlan) {
+				continue
+			}
+			if planTransitionRuleAllowsUser(rule, userInfo) {
+				return nil
+			}
+			deniedForUser = true
+		}
+	}
+
+	var msg string
+	if deniedForUser {
+		userName := ""
+		if userInfo != nil {
+			userName = userInfo.GetName()
+		}
+		msg = fmt.Sprintf("User %q is not allowed to change the plan of Service Instance %v/%v from %q to %q by ClusterServicePlanTransitionPolicy %v.",
+			userName, instance.Namespace, instance.Name, oldPlan.ExternalName, newPlan.ExternalName, policyNames)
+	} else {
+		msg = fmt.Sprintf("Changing the plan of Service Instance %v/%v from %q to %q is not allowed by ClusterServicePlanTransitionPolicy %v.",
+			instance.Namespace, instance.Name, oldPlan.ExternalName, newPlan.ExternalName, policyNames)
+	}
+	glog.V(4).Info(msg)
+	return admission.NewForbidden(a, errors.New(msg))
+}
+
+// findClusterServicePlan returns the plan of the Service Class that is
+// specified by the plan reference.
+func (d *denyPlanChangeIfNotUpdatable) findClusterServicePlan(sc *servicecatalog.ClusterServiceClass, ref *servicecatalog.PlanReference) (*servicecatalog.ClusterServicePlan, error) {
+	if ref.ClusterServicePlanName != "" {
+		return d.spLister.Get(ref.ClusterServicePlanName)
+	}
+
+	plans, err := d.spLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range plans {
+		if plan.Spec.ClusterServiceClassRef.Name != sc.Name {
+			continue
+		}
+		if ref.ClusterServicePlanExternalName != "" && plan.Spec.ExternalName == ref.ClusterServicePlanExternalName {
+			return plan, nil
+		}
+		if ref.ClusterServicePlanExternalID != "" && plan.Spec.ExternalID == ref.ClusterServicePlanExternalID {
+			return plan, nil
+		}
+	}
+	return nil, fmt.Errorf("could not locate plan %v of Service Class %v", ref.GetSpecifiedClusterServicePlan(), sc.Name)
+}
+
+// findServicePlan returns the plan of the namespaced Service Class that is
+// specified by the plan reference.
+func (d *denyPlanChangeIfNotUpdatable) findServicePlan(namespace, className string, ref *servicecatalog.PlanReference) (*servicecatalog.ServicePlan, error) {
+	lister := d.namespacedSpLister.ServicePlans(namespace)
+	if ref.ServicePlanName != "" {
+		return lister.Get(ref.ServicePlanName)
+	}
+
+	plans, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range plans {
+		if plan.Spec.ServiceClassRef.Name != className {
+			continue
+		}
+		if ref.ServicePlanExternalName != "" && plan.Spec.ExternalName == ref.ServicePlanExternalName {
+			return plan, nil
+		}
+		if ref.ServicePlanExternalID != "" && plan.Spec.ExternalID == ref.ServicePlanExternalID {
+			return plan, nil
+		}
+	}
+	return nil, fmt.Errorf("could not locate plan %v of Service Class %v/%v", ref.GetSpecifiedServicePlan(), namespace, className)
+}
+
+// planTransitionRuleMatches returns whether the change from one plan to
+// another is selected by the rule.
+func planTransitionRuleMatches(policy *servicecatalog.ClusterServicePlanTransitionPolicy, rule *servicecatalog.PlanTransitionRule, from, to *servicecatalog.CommonServicePlanSpec) bool {
+	if !planTransitionSelectorMatches(&rule.From, from) || !planTransitionSelectorMatches(&rule.To, to) {
+		return false
+	}
+	if rule.Direction == "" {
+		return true
+	}
+
+	fromIndex, toIndex := -1, -1
+	for i, name := range policy.Spec.PlanOrder {
+		if name == from.ExternalName {
+			fromIndex = i
+		}
+		if name == to.ExternalName {
+			toIndex = i
+		}
+	}
+	if fromIndex < 0 || toIndex < 0 {
+		return false
+	}
+	switch rule.Direction {
+	case servicecatalog.PlanTransitionDirectionUpgrade:
+		return toIndex > fromIndex
+	case servicecatalog.PlanTransitionDirectionDowngrade:
+		return toIndex < fromIndex
+	}
+	return false
+}
+
+func planTransitionSelectorMatches(selector *servicecatalog.PlanTransitionSelector, plan *servicecatalog.CommonServicePlanSpec) bool {
+	if len(selector.ExternalNames) > 0 && !sets.NewString(selector.ExternalNames...).Has(plan.ExternalName) {
+		return false
+	}
+	if selector.Free != nil && *selector.Free != plan.Free {
+		return false
+	}
+	return true
+}
+
+// planTransitionRuleAllowsUser returns whether the user may make the plan
+// changes selected by the rule.
+func planTransitionRuleAllowsUser(rule *servicecatalog.PlanTransitionRule, userInfo user.Info) bool {
+	if len(rule.Users) == 0 && len(rule.Groups) == 0 {
+		return true
+	}
+	if userInfo == nil {
+		return false
+	}
+	if sets.NewString(rule.Users...).Has(userInfo.GetName()) {
+		return true
+	}
+	return sets.NewString(rule.Groups...).HasAny(userInfo.GetGroups()...)
+}
+
 // NewDenyPlanChangeIfNotUpdatable creates a new admission control handler that
 // blocks updates to an instance service plan if the instance has
 // PlanUpdatable=false
@@ -141,8 +398,19 @@ func (d *denyPlanChangeIfNotUpdatable) SetInternalServiceCatalogInformerFactory(
 	spInformer := f.Servicecatalog().InternalVersion().ClusterServicePlans()
 	d.spLister = spInformer.Lister()
 
+	policiesSynced := func() bool { return true }
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.PlanTransitionPolicy) {
+		policyInformer := f.Servicecatalog().InternalVersion().ClusterServicePlanTransitionPolicies()
+		d.policyLister = policyInformer.Lister()
+		namespacedSpInformer := f.Servicecatalog().InternalVersion().ServicePlans()
+		d.namespacedSpLister = namespacedSpInformer.Lister()
+		policiesSynced = func() bool {
+			return policyInformer.Informer().HasSynced() && namespacedSpInformer.Informer().HasSynced()
+		}
+	}
+
 	readyFunc := func() bool {
-		return scInformer.Informer().HasSynced() && instanceInformer.Informer().HasSynced() && spInformer.Informer().HasSynced()
+		return scInformer.Informer().HasSynced() && instanceInformer.Informer().HasSynced() && spInformer.Informer().HasSynced() && policiesSynced()
 	}
 
 	d.SetReadyFunc(readyFunc)
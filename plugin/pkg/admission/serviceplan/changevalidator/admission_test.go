@@ -17,6 +17,7 @@ limitations under the License.
 package changevalidator
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -25,12 +26,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
 	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
 	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	core "k8s.io/client-go/testing"
 )
 
@@ -152,3 +156,278 @@ func TestClusterServicePlanChangePermittedByUpdateablePlanSetting(t *testing.T)
 		t.Errorf("Unexpected error: %v", err.Error())
 	}
 }
+
+// newClusterServicePlan returns a new plan of the specified class.
+func newClusterServicePlan(name string, serviceClassName string, free bool) servicecatalog.ClusterServicePlan {
+	return servicecatalog.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: name + "-id"},
+		Spec: servicecatalog.ClusterServicePlanSpec{
+			CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+				ExternalName: name,
+				ExternalID:   name + "-id",
+				Free:         free,
+			},
+			ClusterServiceClassRef: servicecatalog.ClusterObjectReference{Name: serviceClassName},
+		},
+	}
+}
+
+// TestClusterServicePlanChangeWithTransitionPolicy tests that the Admission
+// Controller only allows the plan changes permitted by the
+// ClusterServicePlanTransitionPolicies of the Service Class.
+func TestClusterServicePlanChangeWithTransitionPolicy(t *testing.T) {
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.PlanTransitionPolicy))
+	if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.PlanTransitionPolicy)); err != nil {
+		t.Fatalf("Failed to enable feature gate: %v", err)
+	}
+
+	paid := false
+	policy := servicecatalog.ClusterServicePlanTransitionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "no-data-loss"},
+		Spec: servicecatalog.ClusterServicePlanTransitionPolicySpec{
+			ClusterServiceClassName: "database-id",
+			PlanOrder:               []string{"free", "small", "large"},
+			Rules: []servicecatalog.PlanTransitionRule{
+				{
+					Direction: servicecatalog.PlanTransitionDirectionUpgrade,
+				},
+				{
+					Direction: servicecatalog.PlanTransitionDirectionDowngrade,
+					To:        servicecatalog.PlanTransitionSelector{Free: &paid},
+					Groups:    []string{"dba"},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name      string
+		className string
+		// classID overrides the Kubernetes name of the class, which is
+		// derived from className by default
+		classID     string
+		oldPlan     string
+		newPlan     string
+		user        user.Info
+		expectedErr string
+	}{
+		{
+			name:      "upgrade allowed for anyone",
+			className: "database",
+			oldPlan:   "free",
+			newPlan:   "small",
+			user:      &user.DefaultInfo{Name: "bob"},
+		},
+		{
+			name:      "downgrade between paid plans allowed for group",
+			className: "database",
+			oldPlan:   "large",
+			newPlan:   "small",
+			user:      &user.DefaultInfo{Name: "alice", Groups: []string{"dba"}},
+		},
+		{
+			name:        "downgrade between paid plans denied for other users",
+			className:   "database",
+			oldPlan:     "large",
+			newPlan:     "small",
+			user:        &user.DefaultInfo{Name: "bob", Groups: []string{"developers"}},
+			expectedErr: `User "bob" is not allowed to change the plan of Service Instance dummy/instance from "large" to "small" by ClusterServicePlanTransitionPolicy [no-data-loss].`,
+		},
+		{
+			name:        "downgrade to free plan denied",
+			className:   "database",
+			oldPlan:     "small",
+			newPlan:     "free",
+			user:        &user.DefaultInfo{Name: "alice", Groups: []string{"dba"}},
+			expectedErr: `Changing the plan of Service Instance dummy/instance from "small" to "free" is not allowed by ClusterServicePlanTransitionPolicy [no-data-loss].`,
+		},
+		{
+			name:      "class without policy",
+			className: "cache",
+			oldPlan:   "small",
+			newPlan:   "free",
+			user:      &user.DefaultInfo{Name: "bob"},
+		},
+		{
+			name:      "class with the same external name from another broker",
+			className: "database",
+			classID:   "other-broker-database-id",
+			oldPlan:   "small",
+			newPlan:   "free",
+			user:      &user.DefaultInfo{Name: "bob"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			classID := tc.classID
+			if classID == "" {
+				classID = tc.className + "-id"
+			}
+			sc := newClusterServiceClass(classID, "", true)
+			sc.Spec.ExternalName = tc.className
+			fakeClient := newFakeServiceCatalogClientForTest(sc)
+
+			planList := &servicecatalog.ClusterServicePlanList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+			for _, plan := range []struct {
+				name string
+				free bool
+			}{{"free", true}, {"small", false}, {"large", false}} {
+				planList.Items = append(planList.Items, newClusterServicePlan(plan.name, sc.Name, plan.free))
+			}
+			fakeClient.AddReactor("list", "clusterserviceplans", func(action core.Action) (bool, runtime.Object, error) {
+				return true, planList, nil
+			})
+			policyList := &servicecatalog.ClusterServicePlanTransitionPolicyList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+			policyList.Items = append(policyList.Items, policy)
+			fakeClient.AddReactor("list", "clusterserviceplantransitionpolicies", func(action core.Action) (bool, runtime.Object, error) {
+				return true, policyList, nil
+			})
+			instanceList := &servicecatalog.ServiceInstanceList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+			instanceList.Items = append(instanceList.Items, newServiceInstance("dummy", sc.Name, tc.oldPlan))
+			fakeClient.AddReactor("list", "serviceinstances", func(action core.Action) (bool, runtime.Object, error) {
+				return true, instanceList, nil
+			})
+
+			handler, informerFactory, err := newHandlerForTest(fakeClient)
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+			informerFactory.Start(wait.NeverStop)
+
+			instance := newServiceInstance("dummy", sc.Name, tc.newPlan)
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Update, tc.user))
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Fatalf("unexpected error %q returned from admission handler, expected %q", err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+// newNamespacedServiceInstance returns a new instance of the namespaced
+// Service Class in the specified namespace.
+func newNamespacedServiceInstance(namespace string, serviceClassName string, planName string) servicecatalog.ServiceInstance {
+	return servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: namespace},
+		Spec: servicecatalog.ServiceInstanceSpec{
+			PlanReference: servicecatalog.PlanReference{
+				ServicePlanExternalName: planName,
+			},
+			ServiceClassRef: &servicecatalog.LocalObjectReference{
+				Name: serviceClassName,
+			},
+		},
+	}
+}
+
+// TestServicePlanChangeWithTransitionPolicy tests that the plan changes of
+// instances of namespaced Service Classes are restricted by the
+// ClusterServicePlanTransitionPolicies that reference the class.
+func TestServicePlanChangeWithTransitionPolicy(t *testing.T) {
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.PlanTransitionPolicy))
+	if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.PlanTransitionPolicy)); err != nil {
+		t.Fatalf("Failed to enable feature gate: %v", err)
+	}
+
+	policy := servicecatalog.ClusterServicePlanTransitionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "upgrades-only"},
+		Spec: servicecatalog.ClusterServicePlanTransitionPolicySpec{
+			ServiceClassRef: &servicecatalog.ObjectReference{Namespace: "dummy", Name: "database-id"},
+			PlanOrder:       []string{"free", "small", "large"},
+			Rules: []servicecatalog.PlanTransitionRule{
+				{Direction: servicecatalog.PlanTransitionDirectionUpgrade},
+			},
+		},
+	}
+
+	cases := []struct {
+		name        string
+		namespace   string
+		oldPlan     string
+		newPlan     string
+		expectedErr string
+	}{
+		{
+			name:      "upgrade allowed",
+			namespace: "dummy",
+			oldPlan:   "free",
+			newPlan:   "large",
+		},
+		{
+			name:        "downgrade denied",
+			namespace:   "dummy",
+			oldPlan:     "large",
+			newPlan:     "small",
+			expectedErr: `Changing the plan of Service Instance dummy/instance from "large" to "small" is not allowed by ClusterServicePlanTransitionPolicy [upgrades-only].`,
+		},
+		{
+			name:      "class with the same name in another namespace",
+			namespace: "other",
+			oldPlan:   "large",
+			newPlan:   "small",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := newFakeServiceCatalogClientForTest(newClusterServiceClass("unrelated-id", "", true))
+
+			planList := &servicecatalog.ServicePlanList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+			for _, name := range []string{"free", "small", "large"} {
+				planList.Items = append(planList.Items, servicecatalog.ServicePlan{
+					ObjectMeta: metav1.ObjectMeta{Name: name + "-id", Namespace: tc.namespace},
+					Spec: servicecatalog.ServicePlanSpec{
+						CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+							ExternalName: name,
+							ExternalID:   name + "-id",
+						},
+						ServiceClassRef: servicecatalog.LocalObjectReference{Name: "database-id"},
+					},
+				})
+			}
+			fakeClient.AddReactor("list", "serviceplans", func(action core.Action) (bool, runtime.Object, error) {
+				return true, planList, nil
+			})
+			policyList := &servicecatalog.ClusterServicePlanTransitionPolicyList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+			policyList.Items = append(policyList.Items, policy)
+			fakeClient.AddReactor("list", "clusterserviceplantransitionpolicies", func(action core.Action) (bool, runtime.Object, error) {
+				return true, policyList, nil
+			})
+			instanceList := &servicecatalog.ServiceInstanceList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}
+			instanceList.Items = append(instanceList.Items, newNamespacedServiceInstance(tc.namespace, "database-id", tc.oldPlan))
+			fakeClient.AddReactor("list", "serviceinstances", func(action core.Action) (bool, runtime.Object, error) {
+				return true, instanceList, nil
+			})
+
+			handler, informerFactory, err := newHandlerForTest(fakeClient)
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+			informerFactory.Start(wait.NeverStop)
+
+			instance := newNamespacedServiceInstance(tc.namespace, "database-id", tc.newPlan)
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Update, &user.DefaultInfo{Name: "bob"}))
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Fatalf("unexpected error %q returned from admission handler, expected %q", err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalogclient "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+)
+
+// TestBrokerCallbackAnnotationPatch verifies that the merge patch the
+// controller records broker callbacks with sets the annotation without
+// bumping the generation, and is refused for another UID.
+func TestBrokerCallbackAnnotationPatch(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ServiceInstance{}
+			})
+			defer shutdownServer()
+			if err := testBrokerCallbackAnnotationPatch(client, "test-instance"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testBrokerCallbackAnnotationPatch(client servicecatalogclient.Interface, name string) error {
+	instanceClient := client.Servicecatalog().ServiceInstances("test-namespace")
+
+	created, err := instanceClient.Create(&v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.ServiceInstanceSpec{
+			PlanReference: v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "service-class-name",
+				ClusterServicePlanExternalName:  "plan-name",
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the instance (%s)", err)
+	}
+
+	patch := func(uid types.UID) []byte {
+		return []byte(fmt.Sprintf(`{"metadata":{"uid":%q,"annotations":{%q:"2018-01-01T00:00:00Z"}}}`, uid, v1beta1.BrokerCallbackAnnotation))
+	}
+
+	if _, err := instanceClient.Patch(name, types.MergePatchType, patch("other-uid")); err == nil {
+		return fmt.Errorf("expected the patch for another UID to fail")
+	}
+
+	patched, err := instanceClient.Patch(name, types.MergePatchType, patch(created.UID))
+	if err != nil {
+		return fmt.Errorf("error patching the instance (%s)", err)
+	}
+	if e, a := "2018-01-01T00:00:00Z", patched.Annotations[v1beta1.BrokerCallbackAnnotation]; e != a {
+		return fmt.Errorf("expected annotation %q, got %q", e, a)
+	}
+	if e, a := created.Generation, patched.Generation; e != a {
+		return fmt.Errorf("expected generation %d, got %d", e, a)
+	}
+	return nil
+}
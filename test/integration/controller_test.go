@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	restclient "k8s.io/client-go/rest"
 	clientgotesting "k8s.io/client-go/testing"
@@ -761,6 +762,7 @@ func newControllerTestTestController(ct *controllerTest) (
 	// create informers
 	informerFactory := scinformers.NewSharedInformerFactory(catalogClient, 10*time.Second)
 	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
+	coreInformerFactory := kubeinformers.NewSharedInformerFactory(fakeKubeClient, 10*time.Second)
 
 	// WARNING: Should you try to record more events than the buffer size
 	// passed here, the recording function will hang indefinitely.
@@ -778,6 +780,7 @@ func newControllerTestTestController(ct *controllerTest) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		coreInformerFactory.Core().V1().Secrets(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -786,6 +789,9 @@ func newControllerTestTestController(ct *controllerTest) (
 		7*24*time.Hour,
 		controller.DefaultClusterIDConfigMapName,
 		controller.DefaultClusterIDConfigMapNamespace,
+		nil,
+		nil,
+		nil,
 	)
 	t.Log("controller start")
 	if err != nil {
@@ -921,6 +927,7 @@ func newTestController(t *testing.T) (
 	// create informers
 	informerFactory := scinformers.NewSharedInformerFactory(catalogClient, 10*time.Second)
 	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
+	coreInformerFactory := kubeinformers.NewSharedInformerFactory(fakeKubeClient, 10*time.Second)
 
 	// WARNING: Should you try to record more events than the buffer size
 	// passed here, the recording function will hang indefinitely.
@@ -938,6 +945,7 @@ func newTestController(t *testing.T) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		coreInformerFactory.Core().V1().Secrets(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -946,6 +954,9 @@ func newTestController(t *testing.T) (
 		7*24*time.Hour,
 		controller.DefaultClusterIDConfigMapName,
 		controller.DefaultClusterIDConfigMapNamespace,
+		nil,
+		nil,
+		nil,
 	)
 	t.Log("controller start")
 	if err != nil {
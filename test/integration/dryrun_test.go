@@ -0,0 +1,221 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalogclient "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/server"
+)
+
+// TestDryRunInstanceClient verifies that an instance created with the dryRun
+// query parameter is defaulted and validated, but not persisted.
+func TestDryRunInstanceClient(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ServiceInstance{}
+			})
+			defer shutdownServer()
+			if err := testDryRunInstanceClient(client, "test-instance"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testDryRunInstanceClient(client servicecatalogclient.Interface, name string) error {
+	instanceClient := client.Servicecatalog().ServiceInstances("test-namespace")
+
+	instance := &v1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1beta1.ServiceInstanceSpec{
+			PlanReference: v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "service-class-name",
+				ClusterServicePlanExternalName:  "plan-name",
+			},
+			Parameters: &runtime.RawExtension{Raw: []byte(instanceParameter)},
+		},
+	}
+
+	dryRun := &v1beta1.ServiceInstance{}
+	statusCode, err := createDryRun(client, "serviceinstances", instance, dryRun)
+	if err != nil {
+		return fmt.Errorf("error creating the instance as a dry run (%s)", err)
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("expected the dry run to return status %d, got %d", http.StatusOK, statusCode)
+	}
+	if dryRun.Name != name {
+		return fmt.Errorf("expected the dry run to return instance %q, got %q", name, dryRun.Name)
+	}
+	if dryRun.Spec.ExternalID == "" {
+		return fmt.Errorf("expected the dry run to default the external ID")
+	}
+	if dryRun.Generation != 1 {
+		return fmt.Errorf("expected the dry run to return generation 1, got %d", dryRun.Generation)
+	}
+
+	if _, err := instanceClient.Get(name, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		return fmt.Errorf("expected the dry run not to persist the instance, got %v", err)
+	}
+
+	invalid := instance.DeepCopy()
+	invalid.Spec.PlanReference = v1beta1.PlanReference{}
+	if _, err := createDryRun(client, "serviceinstances", invalid, &v1beta1.ServiceInstance{}); !apierrors.IsInvalid(err) {
+		return fmt.Errorf("expected the dry run of an invalid instance to fail validation, got %v", err)
+	}
+
+	annotated := instance.DeepCopy()
+	annotated.Annotations = map[string]string{servicecatalog.DryRunAnnotation: "true"}
+	if _, err := instanceClient.Create(annotated); !apierrors.IsBadRequest(err) {
+		return fmt.Errorf("expected an instance carrying the reserved dry-run annotation to be rejected, got %v", err)
+	}
+
+	if _, err := instanceClient.Create(instance); err != nil {
+		return fmt.Errorf("error creating the instance (%s)", err)
+	}
+	if _, err := createDryRun(client, "serviceinstances", instance, &v1beta1.ServiceInstance{}); !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("expected the dry run of an existing instance to fail, got %v", err)
+	}
+
+	// Dry runs of updates are not supported, and must not be persisted.
+	existing, err := instanceClient.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting the instance (%s)", err)
+	}
+	updated := existing.DeepCopy()
+	updated.Spec.UpdateRequests++
+	err = client.ServicecatalogV1beta1().RESTClient().Put().
+		Namespace("test-namespace").
+		Resource("serviceinstances").
+		Name(name).
+		Param("dryRun", "All").
+		Body(updated).
+		Do().
+		Error()
+	if !apierrors.IsBadRequest(err) {
+		return fmt.Errorf("expected the dry run of an update to be rejected, got %v", err)
+	}
+	current, err := instanceClient.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting the instance (%s)", err)
+	}
+	if current.ResourceVersion != existing.ResourceVersion || current.Spec.UpdateRequests != existing.Spec.UpdateRequests {
+		return fmt.Errorf("expected the dry run of an update not to persist the instance")
+	}
+
+	err = client.ServicecatalogV1beta1().RESTClient().Post().
+		Namespace("test-namespace").
+		Resource("serviceinstances").
+		Param("dryRun", "true").
+		Body(instance).
+		Do().
+		Error()
+	if !apierrors.IsBadRequest(err) {
+		return fmt.Errorf("expected a dry run with an unsupported value to be rejected, got %v", err)
+	}
+
+	return nil
+}
+
+// TestDryRunBindingClient verifies that a binding created with the dryRun
+// query parameter is defaulted and validated, but not persisted.
+func TestDryRunBindingClient(t *testing.T) {
+	rootTestFunc := func(sType server.StorageType) func(t *testing.T) {
+		return func(t *testing.T) {
+			client, _, shutdownServer := getFreshApiserverAndClient(t, sType.String(), func() runtime.Object {
+				return &servicecatalog.ServiceBinding{}
+			})
+			defer shutdownServer()
+			if err := testDryRunBindingClient(client, "test-binding"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, sType := range storageTypes {
+		if !t.Run(sType.String(), rootTestFunc(sType)) {
+			t.Errorf("%q test failed", sType)
+		}
+	}
+}
+
+func testDryRunBindingClient(client servicecatalogclient.Interface, name string) error {
+	bindingClient := client.Servicecatalog().ServiceBindings("test-namespace")
+
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			ServiceInstanceRef: v1beta1.LocalObjectReference{
+				Name: "test-instance",
+			},
+			Parameters: &runtime.RawExtension{Raw: []byte(bindingParameter)},
+		},
+	}
+
+	dryRun := &v1beta1.ServiceBinding{}
+	if _, err := createDryRun(client, "servicebindings", binding, dryRun); err != nil {
+		return fmt.Errorf("error creating the binding as a dry run (%s)", err)
+	}
+	if dryRun.Spec.SecretName != name {
+		return fmt.Errorf("expected the dry run to default the secret name to %q, got %q", name, dryRun.Spec.SecretName)
+	}
+
+	bindings, err := bindingClient.List(metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing bindings (%s)", err)
+	}
+	if len(bindings.Items) != 0 {
+		return fmt.Errorf("expected the dry run not to persist the binding, had %v bindings", len(bindings.Items))
+	}
+
+	return nil
+}
+
+// createDryRun creates obj in the test namespace as a dry run, decoding the
+// response into result, and returns the response's status code. The
+// generated clients cannot send query parameters with a create.
+func createDryRun(client servicecatalogclient.Interface, resource string, obj, result runtime.Object) (int, error) {
+	var statusCode int
+	err := client.ServicecatalogV1beta1().RESTClient().Post().
+		Namespace("test-namespace").
+		Resource(resource).
+		Param("dryRun", "All").
+		Body(obj).
+		Do().
+		StatusCode(&statusCode).
+		Into(result)
+	return statusCode, err
+}